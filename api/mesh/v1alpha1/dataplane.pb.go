@@ -30,10 +30,13 @@ type Dataplane struct {
 	//
 	// Settings defined here will override their respective defaults
 	// defined at a Mesh level.
-	Metrics              *Metrics `protobuf:"bytes,2,opt,name=metrics,proto3" json:"metrics,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Metrics *Metrics `protobuf:"bytes,2,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	// Cleanup describes whether the dataplane is deleted by the Control Plane
+	// once it has been offline for too long.
+	Cleanup              *Dataplane_Cleanup `protobuf:"bytes,3,opt,name=cleanup,proto3" json:"cleanup,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *Dataplane) Reset()         { *m = Dataplane{} }
@@ -75,6 +78,13 @@ func (m *Dataplane) GetMetrics() *Metrics {
 	return nil
 }
 
+func (m *Dataplane) GetCleanup() *Dataplane_Cleanup {
+	if m != nil {
+		return m.Cleanup
+	}
+	return nil
+}
+
 // Networking describes inbound and outbound interfaces of a dataplane.
 type Dataplane_Networking struct {
 	// Public IP on which the dataplane is accessible in the network.
@@ -177,10 +187,18 @@ type Dataplane_Networking_Inbound struct {
 	// Tags associated with an application this dataplane is deployed next to,
 	// e.g. service=web, version=1.0.
 	// `service` tag is mandatory.
-	Tags                 map[string]string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Tags map[string]string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Health describes the status of the inbound interface.
+	// If not set, the inbound interface is considered ready.
+	// Inbound interfaces that are not ready are excluded from endpoints
+	// of other dataplanes, e.g. while the dataplane is draining.
+	// kuma-dp marks inbound interfaces as not ready when it drains and
+	// as ready again when it is restarted, unless they have been marked
+	// as not ready by a user.
+	Health               *Dataplane_Networking_Inbound_Health `protobuf:"bytes,6,opt,name=health,proto3" json:"health,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
 }
 
 func (m *Dataplane_Networking_Inbound) Reset()         { *m = Dataplane_Networking_Inbound{} }
@@ -243,6 +261,66 @@ func (m *Dataplane_Networking_Inbound) GetTags() map[string]string {
 	return nil
 }
 
+func (m *Dataplane_Networking_Inbound) GetHealth() *Dataplane_Networking_Inbound_Health {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
+// Health describes the status of an inbound interface.
+type Dataplane_Networking_Inbound_Health struct {
+	// Ready indicates whether the inbound interface is ready to receive
+	// traffic from other dataplanes.
+	Ready bool `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	// Drained indicates that the inbound interface has been marked as not
+	// ready by kuma-dp while draining rather than by a user. Only such
+	// inbound interfaces are marked as ready again once kuma-dp is restarted.
+	Drained              bool     `protobuf:"varint,2,opt,name=drained,proto3" json:"drained,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Dataplane_Networking_Inbound_Health) Reset()         { *m = Dataplane_Networking_Inbound_Health{} }
+func (m *Dataplane_Networking_Inbound_Health) String() string { return proto.CompactTextString(m) }
+func (*Dataplane_Networking_Inbound_Health) ProtoMessage()    {}
+func (*Dataplane_Networking_Inbound_Health) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7608682fd5ea84a4, []int{0, 0, 0, 1}
+}
+
+func (m *Dataplane_Networking_Inbound_Health) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Dataplane_Networking_Inbound_Health.Unmarshal(m, b)
+}
+func (m *Dataplane_Networking_Inbound_Health) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Dataplane_Networking_Inbound_Health.Marshal(b, m, deterministic)
+}
+func (m *Dataplane_Networking_Inbound_Health) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dataplane_Networking_Inbound_Health.Merge(m, src)
+}
+func (m *Dataplane_Networking_Inbound_Health) XXX_Size() int {
+	return xxx_messageInfo_Dataplane_Networking_Inbound_Health.Size(m)
+}
+func (m *Dataplane_Networking_Inbound_Health) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dataplane_Networking_Inbound_Health.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Dataplane_Networking_Inbound_Health proto.InternalMessageInfo
+
+func (m *Dataplane_Networking_Inbound_Health) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+func (m *Dataplane_Networking_Inbound_Health) GetDrained() bool {
+	if m != nil {
+		return m.Drained
+	}
+	return false
+}
+
 // Outbound describes a service consumed by the dataplane.
 type Dataplane_Networking_Outbound struct {
 	// DEPRECATED: use networking.address and networking.outbound[].port
@@ -408,51 +486,102 @@ func (m *Dataplane_Networking_TransparentProxying) GetRedirectPort() uint32 {
 	return 0
 }
 
+// Cleanup describes whether the dataplane is deleted by the Control Plane
+// once it has been offline for too long.
+type Dataplane_Cleanup struct {
+	// Disabled opts the dataplane out of the cleanup. It is meant for
+	// statically registered dataplanes that are expected to come back after
+	// a long period of time.
+	Disabled             bool     `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Dataplane_Cleanup) Reset()         { *m = Dataplane_Cleanup{} }
+func (m *Dataplane_Cleanup) String() string { return proto.CompactTextString(m) }
+func (*Dataplane_Cleanup) ProtoMessage()    {}
+func (*Dataplane_Cleanup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7608682fd5ea84a4, []int{0, 1}
+}
+
+func (m *Dataplane_Cleanup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Dataplane_Cleanup.Unmarshal(m, b)
+}
+func (m *Dataplane_Cleanup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Dataplane_Cleanup.Marshal(b, m, deterministic)
+}
+func (m *Dataplane_Cleanup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dataplane_Cleanup.Merge(m, src)
+}
+func (m *Dataplane_Cleanup) XXX_Size() int {
+	return xxx_messageInfo_Dataplane_Cleanup.Size(m)
+}
+func (m *Dataplane_Cleanup) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dataplane_Cleanup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Dataplane_Cleanup proto.InternalMessageInfo
+
+func (m *Dataplane_Cleanup) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Dataplane)(nil), "kuma.mesh.v1alpha1.Dataplane")
 	proto.RegisterType((*Dataplane_Networking)(nil), "kuma.mesh.v1alpha1.Dataplane.Networking")
 	proto.RegisterType((*Dataplane_Networking_Inbound)(nil), "kuma.mesh.v1alpha1.Dataplane.Networking.Inbound")
 	proto.RegisterMapType((map[string]string)(nil), "kuma.mesh.v1alpha1.Dataplane.Networking.Inbound.TagsEntry")
+	proto.RegisterType((*Dataplane_Networking_Inbound_Health)(nil), "kuma.mesh.v1alpha1.Dataplane.Networking.Inbound.Health")
 	proto.RegisterType((*Dataplane_Networking_Outbound)(nil), "kuma.mesh.v1alpha1.Dataplane.Networking.Outbound")
 	proto.RegisterType((*Dataplane_Networking_Gateway)(nil), "kuma.mesh.v1alpha1.Dataplane.Networking.Gateway")
 	proto.RegisterMapType((map[string]string)(nil), "kuma.mesh.v1alpha1.Dataplane.Networking.Gateway.TagsEntry")
 	proto.RegisterType((*Dataplane_Networking_TransparentProxying)(nil), "kuma.mesh.v1alpha1.Dataplane.Networking.TransparentProxying")
+	proto.RegisterType((*Dataplane_Cleanup)(nil), "kuma.mesh.v1alpha1.Dataplane.Cleanup")
 }
 
 func init() { proto.RegisterFile("mesh/v1alpha1/dataplane.proto", fileDescriptor_7608682fd5ea84a4) }
 
 var fileDescriptor_7608682fd5ea84a4 = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xdf, 0x6e, 0xd3, 0x3e,
-	0x14, 0xc7, 0xe5, 0x24, 0x5d, 0x92, 0xb3, 0x5f, 0xa5, 0x9f, 0xbc, 0x49, 0x44, 0x19, 0x48, 0x85,
-	0xab, 0x8a, 0x0b, 0x97, 0x82, 0x10, 0x68, 0xe2, 0x2a, 0x62, 0xe2, 0x8f, 0x34, 0x98, 0xac, 0x5d,
-	0x20, 0x6e, 0x26, 0xaf, 0x31, 0x6d, 0xd4, 0xd6, 0x89, 0x1c, 0xb7, 0x23, 0xaf, 0xc0, 0x23, 0x20,
-	0x1e, 0x91, 0x27, 0x28, 0x17, 0x43, 0x71, 0xec, 0xb4, 0xd3, 0x26, 0x68, 0x2f, 0xb8, 0x73, 0xce,
-	0x39, 0xdf, 0x8f, 0x7d, 0xbe, 0xe7, 0x28, 0xf0, 0x60, 0xce, 0xcb, 0xc9, 0x60, 0x39, 0x64, 0xb3,
-	0x62, 0xc2, 0x86, 0x83, 0x94, 0x29, 0x56, 0xcc, 0x98, 0xe0, 0xa4, 0x90, 0xb9, 0xca, 0x31, 0x9e,
-	0x2e, 0xe6, 0x8c, 0xd4, 0x35, 0xc4, 0xd6, 0xc4, 0x47, 0x37, 0x25, 0x73, 0xae, 0x64, 0x36, 0x2a,
-	0x1b, 0x41, 0x7c, 0x6f, 0xc9, 0x66, 0x59, 0xca, 0x14, 0x1f, 0xd8, 0x43, 0x93, 0x78, 0xf4, 0x2b,
-	0x80, 0xf0, 0xb5, 0xa5, 0xe3, 0xb7, 0x00, 0x82, 0xab, 0xab, 0x5c, 0x4e, 0x33, 0x31, 0x8e, 0x50,
-	0x0f, 0xf5, 0xf7, 0x9f, 0xf6, 0xc9, 0xed, 0xcb, 0x48, 0x2b, 0x21, 0x1f, 0xda, 0x7a, 0xba, 0xa1,
-	0xc5, 0xcf, 0xc1, 0x37, 0x2f, 0x88, 0x1c, 0x8d, 0x39, 0xba, 0x0b, 0x73, 0xda, 0x94, 0x50, 0x5b,
-	0x1b, 0xff, 0xf4, 0x01, 0xd6, 0x44, 0x1c, 0x81, 0xcf, 0xd2, 0x54, 0xf2, 0xb2, 0x8c, 0x3a, 0x3d,
-	0xd4, 0x0f, 0xa9, 0xfd, 0xc4, 0xef, 0xc1, 0x1f, 0x33, 0xc5, 0xaf, 0x58, 0x15, 0xb9, 0x9a, 0xff,
-	0x64, 0xdb, 0x67, 0x92, 0x37, 0x8d, 0x8e, 0x5a, 0x40, 0xcd, 0xca, 0xc4, 0x65, 0xbe, 0x10, 0x69,
-	0x84, 0x7a, 0xee, 0x4e, 0xac, 0x77, 0x8d, 0x8e, 0x5a, 0x00, 0x3e, 0x85, 0x20, 0x5f, 0xa8, 0x06,
-	0xe6, 0x68, 0xd8, 0x70, 0x6b, 0xd8, 0x47, 0x23, 0xa4, 0x2d, 0x02, 0xe7, 0x70, 0xa8, 0x24, 0x13,
-	0x65, 0xc1, 0x24, 0x17, 0xea, 0xa2, 0x90, 0xf9, 0xd7, 0xaa, 0x1e, 0x8d, 0xa7, 0x7b, 0x7e, 0xb5,
-	0x35, 0xfa, 0x7c, 0x0d, 0x39, 0x33, 0x0c, 0x7a, 0xa0, 0x6e, 0x07, 0xe3, 0x6f, 0x0e, 0xf8, 0xa6,
-	0x29, 0x7c, 0x1f, 0xc2, 0x4c, 0x28, 0x2e, 0xbf, 0xb0, 0x11, 0xd7, 0xcb, 0x10, 0xd2, 0x75, 0x00,
-	0x63, 0xf0, 0x8a, 0x5c, 0x2a, 0x6d, 0x7f, 0x97, 0xea, 0x33, 0xee, 0xc1, 0x7e, 0xc9, 0xe5, 0x32,
-	0x1b, 0xf1, 0xb3, 0x3a, 0xe5, 0xe9, 0xd4, 0x66, 0xe8, 0x0f, 0x13, 0xfd, 0x04, 0x9e, 0x62, 0xe3,
-	0xd2, 0xb8, 0x76, 0xbc, 0xeb, 0x08, 0xc8, 0x39, 0x1b, 0x97, 0x27, 0x42, 0xc9, 0x2a, 0x09, 0x56,
-	0x49, 0xe7, 0x3b, 0x72, 0x02, 0x44, 0x35, 0x31, 0x7e, 0x01, 0x61, 0x9b, 0xc4, 0xff, 0x83, 0x3b,
-	0xe5, 0x95, 0x69, 0xa7, 0x3e, 0xe2, 0x43, 0xe8, 0x2c, 0xd9, 0x6c, 0xc1, 0xf5, 0xa2, 0x86, 0xb4,
-	0xf9, 0x38, 0x76, 0x5e, 0xa2, 0xb8, 0x82, 0xc0, 0xce, 0xe4, 0x2f, 0x66, 0x6c, 0xb4, 0xe5, 0xde,
-	0x6c, 0xcb, 0xda, 0xe4, 0x6d, 0xd8, 0xf4, 0x10, 0x7c, 0xe3, 0x49, 0x73, 0x67, 0xe2, 0xaf, 0x12,
-	0x4f, 0x3a, 0x13, 0x44, 0x6d, 0x3c, 0xfe, 0x81, 0xc0, 0x37, 0x8b, 0xda, 0x3a, 0x83, 0x76, 0x74,
-	0xc6, 0xe8, 0xff, 0x85, 0x33, 0x27, 0x70, 0x70, 0xc7, 0x4a, 0x61, 0x02, 0x5d, 0xc9, 0xd3, 0x4c,
-	0xf2, 0x91, 0xba, 0xd0, 0x5d, 0xd7, 0xb0, 0x6e, 0x12, 0xae, 0x92, 0xbd, 0xc7, 0x5e, 0x74, 0x7d,
-	0xed, 0xd2, 0xff, 0x6c, 0xbe, 0xde, 0x86, 0x04, 0x3e, 0x07, 0xb6, 0x85, 0xcb, 0x3d, 0xfd, 0x43,
-	0x7a, 0xf6, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x05, 0x6a, 0x5b, 0x20, 0xfb, 0x04, 0x00, 0x00,
+	// 592 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x5f, 0x6b, 0xdb, 0x3c,
+	0x14, 0xc6, 0x71, 0xec, 0xc6, 0xf6, 0xe9, 0x1b, 0x78, 0x51, 0x0b, 0x33, 0xee, 0x06, 0xd9, 0xa0,
+	0x10, 0x76, 0xe1, 0x2c, 0x1b, 0xa3, 0xa5, 0x0c, 0x06, 0xde, 0xca, 0xba, 0x41, 0xd7, 0x22, 0x7a,
+	0x31, 0x76, 0x53, 0x94, 0x58, 0x4b, 0x4c, 0x1c, 0xd9, 0xc8, 0x4a, 0xba, 0x7c, 0x90, 0xdd, 0x8c,
+	0x7d, 0xc6, 0xdd, 0x0e, 0x72, 0xd3, 0x61, 0xfd, 0x71, 0x52, 0xda, 0x75, 0xc9, 0xee, 0x74, 0xa4,
+	0xf3, 0xfc, 0xa4, 0x73, 0x9e, 0x63, 0xc3, 0xa3, 0x09, 0x2d, 0x47, 0xdd, 0x59, 0x8f, 0x64, 0xc5,
+	0x88, 0xf4, 0xba, 0x09, 0x11, 0xa4, 0xc8, 0x08, 0xa3, 0x51, 0xc1, 0x73, 0x91, 0x23, 0x34, 0x9e,
+	0x4e, 0x48, 0x54, 0xe5, 0x44, 0x26, 0x27, 0xdc, 0xbb, 0x29, 0x99, 0x50, 0xc1, 0xd3, 0x41, 0xa9,
+	0x04, 0xe1, 0x83, 0x19, 0xc9, 0xd2, 0x84, 0x08, 0xda, 0x35, 0x0b, 0x75, 0xf0, 0xe4, 0x27, 0x80,
+	0xff, 0xd6, 0xd0, 0xd1, 0x09, 0x00, 0xa3, 0xe2, 0x2a, 0xe7, 0xe3, 0x94, 0x0d, 0x03, 0xab, 0x6d,
+	0x75, 0xb6, 0x9f, 0x77, 0xa2, 0xdb, 0x97, 0x45, 0xb5, 0x24, 0xfa, 0x58, 0xe7, 0xe3, 0x15, 0x2d,
+	0x7a, 0x09, 0xae, 0x7e, 0x41, 0xd0, 0x90, 0x98, 0xbd, 0xbb, 0x30, 0xa7, 0x2a, 0x05, 0x9b, 0x5c,
+	0xf4, 0x1a, 0xdc, 0x41, 0x46, 0x09, 0x9b, 0x16, 0x81, 0x2d, 0x65, 0xfb, 0xf7, 0xdf, 0xfe, 0x46,
+	0x25, 0x63, 0xa3, 0x0a, 0x7f, 0x79, 0x00, 0xcb, 0x27, 0xa1, 0x00, 0x5c, 0x92, 0x24, 0x9c, 0x96,
+	0x65, 0xb0, 0xd5, 0xb6, 0x3a, 0x3e, 0x36, 0x21, 0xfa, 0x00, 0xee, 0x90, 0x08, 0x7a, 0x45, 0xe6,
+	0xfa, 0xa6, 0x67, 0xeb, 0xd6, 0x19, 0xbd, 0x53, 0x3a, 0x6c, 0x00, 0x15, 0x2b, 0x65, 0xfd, 0x7c,
+	0xca, 0x92, 0xc0, 0x6a, 0xdb, 0x1b, 0xb1, 0xde, 0x2b, 0x1d, 0x36, 0x00, 0x74, 0x0a, 0x5e, 0x3e,
+	0x15, 0x0a, 0xd6, 0x90, 0xb0, 0xde, 0xda, 0xb0, 0x33, 0x2d, 0xc4, 0x35, 0x02, 0xe5, 0xb0, 0x2b,
+	0x38, 0x61, 0x65, 0x41, 0x38, 0x65, 0xe2, 0xb2, 0xe0, 0xf9, 0xd7, 0x79, 0xe5, 0xad, 0x23, 0x6b,
+	0x7e, 0xb5, 0x36, 0xfa, 0x62, 0x09, 0x39, 0xd7, 0x0c, 0xbc, 0x23, 0x6e, 0x6f, 0x86, 0xdf, 0x6c,
+	0x70, 0x75, 0x51, 0xe8, 0x21, 0xf8, 0x29, 0x13, 0x94, 0x7f, 0x21, 0x03, 0x2a, 0xa7, 0xc9, 0xc7,
+	0xcb, 0x0d, 0x84, 0xc0, 0x29, 0x72, 0x2e, 0x64, 0xfb, 0x5b, 0x58, 0xae, 0x51, 0x1b, 0xb6, 0x4b,
+	0xca, 0x67, 0xe9, 0x80, 0x9e, 0x57, 0x47, 0x8e, 0x3c, 0x5a, 0xdd, 0xba, 0xc7, 0xd1, 0x4f, 0xe0,
+	0x08, 0x32, 0x2c, 0x75, 0xd7, 0x8e, 0x36, 0xb5, 0x20, 0xba, 0x20, 0xc3, 0xf2, 0x98, 0x09, 0x3e,
+	0x8f, 0xbd, 0x45, 0xbc, 0xf5, 0xdd, 0x6a, 0x78, 0x16, 0x96, 0x44, 0x74, 0x06, 0xcd, 0x11, 0x25,
+	0x99, 0x18, 0x05, 0x4d, 0xd9, 0xb6, 0x83, 0x8d, 0xd9, 0x27, 0x52, 0x8e, 0x35, 0x26, 0x3c, 0x00,
+	0xbf, 0xbe, 0x0d, 0xfd, 0x0f, 0xf6, 0x98, 0xce, 0x75, 0x7f, 0xaa, 0x25, 0xda, 0x85, 0xad, 0x19,
+	0xc9, 0xa6, 0x54, 0x7e, 0x3a, 0x3e, 0x56, 0xc1, 0x51, 0xe3, 0xd0, 0x0a, 0x0f, 0xa1, 0xa9, 0x50,
+	0x55, 0x0e, 0xa7, 0x24, 0x51, 0x3a, 0x0f, 0xab, 0xa0, 0xea, 0x4e, 0xc2, 0x49, 0xca, 0x68, 0x22,
+	0xb5, 0x1e, 0x36, 0x61, 0x38, 0x07, 0xcf, 0x8c, 0xc7, 0x5f, 0x7c, 0x59, 0xe9, 0xb0, 0x7d, 0xb3,
+	0xc3, 0xc6, 0x31, 0x67, 0xc5, 0xb1, 0xc7, 0xe0, 0x6a, 0x7b, 0xd4, 0x6b, 0x63, 0x77, 0x11, 0x3b,
+	0xbc, 0x31, 0xb2, 0xb0, 0xd9, 0x0f, 0x7f, 0x58, 0xe0, 0xea, 0x6f, 0xa6, 0x36, 0xc9, 0xda, 0xd0,
+	0x24, 0xad, 0xff, 0xb3, 0x49, 0xff, 0xde, 0xd3, 0x63, 0xd8, 0xb9, 0x63, 0xba, 0x51, 0x04, 0x2d,
+	0x4e, 0x93, 0x94, 0xd3, 0x81, 0xb8, 0x94, 0x55, 0x57, 0xb0, 0x56, 0xec, 0x2f, 0xe2, 0xe6, 0x53,
+	0x27, 0xb8, 0xbe, 0xb6, 0xf1, 0x7f, 0xe6, 0xbc, 0x1a, 0xcc, 0x70, 0x1f, 0x5c, 0xfd, 0x37, 0x42,
+	0x21, 0x78, 0x49, 0x5a, 0x92, 0x7e, 0x46, 0x13, 0x6d, 0x4f, 0x1d, 0xc7, 0xf0, 0xd9, 0x33, 0x95,
+	0xf6, 0x9b, 0xf2, 0x1f, 0xfc, 0xe2, 0xf7, 0x00, 0x64, 0x66, 0x52, 0xe5, 0xee, 0x05, 0x00, 0x00,
 }
@@ -63,6 +63,16 @@ func (m *Dataplane) Validate() error {
 		}
 	}
 
+	if v, ok := interface{}(m.GetCleanup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DataplaneValidationError{
+				field:  "Cleanup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -239,6 +249,75 @@ var _ interface {
 	ErrorName() string
 } = Dataplane_NetworkingValidationError{}
 
+// Validate checks the field values on Dataplane_Cleanup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *Dataplane_Cleanup) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Disabled
+
+	return nil
+}
+
+// Dataplane_CleanupValidationError is the validation error returned by
+// Dataplane_Cleanup.Validate if the designated constraints aren't met.
+type Dataplane_CleanupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Dataplane_CleanupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Dataplane_CleanupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Dataplane_CleanupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Dataplane_CleanupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Dataplane_CleanupValidationError) ErrorName() string {
+	return "Dataplane_CleanupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Dataplane_CleanupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataplane_Cleanup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Dataplane_CleanupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Dataplane_CleanupValidationError{}
+
 // Validate checks the field values on Dataplane_Networking_Inbound with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -262,6 +341,16 @@ func (m *Dataplane_Networking_Inbound) Validate() error {
 		}
 	}
 
+	if v, ok := interface{}(m.GetHealth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Dataplane_Networking_InboundValidationError{
+				field:  "Health",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -583,3 +672,73 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = Dataplane_Networking_TransparentProxyingValidationError{}
+
+// Validate checks the field values on Dataplane_Networking_Inbound_Health with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *Dataplane_Networking_Inbound_Health) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Ready
+
+	return nil
+}
+
+// Dataplane_Networking_Inbound_HealthValidationError is the validation error
+// returned by Dataplane_Networking_Inbound_Health.Validate if the designated
+// constraints aren't met.
+type Dataplane_Networking_Inbound_HealthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Dataplane_Networking_Inbound_HealthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Dataplane_Networking_Inbound_HealthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Dataplane_Networking_Inbound_HealthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Dataplane_Networking_Inbound_HealthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Dataplane_Networking_Inbound_HealthValidationError) ErrorName() string {
+	return "Dataplane_Networking_Inbound_HealthValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Dataplane_Networking_Inbound_HealthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataplane_Networking_Inbound_Health.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Dataplane_Networking_Inbound_HealthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Dataplane_Networking_Inbound_HealthValidationError{}
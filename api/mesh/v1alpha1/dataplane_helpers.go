@@ -272,6 +272,16 @@ func (d *Dataplane_Networking_Inbound) GetProtocol() string {
 	return d.Tags[ProtocolTag]
 }
 
+// IsReady returns true if this inbound interface can receive traffic from other dataplanes.
+//
+// Inbound interfaces without health status are considered ready.
+func (d *Dataplane_Networking_Inbound) IsReady() bool {
+	if d == nil {
+		return false
+	}
+	return d.Health == nil || d.Health.Ready
+}
+
 func (d *Dataplane_Networking_Inbound) MatchTags(selector TagSelector) bool {
 	return selector.Matches(d.Tags)
 }
@@ -603,6 +603,40 @@ var _ = Describe("Dataplane_Networking_Inbound", func() {
 			}),
 		)
 	})
+
+	Describe("IsReady()", func() {
+
+		type testCase struct {
+			inbound  *Dataplane_Networking_Inbound
+			expected bool
+		}
+
+		DescribeTable("should determine readiness from `health`",
+			func(given testCase) {
+				Expect(given.inbound.IsReady()).To(Equal(given.expected))
+			},
+			Entry("inbound is `nil`", testCase{
+				inbound:  nil,
+				expected: false,
+			}),
+			Entry("inbound has no `health`", testCase{
+				inbound:  &Dataplane_Networking_Inbound{},
+				expected: true,
+			}),
+			Entry("inbound is ready", testCase{
+				inbound: &Dataplane_Networking_Inbound{
+					Health: &Dataplane_Networking_Inbound_Health{Ready: true},
+				},
+				expected: true,
+			}),
+			Entry("inbound is not ready", testCase{
+				inbound: &Dataplane_Networking_Inbound{
+					Health: &Dataplane_Networking_Inbound_Health{Ready: false},
+				},
+				expected: false,
+			}),
+		)
+	})
 })
 
 var _ = Describe("Dataplane with inbound", func() {
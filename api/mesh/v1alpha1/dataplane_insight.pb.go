@@ -25,10 +25,12 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 // DataplaneInsight defines the observed state of a Dataplane.
 type DataplaneInsight struct {
 	// List of ADS subscriptions created by a given Dataplane.
-	Subscriptions        []*DiscoverySubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	Subscriptions []*DiscoverySubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	// Status of the Envoy process as reported by kuma-dp.
+	Envoy                *EnvoyStatus `protobuf:"bytes,2,opt,name=envoy,proto3" json:"envoy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *DataplaneInsight) Reset()         { *m = DataplaneInsight{} }
@@ -63,6 +65,82 @@ func (m *DataplaneInsight) GetSubscriptions() []*DiscoverySubscription {
 	return nil
 }
 
+func (m *DataplaneInsight) GetEnvoy() *EnvoyStatus {
+	if m != nil {
+		return m.Envoy
+	}
+	return nil
+}
+
+// EnvoyStatus describes the Envoy process that is supervised by kuma-dp.
+type EnvoyStatus struct {
+	// Number of times kuma-dp has restarted Envoy since kuma-dp was started.
+	Restarts uint32 `protobuf:"varint,1,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	// Time of the most recent restart.
+	LastRestartTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=last_restart_time,json=lastRestartTime,proto3" json:"last_restart_time,omitempty"`
+	// Reason of the most recent restart, e.g. exit status of a crashed Envoy
+	// or a change of the bootstrap configuration.
+	LastRestartReason string `protobuf:"bytes,3,opt,name=last_restart_reason,json=lastRestartReason,proto3" json:"last_restart_reason,omitempty"`
+	// CrashLooping is true if Envoy keeps crashing shortly after being started.
+	CrashLooping         bool     `protobuf:"varint,4,opt,name=crash_looping,json=crashLooping,proto3" json:"crash_looping,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnvoyStatus) Reset()         { *m = EnvoyStatus{} }
+func (m *EnvoyStatus) String() string { return proto.CompactTextString(m) }
+func (*EnvoyStatus) ProtoMessage()    {}
+func (*EnvoyStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35794f05b529b342, []int{1}
+}
+
+func (m *EnvoyStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnvoyStatus.Unmarshal(m, b)
+}
+func (m *EnvoyStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnvoyStatus.Marshal(b, m, deterministic)
+}
+func (m *EnvoyStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnvoyStatus.Merge(m, src)
+}
+func (m *EnvoyStatus) XXX_Size() int {
+	return xxx_messageInfo_EnvoyStatus.Size(m)
+}
+func (m *EnvoyStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnvoyStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnvoyStatus proto.InternalMessageInfo
+
+func (m *EnvoyStatus) GetRestarts() uint32 {
+	if m != nil {
+		return m.Restarts
+	}
+	return 0
+}
+
+func (m *EnvoyStatus) GetLastRestartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastRestartTime
+	}
+	return nil
+}
+
+func (m *EnvoyStatus) GetLastRestartReason() string {
+	if m != nil {
+		return m.LastRestartReason
+	}
+	return ""
+}
+
+func (m *EnvoyStatus) GetCrashLooping() bool {
+	if m != nil {
+		return m.CrashLooping
+	}
+	return false
+}
+
 // DiscoverySubscription describes a single ADS subscription
 // created by a Dataplane to the Control Plane.
 // Ideally, there should be only one such subscription per Dataplane lifecycle.
@@ -92,7 +170,7 @@ func (m *DiscoverySubscription) Reset()         { *m = DiscoverySubscription{} }
 func (m *DiscoverySubscription) String() string { return proto.CompactTextString(m) }
 func (*DiscoverySubscription) ProtoMessage()    {}
 func (*DiscoverySubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_35794f05b529b342, []int{1}
+	return fileDescriptor_35794f05b529b342, []int{2}
 }
 
 func (m *DiscoverySubscription) XXX_Unmarshal(b []byte) error {
@@ -171,7 +249,7 @@ func (m *DiscoverySubscriptionStatus) Reset()         { *m = DiscoverySubscripti
 func (m *DiscoverySubscriptionStatus) String() string { return proto.CompactTextString(m) }
 func (*DiscoverySubscriptionStatus) ProtoMessage()    {}
 func (*DiscoverySubscriptionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_35794f05b529b342, []int{2}
+	return fileDescriptor_35794f05b529b342, []int{3}
 }
 
 func (m *DiscoverySubscriptionStatus) XXX_Unmarshal(b []byte) error {
@@ -251,7 +329,7 @@ func (m *DiscoveryServiceStats) Reset()         { *m = DiscoveryServiceStats{} }
 func (m *DiscoveryServiceStats) String() string { return proto.CompactTextString(m) }
 func (*DiscoveryServiceStats) ProtoMessage()    {}
 func (*DiscoveryServiceStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_35794f05b529b342, []int{3}
+	return fileDescriptor_35794f05b529b342, []int{4}
 }
 
 func (m *DiscoveryServiceStats) XXX_Unmarshal(b []byte) error {
@@ -295,6 +373,7 @@ func (m *DiscoveryServiceStats) GetResponsesRejected() uint64 {
 
 func init() {
 	proto.RegisterType((*DataplaneInsight)(nil), "kuma.mesh.v1alpha1.DataplaneInsight")
+	proto.RegisterType((*EnvoyStatus)(nil), "kuma.mesh.v1alpha1.EnvoyStatus")
 	proto.RegisterType((*DiscoverySubscription)(nil), "kuma.mesh.v1alpha1.DiscoverySubscription")
 	proto.RegisterType((*DiscoverySubscriptionStatus)(nil), "kuma.mesh.v1alpha1.DiscoverySubscriptionStatus")
 	proto.RegisterType((*DiscoveryServiceStats)(nil), "kuma.mesh.v1alpha1.DiscoveryServiceStats")
@@ -305,37 +384,43 @@ func init() {
 }
 
 var fileDescriptor_35794f05b529b342 = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x8e, 0xd3, 0x3c,
-	0x14, 0xc5, 0x95, 0xf4, 0xcf, 0xd7, 0xcf, 0x65, 0x86, 0x62, 0x69, 0x66, 0x42, 0x59, 0x50, 0x55,
-	0x1a, 0xa9, 0x2c, 0x48, 0x34, 0x83, 0x58, 0xb1, 0x40, 0x84, 0x4a, 0x68, 0x56, 0x40, 0x0a, 0x1b,
-	0x36, 0x91, 0x6b, 0x5f, 0x5a, 0x33, 0xae, 0x1d, 0xd9, 0x6e, 0x11, 0xaf, 0xc0, 0x13, 0xf0, 0x00,
-	0x3c, 0x01, 0xef, 0xc2, 0xcb, 0xcc, 0x0a, 0xd9, 0x49, 0xda, 0x8c, 0x40, 0x94, 0xee, 0xa2, 0x9c,
-	0xf3, 0xbb, 0x3e, 0x3e, 0xbe, 0xe8, 0x7c, 0x05, 0x66, 0x99, 0x6c, 0x2e, 0x88, 0x28, 0x96, 0xe4,
-	0x22, 0x61, 0xc4, 0x92, 0x42, 0x10, 0x09, 0x39, 0x97, 0x86, 0x2f, 0x96, 0x36, 0x2e, 0xb4, 0xb2,
-	0x0a, 0xe3, 0xeb, 0xf5, 0x8a, 0xc4, 0xce, 0x1b, 0xd7, 0xde, 0xe1, 0xc3, 0x85, 0x52, 0x0b, 0x01,
-	0x89, 0x77, 0xcc, 0xd7, 0x1f, 0x13, 0xcb, 0x57, 0x60, 0x2c, 0x59, 0x15, 0x25, 0x34, 0x3c, 0xdb,
-	0x10, 0xc1, 0x19, 0xb1, 0x90, 0xd4, 0x1f, 0xa5, 0x30, 0xa6, 0x68, 0x30, 0xad, 0x0f, 0xba, 0x2a,
-	0xcf, 0xc1, 0xaf, 0xd1, 0x91, 0x59, 0xcf, 0x0d, 0xd5, 0xbc, 0xb0, 0x5c, 0x49, 0x13, 0x05, 0xa3,
-	0xd6, 0xa4, 0x7f, 0xf9, 0x28, 0xfe, 0xfd, 0xe4, 0x78, 0xca, 0x0d, 0x55, 0x1b, 0xd0, 0x5f, 0x66,
-	0x0d, 0x22, 0xbb, 0xcd, 0x8f, 0x7f, 0x86, 0xe8, 0xe4, 0x8f, 0x46, 0x7c, 0x86, 0x42, 0xce, 0xa2,
-	0x60, 0x14, 0x4c, 0xfe, 0x4f, 0xff, 0xbb, 0x49, 0xdb, 0x3a, 0x1c, 0x04, 0x59, 0xc8, 0x19, 0x4e,
-	0xd1, 0x7d, 0xaa, 0xa4, 0xd5, 0x4a, 0xe4, 0xdb, 0x12, 0x2c, 0x91, 0x14, 0x72, 0xce, 0xa2, 0xf0,
-	0xb6, 0xff, 0xb4, 0x72, 0xbe, 0xa9, 0x2e, 0xe1, 0x7d, 0x57, 0x0c, 0xbf, 0x42, 0x77, 0xa8, 0x92,
-	0x12, 0xa8, 0xcd, 0x5d, 0x1f, 0x51, 0x6b, 0x14, 0x4c, 0xfa, 0x97, 0xc3, 0xb8, 0x2c, 0x2b, 0xae,
-	0xcb, 0x8a, 0xdf, 0xd5, 0x65, 0xa5, 0xbd, 0x9b, 0xb4, 0xf3, 0x23, 0x08, 0x7b, 0x41, 0xd6, 0xaf,
-	0x48, 0xa7, 0xe1, 0x97, 0xe8, 0x2e, 0x73, 0xf1, 0x1b, 0xb3, 0xda, 0xfb, 0x66, 0x65, 0xc7, 0x3b,
-	0xc4, 0x0f, 0x79, 0x8b, 0xba, 0xc6, 0x12, 0xbb, 0x36, 0x51, 0xc7, 0xb3, 0xc9, 0x3f, 0xd7, 0x39,
-	0xf3, 0x98, 0x0f, 0xf7, 0x35, 0x70, 0x17, 0xae, 0x06, 0x8d, 0xbf, 0xb5, 0xd0, 0x83, 0xbf, 0x10,
-	0x78, 0x8a, 0x06, 0x82, 0x18, 0x9b, 0xaf, 0x0b, 0xf7, 0xe2, 0x65, 0xf0, 0x60, 0x7f, 0x70, 0xc7,
-	0xbc, 0xf7, 0x88, 0x0f, 0xfe, 0x1c, 0x75, 0xac, 0xb2, 0x44, 0xf8, 0xda, 0xf7, 0xae, 0x01, 0xe8,
-	0x0d, 0xa7, 0xe0, 0x02, 0x98, 0xac, 0xe4, 0xf0, 0x33, 0xd4, 0xa2, 0xcc, 0x54, 0xf5, 0x1f, 0x80,
-	0x3b, 0xca, 0xc1, 0xc0, 0x4c, 0xd5, 0xf7, 0x21, 0x30, 0x94, 0xb0, 0x60, 0x75, 0xe1, 0x87, 0xc0,
-	0xa2, 0x84, 0x35, 0x33, 0x51, 0xf7, 0x60, 0x58, 0x33, 0x33, 0xfe, 0x1e, 0x34, 0x57, 0xbe, 0x21,
-	0xe3, 0x73, 0x74, 0xac, 0xc1, 0x14, 0x4a, 0x1a, 0x30, 0xb9, 0x01, 0x69, 0xfd, 0x93, 0xb4, 0xb3,
-	0xa3, 0xed, 0xdf, 0x19, 0x48, 0x8b, 0x9f, 0xa2, 0xd3, 0x9d, 0x8d, 0xd0, 0x6b, 0xa9, 0x3e, 0x0b,
-	0x60, 0x0b, 0x28, 0xb7, 0xbf, 0x9d, 0x9d, 0x6c, 0xd5, 0x17, 0x0d, 0x11, 0x3f, 0x46, 0x78, 0x87,
-	0x69, 0xf8, 0x04, 0xd4, 0x02, 0xf3, 0xd5, 0xb7, 0xb3, 0x7b, 0x5b, 0x25, 0xab, 0x84, 0x14, 0x7d,
-	0xe8, 0xd5, 0xb7, 0x99, 0x77, 0xfd, 0x2e, 0x3c, 0xf9, 0x15, 0x00, 0x00, 0xff, 0xff, 0x63, 0xce,
-	0x8c, 0x05, 0x88, 0x04, 0x00, 0x00,
+	// 604 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6e, 0x13, 0x31,
+	0x10, 0xc6, 0xe5, 0xcd, 0xa6, 0xa4, 0x4e, 0xd3, 0x3f, 0x46, 0x6d, 0x97, 0x70, 0x68, 0x14, 0x54,
+	0x29, 0x1c, 0xd8, 0x55, 0x8b, 0x7a, 0xe2, 0x80, 0x58, 0x0a, 0xa8, 0x12, 0x12, 0xe0, 0xc2, 0x85,
+	0xcb, 0xca, 0x5d, 0x9b, 0xc4, 0x74, 0x63, 0xaf, 0x6c, 0x27, 0xa8, 0xaf, 0xc0, 0x13, 0x20, 0xce,
+	0x3c, 0x01, 0x0f, 0xc1, 0x1b, 0xf0, 0x32, 0x3d, 0x21, 0xdb, 0xbb, 0x69, 0x2a, 0x22, 0x42, 0x6e,
+	0xc9, 0xcc, 0xf7, 0x1b, 0xcf, 0x7c, 0x33, 0x0b, 0x0f, 0xc7, 0x4c, 0x8f, 0x92, 0xe9, 0x11, 0x29,
+	0xca, 0x11, 0x39, 0x4a, 0x28, 0x31, 0xa4, 0x2c, 0x88, 0x60, 0x19, 0x17, 0x9a, 0x0f, 0x47, 0x26,
+	0x2e, 0x95, 0x34, 0x12, 0xa1, 0xcb, 0xc9, 0x98, 0xc4, 0x56, 0x1b, 0xd7, 0xda, 0xee, 0xc1, 0x50,
+	0xca, 0x61, 0xc1, 0x12, 0xa7, 0xb8, 0x98, 0x7c, 0x4a, 0x0c, 0x1f, 0x33, 0x6d, 0xc8, 0xb8, 0xf4,
+	0x50, 0x77, 0x7f, 0x4a, 0x0a, 0x4e, 0x89, 0x61, 0x49, 0xfd, 0xc3, 0x27, 0xfa, 0xdf, 0x01, 0xdc,
+	0x3e, 0xad, 0x5f, 0x3a, 0xf3, 0x0f, 0xa1, 0x37, 0xb0, 0xa3, 0x27, 0x17, 0x3a, 0x57, 0xbc, 0x34,
+	0x5c, 0x0a, 0x1d, 0x81, 0x5e, 0x63, 0xd0, 0x3e, 0x7e, 0x18, 0xff, 0xfd, 0x74, 0x7c, 0xca, 0x75,
+	0x2e, 0xa7, 0x4c, 0x5d, 0x9d, 0xcf, 0x11, 0xf8, 0x36, 0x8f, 0x4e, 0x60, 0x93, 0x89, 0xa9, 0xbc,
+	0x8a, 0x82, 0x1e, 0x18, 0xb4, 0x8f, 0x0f, 0x16, 0x15, 0x7a, 0x61, 0x05, 0xe7, 0x86, 0x98, 0x89,
+	0xc6, 0x5e, 0xdd, 0xff, 0x05, 0x60, 0x7b, 0x2e, 0x8c, 0xba, 0xb0, 0xa5, 0xec, 0x58, 0xca, 0xd8,
+	0x96, 0xc0, 0xa0, 0x83, 0x67, 0xff, 0xd1, 0x4b, 0xb8, 0x53, 0x10, 0x6d, 0xb2, 0x2a, 0x90, 0x59,
+	0x07, 0xaa, 0xe7, 0xba, 0xb1, 0xb7, 0x27, 0xae, 0xed, 0x89, 0xdf, 0xd7, 0xf6, 0xe0, 0x2d, 0x0b,
+	0x61, 0xcf, 0xd8, 0x28, 0x8a, 0xe1, 0xdd, 0x5b, 0x75, 0x14, 0x23, 0x5a, 0x8a, 0xa8, 0xd1, 0x03,
+	0x83, 0x75, 0xbc, 0x33, 0xa7, 0xc6, 0x2e, 0x81, 0x1e, 0xc0, 0x4e, 0xae, 0x88, 0x1e, 0x65, 0x85,
+	0x94, 0x25, 0x17, 0xc3, 0x28, 0xec, 0x81, 0x41, 0x0b, 0x6f, 0xb8, 0xe0, 0x6b, 0x1f, 0xeb, 0xff,
+	0x0e, 0xe0, 0xee, 0x42, 0xa3, 0xd0, 0x3e, 0x0c, 0x38, 0x75, 0xc3, 0xac, 0xa7, 0x77, 0xae, 0xd3,
+	0x50, 0x05, 0xdb, 0x00, 0x07, 0x9c, 0xa2, 0x14, 0xde, 0xcb, 0xa5, 0x30, 0x4a, 0x16, 0xd9, 0xec,
+	0x0a, 0x0c, 0x11, 0x39, 0xcb, 0x38, 0x8d, 0x82, 0xdb, 0xfa, 0xbd, 0x4a, 0xf9, 0xb6, 0x5a, 0xa2,
+	0xd3, 0x9d, 0x51, 0xf4, 0x0a, 0x6e, 0xe4, 0x52, 0x08, 0x96, 0x57, 0x76, 0x34, 0x96, 0xd9, 0x91,
+	0xb6, 0xae, 0xd3, 0xe6, 0x4f, 0x10, 0xb4, 0x00, 0x6e, 0x57, 0xa4, 0x33, 0xe5, 0x39, 0xdc, 0xa2,
+	0x5c, 0x57, 0x11, 0x5f, 0x2b, 0x5c, 0x6a, 0xed, 0xe6, 0x0d, 0xe2, 0x8a, 0xbc, 0x83, 0x6b, 0xda,
+	0xed, 0x31, 0x6a, 0x3a, 0x36, 0xf9, 0xef, 0x73, 0xf2, 0xeb, 0x77, 0xcd, 0x7d, 0x05, 0x76, 0xe0,
+	0xaa, 0x50, 0xff, 0x5b, 0x03, 0xde, 0xff, 0x07, 0x81, 0x4e, 0xe1, 0xb6, 0x5b, 0xe6, 0xa4, 0xb4,
+	0x27, 0xef, 0x1b, 0x07, 0xcb, 0x1b, 0xb7, 0xcc, 0x07, 0x87, 0xb8, 0xc6, 0x9f, 0xc2, 0xa6, 0x91,
+	0x86, 0x14, 0xd5, 0x39, 0x2d, 0xf9, 0x0c, 0x98, 0x9a, 0xf2, 0x9c, 0xd9, 0x06, 0x34, 0xf6, 0x1c,
+	0x7a, 0x02, 0x1b, 0x39, 0xd5, 0x51, 0x63, 0x55, 0xdc, 0x52, 0x16, 0x66, 0x54, 0x47, 0xe1, 0xca,
+	0x30, 0xf3, 0x70, 0x41, 0x6b, 0xc3, 0x57, 0x81, 0x0b, 0x0f, 0x2b, 0xaa, 0xa3, 0xb5, 0x95, 0x61,
+	0x45, 0x75, 0xff, 0x07, 0x80, 0xbb, 0x0b, 0xd3, 0xe8, 0x10, 0x6e, 0x2a, 0xa6, 0x4b, 0x29, 0x34,
+	0xd3, 0x99, 0x66, 0xc2, 0xb8, 0x95, 0x84, 0xb8, 0x33, 0x8b, 0x9e, 0x33, 0x61, 0xd0, 0x09, 0xdc,
+	0xbb, 0x91, 0x91, 0xfc, 0x52, 0xc8, 0x2f, 0x05, 0xa3, 0x43, 0xe6, 0xaf, 0x3f, 0xc4, 0xbb, 0xb3,
+	0xec, 0xb3, 0xb9, 0x24, 0x7a, 0x04, 0xd1, 0x0d, 0xa6, 0xd8, 0x67, 0x96, 0x1b, 0x46, 0x9d, 0xf5,
+	0x21, 0xde, 0x99, 0x65, 0x70, 0x95, 0x48, 0xe1, 0xc7, 0x56, 0x3d, 0xcd, 0xc5, 0x9a, 0xbb, 0x85,
+	0xc7, 0x7f, 0x06, 0x00, 0x28, 0x52, 0xb3, 0x7f, 0x89, 0x05, 0x00, 0x00,
 }
@@ -9,7 +9,7 @@ import (
 	util_proto "github.com/Kong/kuma/api/internal/util/proto"
 	. "github.com/Kong/kuma/api/mesh/v1alpha1"
 
-	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v2"
 )
 
 var _ = Describe("DataplaneHelpers", func() {
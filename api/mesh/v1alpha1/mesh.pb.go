@@ -6,6 +6,7 @@ package v1alpha1
 import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	math "math"
 )
@@ -354,6 +355,8 @@ type TracingBackend struct {
 	Sampling *wrappers.DoubleValue `protobuf:"bytes,2,opt,name=sampling,proto3" json:"sampling,omitempty"`
 	// Types that are valid to be assigned to Type:
 	//	*TracingBackend_Zipkin_
+	//	*TracingBackend_Jaeger_
+	//	*TracingBackend_Datadog_
 	Type                 isTracingBackend_Type `protobuf_oneof:"type"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
@@ -407,8 +410,20 @@ type TracingBackend_Zipkin_ struct {
 	Zipkin *TracingBackend_Zipkin `protobuf:"bytes,3,opt,name=zipkin,proto3,oneof"`
 }
 
+type TracingBackend_Jaeger_ struct {
+	Jaeger *TracingBackend_Jaeger `protobuf:"bytes,4,opt,name=jaeger,proto3,oneof"`
+}
+
+type TracingBackend_Datadog_ struct {
+	Datadog *TracingBackend_Datadog `protobuf:"bytes,5,opt,name=datadog,proto3,oneof"`
+}
+
 func (*TracingBackend_Zipkin_) isTracingBackend_Type() {}
 
+func (*TracingBackend_Jaeger_) isTracingBackend_Type() {}
+
+func (*TracingBackend_Datadog_) isTracingBackend_Type() {}
+
 func (m *TracingBackend) GetType() isTracingBackend_Type {
 	if m != nil {
 		return m.Type
@@ -423,10 +438,26 @@ func (m *TracingBackend) GetZipkin() *TracingBackend_Zipkin {
 	return nil
 }
 
+func (m *TracingBackend) GetJaeger() *TracingBackend_Jaeger {
+	if x, ok := m.GetType().(*TracingBackend_Jaeger_); ok {
+		return x.Jaeger
+	}
+	return nil
+}
+
+func (m *TracingBackend) GetDatadog() *TracingBackend_Datadog {
+	if x, ok := m.GetType().(*TracingBackend_Datadog_); ok {
+		return x.Datadog
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*TracingBackend) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*TracingBackend_Zipkin_)(nil),
+		(*TracingBackend_Jaeger_)(nil),
+		(*TracingBackend_Datadog_)(nil),
 	}
 }
 
@@ -491,6 +522,147 @@ func (m *TracingBackend_Zipkin) GetApiVersion() string {
 	return ""
 }
 
+// Jaeger defines configuration of Jaeger tracer. Exactly one of
+// `agentAddress` and `collectorUrl` has to be defined.
+//
+// With `collectorUrl`, spans are reported by Zipkin tracer of Envoy to the
+// Zipkin-compatible endpoint of Jaeger collector, so no Jaeger client
+// library is needed inside Envoy.
+//
+// With `agentAddress`, spans are reported to Jaeger agent by Jaeger client
+// library loaded into Envoy as a dynamic OpenTracing plugin, which requires
+// an Envoy image that ships the library.
+type TracingBackend_Jaeger struct {
+	// Address of Jaeger agent, e.g. `jaeger-agent:6831`.
+	AgentAddress string `protobuf:"bytes,1,opt,name=agentAddress,proto3" json:"agentAddress,omitempty"`
+	// Name of the service that spans are reported for.
+	// Default: service of a dataplane
+	ServiceName string `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	// Path to Jaeger client library plugin inside Envoy container. Can be
+	// used only with `agentAddress`.
+	// Default: /usr/local/lib/libjaegertracing_plugin.so
+	Library string `protobuf:"bytes,3,opt,name=library,proto3" json:"library,omitempty"`
+	// URL of the Zipkin-compatible endpoint of Jaeger collector, e.g.
+	// `http://jaeger-collector:9411/api/v2/spans`.
+	CollectorUrl string `protobuf:"bytes,4,opt,name=collectorUrl,proto3" json:"collectorUrl,omitempty"`
+	// Generate 128bit traces. Default: false
+	TraceId128Bit        bool     `protobuf:"varint,5,opt,name=traceId128bit,proto3" json:"traceId128bit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TracingBackend_Jaeger) Reset()         { *m = TracingBackend_Jaeger{} }
+func (m *TracingBackend_Jaeger) String() string { return proto.CompactTextString(m) }
+func (*TracingBackend_Jaeger) ProtoMessage()    {}
+func (*TracingBackend_Jaeger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{3, 1}
+}
+
+func (m *TracingBackend_Jaeger) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TracingBackend_Jaeger.Unmarshal(m, b)
+}
+func (m *TracingBackend_Jaeger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TracingBackend_Jaeger.Marshal(b, m, deterministic)
+}
+func (m *TracingBackend_Jaeger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TracingBackend_Jaeger.Merge(m, src)
+}
+func (m *TracingBackend_Jaeger) XXX_Size() int {
+	return xxx_messageInfo_TracingBackend_Jaeger.Size(m)
+}
+func (m *TracingBackend_Jaeger) XXX_DiscardUnknown() {
+	xxx_messageInfo_TracingBackend_Jaeger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TracingBackend_Jaeger proto.InternalMessageInfo
+
+func (m *TracingBackend_Jaeger) GetAgentAddress() string {
+	if m != nil {
+		return m.AgentAddress
+	}
+	return ""
+}
+
+func (m *TracingBackend_Jaeger) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
+}
+
+func (m *TracingBackend_Jaeger) GetLibrary() string {
+	if m != nil {
+		return m.Library
+	}
+	return ""
+}
+
+func (m *TracingBackend_Jaeger) GetCollectorUrl() string {
+	if m != nil {
+		return m.CollectorUrl
+	}
+	return ""
+}
+
+func (m *TracingBackend_Jaeger) GetTraceId128Bit() bool {
+	if m != nil {
+		return m.TraceId128Bit
+	}
+	return false
+}
+
+// Datadog defines configuration of Datadog tracer.
+type TracingBackend_Datadog struct {
+	// Address of Datadog agent, e.g. `datadog-agent:8126`.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Name of the service that spans are reported for.
+	// Default: service of a dataplane
+	ServiceName          string   `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TracingBackend_Datadog) Reset()         { *m = TracingBackend_Datadog{} }
+func (m *TracingBackend_Datadog) String() string { return proto.CompactTextString(m) }
+func (*TracingBackend_Datadog) ProtoMessage()    {}
+func (*TracingBackend_Datadog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{3, 2}
+}
+
+func (m *TracingBackend_Datadog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TracingBackend_Datadog.Unmarshal(m, b)
+}
+func (m *TracingBackend_Datadog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TracingBackend_Datadog.Marshal(b, m, deterministic)
+}
+func (m *TracingBackend_Datadog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TracingBackend_Datadog.Merge(m, src)
+}
+func (m *TracingBackend_Datadog) XXX_Size() int {
+	return xxx_messageInfo_TracingBackend_Datadog.Size(m)
+}
+func (m *TracingBackend_Datadog) XXX_DiscardUnknown() {
+	xxx_messageInfo_TracingBackend_Datadog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TracingBackend_Datadog proto.InternalMessageInfo
+
+func (m *TracingBackend_Datadog) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TracingBackend_Datadog) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
+}
+
 type Logging struct {
 	// Name of the default backend
 	DefaultBackend string `protobuf:"bytes,1,opt,name=defaultBackend,proto3" json:"defaultBackend,omitempty"`
@@ -549,9 +721,20 @@ type LoggingBackend struct {
 	// Format of access logs. Placehodlers available on
 	// https://www.envoyproxy.io/docs/envoy/latest/configuration/observability/access_log
 	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// Structured format of access logs, i.e. a map of a field name in a JSON
+	// object to a format string, e.g. `{"status": "%RESPONSE_CODE%"}`.
+	// Cannot be used together with `format`.
+	//
+	// Values of fields that consist of a single numeric command operator,
+	// `%DYNAMIC_METADATA%` or `%FILTER_STATE%` are rendered as JSON numbers and
+	// objects. Structured access logs are rendered by kuma-dp, including the ones
+	// of file backends, so the file is written by kuma-dp rather than Envoy.
+	JsonFormat map[string]string `protobuf:"bytes,5,rep,name=jsonFormat,proto3" json:"jsonFormat,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Types that are valid to be assigned to Type:
 	//	*LoggingBackend_File_
 	//	*LoggingBackend_Tcp_
+	//	*LoggingBackend_Syslog_
+	//	*LoggingBackend_Http_
 	Type                 isLoggingBackend_Type `protobuf_oneof:"type"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
@@ -597,6 +780,13 @@ func (m *LoggingBackend) GetFormat() string {
 	return ""
 }
 
+func (m *LoggingBackend) GetJsonFormat() map[string]string {
+	if m != nil {
+		return m.JsonFormat
+	}
+	return nil
+}
+
 type isLoggingBackend_Type interface {
 	isLoggingBackend_Type()
 }
@@ -609,10 +799,22 @@ type LoggingBackend_Tcp_ struct {
 	Tcp *LoggingBackend_Tcp `protobuf:"bytes,4,opt,name=tcp,proto3,oneof"`
 }
 
+type LoggingBackend_Syslog_ struct {
+	Syslog *LoggingBackend_Syslog `protobuf:"bytes,6,opt,name=syslog,proto3,oneof"`
+}
+
+type LoggingBackend_Http_ struct {
+	Http *LoggingBackend_Http `protobuf:"bytes,7,opt,name=http,proto3,oneof"`
+}
+
 func (*LoggingBackend_File_) isLoggingBackend_Type() {}
 
 func (*LoggingBackend_Tcp_) isLoggingBackend_Type() {}
 
+func (*LoggingBackend_Syslog_) isLoggingBackend_Type() {}
+
+func (*LoggingBackend_Http_) isLoggingBackend_Type() {}
+
 func (m *LoggingBackend) GetType() isLoggingBackend_Type {
 	if m != nil {
 		return m.Type
@@ -634,11 +836,27 @@ func (m *LoggingBackend) GetTcp() *LoggingBackend_Tcp {
 	return nil
 }
 
+func (m *LoggingBackend) GetSyslog() *LoggingBackend_Syslog {
+	if x, ok := m.GetType().(*LoggingBackend_Syslog_); ok {
+		return x.Syslog
+	}
+	return nil
+}
+
+func (m *LoggingBackend) GetHttp() *LoggingBackend_Http {
+	if x, ok := m.GetType().(*LoggingBackend_Http_); ok {
+		return x.Http
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*LoggingBackend) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*LoggingBackend_File_)(nil),
 		(*LoggingBackend_Tcp_)(nil),
+		(*LoggingBackend_Syslog_)(nil),
+		(*LoggingBackend_Http_)(nil),
 	}
 }
 
@@ -654,7 +872,7 @@ func (m *LoggingBackend_File) Reset()         { *m = LoggingBackend_File{} }
 func (m *LoggingBackend_File) String() string { return proto.CompactTextString(m) }
 func (*LoggingBackend_File) ProtoMessage()    {}
 func (*LoggingBackend_File) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae9b3cd8c92bbf6a, []int{5, 0}
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{5, 1}
 }
 
 func (m *LoggingBackend_File) XXX_Unmarshal(b []byte) error {
@@ -693,7 +911,7 @@ func (m *LoggingBackend_Tcp) Reset()         { *m = LoggingBackend_Tcp{} }
 func (m *LoggingBackend_Tcp) String() string { return proto.CompactTextString(m) }
 func (*LoggingBackend_Tcp) ProtoMessage()    {}
 func (*LoggingBackend_Tcp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae9b3cd8c92bbf6a, []int{5, 1}
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{5, 2}
 }
 
 func (m *LoggingBackend_Tcp) XXX_Unmarshal(b []byte) error {
@@ -721,6 +939,136 @@ func (m *LoggingBackend_Tcp) GetAddress() string {
 	return ""
 }
 
+// Syslog defines configuration of a syslog server that receives access logs
+// as RFC 5424 messages.
+type LoggingBackend_Syslog struct {
+	// Address of a syslog server, e.g. `127.0.0.1:514`.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Transport to a syslog server. values: udp, tcp, tls. Default: udp
+	Transport string `protobuf:"bytes,2,opt,name=transport,proto3" json:"transport,omitempty"`
+	// Syslog facility, e.g. `local0`. Default: local0
+	Facility string `protobuf:"bytes,3,opt,name=facility,proto3" json:"facility,omitempty"`
+	// Value of the APP-NAME field of syslog messages. Default: kuma
+	AppName              string   `protobuf:"bytes,4,opt,name=appName,proto3" json:"appName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoggingBackend_Syslog) Reset()         { *m = LoggingBackend_Syslog{} }
+func (m *LoggingBackend_Syslog) String() string { return proto.CompactTextString(m) }
+func (*LoggingBackend_Syslog) ProtoMessage()    {}
+func (*LoggingBackend_Syslog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{5, 3}
+}
+
+func (m *LoggingBackend_Syslog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoggingBackend_Syslog.Unmarshal(m, b)
+}
+func (m *LoggingBackend_Syslog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoggingBackend_Syslog.Marshal(b, m, deterministic)
+}
+func (m *LoggingBackend_Syslog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoggingBackend_Syslog.Merge(m, src)
+}
+func (m *LoggingBackend_Syslog) XXX_Size() int {
+	return xxx_messageInfo_LoggingBackend_Syslog.Size(m)
+}
+func (m *LoggingBackend_Syslog) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoggingBackend_Syslog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoggingBackend_Syslog proto.InternalMessageInfo
+
+func (m *LoggingBackend_Syslog) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LoggingBackend_Syslog) GetTransport() string {
+	if m != nil {
+		return m.Transport
+	}
+	return ""
+}
+
+func (m *LoggingBackend_Syslog) GetFacility() string {
+	if m != nil {
+		return m.Facility
+	}
+	return ""
+}
+
+func (m *LoggingBackend_Syslog) GetAppName() string {
+	if m != nil {
+		return m.AppName
+	}
+	return ""
+}
+
+// Http defines configuration of an HTTP endpoint that receives access logs
+// in batches, as a body of POST requests with a log entry in JSON format per
+// line. Requires `jsonFormat`.
+type LoggingBackend_Http struct {
+	// URL of an HTTP endpoint, e.g. `https://collector.internal/logs`.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Maximum number of log entries in a single request. Default: 100
+	BatchSize uint32 `protobuf:"varint,2,opt,name=batchSize,proto3" json:"batchSize,omitempty"`
+	// Maximum time a log entry can wait for a batch to fill up. Default: 1s
+	FlushInterval        *duration.Duration `protobuf:"bytes,3,opt,name=flushInterval,proto3" json:"flushInterval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *LoggingBackend_Http) Reset()         { *m = LoggingBackend_Http{} }
+func (m *LoggingBackend_Http) String() string { return proto.CompactTextString(m) }
+func (*LoggingBackend_Http) ProtoMessage()    {}
+func (*LoggingBackend_Http) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{5, 4}
+}
+
+func (m *LoggingBackend_Http) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoggingBackend_Http.Unmarshal(m, b)
+}
+func (m *LoggingBackend_Http) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoggingBackend_Http.Marshal(b, m, deterministic)
+}
+func (m *LoggingBackend_Http) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoggingBackend_Http.Merge(m, src)
+}
+func (m *LoggingBackend_Http) XXX_Size() int {
+	return xxx_messageInfo_LoggingBackend_Http.Size(m)
+}
+func (m *LoggingBackend_Http) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoggingBackend_Http.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoggingBackend_Http proto.InternalMessageInfo
+
+func (m *LoggingBackend_Http) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *LoggingBackend_Http) GetBatchSize() uint32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *LoggingBackend_Http) GetFlushInterval() *duration.Duration {
+	if m != nil {
+		return m.FlushInterval
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Mesh)(nil), "kuma.mesh.v1alpha1.Mesh")
 	proto.RegisterType((*Mesh_Mtls)(nil), "kuma.mesh.v1alpha1.Mesh.Mtls")
@@ -730,51 +1078,75 @@ func init() {
 	proto.RegisterType((*Tracing)(nil), "kuma.mesh.v1alpha1.Tracing")
 	proto.RegisterType((*TracingBackend)(nil), "kuma.mesh.v1alpha1.TracingBackend")
 	proto.RegisterType((*TracingBackend_Zipkin)(nil), "kuma.mesh.v1alpha1.TracingBackend.Zipkin")
+	proto.RegisterType((*TracingBackend_Jaeger)(nil), "kuma.mesh.v1alpha1.TracingBackend.Jaeger")
+	proto.RegisterType((*TracingBackend_Datadog)(nil), "kuma.mesh.v1alpha1.TracingBackend.Datadog")
 	proto.RegisterType((*Logging)(nil), "kuma.mesh.v1alpha1.Logging")
 	proto.RegisterType((*LoggingBackend)(nil), "kuma.mesh.v1alpha1.LoggingBackend")
+	proto.RegisterMapType((map[string]string)(nil), "kuma.mesh.v1alpha1.LoggingBackend.JsonFormatEntry")
 	proto.RegisterType((*LoggingBackend_File)(nil), "kuma.mesh.v1alpha1.LoggingBackend.File")
 	proto.RegisterType((*LoggingBackend_Tcp)(nil), "kuma.mesh.v1alpha1.LoggingBackend.Tcp")
+	proto.RegisterType((*LoggingBackend_Syslog)(nil), "kuma.mesh.v1alpha1.LoggingBackend.Syslog")
+	proto.RegisterType((*LoggingBackend_Http)(nil), "kuma.mesh.v1alpha1.LoggingBackend.Http")
 }
 
 func init() { proto.RegisterFile("mesh/v1alpha1/mesh.proto", fileDescriptor_ae9b3cd8c92bbf6a) }
 
 var fileDescriptor_ae9b3cd8c92bbf6a = []byte{
-	// 589 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0xc7, 0xdb, 0x34, 0x6a, 0xd3, 0x33, 0x31, 0x21, 0x0b, 0xa1, 0x28, 0x83, 0x31, 0x55, 0x68,
-	0x8c, 0x9b, 0x94, 0x16, 0x21, 0x4d, 0x48, 0x20, 0xd1, 0x21, 0x54, 0xa4, 0x4d, 0x20, 0xab, 0xda,
-	0x45, 0xaf, 0x70, 0x12, 0xb7, 0xb5, 0xea, 0x24, 0xc6, 0x71, 0x86, 0xc6, 0x3b, 0xf0, 0x42, 0x3c,
-	0x07, 0x8f, 0xc3, 0x05, 0x72, 0x62, 0x97, 0x75, 0xeb, 0x58, 0x2f, 0xb8, 0xf3, 0x39, 0xe7, 0xff,
-	0x3b, 0x1f, 0x39, 0x27, 0xe0, 0xa7, 0xb4, 0x58, 0xf4, 0x2f, 0x06, 0x84, 0x8b, 0x05, 0x19, 0xf4,
-	0xb5, 0x15, 0x0a, 0x99, 0xab, 0x1c, 0xa1, 0x65, 0x99, 0x92, 0xb0, 0x72, 0xd8, 0x70, 0xb0, 0x77,
-	0x5d, 0xad, 0x24, 0x8b, 0x8b, 0x1a, 0x08, 0xf6, 0xe7, 0x79, 0x3e, 0xe7, 0xb4, 0x5f, 0x59, 0x51,
-	0x39, 0xeb, 0x7f, 0x93, 0x44, 0x08, 0x2a, 0x4d, 0xbc, 0xf7, 0xd3, 0x01, 0xf7, 0x8c, 0x16, 0x0b,
-	0x34, 0x00, 0x37, 0x55, 0xbc, 0xf0, 0x9b, 0x07, 0xcd, 0xa3, 0x9d, 0xe1, 0xe3, 0xf0, 0x66, 0xa1,
-	0x50, 0xeb, 0xc2, 0x33, 0xc5, 0x0b, 0x5c, 0x49, 0xd1, 0x2b, 0xe8, 0x28, 0x49, 0x62, 0x96, 0xcd,
-	0x7d, 0xa7, 0xa2, 0xf6, 0x36, 0x51, 0x93, 0x5a, 0x82, 0xad, 0x56, 0x63, 0x3c, 0x9f, 0xcf, 0x35,
-	0xd6, 0xba, 0x1d, 0x3b, 0xad, 0x25, 0xd8, 0x6a, 0x35, 0x66, 0x46, 0xf3, 0xdd, 0xdb, 0xb1, 0xb3,
-	0x5a, 0x82, 0xad, 0x36, 0x98, 0x82, 0xab, 0x5b, 0x46, 0xc7, 0xe0, 0xc4, 0xc4, 0x4c, 0x77, 0xb4,
-	0x89, 0x3c, 0xa1, 0x52, 0xb1, 0x19, 0x8b, 0x89, 0xa2, 0xef, 0x4a, 0xb5, 0xc8, 0x25, 0x53, 0x97,
-	0xd8, 0x89, 0x09, 0xf2, 0xa1, 0x43, 0x33, 0x12, 0x71, 0x9a, 0x54, 0x63, 0x7a, 0xd8, 0x9a, 0xbd,
-	0x5f, 0x4d, 0x78, 0xb0, 0x09, 0x43, 0xa7, 0xd0, 0x89, 0x4a, 0xc6, 0x15, 0xcb, 0x4c, 0xc5, 0x17,
-	0xdb, 0x56, 0x0c, 0x47, 0x35, 0x37, 0x6e, 0x60, 0x9b, 0x02, 0x7d, 0x02, 0x4f, 0xc8, 0xfc, 0x82,
-	0x25, 0xa6, 0x83, 0x9d, 0xe1, 0x60, 0xeb, 0x74, 0x9f, 0x0d, 0x38, 0x6e, 0xe0, 0x55, 0x92, 0xa0,
-	0x0b, 0x1d, 0x53, 0x26, 0x00, 0xf0, 0xac, 0x64, 0xd4, 0x06, 0x57, 0x5d, 0x0a, 0xda, 0xfb, 0x0a,
-	0x1d, 0xb3, 0x34, 0x74, 0x08, 0xbb, 0x09, 0x9d, 0x91, 0x92, 0xab, 0x11, 0x89, 0x97, 0x34, 0x4b,
-	0xaa, 0x79, 0xba, 0xf8, 0x9a, 0x17, 0xbd, 0x05, 0x2f, 0xaa, 0x9f, 0x85, 0xef, 0x1c, 0xb4, 0x8e,
-	0x76, 0x86, 0xbd, 0x7f, 0xdc, 0x82, 0xa1, 0xf0, 0x8a, 0xe9, 0xfd, 0x70, 0x60, 0x77, 0x3d, 0x88,
-	0x10, 0xb8, 0x19, 0x49, 0xa9, 0x29, 0x58, 0xbd, 0xd1, 0x31, 0x78, 0x05, 0x49, 0x05, 0xff, 0x7b,
-	0x72, 0x8f, 0xc2, 0xfa, 0xc0, 0x43, 0x7b, 0xe0, 0xe1, 0xfb, 0xbc, 0x8c, 0x38, 0x3d, 0x27, 0xbc,
-	0xa4, 0x78, 0xa5, 0x46, 0x27, 0xd0, 0xfe, 0xce, 0xc4, 0x92, 0x65, 0xe6, 0xe6, 0x9e, 0xdf, 0xdd,
-	0x5e, 0x38, 0xad, 0x80, 0x71, 0x03, 0x1b, 0x34, 0xf8, 0x02, 0xed, 0xda, 0x87, 0xee, 0x43, 0xab,
-	0x94, 0xdc, 0xf4, 0xa6, 0x9f, 0xe8, 0x29, 0xdc, 0xd3, 0x07, 0x4e, 0x3f, 0x26, 0x83, 0xe1, 0x71,
-	0xc4, 0x94, 0xb9, 0x95, 0x75, 0x27, 0xda, 0x07, 0x20, 0x82, 0x9d, 0x53, 0x59, 0xb0, 0xbc, 0x6e,
-	0xa5, 0x8b, 0xaf, 0x78, 0xae, 0xae, 0xc0, 0xfc, 0x00, 0xff, 0x7b, 0x05, 0x26, 0xed, 0xcd, 0x15,
-	0xfc, 0x6e, 0xc2, 0xee, 0x7a, 0x70, 0xe3, 0x0a, 0x1e, 0x42, 0x7b, 0x96, 0xcb, 0x94, 0xd4, 0x03,
-	0x76, 0xb1, 0xb1, 0xd0, 0x1b, 0x70, 0x67, 0x8c, 0x53, 0xf3, 0x79, 0x9f, 0xdd, 0x5d, 0x3a, 0xfc,
-	0xc0, 0x38, 0x1d, 0x37, 0x70, 0x85, 0xa1, 0xd7, 0xd0, 0x52, 0xb1, 0x30, 0x7f, 0xf6, 0xe1, 0x16,
-	0xf4, 0x24, 0x16, 0xe3, 0x06, 0xd6, 0x50, 0x10, 0x80, 0xab, 0x73, 0xe9, 0x76, 0x05, 0x51, 0x0b,
-	0xdb, 0xae, 0x7e, 0x07, 0x4f, 0xa0, 0x35, 0x89, 0x85, 0xfe, 0x87, 0x49, 0x92, 0x48, 0x5a, 0x14,
-	0x26, 0x6a, 0x4d, 0xfb, 0xc5, 0x47, 0x30, 0xf5, 0x6c, 0xa9, 0xa8, 0x5d, 0x1d, 0xd3, 0xcb, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xce, 0xec, 0x63, 0x75, 0x88, 0x05, 0x00, 0x00,
+	// 892 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xc7, 0xf5, 0x41, 0x91, 0xd2, 0xb8, 0x76, 0x8b, 0x45, 0x50, 0xb0, 0x8c, 0x9b, 0x1a, 0x42,
+	0x91, 0xba, 0x3d, 0xd0, 0x95, 0x8a, 0x02, 0x46, 0x80, 0xb4, 0x88, 0x93, 0x18, 0x4a, 0x10, 0xb7,
+	0xc5, 0xc6, 0xcd, 0xc1, 0xa7, 0x2e, 0xc9, 0x15, 0xb5, 0xd1, 0x8a, 0x64, 0x97, 0x4b, 0x05, 0xca,
+	0xb9, 0xcf, 0xd0, 0x07, 0xe9, 0xb1, 0xcf, 0xd1, 0x07, 0x2a, 0xf6, 0x83, 0xb2, 0x65, 0x49, 0xb1,
+	0x0e, 0xb9, 0x71, 0x66, 0xe7, 0x37, 0x3b, 0xb3, 0x3b, 0xff, 0x05, 0xc1, 0x9f, 0xd1, 0x72, 0x72,
+	0x32, 0x1f, 0x10, 0x5e, 0x4c, 0xc8, 0xe0, 0x44, 0x59, 0x61, 0x21, 0x72, 0x99, 0x23, 0x34, 0xad,
+	0x66, 0x24, 0xd4, 0x8e, 0x7a, 0x39, 0xb8, 0x7f, 0x3b, 0x5a, 0x0a, 0x16, 0x97, 0x06, 0x08, 0x1e,
+	0xa4, 0x79, 0x9e, 0x72, 0x7a, 0xa2, 0xad, 0xa8, 0x1a, 0x9f, 0x24, 0x95, 0x20, 0x92, 0xe5, 0xd9,
+	0xb6, 0xf5, 0x77, 0x82, 0x14, 0x05, 0x15, 0x96, 0xef, 0xff, 0xdb, 0x02, 0xe7, 0x82, 0x96, 0x13,
+	0x34, 0x00, 0x67, 0x26, 0x79, 0xe9, 0x37, 0x8f, 0x9a, 0xc7, 0x7b, 0xc3, 0x2f, 0xc3, 0xf5, 0x42,
+	0x42, 0x15, 0x17, 0x5e, 0x48, 0x5e, 0x62, 0x1d, 0x8a, 0x7e, 0x04, 0x4f, 0x0a, 0x12, 0xb3, 0x2c,
+	0xf5, 0x5b, 0x9a, 0xba, 0xbf, 0x89, 0xba, 0x34, 0x21, 0xb8, 0x8e, 0x55, 0x18, 0xcf, 0xd3, 0x54,
+	0x61, 0xed, 0xed, 0xd8, 0x2b, 0x13, 0x82, 0xeb, 0x58, 0x85, 0xd9, 0xd6, 0x7d, 0x67, 0x3b, 0x76,
+	0x61, 0x42, 0x70, 0x1d, 0x1b, 0x5c, 0x81, 0xa3, 0x4a, 0x46, 0xa7, 0xd0, 0x8a, 0x89, 0xed, 0xee,
+	0x78, 0x13, 0xf9, 0x94, 0x0a, 0xc9, 0xc6, 0x2c, 0x26, 0x92, 0x3e, 0xa9, 0xe4, 0x24, 0x17, 0x4c,
+	0x2e, 0x70, 0x2b, 0x26, 0xc8, 0x07, 0x8f, 0x66, 0x24, 0xe2, 0x34, 0xd1, 0x6d, 0x76, 0x71, 0x6d,
+	0xf6, 0xff, 0x6b, 0xc2, 0xbd, 0x4d, 0x18, 0x7a, 0x05, 0x5e, 0x54, 0x31, 0x2e, 0x59, 0x66, 0x77,
+	0xfc, 0x7e, 0xd7, 0x1d, 0xc3, 0x33, 0xc3, 0x8d, 0x1a, 0xb8, 0x4e, 0x81, 0x7e, 0x85, 0x6e, 0x21,
+	0xf2, 0x39, 0x4b, 0x6c, 0x05, 0x7b, 0xc3, 0xc1, 0xce, 0xe9, 0x7e, 0xb3, 0xe0, 0xa8, 0x81, 0x97,
+	0x49, 0x82, 0x1e, 0x78, 0x76, 0x9b, 0x00, 0xa0, 0x5b, 0x87, 0x9c, 0xb9, 0xe0, 0xc8, 0x45, 0x41,
+	0xfb, 0x7f, 0x82, 0x67, 0x2f, 0x0d, 0x3d, 0x84, 0x83, 0x84, 0x8e, 0x49, 0xc5, 0xe5, 0x19, 0x89,
+	0xa7, 0x34, 0x4b, 0x74, 0x3f, 0x3d, 0x7c, 0xcb, 0x8b, 0x7e, 0x82, 0x6e, 0x64, 0x3e, 0x4b, 0xbf,
+	0x75, 0xd4, 0x3e, 0xde, 0x1b, 0xf6, 0x3f, 0x30, 0x0b, 0x96, 0xc2, 0x4b, 0xa6, 0xff, 0x77, 0x07,
+	0x0e, 0x56, 0x17, 0x11, 0x02, 0x27, 0x23, 0x33, 0x6a, 0x37, 0xd4, 0xdf, 0xe8, 0x14, 0xba, 0x25,
+	0x99, 0x15, 0xfc, 0x7a, 0xe4, 0x0e, 0x43, 0x33, 0xe0, 0x61, 0x3d, 0xe0, 0xe1, 0xb3, 0xbc, 0x8a,
+	0x38, 0x7d, 0x43, 0x78, 0x45, 0xf1, 0x32, 0x1a, 0x3d, 0x05, 0xf7, 0x3d, 0x2b, 0xa6, 0x2c, 0xb3,
+	0x33, 0xf7, 0xed, 0xdd, 0xe5, 0x85, 0x57, 0x1a, 0x18, 0x35, 0xb0, 0x45, 0x55, 0x92, 0xb7, 0x84,
+	0xa6, 0x54, 0xf8, 0xce, 0xce, 0x49, 0x5e, 0x6a, 0x40, 0x25, 0x31, 0x28, 0x3a, 0x07, 0x2f, 0x21,
+	0x92, 0x24, 0x79, 0xea, 0x77, 0x74, 0x96, 0xef, 0x76, 0xc8, 0xf2, 0xcc, 0x10, 0x6a, 0x2a, 0x2c,
+	0x1c, 0xfc, 0x01, 0xae, 0x29, 0x10, 0x7d, 0x06, 0xed, 0x4a, 0x70, 0x7b, 0x50, 0xea, 0x13, 0x7d,
+	0x0d, 0xfb, 0x4a, 0x6d, 0xf4, 0x45, 0x32, 0x18, 0x9e, 0x46, 0x4c, 0xda, 0xc1, 0x5d, 0x75, 0xa2,
+	0x07, 0x00, 0xa4, 0x60, 0x6f, 0xa8, 0x28, 0x59, 0x6e, 0xce, 0xa5, 0x87, 0x6f, 0x78, 0x82, 0x7f,
+	0x9a, 0xe0, 0x9a, 0xf2, 0x51, 0x1f, 0x3e, 0x21, 0x29, 0xcd, 0xe4, 0x93, 0x24, 0x11, 0xb4, 0x2c,
+	0xed, 0x5e, 0x2b, 0x3e, 0x74, 0x04, 0x7b, 0x25, 0x15, 0x73, 0x16, 0xd3, 0x5f, 0xd4, 0xbd, 0xb5,
+	0x74, 0xc8, 0x4d, 0x97, 0x52, 0x12, 0x67, 0x91, 0x20, 0x62, 0x61, 0x77, 0xab, 0x4d, 0x95, 0x3f,
+	0xce, 0x39, 0xa7, 0xb1, 0xcc, 0xc5, 0xef, 0x82, 0xeb, 0xf3, 0xed, 0xe1, 0x15, 0xdf, 0x7a, 0x53,
+	0x9d, 0x0d, 0x4d, 0x05, 0xcf, 0xc1, 0xb3, 0x87, 0xa5, 0xb6, 0x23, 0x2b, 0xf5, 0x7a, 0x64, 0xd7,
+	0x52, 0x6f, 0x6a, 0xc1, 0xbe, 0x44, 0x1f, 0x5b, 0x0b, 0x36, 0xed, 0xba, 0x16, 0xfe, 0x72, 0xe1,
+	0x60, 0x75, 0x71, 0xa3, 0x16, 0x3e, 0x07, 0x77, 0x9c, 0x8b, 0x19, 0x91, 0xb6, 0x7c, 0x6b, 0x21,
+	0x0c, 0xf0, 0xb6, 0xcc, 0xb3, 0x73, 0xb3, 0xd6, 0xd1, 0x05, 0x0c, 0xef, 0x2e, 0x20, 0x7c, 0xb9,
+	0x84, 0x9e, 0x67, 0x52, 0x2c, 0xf0, 0x8d, 0x2c, 0xe8, 0x31, 0x38, 0x63, 0xc6, 0xa9, 0xd5, 0xce,
+	0x37, 0x3b, 0x64, 0x3b, 0x67, 0x9c, 0x8e, 0x1a, 0x58, 0x63, 0xe8, 0x11, 0xb4, 0x65, 0x5c, 0x58,
+	0xd1, 0x3c, 0xdc, 0x81, 0xbe, 0x8c, 0x8b, 0x51, 0x03, 0x2b, 0x48, 0x69, 0xae, 0x5c, 0x94, 0x3c,
+	0x4f, 0x7d, 0x77, 0xbb, 0xe6, 0x6e, 0xe1, 0xaf, 0x35, 0xa0, 0x34, 0x67, 0x50, 0x55, 0xff, 0x44,
+	0xca, 0xc2, 0xf7, 0x76, 0xae, 0x7f, 0x24, 0xa5, 0x2a, 0x41, 0x63, 0xc1, 0x63, 0xf8, 0xf4, 0xd6,
+	0xe9, 0x28, 0xcd, 0x4d, 0xe9, 0xa2, 0xd6, 0xdc, 0x94, 0x2e, 0xd0, 0x3d, 0xe8, 0xcc, 0xd5, 0xa3,
+	0x63, 0xaf, 0xc3, 0x18, 0x8f, 0x5a, 0xa7, 0xcd, 0x20, 0x00, 0x47, 0x1d, 0x87, 0xba, 0xc5, 0x82,
+	0xc8, 0x49, 0x7d, 0x8b, 0xea, 0x3b, 0xf8, 0x0a, 0xda, 0x97, 0x71, 0xb1, 0x7d, 0x54, 0x83, 0x39,
+	0xb8, 0xa6, 0x9d, 0x0f, 0x8c, 0xf3, 0x21, 0xf4, 0xa4, 0x20, 0x59, 0x59, 0xe4, 0xa2, 0x9e, 0x86,
+	0x6b, 0x07, 0x0a, 0xa0, 0x3b, 0x26, 0x31, 0xe3, 0x4c, 0xd6, 0xb2, 0x5b, 0xda, 0x3a, 0x67, 0x51,
+	0x68, 0x11, 0x38, 0x36, 0xa7, 0x31, 0x83, 0x77, 0xe0, 0xa8, 0x33, 0xd8, 0xf0, 0xb8, 0x1c, 0x42,
+	0x2f, 0x22, 0x32, 0x9e, 0xbc, 0x66, 0xef, 0x4d, 0xb3, 0xfb, 0xf8, 0xda, 0x81, 0x7e, 0x86, 0xfd,
+	0x31, 0xaf, 0xca, 0xc9, 0x8b, 0x4c, 0x52, 0x31, 0x27, 0xdc, 0xce, 0xcc, 0x17, 0xeb, 0xef, 0xb4,
+	0xfd, 0x51, 0xc1, 0xab, 0xf1, 0xb5, 0xf2, 0xce, 0xe0, 0xaa, 0x5b, 0x5f, 0x4e, 0xe4, 0x6a, 0xea,
+	0x87, 0xff, 0x07, 0x00, 0x73, 0xf3, 0x4a, 0xad, 0x39, 0x09, 0x00, 0x00,
 }
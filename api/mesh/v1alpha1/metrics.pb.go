@@ -6,6 +6,7 @@ package v1alpha1
 import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	math "math"
 )
 
@@ -25,10 +26,13 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type Metrics struct {
 	// Prometheus-specific configuration for metrics that should be collected and
 	// exposed by dataplanes.
-	Prometheus           *Metrics_Prometheus `protobuf:"bytes,1,opt,name=prometheus,proto3" json:"prometheus,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	Prometheus *Metrics_Prometheus `protobuf:"bytes,1,opt,name=prometheus,proto3" json:"prometheus,omitempty"`
+	// StatsD-specific configuration for metrics that should be pushed by
+	// dataplanes.
+	Statsd               *Metrics_StatsD `protobuf:"bytes,2,opt,name=statsd,proto3" json:"statsd,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *Metrics) Reset()         { *m = Metrics{} }
@@ -63,6 +67,13 @@ func (m *Metrics) GetPrometheus() *Metrics_Prometheus {
 	return nil
 }
 
+func (m *Metrics) GetStatsd() *Metrics_StatsD {
+	if m != nil {
+		return m.Statsd
+	}
+	return nil
+}
+
 // Prometheus defines Prometheus-specific configuration for metrics that
 // should be collected and exposed by dataplanes.
 type Metrics_Prometheus struct {
@@ -71,10 +82,16 @@ type Metrics_Prometheus struct {
 	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
 	// Path on which a dataplane should expose HTTP endpoint with Prometheus
 	// metrics.
-	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// If true, the endpoint is protected by mTLS, i.e. scrapes have to
+	// present a client certificate issued by the CA of the mesh.
+	//
+	// It can only be enabled on a Mesh with mTLS. Otherwise, a dataplane
+	// does not expose the endpoint at all.
+	Mtls                 *wrappers.BoolValue `protobuf:"bytes,3,opt,name=mtls,proto3" json:"mtls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *Metrics_Prometheus) Reset()         { *m = Metrics_Prometheus{} }
@@ -116,23 +133,102 @@ func (m *Metrics_Prometheus) GetPath() string {
 	return ""
 }
 
+func (m *Metrics_Prometheus) GetMtls() *wrappers.BoolValue {
+	if m != nil {
+		return m.Mtls
+	}
+	return nil
+}
+
+// StatsD defines a StatsD server that dataplanes push their metrics to.
+// StatsD sinks are a part of the bootstrap configuration of Envoy, so
+// changes take effect only after a dataplane is restarted.
+type Metrics_StatsD struct {
+	// Address of the StatsD server in format of IP:PORT, e.g.
+	// `127.0.0.1:8125`. Metrics are sent over UDP.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Prefix of names of metrics. Defaults to `envoy`.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Format of tags of metrics. Either `none` (tags stay a part of names of
+	// metrics) or `dogstatsd` (tags are sent in DogStatsD format, e.g. to a
+	// Datadog agent). Defaults to `none`.
+	TagFormat            string   `protobuf:"bytes,3,opt,name=tagFormat,proto3" json:"tagFormat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Metrics_StatsD) Reset()         { *m = Metrics_StatsD{} }
+func (m *Metrics_StatsD) String() string { return proto.CompactTextString(m) }
+func (*Metrics_StatsD) ProtoMessage()    {}
+func (*Metrics_StatsD) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd8c7f420ce268c, []int{0, 1}
+}
+
+func (m *Metrics_StatsD) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Metrics_StatsD.Unmarshal(m, b)
+}
+func (m *Metrics_StatsD) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Metrics_StatsD.Marshal(b, m, deterministic)
+}
+func (m *Metrics_StatsD) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Metrics_StatsD.Merge(m, src)
+}
+func (m *Metrics_StatsD) XXX_Size() int {
+	return xxx_messageInfo_Metrics_StatsD.Size(m)
+}
+func (m *Metrics_StatsD) XXX_DiscardUnknown() {
+	xxx_messageInfo_Metrics_StatsD.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Metrics_StatsD proto.InternalMessageInfo
+
+func (m *Metrics_StatsD) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Metrics_StatsD) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *Metrics_StatsD) GetTagFormat() string {
+	if m != nil {
+		return m.TagFormat
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Metrics)(nil), "kuma.mesh.v1alpha1.Metrics")
 	proto.RegisterType((*Metrics_Prometheus)(nil), "kuma.mesh.v1alpha1.Metrics.Prometheus")
+	proto.RegisterType((*Metrics_StatsD)(nil), "kuma.mesh.v1alpha1.Metrics.StatsD")
 }
 
 func init() { proto.RegisterFile("mesh/v1alpha1/metrics.proto", fileDescriptor_7dd8c7f420ce268c) }
 
 var fileDescriptor_7dd8c7f420ce268c = []byte{
-	// 153 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x4d, 0x2d, 0xce,
-	0xd0, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8, 0x48, 0x34, 0xd4, 0xcf, 0x4d, 0x2d, 0x29, 0xca, 0x4c,
-	0x2e, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0x2e, 0xcd, 0x4d, 0xd4, 0x03, 0xa9,
-	0xd0, 0x83, 0xa9, 0x50, 0x6a, 0x67, 0xe4, 0x62, 0xf7, 0x85, 0xa8, 0x12, 0x72, 0xe3, 0xe2, 0x2a,
-	0x28, 0xca, 0xcf, 0x4d, 0x2d, 0xc9, 0x48, 0x2d, 0x2d, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36,
-	0x52, 0xd3, 0xc3, 0xd4, 0xa4, 0x07, 0xd5, 0xa0, 0x17, 0x00, 0x57, 0x1d, 0x84, 0xa4, 0x53, 0xca,
-	0x84, 0x8b, 0x0b, 0x21, 0x23, 0x24, 0xc4, 0xc5, 0x52, 0x90, 0x5f, 0x54, 0x02, 0x36, 0x8f, 0x37,
-	0x08, 0xcc, 0x06, 0x8b, 0x25, 0x96, 0x64, 0x48, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x06, 0x81, 0xd9,
-	0x4e, 0x5c, 0x51, 0x1c, 0x30, 0x0b, 0x92, 0xd8, 0xc0, 0x0e, 0x36, 0x06, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xbf, 0xe9, 0xed, 0xe9, 0xcf, 0x00, 0x00, 0x00,
+	// 267 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x8f, 0x41, 0x4b, 0xc3, 0x40,
+	0x10, 0x85, 0x69, 0x2d, 0xa9, 0x19, 0xf1, 0xb2, 0x07, 0x09, 0x51, 0x44, 0x7a, 0x10, 0x4f, 0x13,
+	0xaa, 0x37, 0x8f, 0x45, 0x7a, 0x13, 0x64, 0x05, 0x11, 0x6f, 0x53, 0xb3, 0x6d, 0x8a, 0x59, 0x76,
+	0xd9, 0x9d, 0xa8, 0x7f, 0xd2, 0xff, 0x24, 0x9d, 0x6c, 0xac, 0x20, 0x78, 0x9b, 0x19, 0xde, 0xfb,
+	0xde, 0x3c, 0x38, 0xb5, 0x26, 0x36, 0xd5, 0xfb, 0x9c, 0x5a, 0xdf, 0xd0, 0xbc, 0xb2, 0x86, 0xc3,
+	0xf6, 0x35, 0xa2, 0x0f, 0x8e, 0x9d, 0x52, 0x6f, 0x9d, 0x25, 0xdc, 0x29, 0x70, 0x50, 0x94, 0xe7,
+	0x1b, 0xe7, 0x36, 0xad, 0xa9, 0x44, 0xb1, 0xea, 0xd6, 0xd5, 0x47, 0x20, 0xef, 0x4d, 0x48, 0x9e,
+	0xd9, 0xd7, 0x18, 0xa6, 0xf7, 0x3d, 0x45, 0x2d, 0x01, 0x7c, 0x70, 0xd6, 0x70, 0x63, 0xba, 0x58,
+	0x8c, 0x2e, 0x46, 0x57, 0x47, 0xd7, 0x97, 0xf8, 0x17, 0x8a, 0xc9, 0x80, 0x0f, 0x3f, 0x6a, 0xfd,
+	0xcb, 0xa9, 0x6e, 0x21, 0x8b, 0x4c, 0x1c, 0xeb, 0x62, 0x2c, 0x8c, 0xd9, 0x7f, 0x8c, 0xc7, 0x9d,
+	0xf2, 0x4e, 0x27, 0x47, 0x59, 0x03, 0xec, 0xa9, 0x4a, 0xc1, 0xc4, 0xbb, 0xc0, 0xf2, 0xcb, 0xb1,
+	0x96, 0x59, 0x6e, 0xc4, 0x8d, 0xb0, 0x73, 0x2d, 0xb3, 0x42, 0x98, 0x58, 0x6e, 0x63, 0x71, 0x20,
+	0x79, 0x25, 0xf6, 0xa5, 0x71, 0x28, 0x8d, 0x0b, 0xe7, 0xda, 0x27, 0x6a, 0x3b, 0xa3, 0x45, 0x57,
+	0x3e, 0x43, 0xd6, 0xe7, 0xaa, 0x02, 0xa6, 0x54, 0xd7, 0xc1, 0xc4, 0xbe, 0x70, 0xae, 0x87, 0x55,
+	0x9d, 0x40, 0xe6, 0x83, 0x59, 0x6f, 0x3f, 0x53, 0x52, 0xda, 0xd4, 0x19, 0xe4, 0x4c, 0x9b, 0xa5,
+	0x0b, 0x96, 0x58, 0x02, 0x73, 0xbd, 0x3f, 0x2c, 0xe0, 0xe5, 0x70, 0xa8, 0xb8, 0xca, 0x24, 0xff,
+	0xe6, 0x7b, 0x00, 0x0a, 0x1e, 0xb8, 0xc8, 0xb5, 0x01, 0x00, 0x00,
 }
@@ -6,6 +6,7 @@ package v1alpha1
 import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	math "math"
 )
 
@@ -73,7 +74,15 @@ func (m *TrafficTrace) GetConf() *TrafficTrace_Conf {
 // Configuration defines settings of the tracing.
 type TrafficTrace_Conf struct {
 	// Backend defined in the Mesh entity.
-	Backend              string   `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	Backend string `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	// Percentage of requests that will be traced (range 0.0 - 100.0).
+	// Overrides sampling of the backend.
+	Sampling *wrappers.DoubleValue `protobuf:"bytes,2,opt,name=sampling,proto3" json:"sampling,omitempty"`
+	// Custom tags added to spans.
+	Tags []*TrafficTrace_Conf_Tag `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Paths of requests to a dataplane that are not traced, e.g. paths of
+	// health checks. Paths are matched exactly.
+	ExcludedPaths        []string `protobuf:"bytes,4,rep,name=excludedPaths,proto3" json:"excludedPaths,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -111,25 +120,268 @@ func (m *TrafficTrace_Conf) GetBackend() string {
 	return ""
 }
 
+func (m *TrafficTrace_Conf) GetSampling() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Sampling
+	}
+	return nil
+}
+
+func (m *TrafficTrace_Conf) GetTags() []*TrafficTrace_Conf_Tag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *TrafficTrace_Conf) GetExcludedPaths() []string {
+	if m != nil {
+		return m.ExcludedPaths
+	}
+	return nil
+}
+
+// Tag defines a custom tag added to spans.
+type TrafficTrace_Conf_Tag struct {
+	// Name of the tag.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//	*TrafficTrace_Conf_Tag_Header_
+	//	*TrafficTrace_Conf_Tag_Literal
+	//	*TrafficTrace_Conf_Tag_Env_
+	Value                isTrafficTrace_Conf_Tag_Value `protobuf_oneof:"value"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *TrafficTrace_Conf_Tag) Reset()         { *m = TrafficTrace_Conf_Tag{} }
+func (m *TrafficTrace_Conf_Tag) String() string { return proto.CompactTextString(m) }
+func (*TrafficTrace_Conf_Tag) ProtoMessage()    {}
+func (*TrafficTrace_Conf_Tag) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc2b4b31d8d46cbb, []int{0, 0, 0}
+}
+
+func (m *TrafficTrace_Conf_Tag) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficTrace_Conf_Tag.Unmarshal(m, b)
+}
+func (m *TrafficTrace_Conf_Tag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficTrace_Conf_Tag.Marshal(b, m, deterministic)
+}
+func (m *TrafficTrace_Conf_Tag) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficTrace_Conf_Tag.Merge(m, src)
+}
+func (m *TrafficTrace_Conf_Tag) XXX_Size() int {
+	return xxx_messageInfo_TrafficTrace_Conf_Tag.Size(m)
+}
+func (m *TrafficTrace_Conf_Tag) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficTrace_Conf_Tag.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficTrace_Conf_Tag proto.InternalMessageInfo
+
+func (m *TrafficTrace_Conf_Tag) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type isTrafficTrace_Conf_Tag_Value interface {
+	isTrafficTrace_Conf_Tag_Value()
+}
+
+type TrafficTrace_Conf_Tag_Header_ struct {
+	Header *TrafficTrace_Conf_Tag_Header `protobuf:"bytes,2,opt,name=header,proto3,oneof"`
+}
+
+type TrafficTrace_Conf_Tag_Literal struct {
+	Literal string `protobuf:"bytes,3,opt,name=literal,proto3,oneof"`
+}
+
+type TrafficTrace_Conf_Tag_Env_ struct {
+	Env *TrafficTrace_Conf_Tag_Env `protobuf:"bytes,4,opt,name=env,proto3,oneof"`
+}
+
+func (*TrafficTrace_Conf_Tag_Header_) isTrafficTrace_Conf_Tag_Value() {}
+
+func (*TrafficTrace_Conf_Tag_Literal) isTrafficTrace_Conf_Tag_Value() {}
+
+func (*TrafficTrace_Conf_Tag_Env_) isTrafficTrace_Conf_Tag_Value() {}
+
+func (m *TrafficTrace_Conf_Tag) GetValue() isTrafficTrace_Conf_Tag_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *TrafficTrace_Conf_Tag) GetHeader() *TrafficTrace_Conf_Tag_Header {
+	if x, ok := m.GetValue().(*TrafficTrace_Conf_Tag_Header_); ok {
+		return x.Header
+	}
+	return nil
+}
+
+func (m *TrafficTrace_Conf_Tag) GetLiteral() string {
+	if x, ok := m.GetValue().(*TrafficTrace_Conf_Tag_Literal); ok {
+		return x.Literal
+	}
+	return ""
+}
+
+func (m *TrafficTrace_Conf_Tag) GetEnv() *TrafficTrace_Conf_Tag_Env {
+	if x, ok := m.GetValue().(*TrafficTrace_Conf_Tag_Env_); ok {
+		return x.Env
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*TrafficTrace_Conf_Tag) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*TrafficTrace_Conf_Tag_Header_)(nil),
+		(*TrafficTrace_Conf_Tag_Literal)(nil),
+		(*TrafficTrace_Conf_Tag_Env_)(nil),
+	}
+}
+
+// Header defines a value of a tag taken from a request header.
+type TrafficTrace_Conf_Tag_Header struct {
+	// Name of the request header.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Value of the tag when the request header is absent.
+	Default              string   `protobuf:"bytes,2,opt,name=default,proto3" json:"default,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrafficTrace_Conf_Tag_Header) Reset()         { *m = TrafficTrace_Conf_Tag_Header{} }
+func (m *TrafficTrace_Conf_Tag_Header) String() string { return proto.CompactTextString(m) }
+func (*TrafficTrace_Conf_Tag_Header) ProtoMessage()    {}
+func (*TrafficTrace_Conf_Tag_Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc2b4b31d8d46cbb, []int{0, 0, 0, 0}
+}
+
+func (m *TrafficTrace_Conf_Tag_Header) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficTrace_Conf_Tag_Header.Unmarshal(m, b)
+}
+func (m *TrafficTrace_Conf_Tag_Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficTrace_Conf_Tag_Header.Marshal(b, m, deterministic)
+}
+func (m *TrafficTrace_Conf_Tag_Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficTrace_Conf_Tag_Header.Merge(m, src)
+}
+func (m *TrafficTrace_Conf_Tag_Header) XXX_Size() int {
+	return xxx_messageInfo_TrafficTrace_Conf_Tag_Header.Size(m)
+}
+func (m *TrafficTrace_Conf_Tag_Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficTrace_Conf_Tag_Header.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficTrace_Conf_Tag_Header proto.InternalMessageInfo
+
+func (m *TrafficTrace_Conf_Tag_Header) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TrafficTrace_Conf_Tag_Header) GetDefault() string {
+	if m != nil {
+		return m.Default
+	}
+	return ""
+}
+
+// Env defines a value of a tag taken from an environment variable of
+// Envoy.
+type TrafficTrace_Conf_Tag_Env struct {
+	// Name of the environment variable.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Value of the tag when the environment variable is absent.
+	Default              string   `protobuf:"bytes,2,opt,name=default,proto3" json:"default,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrafficTrace_Conf_Tag_Env) Reset()         { *m = TrafficTrace_Conf_Tag_Env{} }
+func (m *TrafficTrace_Conf_Tag_Env) String() string { return proto.CompactTextString(m) }
+func (*TrafficTrace_Conf_Tag_Env) ProtoMessage()    {}
+func (*TrafficTrace_Conf_Tag_Env) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc2b4b31d8d46cbb, []int{0, 0, 0, 1}
+}
+
+func (m *TrafficTrace_Conf_Tag_Env) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficTrace_Conf_Tag_Env.Unmarshal(m, b)
+}
+func (m *TrafficTrace_Conf_Tag_Env) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficTrace_Conf_Tag_Env.Marshal(b, m, deterministic)
+}
+func (m *TrafficTrace_Conf_Tag_Env) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficTrace_Conf_Tag_Env.Merge(m, src)
+}
+func (m *TrafficTrace_Conf_Tag_Env) XXX_Size() int {
+	return xxx_messageInfo_TrafficTrace_Conf_Tag_Env.Size(m)
+}
+func (m *TrafficTrace_Conf_Tag_Env) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficTrace_Conf_Tag_Env.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficTrace_Conf_Tag_Env proto.InternalMessageInfo
+
+func (m *TrafficTrace_Conf_Tag_Env) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TrafficTrace_Conf_Tag_Env) GetDefault() string {
+	if m != nil {
+		return m.Default
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TrafficTrace)(nil), "kuma.mesh.v1alpha1.TrafficTrace")
 	proto.RegisterType((*TrafficTrace_Conf)(nil), "kuma.mesh.v1alpha1.TrafficTrace.Conf")
+	proto.RegisterType((*TrafficTrace_Conf_Tag)(nil), "kuma.mesh.v1alpha1.TrafficTrace.Conf.Tag")
+	proto.RegisterType((*TrafficTrace_Conf_Tag_Header)(nil), "kuma.mesh.v1alpha1.TrafficTrace.Conf.Tag.Header")
+	proto.RegisterType((*TrafficTrace_Conf_Tag_Env)(nil), "kuma.mesh.v1alpha1.TrafficTrace.Conf.Tag.Env")
 }
 
 func init() { proto.RegisterFile("mesh/v1alpha1/traffic_trace.proto", fileDescriptor_bc2b4b31d8d46cbb) }
 
 var fileDescriptor_bc2b4b31d8d46cbb = []byte{
-	// 187 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0x4d, 0x2d, 0xce,
-	0xd0, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8, 0x48, 0x34, 0xd4, 0x2f, 0x29, 0x4a, 0x4c, 0x4b, 0xcb,
-	0x4c, 0x8e, 0x2f, 0x29, 0x4a, 0x4c, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca,
-	0x2e, 0xcd, 0x4d, 0xd4, 0x03, 0xa9, 0xd3, 0x83, 0xa9, 0x93, 0x92, 0x41, 0xd5, 0x56, 0x9c, 0x9a,
-	0x93, 0x9a, 0x5c, 0x92, 0x5f, 0x04, 0xd1, 0xa1, 0xb4, 0x9c, 0x91, 0x8b, 0x27, 0x04, 0x62, 0x52,
-	0x08, 0xc8, 0x20, 0x21, 0x2b, 0x2e, 0x4e, 0x98, 0x92, 0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0x6e,
-	0x23, 0x19, 0x3d, 0x4c, 0x63, 0xf5, 0x82, 0xa1, 0x8a, 0x82, 0x10, 0xca, 0x85, 0x2c, 0xb9, 0x58,
-	0x92, 0xf3, 0xf3, 0xd2, 0x24, 0x98, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x54, 0xb1, 0x69, 0x43, 0xb6,
-	0x4b, 0xcf, 0x39, 0x3f, 0x2f, 0x2d, 0x08, 0xac, 0x45, 0x4a, 0x81, 0x8b, 0x05, 0xc4, 0x13, 0x92,
-	0xe0, 0x62, 0x4f, 0x4a, 0x4c, 0xce, 0x4e, 0xcd, 0x4b, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c,
-	0x82, 0x71, 0x9d, 0xb8, 0xa2, 0x38, 0x60, 0xa6, 0x24, 0xb1, 0x81, 0x1d, 0x6f, 0x0c, 0x08, 0x00,
-	0x00, 0xff, 0xff, 0x2b, 0xc3, 0x68, 0xa5, 0x13, 0x01, 0x00, 0x00,
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xc1, 0x6f, 0xd3, 0x30,
+	0x14, 0xc6, 0xdb, 0x39, 0x6b, 0x97, 0x37, 0xb8, 0xf8, 0x64, 0x45, 0x15, 0x2a, 0x08, 0xa4, 0x72,
+	0xc0, 0x65, 0x9b, 0x84, 0x00, 0x89, 0x03, 0x83, 0x49, 0x15, 0x27, 0x64, 0x2a, 0x0e, 0x5c, 0xd0,
+	0x6b, 0xf2, 0x92, 0x56, 0x73, 0xed, 0xc8, 0x71, 0x02, 0x7f, 0x00, 0x7f, 0x15, 0x7f, 0x1b, 0x07,
+	0x14, 0x27, 0x01, 0x26, 0x76, 0xe8, 0x6e, 0x79, 0xce, 0xf7, 0xf9, 0xf7, 0x7d, 0x7e, 0xf0, 0x70,
+	0x4f, 0xd5, 0x76, 0xd9, 0x9c, 0xa1, 0x2e, 0xb7, 0x78, 0xb6, 0xf4, 0x0e, 0xf3, 0x7c, 0x97, 0x7e,
+	0xf5, 0x0e, 0x53, 0x92, 0xa5, 0xb3, 0xde, 0x72, 0x7e, 0x5d, 0xef, 0x51, 0xb6, 0x3a, 0x39, 0xe8,
+	0x92, 0xd9, 0x4d, 0x5b, 0x45, 0x9a, 0x52, 0x6f, 0x5d, 0xe7, 0x48, 0x1e, 0x14, 0xd6, 0x16, 0x9a,
+	0x96, 0x61, 0xda, 0xd4, 0xf9, 0xf2, 0x9b, 0xc3, 0xb2, 0x24, 0x57, 0x75, 0xff, 0x1f, 0xfd, 0x38,
+	0x86, 0x7b, 0xeb, 0x8e, 0xb4, 0x6e, 0x41, 0xfc, 0x35, 0xc4, 0xc3, 0x15, 0x95, 0x18, 0xcf, 0xd9,
+	0xe2, 0xf4, 0x7c, 0x26, 0xff, 0xc7, 0xca, 0x4f, 0xbd, 0x48, 0xfd, 0x95, 0xf3, 0x57, 0x10, 0xa5,
+	0xd6, 0xe4, 0x82, 0xcd, 0xc7, 0x8b, 0xd3, 0xf3, 0x27, 0xb7, 0xd9, 0xfe, 0x65, 0xc9, 0x77, 0xd6,
+	0xe4, 0x2a, 0x58, 0x92, 0x5f, 0x0c, 0xa2, 0x76, 0xe4, 0x02, 0xa6, 0x1b, 0x4c, 0xaf, 0xc9, 0x64,
+	0x62, 0x3c, 0x1f, 0x2f, 0x62, 0x35, 0x8c, 0xfc, 0x25, 0x9c, 0x54, 0xb8, 0x2f, 0xf5, 0xce, 0x14,
+	0xe2, 0x28, 0x10, 0x66, 0xb2, 0x6b, 0x27, 0x87, 0x76, 0xf2, 0xbd, 0xad, 0x37, 0x9a, 0x3e, 0xa3,
+	0xae, 0x49, 0xfd, 0x51, 0xf3, 0x37, 0x10, 0x79, 0x2c, 0x2a, 0xc1, 0x42, 0x9d, 0xa7, 0x07, 0xe5,
+	0x92, 0x6b, 0x2c, 0x54, 0xb0, 0xf1, 0xc7, 0x70, 0x9f, 0xbe, 0xa7, 0xba, 0xce, 0x28, 0xfb, 0x88,
+	0x7e, 0x5b, 0x89, 0x68, 0xce, 0x16, 0xb1, 0xba, 0x79, 0x98, 0xfc, 0x3c, 0x02, 0xb6, 0xc6, 0x82,
+	0x73, 0x88, 0x0c, 0xee, 0xa9, 0x4f, 0x1f, 0xbe, 0xf9, 0x07, 0x98, 0x6c, 0x09, 0x33, 0x72, 0x7d,
+	0xf0, 0xe7, 0x07, 0x47, 0x90, 0xab, 0xe0, 0x5b, 0x8d, 0x54, 0x7f, 0x03, 0x4f, 0x60, 0xaa, 0x77,
+	0x9e, 0x1c, 0xea, 0xf0, 0xce, 0xf1, 0x6a, 0xa4, 0x86, 0x03, 0xfe, 0x16, 0x18, 0x99, 0x46, 0x44,
+	0x01, 0xf2, 0xec, 0x70, 0xc8, 0x95, 0x69, 0x56, 0x23, 0xd5, 0x7a, 0x93, 0x17, 0x30, 0xe9, 0x90,
+	0xb7, 0x16, 0x11, 0x30, 0xcd, 0x28, 0xc7, 0x5a, 0xfb, 0xd0, 0x24, 0x56, 0xc3, 0x98, 0x5c, 0x00,
+	0xbb, 0x32, 0xcd, 0xdd, 0x4c, 0x97, 0x53, 0x38, 0x6e, 0xda, 0x5d, 0x5d, 0xc2, 0x97, 0x93, 0x21,
+	0xe2, 0x66, 0x12, 0xb6, 0x79, 0xf1, 0x7b, 0x00, 0x27, 0x5c, 0x4a, 0xb4, 0x10, 0x03, 0x00, 0x00,
 }
@@ -12,6 +12,8 @@ import (
 	kuma_cp "github.com/Kong/kuma/pkg/config/app/kuma-cp"
 	"github.com/Kong/kuma/pkg/core"
 	"github.com/Kong/kuma/pkg/core/bootstrap"
+	"github.com/Kong/kuma/pkg/dns"
+	"github.com/Kong/kuma/pkg/gc"
 	mads_server "github.com/Kong/kuma/pkg/mads/server"
 	sds_server "github.com/Kong/kuma/pkg/sds/server"
 	xds_server "github.com/Kong/kuma/pkg/xds/server"
@@ -62,11 +64,16 @@ func newRunCmdWithOpts(opts runCmdOpts) *cobra.Command {
 				return err
 			}
 			runLog.Info(fmt.Sprintf("Current config %s", cfgBytes))
-			if err := sds_server.SetupServer(rt); err != nil {
+			streamCloser, err := sds_server.SetupRevokedStreamCloser(rt)
+			if err != nil {
+				runLog.Error(err, "unable to set up closer of streams opened with revoked tokens")
+				return err
+			}
+			if err := sds_server.SetupServer(rt, streamCloser); err != nil {
 				runLog.Error(err, "unable to set up SDS server")
 				return err
 			}
-			if err := xds_server.SetupServer(rt); err != nil {
+			if err := xds_server.SetupServer(rt, streamCloser); err != nil {
 				runLog.Error(err, "unable to set up xDS server")
 				return err
 			}
@@ -86,6 +93,14 @@ func newRunCmdWithOpts(opts runCmdOpts) *cobra.Command {
 				runLog.Error(err, "unable to set up GUI server")
 				return err
 			}
+			if err := gc.Setup(rt); err != nil {
+				runLog.Error(err, "unable to set up garbage collection")
+				return err
+			}
+			if err := dns.Setup(rt); err != nil {
+				runLog.Error(err, "unable to set up VIPs allocator")
+				return err
+			}
 
 			runLog.Info("starting Control Plane")
 			if err := rt.Start(opts.SetupSignalHandler()); err != nil {
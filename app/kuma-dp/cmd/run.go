@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/Kong/kuma/pkg/catalog/client"
@@ -13,12 +14,16 @@ import (
 
 	kumadp_config "github.com/Kong/kuma/app/kuma-dp/pkg/config"
 	"github.com/Kong/kuma/app/kuma-dp/pkg/dataplane/accesslogs"
+	"github.com/Kong/kuma/app/kuma-dp/pkg/dataplane/dns"
 	"github.com/Kong/kuma/app/kuma-dp/pkg/dataplane/envoy"
+	dataplane_metrics "github.com/Kong/kuma/app/kuma-dp/pkg/dataplane/metrics"
 	"github.com/Kong/kuma/pkg/config"
 	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
 	config_types "github.com/Kong/kuma/pkg/config/types"
 	"github.com/Kong/kuma/pkg/core"
+	"github.com/Kong/kuma/pkg/metrics"
 	util_net "github.com/Kong/kuma/pkg/util/net"
+	"github.com/Kong/kuma/pkg/xds/bootstrap/types"
 )
 
 type CatalogClientFactory func(string) (client.CatalogClient, error)
@@ -27,6 +32,10 @@ var (
 	runLog = dataplaneLog.WithName("run")
 	// overridable by tests
 	bootstrapGenerator   = envoy.NewRemoteBootstrapGenerator(&http.Client{Timeout: 10 * time.Second})
+	dataplaneUnregister  = envoy.NewRemoteDataplaneUnregister(&http.Client{Timeout: 10 * time.Second})
+	dataplaneDrain       = envoy.NewRemoteDataplaneDrain(&http.Client{Timeout: 10 * time.Second})
+	envoyStatusReport    = envoy.NewRemoteEnvoyStatusReport(&http.Client{Timeout: 10 * time.Second})
+	vipsFetcher          = dns.NewRemoteVIPsFetcher(&http.Client{Timeout: 10 * time.Second})
 	catalogClientFactory = client.NewCatalogClient
 )
 
@@ -66,6 +75,9 @@ func newRunCmd() *cobra.Command {
 					return err
 				}
 			}
+			if cfg.DataplaneRuntime.TokenPath != "" && strings.HasPrefix(catalog.Apis.Bootstrap.Url, "http://") {
+				runLog.Info("dataplane token is sent to the Bootstrap Server in plaintext. Configure the Control Plane to serve the Bootstrap Server over TLS", "url", catalog.Apis.Bootstrap.Url)
+			}
 
 			if !cfg.Dataplane.AdminPort.Empty() {
 				// unless a user has explicitly opted out of Envoy Admin API, pick a free port from the range
@@ -95,14 +107,24 @@ func newRunCmd() *cobra.Command {
 			runLog.Info("starting Dataplane (Envoy) ...")
 
 			dataplane := envoy.New(envoy.Opts{
-				Catalog:   catalog,
-				Config:    cfg,
-				Generator: bootstrapGenerator,
-				Stdout:    cmd.OutOrStdout(),
-				Stderr:    cmd.OutOrStderr(),
+				Catalog:        catalog,
+				Config:         cfg,
+				Generator:      bootstrapGenerator,
+				DataplaneDrain: dataplaneDrain,
+				StatusReport:   envoyStatusReport,
+				Stdout:         cmd.OutOrStdout(),
+				Stderr:         cmd.OutOrStderr(),
 			})
 
-			server := accesslogs.NewAccessLogServer()
+			metricsRegistry, err := metrics.NewMetrics()
+			if err != nil {
+				return errors.Wrap(err, "could not create metrics registry")
+			}
+
+			server, err := accesslogs.NewAccessLogServer(cfg.DataplaneRuntime.AccessLogs, metricsRegistry)
+			if err != nil {
+				return errors.Wrap(err, "could not create Access Log server")
+			}
 			defer server.Close()
 
 			logServerErr := make(chan error)
@@ -115,6 +137,42 @@ func newRunCmd() *cobra.Command {
 				runLog.Info("stopped Access Log server")
 			}()
 
+			var dnsServerErr chan error
+			if cfg.DataplaneRuntime.DNS.Enabled {
+				dnsServer := dns.NewServer(cfg.DataplaneRuntime.DNS, func() (*types.VIPsResponse, error) {
+					return vipsFetcher(catalog.Apis.Bootstrap.Url, cfg)
+				})
+				dnsServerStop := make(chan struct{})
+				defer close(dnsServerStop)
+
+				dnsServerErr = make(chan error)
+				go func() {
+					defer close(dnsServerErr)
+					if err := dnsServer.Start(dnsServerStop); err != nil {
+						runLog.Error(err, "problem running DNS server")
+						dnsServerErr <- err
+					}
+					runLog.Info("stopped DNS server")
+				}()
+			}
+
+			var metricsServerErr chan error
+			if cfg.DataplaneRuntime.Metrics.Address != "" {
+				metricsServer := dataplane_metrics.NewServer(cfg.DataplaneRuntime.Metrics, cfg.Dataplane.AdminPort.Lowest(), metricsRegistry)
+				metricsServerStop := make(chan struct{})
+				defer close(metricsServerStop)
+
+				metricsServerErr = make(chan error)
+				go func() {
+					defer close(metricsServerErr)
+					if err := metricsServer.Start(metricsServerStop); err != nil {
+						runLog.Error(err, "problem running Metrics server")
+						metricsServerErr <- err
+					}
+					runLog.Info("stopped Metrics server")
+				}()
+			}
+
 			dataplaneErr := make(chan error)
 			go func() {
 				defer close(dataplaneErr)
@@ -131,7 +189,24 @@ func newRunCmd() *cobra.Command {
 					return errors.New("Access Log server terminated unexpectedly")
 				}
 				return err
+			case err := <-dnsServerErr:
+				if err == nil {
+					return errors.New("DNS server terminated unexpectedly")
+				}
+				return err
+			case err := <-metricsServerErr:
+				if err == nil {
+					return errors.New("Metrics server terminated unexpectedly")
+				}
+				return err
 			case err := <-dataplaneErr:
+				if err == nil && cfg.DataplaneRuntime.DeleteDataplaneOnExit {
+					runLog.Info("deleting Dataplane")
+					if err := dataplaneUnregister(catalog.Apis.Bootstrap.Url, cfg); err != nil {
+						runLog.Error(err, "unable to delete Dataplane")
+						return err
+					}
+				}
 				return err
 			}
 		},
@@ -140,9 +215,26 @@ func newRunCmd() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&cfg.Dataplane.Name, "name", cfg.Dataplane.Name, "Name of the Dataplane")
 	cmd.PersistentFlags().Var(&cfg.Dataplane.AdminPort, "admin-port", `Port (or range of ports to choose from) for Envoy Admin API to listen on. Empty value indicates that Envoy Admin API should not be exposed over TCP. Format: "9901 | 9901-9999 | 9901- | -9901"`)
 	cmd.PersistentFlags().StringVar(&cfg.Dataplane.Mesh, "mesh", cfg.Dataplane.Mesh, "Mesh that Dataplane belongs to")
+	cmd.PersistentFlags().DurationVar(&cfg.Dataplane.DrainTime, "drain-time", cfg.Dataplane.DrainTime, "Time Envoy is given on shutdown to complete in-flight requests")
 	cmd.PersistentFlags().StringVar(&cfg.ControlPlane.ApiServer.URL, "cp-address", cfg.ControlPlane.ApiServer.URL, "URL of the Control Plane API Server")
+	cmd.PersistentFlags().StringVar(&cfg.ControlPlane.BootstrapServer.CaCertFile, "ca-cert-file", cfg.ControlPlane.BootstrapServer.CaCertFile, "Path to a file with a PEM-encoded CA certificate that the Bootstrap Server of the Control Plane served over TLS is verified with. If empty, system CA certificates are used")
 	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.BinaryPath, "binary-path", cfg.DataplaneRuntime.BinaryPath, "Binary path of Envoy executable")
 	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.ConfigDir, "config-dir", cfg.DataplaneRuntime.ConfigDir, "Directory in which Envoy config will be generated")
 	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.TokenPath, "dataplane-token-file", cfg.DataplaneRuntime.TokenPath, "Path to a file with dataplane token (use 'kumactl generate dataplane-token' to get one)")
+	cmd.PersistentFlags().DurationVar(&cfg.DataplaneRuntime.TokenWatchInterval, "dataplane-token-watch-interval", cfg.DataplaneRuntime.TokenWatchInterval, "Interval of checking whether dataplane token file has changed. Envoy is restarted to pick up a new token. Zero value (default) disables watching")
+	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.DataplaneFile, "dataplane-file", cfg.DataplaneRuntime.DataplaneFile, "Path to a file with Dataplane resource template. If provided, Dataplane is created or updated on start. Requires --dataplane-token-file")
+	cmd.PersistentFlags().StringToStringVar(&cfg.DataplaneRuntime.DataplaneVars, "dataplane-var", cfg.DataplaneRuntime.DataplaneVars, "Variable to replace in Dataplane resource template")
+	cmd.PersistentFlags().BoolVar(&cfg.DataplaneRuntime.DeleteDataplaneOnExit, "delete-dataplane-on-exit", cfg.DataplaneRuntime.DeleteDataplaneOnExit, "Delete Dataplane created from --dataplane-file on clean shutdown")
+	cmd.PersistentFlags().BoolVar(&cfg.DataplaneRuntime.DNS.Enabled, "dns-enabled", cfg.DataplaneRuntime.DNS.Enabled, "If true, kuma-dp runs DNS server that resolves <service>.<domain> to virtual IPs of services in the mesh")
+	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.DNS.Address, "dns-address", cfg.DataplaneRuntime.DNS.Address, "Address for DNS server to listen on (UDP and TCP)")
+	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.DNS.Upstream, "dns-upstream", cfg.DataplaneRuntime.DNS.Upstream, "Address of the DNS server to forward queries for names outside of the mesh to. If empty, the first nameserver from /etc/resolv.conf is used")
+	cmd.PersistentFlags().DurationVar(&cfg.DataplaneRuntime.DNS.RefreshInterval, "dns-refresh-interval", cfg.DataplaneRuntime.DNS.RefreshInterval, "Interval of fetching virtual IPs of services from the Control Plane")
+	cmd.PersistentFlags().IntVar(&cfg.DataplaneRuntime.AccessLogs.QueueSize, "access-logs-queue-size", cfg.DataplaneRuntime.AccessLogs.QueueSize, "Maximum number of log entries per TCP logging backend buffered in memory while the logging backend is slow or unavailable")
+	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.AccessLogs.SpillDir, "access-logs-spill-dir", cfg.DataplaneRuntime.AccessLogs.SpillDir, "Directory to spill log entries that do not fit into the in-memory queue to. If empty, such log entries are dropped")
+	cmd.PersistentFlags().Int64Var(&cfg.DataplaneRuntime.AccessLogs.MaxSpillSize, "access-logs-max-spill-size", cfg.DataplaneRuntime.AccessLogs.MaxSpillSize, "Maximum size in bytes of spilled log entries per TCP logging backend")
+	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.Metrics.Address, "metrics-address", cfg.DataplaneRuntime.Metrics.Address, "Address for kuma-dp to expose its own metrics in Prometheus format on. Prometheus endpoint of the dataplane then serves metrics of Envoy, of the application and of kuma-dp together. Empty value disables the metrics endpoint. It has to be a loopback address")
+	cmd.PersistentFlags().Uint32Var(&cfg.DataplaneRuntime.Metrics.AppPort, "metrics-app-port", cfg.DataplaneRuntime.Metrics.AppPort, "Port on which the application exposes its own metrics in Prometheus format on the loopback interface. If set, they are served together with metrics of the dataplane. Requires --metrics-address")
+	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.Metrics.AppPath, "metrics-app-path", cfg.DataplaneRuntime.Metrics.AppPath, "Path on which the application exposes its own metrics")
+	cmd.PersistentFlags().StringVar(&cfg.DataplaneRuntime.XdsApiVersion, "xds-api-version", cfg.DataplaneRuntime.XdsApiVersion, "Version of Envoy xDS API to use: v2 or v3 (requires Envoy 1.14+)")
 	return cmd
 }
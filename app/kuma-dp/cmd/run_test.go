@@ -1,3 +1,4 @@
+//go:build !windows
 // +build !windows
 
 package cmd
@@ -12,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	envoy_bootstrap "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v2"
 	"github.com/golang/protobuf/proto"
@@ -34,6 +36,8 @@ var _ = Describe("run", func() {
 	var backupSetupSignalHandler func() <-chan struct{}
 	var backupBootstrapGenerator envoy.BootstrapConfigFactoryFunc
 	var backupCatalogClientFactory CatalogClientFactory
+	var backupDataplaneDrain envoy.DataplaneDrainFunc
+	var drained chan kumadp.Config
 
 	catalogDataplaneTokenServerEnabledFn := func(address string) (client catalog_client.CatalogClient, e error) {
 		return &test_catalog.StaticCatalogClient{
@@ -54,6 +58,12 @@ var _ = Describe("run", func() {
 		backupSetupSignalHandler = core.SetupSignalHandler
 		backupBootstrapGenerator = bootstrapGenerator
 		backupCatalogClientFactory = catalogClientFactory
+		backupDataplaneDrain = dataplaneDrain
+		drained = make(chan kumadp.Config, 1)
+		dataplaneDrain = func(_ string, cfg kumadp.Config) error {
+			drained <- cfg
+			return nil
+		}
 		bootstrapGenerator = func(_ string, cfg kumadp.Config) (proto.Message, error) {
 			bootstrap := envoy_bootstrap.Bootstrap{}
 			respBytes, err := ioutil.ReadFile(filepath.Join("testdata", "bootstrap-config.golden.yaml"))
@@ -81,6 +91,7 @@ var _ = Describe("run", func() {
 		core.SetupSignalHandler = backupSetupSignalHandler
 		bootstrapGenerator = backupBootstrapGenerator
 		catalogClientFactory = backupCatalogClientFactory
+		dataplaneDrain = backupDataplaneDrain
 	})
 
 	var stopCh chan struct{}
@@ -113,6 +124,8 @@ var _ = Describe("run", func() {
 
 	BeforeEach(func() {
 		backupEnvVars = os.Environ()
+		// don't let tests wait for the default drain time
+		Expect(os.Setenv("KUMA_DATAPLANE_DRAIN_TIME", "100ms")).To(Succeed())
 	})
 	AfterEach(func() {
 		os.Clearenv()
@@ -315,6 +328,111 @@ var _ = Describe("run", func() {
 		}),
 	)
 
+	It("should drain Dataplane before stopping Envoy", func() {
+		// setup
+		Expect(os.Unsetenv("KUMA_DATAPLANE_DRAIN_TIME")).To(Succeed())
+
+		// given
+		cmd := newRootCmd()
+		cmd.SetArgs([]string{
+			"run",
+			"--cp-address", "http://localhost:1234",
+			"--name", "example",
+			"--admin-port", fmt.Sprintf("%d", port),
+			"--binary-path", filepath.Join("testdata", "envoy-mock.sleep.sh"),
+			"--drain-time", "200ms",
+		})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		pidFile := filepath.Join(tmpDir, "envoy-mock.pid")
+		Expect(os.Setenv("ENVOY_MOCK_PID_FILE", pidFile)).To(Succeed())
+		Expect(os.Setenv("ENVOY_MOCK_CMDLINE_FILE", filepath.Join(tmpDir, "envoy-mock.cmdline"))).To(Succeed())
+
+		// when
+		errCh := make(chan error)
+		go func() {
+			defer close(errCh)
+			errCh <- cmd.Execute()
+		}()
+		var pid int64
+		Eventually(func() error {
+			data, err := ioutil.ReadFile(pidFile)
+			if err != nil {
+				return err
+			}
+			pid, err = strconv.ParseInt(strings.TrimSpace(string(data)), 10, 32)
+			return err
+		}, "5s", "100ms").Should(Succeed())
+		// and
+		close(stopCh)
+
+		// then
+		var cfg kumadp.Config
+		Eventually(drained).Should(Receive(&cfg))
+		Expect(cfg.Dataplane.Name).To(Equal("example"))
+		Expect(cfg.Dataplane.DrainTime).To(Equal(200 * time.Millisecond))
+		// and Envoy is given drain time before it gets stopped
+		Expect(syscall.Kill(int(pid), syscall.Signal(0))).To(Succeed())
+
+		// then
+		Expect(<-errCh).ToNot(HaveOccurred())
+		// and
+		Eventually(func() error {
+			return syscall.Kill(int(pid), syscall.Signal(0))
+		}, "5s", "100ms").Should(HaveOccurred())
+	})
+
+	It("should delete Dataplane on exit when it was created from a template", func() {
+		// setup
+		backupDataplaneUnregister := dataplaneUnregister
+		defer func() {
+			dataplaneUnregister = backupDataplaneUnregister
+		}()
+		unregistered := make(chan kumadp.Config, 1)
+		dataplaneUnregister = func(_ string, cfg kumadp.Config) error {
+			unregistered <- cfg
+			return nil
+		}
+
+		// given
+		cmd := newRootCmd()
+		cmd.SetArgs([]string{
+			"run",
+			"--cp-address", "http://localhost:1234",
+			"--name", "example",
+			"--admin-port", fmt.Sprintf("%d", port),
+			"--binary-path", filepath.Join("testdata", "envoy-mock.sleep.sh"),
+			"--dataplane-file", filepath.Join("testdata", "dataplane.yaml"),
+			"--dataplane-var", "address=192.168.0.1",
+			"--delete-dataplane-on-exit",
+		})
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		Expect(os.Setenv("ENVOY_MOCK_PID_FILE", filepath.Join(tmpDir, "envoy-mock.pid"))).To(Succeed())
+		Expect(os.Setenv("ENVOY_MOCK_CMDLINE_FILE", filepath.Join(tmpDir, "envoy-mock.cmdline"))).To(Succeed())
+
+		// when
+		errCh := make(chan error)
+		go func() {
+			defer close(errCh)
+			errCh <- cmd.Execute()
+		}()
+		Eventually(func() error {
+			_, err := os.Stat(filepath.Join(tmpDir, "envoy-mock.pid"))
+			return err
+		}, "5s", "100ms").Should(Succeed())
+		// and
+		close(stopCh)
+
+		// then
+		Expect(<-errCh).ToNot(HaveOccurred())
+		// and
+		var cfg kumadp.Config
+		Eventually(unregistered).Should(Receive(&cfg))
+		Expect(cfg.Dataplane.Name).To(Equal("example"))
+		Expect(cfg.DataplaneRuntime.DataplaneVars).To(Equal(map[string]string{"address": "192.168.0.1"}))
+	})
+
 	It("should fail when dataplane token server is enabled but token is not provided", func() {
 		// setup
 		catalogClientFactory = catalogDataplaneTokenServerEnabledFn
@@ -9,38 +9,83 @@ import (
 
 	envoy_accesslog "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v2"
 
+	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
 	"github.com/Kong/kuma/pkg/envoy/accesslog"
 )
 
-func defaultHandler(log logr.Logger, msg *envoy_accesslog.StreamAccessLogsMessage) (logHandler, error) {
-	parts := strings.SplitN(msg.GetIdentifier().GetLogName(), ";", 2)
-	if len(parts) != 2 {
-		return nil, errors.Errorf("log name %q has invalid format: expected %d components separated by ';', got %d", msg.GetIdentifier().GetLogName(), 2, len(parts))
-	}
-	address, formatString := parts[0], parts[1]
-
-	format, err := accesslog.ParseFormat(formatString)
+func defaultHandler(log logr.Logger, msg *envoy_accesslog.StreamAccessLogsMessage, sender logSenderFunc) (logHandler, error) {
+	address, format, err := parseLogName(msg.GetIdentifier().GetLogName())
 	if err != nil {
 		return nil, err
 	}
 
-	sender, err := defaultSender(log, address)
+	logSender, err := sender(address)
 	if err != nil {
 		return nil, err
 	}
-	if err := sender.Connect(); err != nil {
-		return nil, err
-	}
 
 	return &handler{
 		format: format,
-		sender: sender,
+		sender: logSender,
 	}, nil
 }
 
-func defaultSender(log logr.Logger, address string) (logSender, error) {
-	return &sender{
-		log:     log,
-		address: address,
-	}, nil
+// parseLogName extracts the address of a logging backend and the format of access logs from a log name,
+// which is either `<address>;<format string>` or `json;{"address":"<address>","format":{<structured format>}}`.
+func parseLogName(logName string) (string, accesslog.LogEntryFormatter, error) {
+	if accesslog.IsJsonLogName(logName) {
+		address, format, err := accesslog.ParseJsonLogName(logName)
+		if err != nil {
+			return "", nil, err
+		}
+		return address, format, nil
+	}
+	parts := strings.SplitN(logName, ";", 2)
+	if len(parts) != 2 {
+		return "", nil, errors.Errorf("log name %q has invalid format: expected %d components separated by ';', got %d", logName, 2, len(parts))
+	}
+	format, err := accesslog.ParseFormat(parts[1])
+	if err != nil {
+		return "", nil, err
+	}
+	return parts[0], format, nil
+}
+
+// defaultSender returns a sender to a logging backend of a kind determined by the address,
+// which is either an address of a TCP logging backend, of a syslog server, of an HTTP endpoint or of a file.
+func defaultSender(log logr.Logger, address string, cfg kuma_dp.AccessLogs, metrics *senderMetrics) (logSender, error) {
+	switch {
+	case accesslog.IsSyslogAddress(address):
+		transport, err := newSyslogTransport(address)
+		if err != nil {
+			return nil, err
+		}
+		return newSender(log, address, transport, cfg, metrics)
+	case accesslog.IsHttpAddress(address):
+		httpAddress, err := accesslog.ParseHttpAddress(address)
+		if err != nil {
+			return nil, err
+		}
+		s, err := newSender(log, address, newHttpTransport(httpAddress.URL), cfg, metrics)
+		if err != nil {
+			return nil, err
+		}
+		s.batchSize = defaultHttpBatchSize
+		if httpAddress.BatchSize != 0 {
+			s.batchSize = int(httpAddress.BatchSize)
+		}
+		s.batchTimeout = defaultHttpBatchTimeout
+		if httpAddress.FlushInterval != 0 {
+			s.batchTimeout = httpAddress.FlushInterval
+		}
+		return s, nil
+	case accesslog.IsFileAddress(address):
+		path, err := accesslog.ParseFileAddress(address)
+		if err != nil {
+			return nil, err
+		}
+		return newSender(log, address, newFileTransport(path), cfg, metrics)
+	default:
+		return newSender(log, address, newTcpTransport(address), cfg, metrics)
+	}
 }
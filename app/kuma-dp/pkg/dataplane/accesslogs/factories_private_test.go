@@ -18,7 +18,9 @@ var _ = Describe("defaultHandler", func() {
 		DescribeTable("should fail if configuration is not valid",
 			func(given testCase) {
 				// when
-				_, err := defaultHandler(nil, given.msg)
+				_, err := defaultHandler(nil, given.msg, func(string) (logSender, error) {
+					return &fakeSender{}, nil
+				})
 				// then
 				Expect(err).To(HaveOccurred())
 				// and
@@ -27,6 +29,14 @@ var _ = Describe("defaultHandler", func() {
 			Entry("empty `identifier.log_name` field", testCase{
 				expectedErr: `log name "" has invalid format: expected 2 components separated by ';', got 1`,
 			}),
+			Entry("invalid access log JSON format", testCase{
+				msg: &envoy_accesslog.StreamAccessLogsMessage{
+					Identifier: &envoy_accesslog.StreamAccessLogsMessage_Identifier{
+						LogName: `json;{"address":"127.0.0.1:1234","format":"%RESPONSE_CODE%"}`,
+					},
+				},
+				expectedErr: `log name "json;{\"address\":\"127.0.0.1:1234\",\"format\":\"%RESPONSE_CODE%\"}" has invalid format: json: cannot unmarshal string into Go struct field jsonLogName.format of type map[string]string`,
+			}),
 			Entry("invalid access log format string", testCase{
 				msg: &envoy_accesslog.StreamAccessLogsMessage{
 					Identifier: &envoy_accesslog.StreamAccessLogsMessage_Identifier{
@@ -37,4 +47,39 @@ var _ = Describe("defaultHandler", func() {
 			}),
 		)
 	})
+
+	Describe("parseLogName()", func() {
+		type testCase struct {
+			logName         string
+			expectedAddress string
+			expectedFormat  string
+		}
+
+		DescribeTable("should extract address and format",
+			func(given testCase) {
+				// when
+				address, format, err := parseLogName(given.logName)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(address).To(Equal(given.expectedAddress))
+				Expect(format.String()).To(Equal(given.expectedFormat))
+			},
+			Entry("format string", testCase{
+				logName:         "127.0.0.1:1234;%START_TIME%;%RESPONSE_CODE%",
+				expectedAddress: "127.0.0.1:1234",
+				expectedFormat:  "%START_TIME%;%RESPONSE_CODE%",
+			}),
+			Entry("JSON format", testCase{
+				logName:         `json;{"address":"127.0.0.1:1234","format":{"status":"%RESPONSE_CODE%","text":"a;b"}}`,
+				expectedAddress: "127.0.0.1:1234",
+				expectedFormat:  `{"status":"%RESPONSE_CODE%","text":"a;b"}`,
+			}),
+			Entry("JSON format with an address of a file", testCase{
+				logName:         `json;{"address":"file:///var/log/a;b.log","format":{"status":"%RESPONSE_CODE%"}}`,
+				expectedAddress: "file:///var/log/a;b.log",
+				expectedFormat:  `{"status":"%RESPONSE_CODE%"}`,
+			}),
+		)
+	})
 })
@@ -0,0 +1,41 @@
+package accesslogs
+
+import (
+	"os"
+	"strings"
+)
+
+// fileTransport appends log entries to a file, one log entry per line.
+type fileTransport struct {
+	path string
+	file *os.File
+}
+
+func newFileTransport(path string) *fileTransport {
+	return &fileTransport{
+		path: path,
+	}
+}
+
+func (t *fileTransport) Connect() error {
+	file, err := os.OpenFile(t.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	t.file = file
+	return nil
+}
+
+func (t *fileTransport) Send(records []string) error {
+	_, err := t.file.WriteString(strings.Join(records, "\n") + "\n")
+	return err
+}
+
+func (t *fileTransport) Disconnect() error {
+	if t.file == nil {
+		return nil
+	}
+	err := t.file.Close()
+	t.file = nil
+	return err
+}
@@ -0,0 +1,51 @@
+package accesslogs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("fileTransport", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "file-transport")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("should append log entries to a file", func() {
+		// given
+		path := filepath.Join(dir, "access.log")
+		Expect(ioutil.WriteFile(path, []byte("{\"status\":201}\n"), 0644)).To(Succeed())
+		transport := newFileTransport(path)
+
+		// when
+		Expect(transport.Connect()).To(Succeed())
+		err := transport.Send([]string{`{"status":200}`, `{"status":503}`})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(transport.Disconnect()).To(Succeed())
+		content, err := ioutil.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("{\"status\":201}\n{\"status\":200}\n{\"status\":503}\n"))
+	})
+
+	It("should fail to connect when a file cannot be opened", func() {
+		// given
+		transport := newFileTransport(filepath.Join(dir, "non-existing", "access.log"))
+
+		// expect
+		Expect(transport.Connect()).ToNot(Succeed())
+	})
+})
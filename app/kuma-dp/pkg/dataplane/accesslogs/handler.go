@@ -9,7 +9,7 @@ import (
 )
 
 type handler struct {
-	format *accesslog.AccessLogFormat
+	format accesslog.LogEntryFormatter
 	sender logSender
 }
 
@@ -41,6 +41,7 @@ func (h *handler) Handle(msg *envoy_accesslog.StreamAccessLogsMessage) error {
 	return nil
 }
 
+// Close has no effect on the sender since it is shared by all streams to the same logging backend.
 func (h *handler) Close() error {
-	return h.sender.Close()
+	return nil
 }
@@ -165,6 +165,36 @@ var _ = Describe("handler", func() {
 			)
 		})
 
+		It("should handle valid messages with JSON format", func() {
+			// setup
+			fakeSender := fakeSender{}
+			format, err := accesslog.ParseJsonFormat(map[string]string{
+				"method": "%REQ(:METHOD)%",
+				"status": "%RESPONSE_CODE%",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			handler := &handler{format: format, sender: &fakeSender}
+
+			// given
+			msg := &envoy_accesslog.StreamAccessLogsMessage{}
+			err = util_proto.FromYAML([]byte(`
+            http_logs:
+              log_entry:
+              - request:
+                  request_method: POST
+                response:
+                  response_code: 200
+`), msg)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = handler.Handle(msg)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect([]string(fakeSender)).To(Equal([]string{`{"method":"POST","status":200}`}))
+		})
+
 		Describe("error path", func() {
 			type testCase struct {
 				format      string
@@ -2,7 +2,7 @@ package accesslogs
 
 type fakeSender []string
 
-func (s *fakeSender) Connect() error {
+func (s *fakeSender) Start() error {
 	return nil
 }
 func (s *fakeSender) Send(record string) error {
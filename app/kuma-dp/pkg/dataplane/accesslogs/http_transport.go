@@ -0,0 +1,54 @@
+package accesslogs
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// httpTransport delivers batches of log entries to an HTTP endpoint as a body of POST requests
+// with a log entry per line.
+type httpTransport struct {
+	url    string
+	client *http.Client
+}
+
+func newHttpTransport(url string) *httpTransport {
+	return &httpTransport{
+		url: url,
+		client: &http.Client{
+			Timeout: defaultWriteTimeout,
+		},
+	}
+}
+
+func (t *httpTransport) Connect() error {
+	return nil
+}
+
+func (t *httpTransport) Send(records []string) error {
+	body := strings.Join(records, "\n") + "\n"
+	resp, err := t.client.Post(t.url, "application/x-ndjson", bytes.NewBufferString(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// drain the body to reuse the connection
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return errors.Errorf("HTTP endpoint responded with status code %d", resp.StatusCode)
+	default:
+		return errors.Wrapf(errRejected, "HTTP endpoint responded with status code %d", resp.StatusCode)
+	}
+}
+
+func (t *httpTransport) Disconnect() error {
+	return nil
+}
@@ -0,0 +1,75 @@
+package accesslogs
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/pkg/errors"
+)
+
+var _ = Describe("httpTransport", func() {
+
+	It("should POST a batch of log entries as JSON lines", func() {
+		// setup
+		var contentType, body string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.Method).To(Equal(http.MethodPost))
+			contentType = req.Header.Get("Content-Type")
+			data, err := ioutil.ReadAll(req.Body)
+			Expect(err).ToNot(HaveOccurred())
+			body = string(data)
+		}))
+		defer server.Close()
+
+		// given
+		transport := newHttpTransport(server.URL + "/logs")
+
+		// when
+		err := transport.Send([]string{`{"status":200}`, `{"status":503}`})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(contentType).To(Equal("application/x-ndjson"))
+		Expect(body).To(Equal("{\"status\":200}\n{\"status\":503}\n"))
+	})
+
+	It("should fail on server errors", func() {
+		// setup
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		// given
+		transport := newHttpTransport(server.URL)
+
+		// when
+		err := transport.Send([]string{`{}`})
+
+		// then
+		Expect(err).To(MatchError("HTTP endpoint responded with status code 503"))
+		Expect(errors.Cause(err)).ToNot(Equal(errRejected))
+	})
+
+	It("should report log entries rejected by the server", func() {
+		// setup
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		// given
+		transport := newHttpTransport(server.URL)
+
+		// when
+		err := transport.Send([]string{`{}`})
+
+		// then
+		Expect(errors.Cause(err)).To(Equal(errRejected))
+	})
+})
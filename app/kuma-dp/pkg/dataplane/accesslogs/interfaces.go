@@ -16,10 +16,23 @@ type logHandler interface {
 
 // logSender represents a contract between a log handler and a log sender.
 type logSender interface {
-	Connect() error
+	Start() error
 	Send(entry string) error
 	io.Closer
 }
 
+// logTransport represents a contract between a log sender and a particular kind of logging backend.
+type logTransport interface {
+	// Connect establishes a connection to a logging backend.
+	Connect() error
+	// Send delivers a batch of log entries over the established connection.
+	Send(entries []string) error
+	// Disconnect closes the connection to a logging backend.
+	Disconnect() error
+}
+
+// logSenderFunc returns a log sender for a given address of a logging backend.
+type logSenderFunc = func(address string) (logSender, error)
+
 // logHandlerFactoryFunc represents a factory of log handler implementations.
-type logHandlerFactoryFunc = func(log logr.Logger, msg *envoy_accesslog.StreamAccessLogsMessage) (logHandler, error)
+type logHandlerFactoryFunc = func(log logr.Logger, msg *envoy_accesslog.StreamAccessLogsMessage, sender logSenderFunc) (logHandler, error)
@@ -0,0 +1,53 @@
+package accesslogs
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Reasons why a log entry has not been delivered to a logging backend.
+const (
+	dropReasonQueueFull = "queue_full"
+	dropReasonSpillFull = "spill_full"
+	dropReasonShutdown  = "shutdown"
+	dropReasonRejected  = "rejected"
+)
+
+// senderMetrics are metrics of delivery of log entries to logging backends.
+type senderMetrics struct {
+	sent             *prometheus.CounterVec
+	dropped          *prometheus.CounterVec
+	spilled          *prometheus.CounterVec
+	queued           *prometheus.GaugeVec
+	connectionErrors *prometheus.CounterVec
+}
+
+func newSenderMetrics(registerer prometheus.Registerer) (*senderMetrics, error) {
+	m := &senderMetrics{
+		sent: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "access_log_entries_sent_total",
+			Help: "Number of log entries delivered to a logging backend",
+		}, []string{"backend"}),
+		dropped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "access_log_entries_dropped_total",
+			Help: "Number of log entries that have not been delivered to a logging backend",
+		}, []string{"backend", "reason"}),
+		spilled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "access_log_entries_spilled_total",
+			Help: "Number of log entries spilled to disk since they did not fit into the in-memory queue",
+		}, []string{"backend"}),
+		queued: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "access_log_entries_queued",
+			Help: "Number of log entries buffered in memory",
+		}, []string{"backend"}),
+		connectionErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "access_log_backend_connection_errors_total",
+			Help: "Number of failures to connect or to write to a logging backend",
+		}, []string{"backend"}),
+	}
+	for _, collector := range []prometheus.Collector{m.sent, m.dropped, m.spilled, m.queued, m.connectionErrors} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
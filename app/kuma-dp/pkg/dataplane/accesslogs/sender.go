@@ -1,42 +1,341 @@
 package accesslogs
 
 import (
-	"net"
+	"io"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/go-logr/logr"
+
+	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
 )
 
 const (
-	defaultConnectTimeout = 5 * time.Second
+	defaultConnectTimeout   = 5 * time.Second
+	defaultWriteTimeout     = 5 * time.Second
+	defaultHttpBatchSize    = 100
+	defaultHttpBatchTimeout = time.Second
+	// defaultFlushTimeout is how long a sender keeps delivering buffered log entries once it is closed.
+	defaultFlushTimeout = 5 * time.Second
 )
 
+// errRejected means that a logging backend has rejected log entries and there is no point in sending them again.
+var errRejected = errors.New("log entries have been rejected by the logging backend")
+
+// sender delivers log entries to a logging backend.
+//
+// Log entries are buffered in a bounded in-memory queue and delivered in the background,
+// so that an unavailable logging backend never blocks Envoy. Once connection to the logging backend
+// is lost, sender keeps reconnecting to it with a back-off.
+// Log entries can be delivered in batches of up to batchSize entries, waiting up to batchTimeout for a batch to fill up.
+//
+// Log entries that do not fit into the queue are either spilled to disk or dropped.
+// While there are spilled log entries, new ones are spilled as well to preserve the order of delivery.
 type sender struct {
-	log     logr.Logger
-	address string
-	conn    net.Conn
+	log          logr.Logger
+	address      string
+	cfg          kuma_dp.AccessLogs
+	metrics      *senderMetrics
+	transport    logTransport
+	batchSize    int
+	batchTimeout time.Duration
+	flushTimeout time.Duration
+
+	queue chan string
+	spill *spillFile // nil if spilling is disabled
+
+	mu       sync.Mutex // protects access to the fields below and to the spill file
+	spilling bool
+	dropping bool
+
+	pending   []string      // a batch of log entries that have been taken from the queue but have not been delivered
+	flush     chan struct{} // closed once sender should deliver the remaining log entries and stop
+	stop      chan struct{} // closed once sender should stop immediately
+	done      chan struct{} // closed once sender has stopped
+	startOnce sync.Once
+	closeOnce sync.Once
 }
 
-func (s *sender) Connect() error {
-	conn, err := net.DialTimeout("tcp", s.address, defaultConnectTimeout)
-	if err != nil {
-		return errors.Wrapf(err, "failed to connect to a TCP logging backend: %s", s.address)
+func newSender(log logr.Logger, address string, transport logTransport, cfg kuma_dp.AccessLogs, metrics *senderMetrics) (*sender, error) {
+	s := &sender{
+		log:          log,
+		address:      address,
+		cfg:          cfg,
+		metrics:      metrics,
+		transport:    transport,
+		batchSize:    1,
+		flushTimeout: defaultFlushTimeout,
+		queue:        make(chan string, cfg.QueueSize),
+		flush:        make(chan struct{}),
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+	if cfg.SpillDir != "" {
+		spill, err := openSpillFile(spillFilePath(cfg.SpillDir, address), cfg.MaxSpillSize)
+		if err != nil {
+			return nil, err
+		}
+		s.spill = spill
+		// log entries spilled before kuma-dp was restarted have to be delivered first
+		s.spilling = !spill.Empty()
 	}
-	s.log.Info("connected to TCP logging backend", "address", s.address)
-	s.conn = conn
+	return s, nil
+}
+
+// Start delivers log entries in the background until the sender is closed.
+func (s *sender) Start() error {
+	s.startOnce.Do(func() {
+		go s.run()
+	})
 	return nil
 }
 
+// Send buffers a log entry for delivery. It never blocks.
 func (s *sender) Send(record string) error {
-	_, err := s.conn.Write(append([]byte(record), byte('\n')))
-	return errors.Wrapf(err, "failed to send a log entry to a TCP logging backend: %s", s.address)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.spilling {
+		select {
+		case s.queue <- record:
+			s.metrics.queued.WithLabelValues(s.address).Inc()
+			s.dropping = false
+			return nil
+		default:
+		}
+		if s.spill == nil {
+			s.drop(dropReasonQueueFull, 1)
+			return nil
+		}
+		s.log.Info("in-memory queue is full, spilling log entries to disk", "address", s.address, "path", s.spill.path)
+		s.spilling = true
+	}
+	if err := s.spill.Append(record); err != nil {
+		if err != errSpillFull {
+			s.log.Error(err, "could not spill a log entry to disk", "address", s.address)
+		}
+		s.drop(dropReasonSpillFull, 1)
+		return nil
+	}
+	s.dropping = false
+	s.metrics.spilled.WithLabelValues(s.address).Inc()
+	return nil
 }
 
-func (s *sender) Close() error {
-	if s.conn != nil {
-		return s.conn.Close()
+// drop accounts for log entries that will not be delivered.
+func (s *sender) drop(reason string, count int) {
+	if !s.dropping {
+		s.log.Info("dropping log entries", "address", s.address, "reason", reason)
+		s.dropping = true
 	}
-	return nil
+	s.metrics.dropped.WithLabelValues(s.address, reason).Add(float64(count))
+}
+
+func (s *sender) run() {
+	defer close(s.done)
+	connected := false
+	defer func() {
+		if connected {
+			s.disconnect()
+		}
+	}()
+	backoff := s.cfg.InitialBackoff
+	for {
+		if len(s.pending) == 0 {
+			record, ok := s.next()
+			if !ok {
+				return
+			}
+			s.pending = s.batch(record)
+		}
+		if !connected {
+			if err := s.transport.Connect(); err != nil {
+				s.metrics.connectionErrors.WithLabelValues(s.address).Inc()
+				s.log.V(1).Info("could not connect to a logging backend", "address", s.address, "backoff", backoff, "err", err)
+				if !s.sleep(backoff) {
+					return
+				}
+				backoff = s.nextBackoff(backoff)
+				continue
+			}
+			s.log.Info("connected to logging backend", "address", s.address)
+			connected = true
+		}
+		if err := s.transport.Send(s.pending); err != nil {
+			if errors.Cause(err) == errRejected {
+				s.log.Error(err, "logging backend rejected log entries, dropping them", "address", s.address)
+				s.mu.Lock()
+				s.drop(dropReasonRejected, len(s.pending))
+				s.mu.Unlock()
+				s.pending = nil
+				continue
+			}
+			s.metrics.connectionErrors.WithLabelValues(s.address).Inc()
+			s.log.Error(err, "failed to send log entries to a logging backend, reconnecting", "address", s.address, "backoff", backoff)
+			s.disconnect()
+			connected = false
+			if !s.sleep(backoff) {
+				return
+			}
+			backoff = s.nextBackoff(backoff)
+			continue
+		}
+		s.metrics.sent.WithLabelValues(s.address).Add(float64(len(s.pending)))
+		s.pending = nil
+		backoff = s.cfg.InitialBackoff
+	}
+}
+
+func (s *sender) nextBackoff(backoff time.Duration) time.Duration {
+	if backoff *= 2; backoff > s.cfg.MaxBackoff {
+		return s.cfg.MaxBackoff
+	}
+	return backoff
+}
+
+func (s *sender) disconnect() {
+	if err := s.transport.Disconnect(); err != nil {
+		s.log.V(1).Info("could not disconnect from a logging backend", "address", s.address, "err", err)
+	}
+}
+
+// next returns the oldest log entry that has not been delivered yet.
+// It blocks until there is a log entry or the sender is closed.
+func (s *sender) next() (string, bool) {
+	if record, ok := s.poll(); ok {
+		return record, true
+	}
+	select {
+	case record := <-s.queue:
+		s.metrics.queued.WithLabelValues(s.address).Dec()
+		return record, true
+	case <-s.flush:
+		return "", false
+	case <-s.stop:
+		return "", false
+	}
+}
+
+// poll returns the oldest log entry that has not been delivered yet, if there is any.
+func (s *sender) poll() (string, bool) {
+	// log entries in the in-memory queue are older than the spilled ones
+	select {
+	case record := <-s.queue:
+		s.metrics.queued.WithLabelValues(s.address).Dec()
+		return record, true
+	default:
+	}
+	return s.unspill()
+}
+
+// batch collects log entries to deliver together with a given one.
+func (s *sender) batch(record string) []string {
+	records := []string{record}
+	if s.batchSize <= 1 {
+		return records
+	}
+	timeout := time.NewTimer(s.batchTimeout)
+	defer timeout.Stop()
+	for len(records) < s.batchSize {
+		if record, ok := s.poll(); ok {
+			records = append(records, record)
+			continue
+		}
+		select {
+		case record := <-s.queue:
+			s.metrics.queued.WithLabelValues(s.address).Dec()
+			records = append(records, record)
+		case <-timeout.C:
+			return records
+		case <-s.flush:
+			return records
+		case <-s.stop:
+			return records
+		}
+	}
+	return records
+}
+
+// unspill reads the oldest spilled log entry.
+func (s *sender) unspill() (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.spilling {
+		return "", false
+	}
+	record, err := s.spill.Next()
+	if err == nil {
+		return record, true
+	}
+	if err != io.EOF {
+		s.log.Error(err, "could not read spilled log entries, discarding them", "address", s.address)
+	}
+	if err := s.spill.Reset(); err != nil {
+		s.log.Error(err, "could not truncate spill file", "address", s.address)
+	}
+	s.spilling = false
+	return "", false
+}
+
+// sleep waits for a given period of time. It returns false if the sender is closed in the meantime.
+func (s *sender) sleep(period time.Duration) bool {
+	select {
+	case <-time.After(period):
+		return true
+	case <-s.flush:
+		// there is no point in waiting for an unavailable logging backend on shutdown
+		return false
+	case <-s.stop:
+		return false
+	}
+}
+
+// Close delivers the remaining log entries, giving up after a timeout.
+// Log entries that have not been delivered are either kept in the spill file or dropped.
+func (s *sender) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		// a sender that has never been started must not be started anymore
+		s.startOnce.Do(func() {
+			close(s.done)
+		})
+		close(s.flush)
+		select {
+		case <-s.done:
+		case <-time.After(s.flushTimeout):
+			close(s.stop)
+			<-s.done
+		}
+
+		undelivered := s.pending
+	loop:
+		for {
+			select {
+			case record := <-s.queue:
+				s.metrics.queued.WithLabelValues(s.address).Dec()
+				undelivered = append(undelivered, record)
+			default:
+				break loop
+			}
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.spill == nil {
+			if len(undelivered) > 0 {
+				s.drop(dropReasonShutdown, len(undelivered))
+			}
+			return
+		}
+		// keep log entries until kuma-dp is started again
+		if err = s.spill.Compact(undelivered); err != nil {
+			s.drop(dropReasonShutdown, len(undelivered))
+			err = errors.Wrapf(err, "could not spill undelivered log entries to disk")
+		}
+		if closeErr := s.spill.Close(); err == nil {
+			err = closeErr
+		}
+	})
+	return err
 }
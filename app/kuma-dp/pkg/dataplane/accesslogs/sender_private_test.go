@@ -0,0 +1,330 @@
+package accesslogs
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/go-logr/logr"
+
+	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+	"github.com/Kong/kuma/pkg/core"
+)
+
+var _ = Describe("sender", func() {
+
+	var log logr.Logger
+	var metrics *senderMetrics
+	var cfg kuma_dp.AccessLogs
+
+	BeforeEach(func() {
+		log = core.Log.WithName("test")
+		var err error
+		metrics, err = newSenderMetrics(prometheus.NewRegistry())
+		Expect(err).ToNot(HaveOccurred())
+		cfg = kuma_dp.AccessLogs{
+			QueueSize:      10,
+			InitialBackoff: 10 * time.Millisecond,
+			MaxBackoff:     50 * time.Millisecond,
+		}
+	})
+
+	// backend accepts connections on a given listener and reports received log entries
+	backend := func(listener net.Listener) <-chan string {
+		records := make(chan string, 100)
+		go func() {
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				go func() {
+					defer conn.Close()
+					scanner := bufio.NewScanner(conn)
+					for scanner.Scan() {
+						records <- scanner.Text()
+					}
+				}()
+			}
+		}()
+		return records
+	}
+
+	// freeAddress returns an address no one listens on
+	freeAddress := func() string {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		address := listener.Addr().String()
+		Expect(listener.Close()).To(Succeed())
+		return address
+	}
+
+	receive := func(records <-chan string, count int) []string {
+		var received []string
+		for i := 0; i < count; i++ {
+			select {
+			case record := <-records:
+				received = append(received, record)
+			case <-time.After(5 * time.Second):
+				Fail("timed out waiting for log entries")
+			}
+		}
+		return received
+	}
+
+	It("should deliver log entries to a TCP logging backend", func() {
+		// setup
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		defer listener.Close()
+		records := backend(listener)
+		address := listener.Addr().String()
+
+		// given
+		s, err := newSender(log, address, newTcpTransport(address), cfg, metrics)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.Start()).To(Succeed())
+		defer s.Close()
+
+		// when
+		for _, record := range []string{"first", "second", "third"} {
+			Expect(s.Send(record)).To(Succeed())
+		}
+
+		// then
+		Expect(receive(records, 3)).To(Equal([]string{"first", "second", "third"}))
+		// and
+		Eventually(func() float64 {
+			return testutil.ToFloat64(metrics.sent.WithLabelValues(address))
+		}, "5s", "10ms").Should(Equal(3.0))
+	})
+
+	It("should keep reconnecting to an unavailable TCP logging backend", func() {
+		// given
+		address := freeAddress()
+		// and
+		s, err := newSender(log, address, newTcpTransport(address), cfg, metrics)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.Start()).To(Succeed())
+		defer s.Close()
+
+		// when
+		Expect(s.Send("first")).To(Succeed())
+		Expect(s.Send("second")).To(Succeed())
+		// then
+		Eventually(func() float64 {
+			return testutil.ToFloat64(metrics.connectionErrors.WithLabelValues(address))
+		}, "5s", "10ms").Should(BeNumerically(">=", 2))
+
+		// when
+		listener, err := net.Listen("tcp", address)
+		Expect(err).ToNot(HaveOccurred())
+		defer listener.Close()
+		records := backend(listener)
+
+		// then
+		Expect(receive(records, 2)).To(Equal([]string{"first", "second"}))
+	})
+
+	It("should drop log entries that do not fit into the queue", func() {
+		// given
+		address := freeAddress()
+		cfg.QueueSize = 2
+		// and
+		s, err := newSender(log, address, newTcpTransport(address), cfg, metrics)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		for _, record := range []string{"1", "2", "3", "4", "5"} {
+			Expect(s.Send(record)).To(Succeed())
+		}
+
+		// then
+		Expect(testutil.ToFloat64(metrics.queued.WithLabelValues(address))).To(Equal(2.0))
+		Expect(testutil.ToFloat64(metrics.dropped.WithLabelValues(address, dropReasonQueueFull))).To(Equal(3.0))
+
+		// when
+		Expect(s.Close()).To(Succeed())
+
+		// then
+		Expect(testutil.ToFloat64(metrics.queued.WithLabelValues(address))).To(Equal(0.0))
+		Expect(testutil.ToFloat64(metrics.dropped.WithLabelValues(address, dropReasonShutdown))).To(Equal(2.0))
+	})
+
+	Describe("with spilling to disk", func() {
+
+		var spillDir string
+
+		BeforeEach(func() {
+			var err error
+			spillDir, err = ioutil.TempDir("", "access-logs-")
+			Expect(err).ToNot(HaveOccurred())
+			cfg.SpillDir = spillDir
+			cfg.MaxSpillSize = 1024
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(spillDir)).To(Succeed())
+		})
+
+		It("should spill log entries that do not fit into the queue and deliver them in order", func() {
+			// setup
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+			defer listener.Close()
+			records := backend(listener)
+			address := listener.Addr().String()
+
+			// given
+			cfg.QueueSize = 2
+			// and
+			s, err := newSender(log, address, newTcpTransport(address), cfg, metrics)
+			Expect(err).ToNot(HaveOccurred())
+			defer s.Close()
+
+			// when
+			for _, record := range []string{"1", "2", "3", "4", "5"} {
+				Expect(s.Send(record)).To(Succeed())
+			}
+			// then
+			Expect(testutil.ToFloat64(metrics.spilled.WithLabelValues(address))).To(Equal(3.0))
+
+			// when
+			Expect(s.Start()).To(Succeed())
+			// and
+			Expect(s.Send("6")).To(Succeed())
+
+			// then
+			Expect(receive(records, 6)).To(Equal([]string{"1", "2", "3", "4", "5", "6"}))
+		})
+
+		It("should drop log entries once the spill file is full", func() {
+			// given
+			address := freeAddress()
+			cfg.QueueSize = 1
+			cfg.MaxSpillSize = 2 * (recordHeaderSize + 1)
+			// and
+			s, err := newSender(log, address, newTcpTransport(address), cfg, metrics)
+			Expect(err).ToNot(HaveOccurred())
+			defer s.Close()
+
+			// when
+			for _, record := range []string{"1", "2", "3", "4"} {
+				Expect(s.Send(record)).To(Succeed())
+			}
+
+			// then
+			Expect(testutil.ToFloat64(metrics.spilled.WithLabelValues(address))).To(Equal(2.0))
+			Expect(testutil.ToFloat64(metrics.dropped.WithLabelValues(address, dropReasonSpillFull))).To(Equal(1.0))
+		})
+
+		It("should keep undelivered log entries until restart", func() {
+			// given
+			address := freeAddress()
+			cfg.QueueSize = 1
+			// and
+			s, err := newSender(log, address, newTcpTransport(address), cfg, metrics)
+			Expect(err).ToNot(HaveOccurred())
+			s.flushTimeout = 100 * time.Millisecond
+			Expect(s.Start()).To(Succeed())
+
+			// when
+			for _, record := range []string{"1", "2", "3"} {
+				Expect(s.Send(record)).To(Succeed())
+			}
+			// and
+			Expect(s.Close()).To(Succeed())
+
+			// then
+			Expect(testutil.ToFloat64(metrics.dropped.WithLabelValues(address, dropReasonShutdown))).To(Equal(0.0))
+
+			// when
+			listener, err := net.Listen("tcp", address)
+			Expect(err).ToNot(HaveOccurred())
+			defer listener.Close()
+			records := backend(listener)
+			// and
+			s, err = newSender(log, address, newTcpTransport(address), cfg, metrics)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.Start()).To(Succeed())
+			defer s.Close()
+			// and
+			Expect(s.Send("4")).To(Succeed())
+
+			// then
+			Expect(receive(records, 4)).To(Equal([]string{"1", "2", "3", "4"}))
+		})
+	})
+
+	Describe("with batching", func() {
+
+		// httpBackend accepts batches of log entries with a given status code and reports their bodies
+		httpBackend := func(statusCode int) (*httptest.Server, <-chan string) {
+			bodies := make(chan string, 100)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				body, _ := ioutil.ReadAll(req.Body)
+				bodies <- string(body)
+				w.WriteHeader(statusCode)
+			}))
+			return server, bodies
+		}
+
+		It("should deliver log entries to an HTTP endpoint in batches", func() {
+			// setup
+			server, bodies := httpBackend(http.StatusOK)
+			defer server.Close()
+
+			// given
+			address := server.URL + "#batchSize=2&flushInterval=50ms"
+			s, err := defaultSender(log, address, cfg, metrics)
+			Expect(err).ToNot(HaveOccurred())
+			defer s.Close()
+
+			// when
+			for _, record := range []string{"1", "2", "3"} {
+				Expect(s.Send(record)).To(Succeed())
+			}
+			// and
+			Expect(s.Start()).To(Succeed())
+
+			// then
+			Expect(receive(bodies, 2)).To(Equal([]string{"1\n2\n", "3\n"}))
+			// and
+			Eventually(func() float64 {
+				return testutil.ToFloat64(metrics.sent.WithLabelValues(address))
+			}, "5s", "10ms").Should(Equal(3.0))
+		})
+
+		It("should drop log entries rejected by an HTTP endpoint", func() {
+			// setup
+			server, bodies := httpBackend(http.StatusBadRequest)
+			defer server.Close()
+
+			// given
+			s, err := defaultSender(log, server.URL, cfg, metrics)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(s.Start()).To(Succeed())
+			defer s.Close()
+
+			// when
+			Expect(s.Send("1")).To(Succeed())
+
+			// then
+			Expect(receive(bodies, 1)).To(Equal([]string{"1\n"}))
+			// and
+			Eventually(func() float64 {
+				return testutil.ToFloat64(metrics.dropped.WithLabelValues(server.URL, dropReasonRejected))
+			}, "5s", "10ms").Should(Equal(1.0))
+		})
+	})
+})
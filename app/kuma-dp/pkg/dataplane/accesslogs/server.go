@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"sync"
 	"sync/atomic"
 
 	envoy_accesslog "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v2"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
 
 	kumadp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
@@ -19,16 +21,50 @@ var logger = core.Log.WithName("accesslogs-server")
 type accessLogServer struct {
 	server     *grpc.Server
 	newHandler logHandlerFactoryFunc
+	newSender  logSenderFunc
 
 	// streamCount for counting streams
 	streamCount int64
+
+	mu      sync.Mutex // protects access to senders
+	senders map[string]logSender
 }
 
-func NewAccessLogServer() *accessLogServer {
+// NewAccessLogServer returns a server that receives access logs from Envoy and forwards them to logging backends.
+//
+// Delivery to every logging backend is handled by a single sender shared by all Access Logs streams,
+// so that log entries buffered while a logging backend is unavailable survive reconnects of Envoy.
+func NewAccessLogServer(cfg kumadp.AccessLogs, registerer prometheus.Registerer) (*accessLogServer, error) {
+	metrics, err := newSenderMetrics(registerer)
+	if err != nil {
+		return nil, err
+	}
 	return &accessLogServer{
 		server:     grpc.NewServer(),
 		newHandler: defaultHandler,
+		newSender: func(address string) (logSender, error) {
+			return defaultSender(logger.WithName("sender"), address, cfg, metrics)
+		},
+		senders: map[string]logSender{},
+	}, nil
+}
+
+// sender returns a sender for a given logging backend, starting it on first use.
+func (s *accessLogServer) sender(address string) (logSender, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sender, ok := s.senders[address]; ok {
+		return sender, nil
 	}
+	sender, err := s.newSender(address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create a sender to a logging backend: %s", address)
+	}
+	if err := sender.Start(); err != nil {
+		return nil, errors.Wrapf(err, "failed to start a sender to a logging backend: %s", address)
+	}
+	s.senders[address] = sender
+	return sender, nil
 }
 
 func (s *accessLogServer) StreamAccessLogs(stream envoy_accesslog.AccessLogService_StreamAccessLogsServer) (err error) {
@@ -62,7 +98,7 @@ func (s *accessLogServer) StreamAccessLogs(stream envoy_accesslog.AccessLogServi
 		if !initialized {
 			initialized = true
 
-			handler, err = s.newHandler(log, msg)
+			handler, err = s.newHandler(log, msg, s.sender)
 			if err != nil {
 				return errors.Wrap(err, "failed to initialize Access Logs stream")
 			}
@@ -90,8 +126,17 @@ func (s *accessLogServer) Start(dataplane kumadp.Dataplane) error {
 	return nil
 }
 
+// Close stops receiving access logs and delivers the buffered ones.
 func (s *accessLogServer) Close() {
 	s.server.GracefulStop()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for address, sender := range s.senders {
+		if err := sender.Close(); err != nil {
+			logger.Error(err, "failed to close a sender to a logging backend", "address", address)
+		}
+	}
+	s.senders = map[string]logSender{}
 }
 
 var _ envoy_accesslog.AccessLogServiceServer = &accessLogServer{}
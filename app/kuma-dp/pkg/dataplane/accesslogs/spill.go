@@ -0,0 +1,146 @@
+package accesslogs
+
+import (
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+// recordHeaderSize is the size of a length prefix of every record in a spill file.
+const recordHeaderSize = 4
+
+var (
+	errSpillFull = errors.New("spill file has reached its maximum size")
+
+	unsafeFileNameCharsRE = regexp.MustCompile(`[^A-Za-z0-9.-]`)
+)
+
+// spillFilePath returns a path of a file to spill log entries for a given logging backend to.
+func spillFilePath(dir string, address string) string {
+	return filepath.Join(dir, unsafeFileNameCharsRE.ReplaceAllString(address, "_")+".spill")
+}
+
+// spillFile is a file of length-prefixed log entries that are appended to the end
+// and read from the beginning.
+//
+// spillFile is not safe for concurrent use.
+type spillFile struct {
+	path    string
+	maxSize int64
+
+	file       *os.File
+	size       int64
+	readOffset int64
+}
+
+// openSpillFile opens a spill file, keeping log entries that have been spilled to it
+// before kuma-dp was restarted.
+func openSpillFile(path string, maxSize int64) (*spillFile, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, errors.Wrapf(err, "could not create a directory for spill file %q", path)
+	}
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open spill file %q", path)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, errors.Wrapf(err, "could not stat spill file %q", path)
+	}
+	return &spillFile{
+		path:    path,
+		maxSize: maxSize,
+		file:    file,
+		size:    info.Size(),
+	}, nil
+}
+
+// Empty returns true if all log entries have been read from the spill file.
+func (f *spillFile) Empty() bool {
+	return f.readOffset >= f.size
+}
+
+// Append adds a log entry to the end of the spill file.
+func (f *spillFile) Append(record string) error {
+	if f.size+recordHeaderSize+int64(len(record)) > f.maxSize {
+		return errSpillFull
+	}
+	n, err := f.file.WriteAt(encodeRecord(record), f.size)
+	f.size += int64(n)
+	return err
+}
+
+// Next reads the oldest log entry that has not been read yet.
+// It returns io.EOF if there are no more log entries.
+func (f *spillFile) Next() (string, error) {
+	if f.Empty() {
+		return "", io.EOF
+	}
+	header := make([]byte, recordHeaderSize)
+	if _, err := f.file.ReadAt(header, f.readOffset); err != nil {
+		return "", errors.Wrapf(err, "could not read spill file %q", f.path)
+	}
+	record := make([]byte, binary.BigEndian.Uint32(header))
+	if _, err := f.file.ReadAt(record, f.readOffset+recordHeaderSize); err != nil {
+		return "", errors.Wrapf(err, "could not read spill file %q", f.path)
+	}
+	f.readOffset += recordHeaderSize + int64(len(record))
+	return string(record), nil
+}
+
+// Reset discards all log entries.
+func (f *spillFile) Reset() error {
+	f.size = 0
+	f.readOffset = 0
+	return f.file.Truncate(0)
+}
+
+// Compact discards log entries that have already been read and puts given log entries
+// in front of the ones that have not been read yet.
+func (f *spillFile) Compact(head []string) error {
+	tmpPath := f.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "could not create spill file %q", tmpPath)
+	}
+	var size int64
+	for _, record := range head {
+		n, err := tmp.Write(encodeRecord(record))
+		size += int64(n)
+		if err != nil {
+			_ = tmp.Close()
+			return errors.Wrapf(err, "could not write spill file %q", tmpPath)
+		}
+	}
+	n, err := io.Copy(tmp, io.NewSectionReader(f.file, f.readOffset, f.size-f.readOffset))
+	size += n
+	if err != nil {
+		_ = tmp.Close()
+		return errors.Wrapf(err, "could not write spill file %q", tmpPath)
+	}
+	if err := os.Rename(tmpPath, f.path); err != nil {
+		_ = tmp.Close()
+		return errors.Wrapf(err, "could not replace spill file %q", f.path)
+	}
+	_ = f.file.Close()
+	f.file = tmp
+	f.size = size
+	f.readOffset = 0
+	return nil
+}
+
+func (f *spillFile) Close() error {
+	return f.file.Close()
+}
+
+func encodeRecord(record string) []byte {
+	buf := make([]byte, recordHeaderSize+len(record))
+	binary.BigEndian.PutUint32(buf, uint32(len(record)))
+	copy(buf[recordHeaderSize:], record)
+	return buf
+}
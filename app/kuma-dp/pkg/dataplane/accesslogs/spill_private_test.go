@@ -0,0 +1,117 @@
+package accesslogs
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("spillFile", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "access-logs-")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	readAll := func(f *spillFile) []string {
+		var records []string
+		for {
+			record, err := f.Next()
+			if err == io.EOF {
+				return records
+			}
+			Expect(err).ToNot(HaveOccurred())
+			records = append(records, record)
+		}
+	}
+
+	It("should derive a file name from an address of a logging backend", func() {
+		// expect
+		Expect(spillFilePath("/tmp", "logstash.internal:9000")).To(Equal(filepath.Join("/tmp", "logstash.internal_9000.spill")))
+	})
+
+	It("should read log entries in the order they were appended", func() {
+		// given
+		f, err := openSpillFile(filepath.Join(dir, "backend.spill"), 1024)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+
+		// expect
+		Expect(f.Empty()).To(BeTrue())
+
+		// when
+		Expect(f.Append("first")).To(Succeed())
+		Expect(f.Append("multi\nline")).To(Succeed())
+
+		// then
+		Expect(f.Empty()).To(BeFalse())
+		Expect(readAll(f)).To(Equal([]string{"first", "multi\nline"}))
+		Expect(f.Empty()).To(BeTrue())
+	})
+
+	It("should refuse log entries beyond the maximum size", func() {
+		// given
+		f, err := openSpillFile(filepath.Join(dir, "backend.spill"), 2*recordHeaderSize+10)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+
+		// when
+		Expect(f.Append("12345")).To(Succeed())
+		Expect(f.Append("12345")).To(Succeed())
+		// then
+		Expect(f.Append("1")).To(MatchError(errSpillFull))
+	})
+
+	It("should keep unread log entries across restarts", func() {
+		// given
+		path := filepath.Join(dir, "backend.spill")
+		f, err := openSpillFile(path, 1024)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		for _, record := range []string{"1", "2", "3"} {
+			Expect(f.Append(record)).To(Succeed())
+		}
+		// and
+		record, err := f.Next()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(record).To(Equal("1"))
+
+		// when
+		Expect(f.Compact([]string{"0"})).To(Succeed())
+		// and
+		Expect(f.Close()).To(Succeed())
+
+		// then
+		f, err = openSpillFile(path, 1024)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		Expect(readAll(f)).To(Equal([]string{"0", "2", "3"}))
+	})
+
+	It("should discard all log entries on reset", func() {
+		// given
+		f, err := openSpillFile(filepath.Join(dir, "backend.spill"), 1024)
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		// and
+		Expect(f.Append("1")).To(Succeed())
+
+		// when
+		Expect(f.Reset()).To(Succeed())
+
+		// then
+		Expect(f.Empty()).To(BeTrue())
+		Expect(readAll(f)).To(BeEmpty())
+	})
+})
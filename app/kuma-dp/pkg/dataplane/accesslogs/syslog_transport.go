@@ -0,0 +1,126 @@
+package accesslogs
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/pkg/envoy/accesslog"
+)
+
+const (
+	defaultSyslogTransport = accesslog.SyslogTransportUDP
+	defaultSyslogFacility  = "local0"
+	defaultSyslogAppName   = "kuma"
+
+	// syslogSeverityInformational is a severity of all syslog messages with log entries.
+	syslogSeverityInformational = 6
+	// syslogTimestampFormat is a format of TIMESTAMP field according to RFC 5424.
+	syslogTimestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// syslogTransport delivers log entries to a syslog server as RFC 5424 messages.
+//
+// Over UDP every message is sent in a separate datagram, over TCP and TLS messages are framed
+// using octet counting according to RFC 6587.
+type syslogTransport struct {
+	address   string
+	transport string
+	priority  int
+	hostname  string
+	appName   string
+	tlsConfig *tls.Config
+	now       func() time.Time
+
+	conn net.Conn
+}
+
+func newSyslogTransport(address string) (*syslogTransport, error) {
+	syslogAddress, err := accesslog.ParseSyslogAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	t := &syslogTransport{
+		address:   syslogAddress.Address,
+		transport: syslogAddress.Transport,
+		appName:   syslogAddress.AppName,
+		tlsConfig: &tls.Config{},
+		now:       time.Now,
+	}
+	switch t.transport {
+	case "":
+		t.transport = defaultSyslogTransport
+	case accesslog.SyslogTransportUDP, accesslog.SyslogTransportTCP, accesslog.SyslogTransportTLS:
+	default:
+		return nil, errors.Errorf("unsupported transport to a syslog server: %q", t.transport)
+	}
+	facilityName := syslogAddress.Facility
+	if facilityName == "" {
+		facilityName = defaultSyslogFacility
+	}
+	facility, ok := accesslog.SyslogFacilities[facilityName]
+	if !ok {
+		return nil, errors.Errorf("unknown syslog facility: %q", facilityName)
+	}
+	t.priority = facility*8 + syslogSeverityInformational
+	if t.appName == "" {
+		t.appName = defaultSyslogAppName
+	}
+	if t.hostname, err = os.Hostname(); err != nil || t.hostname == "" {
+		t.hostname = "-"
+	}
+	return t, nil
+}
+
+func (t *syslogTransport) Connect() error {
+	var conn net.Conn
+	var err error
+	switch t.transport {
+	case accesslog.SyslogTransportUDP:
+		conn, err = net.DialTimeout("udp", t.address, defaultConnectTimeout)
+	case accesslog.SyslogTransportTCP:
+		conn, err = net.DialTimeout("tcp", t.address, defaultConnectTimeout)
+	case accesslog.SyslogTransportTLS:
+		conn, err = tls.DialWithDialer(&net.Dialer{Timeout: defaultConnectTimeout}, "tcp", t.address, t.tlsConfig)
+	}
+	if err != nil {
+		return err
+	}
+	t.conn = conn
+	return nil
+}
+
+func (t *syslogTransport) Send(records []string) error {
+	if err := t.conn.SetWriteDeadline(time.Now().Add(defaultWriteTimeout)); err != nil {
+		return err
+	}
+	for _, record := range records {
+		message := t.message(record)
+		if t.transport != accesslog.SyslogTransportUDP {
+			message = fmt.Sprintf("%d %s", len(message), message)
+		}
+		if _, err := t.conn.Write([]byte(message)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// message formats a log entry as a syslog message without structured data, process and message ids.
+func (t *syslogTransport) message(record string) string {
+	return fmt.Sprintf("<%d>1 %s %s %s - - - %s", t.priority, t.now().UTC().Format(syslogTimestampFormat), t.hostname, t.appName, strings.TrimRight(record, "\n"))
+}
+
+func (t *syslogTransport) Disconnect() error {
+	if t.conn == nil {
+		return nil
+	}
+	err := t.conn.Close()
+	t.conn = nil
+	return err
+}
@@ -0,0 +1,147 @@
+package accesslogs
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	util_tls "github.com/Kong/kuma/pkg/tls"
+)
+
+var _ = Describe("syslogTransport", func() {
+
+	now := func() time.Time {
+		return time.Date(2020, 2, 18, 21, 52, 17, 987654000, time.UTC)
+	}
+
+	// readOctetCounted reads a message framed according to RFC 6587
+	readOctetCounted := func(reader *bufio.Reader) string {
+		length, err := reader.ReadString(' ')
+		Expect(err).ToNot(HaveOccurred())
+		n, err := strconv.Atoi(length[:len(length)-1])
+		Expect(err).ToNot(HaveOccurred())
+		message := make([]byte, n)
+		_, err = io.ReadFull(reader, message)
+		Expect(err).ToNot(HaveOccurred())
+		return string(message)
+	}
+
+	It("should send RFC 5424 messages over UDP", func() {
+		// setup
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+
+		// given
+		transport, err := newSyslogTransport(fmt.Sprintf("syslog://%s?facility=local3&appName=web", conn.LocalAddr()))
+		Expect(err).ToNot(HaveOccurred())
+		transport.hostname = "example"
+		transport.now = now
+
+		// when
+		Expect(transport.Connect()).To(Succeed())
+		defer transport.Disconnect()
+		// and
+		Expect(transport.Send([]string{"first\n", "second"})).To(Succeed())
+
+		// then
+		var messages []string
+		buf := make([]byte, 1024)
+		for i := 0; i < 2; i++ {
+			Expect(conn.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+			n, _, err := conn.ReadFrom(buf)
+			Expect(err).ToNot(HaveOccurred())
+			messages = append(messages, string(buf[:n]))
+		}
+		Expect(messages).To(Equal([]string{
+			"<158>1 2020-02-18T21:52:17.987654Z example web - - - first",
+			"<158>1 2020-02-18T21:52:17.987654Z example web - - - second",
+		}))
+	})
+
+	It("should send octet counted messages over TCP", func() {
+		// setup
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		defer listener.Close()
+
+		// given
+		transport, err := newSyslogTransport(fmt.Sprintf("syslog://%s?transport=tcp", listener.Addr()))
+		Expect(err).ToNot(HaveOccurred())
+		transport.hostname = "example"
+		transport.now = now
+
+		// when
+		Expect(transport.Connect()).To(Succeed())
+		defer transport.Disconnect()
+		// and
+		Expect(transport.Send([]string{"first", "second"})).To(Succeed())
+
+		// then
+		conn, err := listener.Accept()
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		Expect(readOctetCounted(reader)).To(Equal("<134>1 2020-02-18T21:52:17.987654Z example kuma - - - first"))
+		Expect(readOctetCounted(reader)).To(Equal("<134>1 2020-02-18T21:52:17.987654Z example kuma - - - second"))
+	})
+
+	It("should send octet counted messages over TLS", func() {
+		// setup
+		keyPair, err := util_tls.NewSelfSignedCert("syslog", util_tls.ServerCertType, "127.0.0.1")
+		Expect(err).ToNot(HaveOccurred())
+		cert, err := tls.X509KeyPair(keyPair.CertPEM, keyPair.KeyPEM)
+		Expect(err).ToNot(HaveOccurred())
+		listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{cert}})
+		Expect(err).ToNot(HaveOccurred())
+		defer listener.Close()
+		// and
+		roots := x509.NewCertPool()
+		Expect(roots.AppendCertsFromPEM(keyPair.CertPEM)).To(BeTrue())
+
+		// given
+		transport, err := newSyslogTransport(fmt.Sprintf("syslog://%s?transport=tls", listener.Addr()))
+		Expect(err).ToNot(HaveOccurred())
+		transport.hostname = "example"
+		transport.now = now
+		transport.tlsConfig = &tls.Config{RootCAs: roots}
+
+		// when
+		received := make(chan string, 1)
+		go func() {
+			defer GinkgoRecover()
+			conn, err := listener.Accept()
+			Expect(err).ToNot(HaveOccurred())
+			defer conn.Close()
+			received <- readOctetCounted(bufio.NewReader(conn))
+		}()
+		// and
+		Expect(transport.Connect()).To(Succeed())
+		defer transport.Disconnect()
+		// and
+		Expect(transport.Send([]string{"first"})).To(Succeed())
+
+		// then
+		Eventually(received, "5s").Should(Receive(Equal("<134>1 2020-02-18T21:52:17.987654Z example kuma - - - first")))
+	})
+
+	DescribeTable("should reject invalid addresses",
+		func(address string, expectedErr string) {
+			// when
+			_, err := newSyslogTransport(address)
+			// then
+			Expect(err).To(MatchError(expectedErr))
+		},
+		Entry("unknown transport", "syslog://127.0.0.1:514?transport=quic", `unsupported transport to a syslog server: "quic"`),
+		Entry("unknown facility", "syslog://127.0.0.1:514?facility=local9", `unknown syslog facility: "local9"`),
+	)
+})
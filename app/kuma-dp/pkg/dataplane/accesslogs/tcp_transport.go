@@ -0,0 +1,45 @@
+package accesslogs
+
+import (
+	"net"
+	"strings"
+	"time"
+)
+
+// tcpTransport delivers log entries to a TCP logging backend, one log entry per line.
+type tcpTransport struct {
+	address string
+	conn    net.Conn
+}
+
+func newTcpTransport(address string) *tcpTransport {
+	return &tcpTransport{
+		address: address,
+	}
+}
+
+func (t *tcpTransport) Connect() error {
+	conn, err := net.DialTimeout("tcp", t.address, defaultConnectTimeout)
+	if err != nil {
+		return err
+	}
+	t.conn = conn
+	return nil
+}
+
+func (t *tcpTransport) Send(records []string) error {
+	if err := t.conn.SetWriteDeadline(time.Now().Add(defaultWriteTimeout)); err != nil {
+		return err
+	}
+	_, err := t.conn.Write([]byte(strings.Join(records, "\n") + "\n"))
+	return err
+}
+
+func (t *tcpTransport) Disconnect() error {
+	if t.conn == nil {
+		return nil
+	}
+	err := t.conn.Close()
+	t.conn = nil
+	return err
+}
@@ -0,0 +1,66 @@
+package dns
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	net_url "net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/app/kuma-dp/pkg/dataplane/envoy"
+	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+	"github.com/Kong/kuma/pkg/xds/bootstrap/types"
+)
+
+// VIPsFetcherFunc fetches virtual IPs of services in the mesh of a Dataplane from the Control Plane.
+type VIPsFetcherFunc func(url string, cfg kuma_dp.Config) (*types.VIPsResponse, error)
+
+func NewRemoteVIPsFetcher(client *http.Client) VIPsFetcherFunc {
+	bootstrapServerClient := envoy.NewBootstrapServerClient(client)
+	return func(url string, cfg kuma_dp.Config) (*types.VIPsResponse, error) {
+		requestUrl, err := net_url.Parse(url)
+		if err != nil {
+			return nil, err
+		}
+		requestUrl.Path = "/vips"
+		token, err := envoy.ReadDataplaneToken(cfg.DataplaneRuntime)
+		if err != nil {
+			return nil, err
+		}
+		jsonBytes, err := json.Marshal(types.VIPsRequest{
+			Mesh:           cfg.Dataplane.Mesh,
+			Name:           cfg.Dataplane.Name,
+			DataplaneToken: token,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not marshal request to json")
+		}
+		client, err := bootstrapServerClient.For(cfg.ControlPlane.BootstrapServer)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Post(requestUrl.String(), "application/json", bytes.NewReader(jsonBytes))
+		if err != nil {
+			return nil, errors.Wrap(err, "request to bootstrap server failed")
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read the response")
+		}
+		if resp.StatusCode != http.StatusOK {
+			if len(body) == 0 {
+				return nil, errors.Errorf("unexpected status code: %d", resp.StatusCode)
+			}
+			return nil, errors.Errorf("unexpected status code: %d. %s", resp.StatusCode, strings.TrimSpace(string(body)))
+		}
+		vips := &types.VIPsResponse{}
+		if err := json.Unmarshal(body, vips); err != nil {
+			return nil, errors.Wrap(err, "could not parse the response")
+		}
+		return vips, nil
+	}
+}
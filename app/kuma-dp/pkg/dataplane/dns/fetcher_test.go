@@ -0,0 +1,72 @@
+package dns
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+	"github.com/Kong/kuma/pkg/xds/bootstrap/types"
+)
+
+var _ = Describe("Remote VIPs Fetcher", func() {
+
+	var mux *http.ServeMux
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		mux = http.NewServeMux()
+		server = httptest.NewServer(mux)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should fetch VIPs of services in the mesh of a Dataplane", func() {
+		// given
+		mux.HandleFunc("/vips", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			body, err := ioutil.ReadAll(req.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`{"mesh": "demo", "name": "sample", "dataplaneToken": "sample-token"}`))
+
+			_, err = writer.Write([]byte(`{"domain": "mesh", "vips": {"backend": "240.0.0.1"}}`))
+			Expect(err).ToNot(HaveOccurred())
+		})
+		cfg := kuma_dp.DefaultConfig()
+		cfg.Dataplane.Mesh = "demo"
+		cfg.Dataplane.Name = "sample"
+		cfg.DataplaneRuntime.TokenPath = filepath.Join("..", "envoy", "testdata", "token")
+
+		// when
+		vips, err := NewRemoteVIPsFetcher(http.DefaultClient)(server.URL, cfg)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vips).To(Equal(&types.VIPsResponse{
+			Domain: "mesh",
+			VIPs: map[string]string{
+				"backend": "240.0.0.1",
+			},
+		}))
+	})
+
+	It("should return an error on unexpected status code", func() {
+		// given
+		mux.HandleFunc("/vips", func(writer http.ResponseWriter, req *http.Request) {
+			writer.WriteHeader(http.StatusNotImplemented)
+			_, _ = writer.Write([]byte("DNS resolution of services is not supported by the Control Plane in this environment"))
+		})
+
+		// when
+		_, err := NewRemoteVIPsFetcher(http.DefaultClient)(server.URL, kuma_dp.DefaultConfig())
+
+		// then
+		Expect(err).To(MatchError("unexpected status code: 501. DNS resolution of services is not supported by the Control Plane in this environment"))
+	})
+})
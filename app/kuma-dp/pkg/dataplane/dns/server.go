@@ -0,0 +1,345 @@
+package dns
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/dns/dnsmessage"
+
+	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+	"github.com/Kong/kuma/pkg/core"
+	"github.com/Kong/kuma/pkg/xds/bootstrap/types"
+)
+
+var log = core.Log.WithName("dns-server")
+
+const (
+	// maxMessageSize is the maximum size of a DNS message over UDP (with EDNS0).
+	maxMessageSize  = 65535
+	upstreamTimeout = 5 * time.Second
+	// tcpIdleTimeout is how long a TCP connection is kept open without queries.
+	tcpIdleTimeout = 10 * time.Second
+)
+
+// overridable by tests
+var resolvConfPath = "/etc/resolv.conf"
+
+// VIPsFunc returns virtual IPs of services in the mesh of a Dataplane.
+type VIPsFunc func() (*types.VIPsResponse, error)
+
+// Server is a DNS server (UDP and TCP) that resolves `<service>.<domain>` to a virtual IP of the service.
+// Queries for other names are forwarded to the upstream DNS server.
+type Server struct {
+	cfg  kuma_dp.DNS
+	vips VIPsFunc
+
+	mu      sync.RWMutex // protects access to the fields below
+	domain  string
+	records map[string][4]byte
+}
+
+func NewServer(cfg kuma_dp.DNS, vips VIPsFunc) *Server {
+	return &Server{
+		cfg:  cfg,
+		vips: vips,
+	}
+}
+
+// Start serves DNS queries until the Stop channel is closed.
+func (s *Server) Start(stop <-chan struct{}) error {
+	upstream := s.cfg.Upstream
+	if upstream == "" {
+		var err error
+		if upstream, err = upstreamFromResolvConf(resolvConfPath); err != nil {
+			return err
+		}
+	}
+	conn, err := net.ListenPacket("udp", s.cfg.Address)
+	if err != nil {
+		return err
+	}
+	// TCP listens on the same port as UDP, which matters when the port of the address is chosen by the system
+	listener, err := net.Listen("tcp", conn.LocalAddr().String())
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	log.Info("starting", "address", conn.LocalAddr(), "upstream", upstream)
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-stop:
+		case <-done:
+		}
+		_ = conn.Close()
+		_ = listener.Close()
+	}()
+	go s.refreshVIPs(done)
+
+	errs := make(chan error, 2)
+	go func() {
+		errs <- s.serveUDP(conn, upstream)
+	}()
+	go func() {
+		errs <- s.serveTCP(listener, upstream)
+	}()
+	select {
+	case err := <-errs:
+		select {
+		case <-stop:
+			log.Info("stopping")
+			return nil
+		default:
+			return err
+		}
+	case <-stop:
+		log.Info("stopping")
+		return nil
+	}
+}
+
+// serveUDP answers queries over UDP until the connection is closed.
+func (s *Server) serveUDP(conn net.PacketConn, upstream string) error {
+	buf := make([]byte, maxMessageSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return err
+		}
+		query := append([]byte(nil), buf[:n]...)
+		go func() {
+			response, err := s.resolve(query, "udp", upstream)
+			if err != nil {
+				log.V(1).Info("could not resolve a query", "err", err)
+				return
+			}
+			if _, err := conn.WriteTo(response, addr); err != nil {
+				log.V(1).Info("could not send a response", "err", err)
+			}
+		}()
+	}
+}
+
+// serveTCP answers queries over TCP until the listener is closed.
+// Clients fall back to TCP when a response over UDP is truncated.
+func (s *Server) serveTCP(listener net.Listener, upstream string) error {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			if err := s.serveTCPConn(conn, upstream); err != nil && err != io.EOF {
+				log.V(1).Info("could not serve a TCP connection", "err", err)
+			}
+		}()
+	}
+}
+
+// serveTCPConn answers queries of a single TCP connection one by one until the client closes it or goes idle.
+func (s *Server) serveTCPConn(conn net.Conn, upstream string) error {
+	for {
+		if err := conn.SetDeadline(time.Now().Add(tcpIdleTimeout)); err != nil {
+			return err
+		}
+		query, err := readTCPMessage(conn)
+		if err != nil {
+			return err
+		}
+		response, err := s.resolve(query, "tcp", upstream)
+		if err != nil {
+			return errors.Wrap(err, "could not resolve a query")
+		}
+		if err := writeTCPMessage(conn, response); err != nil {
+			return errors.Wrap(err, "could not send a response")
+		}
+	}
+}
+
+// readTCPMessage reads a DNS message that is prefixed with its length, as DNS over TCP requires.
+func readTCPMessage(conn io.Reader) ([]byte, error) {
+	var length uint16
+	if err := binary.Read(conn, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+	msg := make([]byte, length)
+	if _, err := io.ReadFull(conn, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// writeTCPMessage writes a DNS message prefixed with its length, as DNS over TCP requires.
+func writeTCPMessage(conn io.Writer, msg []byte) error {
+	buf := make([]byte, 2, 2+len(msg))
+	binary.BigEndian.PutUint16(buf, uint16(len(msg)))
+	_, err := conn.Write(append(buf, msg...))
+	return err
+}
+
+// refreshVIPs periodically fetches virtual IPs of services until the Stop channel is closed.
+func (s *Server) refreshVIPs(stop <-chan struct{}) {
+	ticker := time.NewTicker(s.cfg.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.refresh(); err != nil {
+			log.Error(err, "could not fetch virtual IPs of services")
+		}
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Server) refresh() error {
+	vips, err := s.vips()
+	if err != nil {
+		return err
+	}
+	records := map[string][4]byte{}
+	for service, vip := range vips.VIPs {
+		ip := net.ParseIP(vip).To4()
+		if ip == nil {
+			log.Info("ignoring service with invalid virtual IP", "service", service, "vip", vip)
+			continue
+		}
+		var a [4]byte
+		copy(a[:], ip)
+		records[strings.ToLower(service)] = a
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.domain = strings.ToLower(strings.Trim(vips.Domain, "."))
+	s.records = records
+	return nil
+}
+
+// lookup returns a virtual IP of a service and whether a name belongs to the domain of the mesh.
+func (s *Server) lookup(name string) (ip [4]byte, found bool, inDomain bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.domain == "" {
+		return
+	}
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	suffix := "." + s.domain
+	if name != s.domain && !strings.HasSuffix(name, suffix) {
+		return
+	}
+	ip, found = s.records[strings.TrimSuffix(name, suffix)]
+	return ip, found, true
+}
+
+// resolve returns a response to a query. Queries for names outside of the domain of the mesh are forwarded to the upstream
+// over the same network ("udp" or "tcp") that the query has been received on.
+func (s *Server) resolve(query []byte, network string, upstream string) ([]byte, error) {
+	var parser dnsmessage.Parser
+	header, err := parser.Start(query)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse a query")
+	}
+	question, err := parser.Question()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse a question")
+	}
+	ip, found, inDomain := s.lookup(question.Name.String())
+	if !inDomain {
+		return forward(query, network, upstream)
+	}
+
+	rcode := dnsmessage.RCodeSuccess
+	if !found {
+		rcode = dnsmessage.RCodeNameError
+	}
+	builder := dnsmessage.NewBuilder(nil, dnsmessage.Header{
+		ID:               header.ID,
+		Response:         true,
+		Authoritative:    true,
+		RecursionDesired: header.RecursionDesired,
+		RCode:            rcode,
+	})
+	builder.EnableCompression()
+	if err := builder.StartQuestions(); err != nil {
+		return nil, err
+	}
+	if err := builder.Question(question); err != nil {
+		return nil, err
+	}
+	if found && question.Type == dnsmessage.TypeA && question.Class == dnsmessage.ClassINET {
+		if err := builder.StartAnswers(); err != nil {
+			return nil, err
+		}
+		resource := dnsmessage.ResourceHeader{
+			Name:  question.Name,
+			Class: dnsmessage.ClassINET,
+			TTL:   uint32(s.cfg.RefreshInterval / time.Second),
+		}
+		if err := builder.AResource(resource, dnsmessage.AResource{A: ip}); err != nil {
+			return nil, err
+		}
+	}
+	return builder.Finish()
+}
+
+// forward sends a query to the upstream DNS server and returns its response.
+func forward(query []byte, network string, upstream string) ([]byte, error) {
+	conn, err := net.DialTimeout(network, upstream, upstreamTimeout)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not connect to upstream DNS server %q", upstream)
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(upstreamTimeout)); err != nil {
+		return nil, err
+	}
+	if network == "tcp" {
+		if err := writeTCPMessage(conn, query); err != nil {
+			return nil, errors.Wrapf(err, "could not forward a query to upstream DNS server %q", upstream)
+		}
+		response, err := readTCPMessage(conn)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not receive a response from upstream DNS server %q", upstream)
+		}
+		return response, nil
+	}
+	if _, err := conn.Write(query); err != nil {
+		return nil, errors.Wrapf(err, "could not forward a query to upstream DNS server %q", upstream)
+	}
+	response := make([]byte, maxMessageSize)
+	n, err := conn.Read(response)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not receive a response from upstream DNS server %q", upstream)
+	}
+	return response[:n], nil
+}
+
+// upstreamFromResolvConf returns the address of the first nameserver in a given resolv.conf file.
+func upstreamFromResolvConf(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not read upstream DNS server from %q", path)
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			return net.JoinHostPort(fields[1], "53"), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", errors.Wrapf(err, "could not read upstream DNS server from %q", path)
+	}
+	return "", errors.Errorf("there is no nameserver in %q", path)
+}
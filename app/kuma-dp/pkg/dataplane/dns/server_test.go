@@ -0,0 +1,258 @@
+package dns
+
+import (
+	"net"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/dns/dnsmessage"
+
+	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+	"github.com/Kong/kuma/pkg/xds/bootstrap/types"
+)
+
+var _ = Describe("DNS Server", func() {
+
+	freeUDPAddress := func() string {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+		return conn.LocalAddr().String()
+	}
+
+	query := func(network string, address string, name string, qtype dnsmessage.Type) dnsmessage.Message {
+		builder := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: 42, RecursionDesired: true})
+		Expect(builder.StartQuestions()).To(Succeed())
+		Expect(builder.Question(dnsmessage.Question{
+			Name:  dnsmessage.MustNewName(name),
+			Type:  qtype,
+			Class: dnsmessage.ClassINET,
+		})).To(Succeed())
+		request, err := builder.Finish()
+		Expect(err).ToNot(HaveOccurred())
+
+		conn, err := net.Dial(network, address)
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+		Expect(conn.SetDeadline(time.Now().Add(time.Second))).To(Succeed())
+		var response []byte
+		if network == "tcp" {
+			Expect(writeTCPMessage(conn, request)).To(Succeed())
+			response, err = readTCPMessage(conn)
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			_, err = conn.Write(request)
+			Expect(err).ToNot(HaveOccurred())
+			buf := make([]byte, maxMessageSize)
+			n, err := conn.Read(buf)
+			Expect(err).ToNot(HaveOccurred())
+			response = buf[:n]
+		}
+
+		msg := dnsmessage.Message{}
+		Expect(msg.Unpack(response)).To(Succeed())
+		Expect(msg.Header.ID).To(Equal(uint16(42)))
+		return msg
+	}
+
+	// upstream answers every A query with 192.0.2.1 over UDP and TCP
+	startUpstream := func(stop <-chan struct{}) string {
+		answer := func(query []byte) []byte {
+			request := dnsmessage.Message{}
+			if err := request.Unpack(query); err != nil {
+				return nil
+			}
+			response := dnsmessage.Message{
+				Header:    dnsmessage.Header{ID: request.Header.ID, Response: true},
+				Questions: request.Questions,
+				Answers: []dnsmessage.Resource{{
+					Header: dnsmessage.ResourceHeader{Name: request.Questions[0].Name, Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET, TTL: 60},
+					Body:   &dnsmessage.AResource{A: [4]byte{192, 0, 2, 1}},
+				}},
+			}
+			packed, err := response.Pack()
+			if err != nil {
+				return nil
+			}
+			return packed
+		}
+
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		listener, err := net.Listen("tcp", conn.LocalAddr().String())
+		Expect(err).ToNot(HaveOccurred())
+		go func() {
+			<-stop
+			_ = conn.Close()
+			_ = listener.Close()
+		}()
+		go func() {
+			buf := make([]byte, maxMessageSize)
+			for {
+				n, addr, err := conn.ReadFrom(buf)
+				if err != nil {
+					return
+				}
+				if response := answer(buf[:n]); response != nil {
+					_, _ = conn.WriteTo(response, addr)
+				}
+			}
+		}()
+		go func() {
+			for {
+				tcpConn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				query, err := readTCPMessage(tcpConn)
+				if err == nil {
+					if response := answer(query); response != nil {
+						_ = writeTCPMessage(tcpConn, response)
+					}
+				}
+				_ = tcpConn.Close()
+			}
+		}()
+		return conn.LocalAddr().String()
+	}
+
+	var stop chan struct{}
+	var address string
+
+	BeforeEach(func() {
+		stop = make(chan struct{})
+		address = freeUDPAddress()
+		server := NewServer(kuma_dp.DNS{
+			Enabled:         true,
+			Address:         address,
+			Upstream:        startUpstream(stop),
+			RefreshInterval: 5 * time.Second,
+		}, func() (*types.VIPsResponse, error) {
+			return &types.VIPsResponse{
+				Domain: "mesh",
+				VIPs: map[string]string{
+					"backend": "240.0.0.1",
+				},
+			}, nil
+		})
+		go func() {
+			defer GinkgoRecover()
+			Expect(server.Start(stop)).To(Succeed())
+		}()
+		Eventually(func() bool {
+			_, found, _ := server.lookup("backend.mesh.")
+			return found
+		}, "5s", "10ms").Should(BeTrue())
+	})
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	type testCase struct {
+		network         string
+		name            string
+		qtype           dnsmessage.Type
+		expectedRCode   dnsmessage.RCode
+		expectedAnswers []dnsmessage.Resource
+	}
+
+	DescribeTable("should answer queries",
+		func(given testCase) {
+			// when
+			msg := query(given.network, address, given.name, given.qtype)
+
+			// then
+			Expect(msg.Header.RCode).To(Equal(given.expectedRCode))
+			if given.expectedAnswers == nil {
+				Expect(msg.Answers).To(BeEmpty())
+			} else {
+				Expect(msg.Answers).To(Equal(given.expectedAnswers))
+			}
+		},
+		Entry("A query for a service", testCase{
+			network:       "udp",
+			name:          "backend.mesh.",
+			qtype:         dnsmessage.TypeA,
+			expectedRCode: dnsmessage.RCodeSuccess,
+			expectedAnswers: []dnsmessage.Resource{{
+				Header: dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName("backend.mesh."), Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET, TTL: 5, Length: 4},
+				Body:   &dnsmessage.AResource{A: [4]byte{240, 0, 0, 1}},
+			}},
+		}),
+		Entry("A query for a service in upper case", testCase{
+			network:       "udp",
+			name:          "Backend.MESH.",
+			qtype:         dnsmessage.TypeA,
+			expectedRCode: dnsmessage.RCodeSuccess,
+			expectedAnswers: []dnsmessage.Resource{{
+				Header: dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName("Backend.MESH."), Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET, TTL: 5, Length: 4},
+				Body:   &dnsmessage.AResource{A: [4]byte{240, 0, 0, 1}},
+			}},
+		}),
+		Entry("AAAA query for a service", testCase{
+			network:       "udp",
+			name:          "backend.mesh.",
+			qtype:         dnsmessage.TypeAAAA,
+			expectedRCode: dnsmessage.RCodeSuccess,
+		}),
+		Entry("A query for an unknown service", testCase{
+			network:       "udp",
+			name:          "web.mesh.",
+			qtype:         dnsmessage.TypeA,
+			expectedRCode: dnsmessage.RCodeNameError,
+		}),
+		Entry("A query for a name outside of the mesh", testCase{
+			network:       "udp",
+			name:          "example.com.",
+			qtype:         dnsmessage.TypeA,
+			expectedRCode: dnsmessage.RCodeSuccess,
+			expectedAnswers: []dnsmessage.Resource{{
+				Header: dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName("example.com."), Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET, TTL: 60, Length: 4},
+				Body:   &dnsmessage.AResource{A: [4]byte{192, 0, 2, 1}},
+			}},
+		}),
+		Entry("A query over TCP for a service", testCase{
+			network:       "tcp",
+			name:          "backend.mesh.",
+			qtype:         dnsmessage.TypeA,
+			expectedRCode: dnsmessage.RCodeSuccess,
+			expectedAnswers: []dnsmessage.Resource{{
+				Header: dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName("backend.mesh."), Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET, TTL: 5, Length: 4},
+				Body:   &dnsmessage.AResource{A: [4]byte{240, 0, 0, 1}},
+			}},
+		}),
+		Entry("A query over TCP for a name outside of the mesh", testCase{
+			network:       "tcp",
+			name:          "example.com.",
+			qtype:         dnsmessage.TypeA,
+			expectedRCode: dnsmessage.RCodeSuccess,
+			expectedAnswers: []dnsmessage.Resource{{
+				Header: dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName("example.com."), Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET, TTL: 60, Length: 4},
+				Body:   &dnsmessage.AResource{A: [4]byte{192, 0, 2, 1}},
+			}},
+		}),
+	)
+})
+
+var _ = Describe("upstreamFromResolvConf", func() {
+	It("should return the first nameserver", func() {
+		// when
+		upstream, err := upstreamFromResolvConf(filepath.Join("testdata", "resolv.conf"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(upstream).To(Equal("10.0.0.2:53"))
+	})
+
+	It("should fail when there is no nameserver", func() {
+		// when
+		_, err := upstreamFromResolvConf(filepath.Join("testdata", "dns_suite_test.go"))
+
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+})
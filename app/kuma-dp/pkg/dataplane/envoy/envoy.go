@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"os/exec"
 	"time"
@@ -18,6 +19,7 @@ import (
 
 	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
 	"github.com/Kong/kuma/pkg/core"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
 )
 
 var (
@@ -29,14 +31,26 @@ var (
 	newConfigFile = GenerateBootstrapFile
 )
 
+var adminClient = &http.Client{Timeout: 5 * time.Second}
+
+// errAdminPathNotFound is returned when an endpoint of Envoy Admin API is not supported by a given version of Envoy.
+var errAdminPathNotFound = errors.New("Envoy Admin API does not support the path")
+
+// parentShutdownGracePeriod is how long a parent Envoy is kept alive after the drain time on hot restart.
+const parentShutdownGracePeriod = 15 * time.Second
+
 type BootstrapConfigFactoryFunc func(url string, cfg kuma_dp.Config) (proto.Message, error)
 
 type Opts struct {
 	Catalog   catalog.Catalog
 	Config    kuma_dp.Config
 	Generator BootstrapConfigFactoryFunc
-	Stdout    io.Writer
-	Stderr    io.Writer
+	// DataplaneDrain is called on stop to make other Dataplanes stop sending traffic to this one. Optional.
+	DataplaneDrain DataplaneDrainFunc
+	// StatusReport is called on every restart of Envoy to let the Control Plane know about it. Optional.
+	StatusReport EnvoyStatusReportFunc
+	Stdout       io.Writer
+	Stderr       io.Writer
 }
 
 func New(opts Opts) *Envoy {
@@ -45,6 +59,8 @@ func New(opts Opts) *Envoy {
 
 type Envoy struct {
 	opts Opts
+	// statusReportNotSupported is true once the Control Plane has refused status of Envoy as not supported.
+	statusReportNotSupported bool
 }
 
 func getSelfPath() (string, error) {
@@ -90,60 +106,162 @@ func lookupEnvoyPath(configuredPath string) (string, error) {
 	return path, nil
 }
 
-func (e *Envoy) Run(stop <-chan struct{}) error {
+// process is a single Envoy process.
+type process struct {
+	// epoch is a hot restart epoch of the process.
+	epoch uint32
+	// bootstrap is a bootstrap config the process has been started with.
+	bootstrap []byte
+	done      chan error
+	// terminated is closed once the process has terminated.
+	terminated chan struct{}
+	cancel     context.CancelFunc
+}
+
+// kill stops the process unless it has already terminated.
+func (p *process) kill() {
+	p.cancel()
+}
+
+func (p *process) hasTerminated() bool {
+	select {
+	case <-p.terminated:
+		return true
+	default:
+		return false
+	}
+}
+
+// generateBootstrap asks the Control Plane for a bootstrap config.
+//
+// It is called on every (re)start of Envoy to pick up changes made on the Control Plane side.
+func (e *Envoy) generateBootstrap() (proto.Message, []byte, error) {
 	bootstrapConfig, err := e.opts.Generator(e.opts.Catalog.Apis.Bootstrap.Url, e.opts.Config)
 	if err != nil {
-		return errors.Wrapf(err, "failed to generate Envoy bootstrap config")
+		return nil, nil, errors.Wrapf(err, "failed to generate Envoy bootstrap config")
 	}
-	configFile, err := newConfigFile(e.opts.Config.DataplaneRuntime, bootstrapConfig)
+	bootstrap, err := util_proto.ToYAML(bootstrapConfig)
 	if err != nil {
-		return err
+		return nil, nil, errors.Wrap(err, "failed to marshal Envoy bootstrap config")
 	}
+	return bootstrapConfig, bootstrap, nil
+}
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+func (e *Envoy) start(bootstrapConfig proto.Message, bootstrap []byte, epoch uint32) (*process, error) {
+	configFile, err := newConfigFile(e.opts.Config.DataplaneRuntime, bootstrapConfig)
+	if err != nil {
+		return nil, err
+	}
 
 	binaryPathConfig := e.opts.Config.DataplaneRuntime.BinaryPath
 	resolvedPath, err := lookupEnvoyPath(binaryPathConfig)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	args := []string{
-		"-c", configFile,
-		"--drain-time-s",
-		fmt.Sprintf("%d", e.opts.Config.Dataplane.DrainTime/time.Second),
-		// "hot restart" (enabled by default) requires each Envoy instance to have
-		// `--base-id <uint32_t>` argument.
-		// it is not possible to start multiple Envoy instances on the same Linux machine
-		// without `--base-id <uint32_t>` set.
-		// although we could come up with a solution how to generate `--base-id <uint32_t>`
-		// automatically, it is not strictly necessary since we're not using "hot restart"
-		// and we don't expect users to do "hot restart" manually.
-		// so, let's turn it off to simplify getting started experience.
-		"--disable-hot-restart",
-	}
-	command := exec.CommandContext(ctx, resolvedPath, args...)
+	ctx, cancel := context.WithCancel(context.Background())
+	command := exec.CommandContext(ctx, resolvedPath, e.args(configFile, epoch)...)
 	command.Stdout = e.opts.Stdout
 	command.Stderr = e.opts.Stderr
 	if err := command.Start(); err != nil {
-		return err
+		cancel()
+		return nil, err
+	}
+	p := &process{
+		epoch:      epoch,
+		bootstrap:  bootstrap,
+		done:       make(chan error, 1),
+		terminated: make(chan struct{}),
+		cancel:     cancel,
 	}
-	done := make(chan error, 1)
 	go func() {
-		done <- command.Wait()
+		err := command.Wait()
+		close(p.terminated)
+		p.done <- err
 	}()
+	return p, nil
+}
+
+func (e *Envoy) args(configFile string, epoch uint32) []string {
+	drainTime := e.opts.Config.Dataplane.DrainTime
+	args := []string{
+		"-c", configFile,
+		"--drain-time-s",
+		fmt.Sprintf("%d", drainTime/time.Second),
+	}
+	if !e.hotRestartEnabled() {
+		return append(args,
+			// "hot restart" (enabled by default) requires each Envoy instance to have
+			// `--base-id <uint32_t>` argument.
+			// it is not possible to start multiple Envoy instances on the same Linux machine
+			// without `--base-id <uint32_t>` set.
+			// unless a user has explicitly opted in for "hot restart" on changes of bootstrap config,
+			// let's turn it off to simplify getting started experience.
+			"--disable-hot-restart",
+		)
+	}
+	return append(args,
+		// `--base-id` has to be unique per machine and the same for all epochs,
+		// port of Envoy Admin API satisfies both
+		"--base-id", fmt.Sprintf("%d", e.opts.Config.Dataplane.AdminPort.Lowest()),
+		"--restart-epoch", fmt.Sprintf("%d", epoch),
+		// parent Envoy has to outlive the drain time
+		"--parent-shutdown-time-s", fmt.Sprintf("%d", (drainTime+parentShutdownGracePeriod)/time.Second),
+	)
+}
 
+func (e *Envoy) hotRestartEnabled() bool {
+	return e.opts.Config.DataplaneRuntime.Restart.BootstrapRefreshInterval > 0
+}
+
+// drain makes other Dataplanes stop sending traffic to Envoy and gives Envoy DrainTime
+// to complete in-flight requests before it gets stopped.
+func (e *Envoy) drain(done <-chan error) {
+	drainTime := e.opts.Config.Dataplane.DrainTime
+	runLog.Info("draining Envoy", "drainTime", drainTime)
+	if e.opts.DataplaneDrain != nil {
+		if err := e.opts.DataplaneDrain(e.opts.Catalog.Apis.Bootstrap.Url, e.opts.Config); err != nil {
+			if isNotSupported(err) {
+				runLog.V(1).Info("Dataplane is not marked as draining since the Control Plane does not support it", "reason", err.Error())
+			} else {
+				runLog.Error(err, "could not mark Dataplane as draining in the Control Plane")
+			}
+		}
+	}
+	if !e.opts.Config.Dataplane.AdminPort.Empty() {
+		// fail health checks of other Envoys first and then stop accepting new connections
+		if err := e.callAdmin("/healthcheck/fail"); err != nil {
+			runLog.Error(err, "could not call Envoy Admin API", "path", "/healthcheck/fail")
+		}
+		if err := e.callAdmin("/drain_listeners?graceful"); err != nil {
+			if err == errAdminPathNotFound {
+				// Envoy older than 1.15 cannot drain listeners, failed health checks and the drain time have to do
+				runLog.V(1).Info("listeners are not drained since Envoy does not support it")
+			} else {
+				runLog.Error(err, "could not call Envoy Admin API", "path", "/drain_listeners?graceful")
+			}
+		}
+	}
 	select {
-	case <-stop:
-		cancel()
-		return nil
+	case <-time.After(drainTime):
+		runLog.Info("drain time is over")
 	case err := <-done:
-		if err != nil {
-			runLog.Error(err, "Envoy terminated with an error")
-		} else {
-			runLog.Info("Envoy terminated successfully")
-		}
+		runLog.Info("Envoy terminated while draining", "err", err)
+	}
+}
+
+func (e *Envoy) callAdmin(path string) error {
+	url := fmt.Sprintf("http://127.0.0.1:%d%s", e.opts.Config.Dataplane.AdminPort.Lowest(), path)
+	resp, err := adminClient.Post(url, "", nil)
+	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return errAdminPathNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
 }
@@ -1,3 +1,4 @@
+//go:build !windows
 // +build !windows
 
 package envoy
@@ -6,10 +7,14 @@ import (
 	"bytes"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -21,6 +26,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+	config_types "github.com/Kong/kuma/pkg/config/types"
 )
 
 var _ = Describe("Envoy", func() {
@@ -174,5 +180,136 @@ var _ = Describe("Envoy", func() {
 			// complete
 			close(done)
 		}, 10)
+
+		It("should drain Envoy before stopping it", func(done Done) {
+			// setup
+			var mu sync.Mutex
+			var calls []string
+			record := func(call string) {
+				mu.Lock()
+				defer mu.Unlock()
+				calls = append(calls, call)
+			}
+			admin := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+				record(req.Method + " " + req.URL.String())
+			}))
+			defer admin.Close()
+			adminPort, err := strconv.Atoi(admin.URL[strings.LastIndex(admin.URL, ":")+1:])
+			Expect(err).ToNot(HaveOccurred())
+
+			// given
+			cfg := kuma_dp.Config{
+				Dataplane: kuma_dp.Dataplane{
+					AdminPort: config_types.MustExactPort(uint32(adminPort)),
+					DrainTime: 500 * time.Millisecond,
+				},
+				DataplaneRuntime: kuma_dp.DataplaneRuntime{
+					BinaryPath: filepath.Join("testdata", "envoy-mock.sleep.sh"),
+					ConfigDir:  configDir,
+				},
+			}
+			sampleConfig := func(string, kuma_dp.Config) (proto.Message, error) {
+				return &envoy_bootstrap.Bootstrap{}, nil
+			}
+			dataplaneDrain := func(string, kuma_dp.Config) error {
+				record("drain Dataplane")
+				return nil
+			}
+
+			By("starting a mock dataplane")
+			// when
+			dataplane := New(Opts{
+				Config:         cfg,
+				Generator:      sampleConfig,
+				DataplaneDrain: dataplaneDrain,
+				Stdout:         &bytes.Buffer{},
+				Stderr:         &bytes.Buffer{},
+			})
+			// and
+			go func() {
+				errCh <- dataplane.Run(stopCh)
+			}()
+
+			By("stopping mock dataplane")
+			// when
+			stoppedAt := time.Now()
+			close(stopCh)
+			// then
+			Expect(<-errCh).ToNot(HaveOccurred())
+			// and
+			Expect(time.Since(stoppedAt)).To(BeNumerically(">=", cfg.Dataplane.DrainTime))
+			// and
+			Expect(calls).To(Equal([]string{
+				"drain Dataplane",
+				"POST /healthcheck/fail",
+				"POST /drain_listeners?graceful",
+			}))
+
+			// complete
+			close(done)
+		}, 10)
+
+		It("should drain Envoy that does not support draining of listeners", func(done Done) {
+			// setup
+			var mu sync.Mutex
+			var calls []string
+			admin := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				calls = append(calls, req.Method+" "+req.URL.String())
+				// Envoy 1.12 responds to unknown Admin API paths with 404
+				if req.URL.Path == "/drain_listeners" {
+					writer.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer admin.Close()
+			adminPort, err := strconv.Atoi(admin.URL[strings.LastIndex(admin.URL, ":")+1:])
+			Expect(err).ToNot(HaveOccurred())
+
+			// given
+			cfg := kuma_dp.Config{
+				Dataplane: kuma_dp.Dataplane{
+					AdminPort: config_types.MustExactPort(uint32(adminPort)),
+					DrainTime: 500 * time.Millisecond,
+				},
+				DataplaneRuntime: kuma_dp.DataplaneRuntime{
+					BinaryPath: filepath.Join("testdata", "envoy-mock.sleep.sh"),
+					ConfigDir:  configDir,
+				},
+			}
+			sampleConfig := func(string, kuma_dp.Config) (proto.Message, error) {
+				return &envoy_bootstrap.Bootstrap{}, nil
+			}
+
+			By("starting a mock dataplane")
+			// when
+			dataplane := New(Opts{
+				Config:    cfg,
+				Generator: sampleConfig,
+				Stdout:    &bytes.Buffer{},
+				Stderr:    &bytes.Buffer{},
+			})
+			// and
+			go func() {
+				errCh <- dataplane.Run(stopCh)
+			}()
+
+			By("stopping mock dataplane")
+			// when
+			stoppedAt := time.Now()
+			close(stopCh)
+			// then
+			Expect(<-errCh).ToNot(HaveOccurred())
+			// and Envoy is still given the drain time to complete in-flight requests
+			Expect(time.Since(stoppedAt)).To(BeNumerically(">=", cfg.Dataplane.DrainTime))
+			// and
+			Expect(calls).To(Equal([]string{
+				"POST /healthcheck/fail",
+				"POST /drain_listeners?graceful",
+			}))
+
+			// complete
+			close(done)
+		}, 10)
 	})
 })
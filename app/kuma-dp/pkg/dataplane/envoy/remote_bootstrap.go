@@ -2,10 +2,14 @@ package envoy
 
 import (
 	"bytes"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	net_url "net/url"
+	"strings"
+	"sync"
 
 	envoy_bootstrap "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v2"
 	"github.com/golang/protobuf/proto"
@@ -13,15 +17,18 @@ import (
 
 	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
 	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	util_template "github.com/Kong/kuma/pkg/util/template"
 	"github.com/Kong/kuma/pkg/xds/bootstrap/types"
 )
 
 type remoteBootstrap struct {
-	client *http.Client
+	client *BootstrapServerClient
+	// undrained is true once the Control Plane has accepted a bootstrap request that undrains the Dataplane
+	undrained bool
 }
 
 func NewRemoteBootstrapGenerator(client *http.Client) BootstrapConfigFactoryFunc {
-	rb := remoteBootstrap{client: client}
+	rb := remoteBootstrap{client: NewBootstrapServerClient(client)}
 	return rb.Generate
 }
 
@@ -37,22 +44,45 @@ func (b *remoteBootstrap) Generate(url string, cfg kuma_dp.Config) (proto.Messag
 		// if not set in config, the 0 will be sent which will result in providing default admin port
 		// that is set in the control plane bootstrap params
 		AdminPort:          cfg.Dataplane.AdminPort.Lowest(),
+		MetricsPort:        cfg.DataplaneRuntime.Metrics.Port(),
 		DataplaneTokenPath: cfg.DataplaneRuntime.TokenPath,
+		XdsApiVersion:      cfg.DataplaneRuntime.XdsApiVersion,
+		// inbound interfaces drained on a previous shutdown are undrained only once per kuma-dp process,
+		// so that bootstrap requests of hot restarts do not undo a drain
+		Undrain: !b.undrained,
+	}
+	// the token authorizes self-registration of the Dataplane,
+	// Envoy itself reads the token from DataplaneTokenPath to authenticate on the XDS server
+	token, err := ReadDataplaneToken(cfg.DataplaneRuntime)
+	if err != nil {
+		return nil, err
+	}
+	request.DataplaneToken = token
+	if cfg.DataplaneRuntime.DataplaneFile != "" {
+		dataplane, err := RenderDataplaneFile(cfg.DataplaneRuntime)
+		if err != nil {
+			return nil, err
+		}
+		request.DataplaneResource = string(dataplane)
 	}
 	jsonBytes, err := json.Marshal(request)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not marshal request to json")
 	}
-	resp, err := b.client.Post(bootstrapUrl.String(), "application/json", bytes.NewReader(jsonBytes))
+	client, err := b.client.For(cfg.ControlPlane.BootstrapServer)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Post(bootstrapUrl.String(), "application/json", bytes.NewReader(jsonBytes))
 	if err != nil {
 		return nil, errors.Wrap(err, "request to bootstrap server failed")
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != 200 {
-		if resp.StatusCode == 404 {
-			return nil, errors.New("status: 404. Did you first apply a Dataplane resource?")
+		if resp.StatusCode == 404 && cfg.DataplaneRuntime.DataplaneFile == "" {
+			return nil, errors.New("status: 404. Did you first apply a Dataplane resource? Alternatively, provide it via --dataplane-file argument")
 		}
-		return nil, errors.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, unexpectedStatusError(resp)
 	}
 
 	bootstrap := envoy_bootstrap.Bootstrap{}
@@ -63,6 +93,185 @@ func (b *remoteBootstrap) Generate(url string, cfg kuma_dp.Config) (proto.Messag
 	if err := util_proto.FromYAML(respBytes, &bootstrap); err != nil {
 		return nil, errors.Wrap(err, "could not parse the bootstrap configuration")
 	}
+	b.undrained = true
 
 	return &bootstrap, nil
 }
+
+// RenderDataplaneFile returns a Dataplane resource from a template file with variables replaced by values.
+func RenderDataplaneFile(cfg kuma_dp.DataplaneRuntime) ([]byte, error) {
+	template, err := ioutil.ReadFile(cfg.DataplaneFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read Dataplane resource from %q", cfg.DataplaneFile)
+	}
+	return util_template.Render(string(template), cfg.DataplaneVars), nil
+}
+
+type DataplaneUnregisterFunc func(url string, cfg kuma_dp.Config) error
+
+func NewRemoteDataplaneUnregister(client *http.Client) DataplaneUnregisterFunc {
+	rb := remoteBootstrap{client: NewBootstrapServerClient(client)}
+	return rb.Unregister
+}
+
+func (b *remoteBootstrap) Unregister(url string, cfg kuma_dp.Config) error {
+	token, err := ReadDataplaneToken(cfg.DataplaneRuntime)
+	if err != nil {
+		return err
+	}
+	return b.post(url, cfg.ControlPlane.BootstrapServer, "/unregister", types.UnregisterRequest{
+		Mesh:           cfg.Dataplane.Mesh,
+		Name:           cfg.Dataplane.Name,
+		DataplaneToken: token,
+	})
+}
+
+type DataplaneDrainFunc func(url string, cfg kuma_dp.Config) error
+
+func NewRemoteDataplaneDrain(client *http.Client) DataplaneDrainFunc {
+	rb := remoteBootstrap{client: NewBootstrapServerClient(client)}
+	return rb.Drain
+}
+
+func (b *remoteBootstrap) Drain(url string, cfg kuma_dp.Config) error {
+	token, err := ReadDataplaneToken(cfg.DataplaneRuntime)
+	if err != nil {
+		return err
+	}
+	return b.post(url, cfg.ControlPlane.BootstrapServer, "/drain", types.DrainRequest{
+		Mesh:           cfg.Dataplane.Mesh,
+		Name:           cfg.Dataplane.Name,
+		DataplaneToken: token,
+	})
+}
+
+type EnvoyStatusReportFunc func(url string, cfg kuma_dp.Config, status Status) error
+
+func NewRemoteEnvoyStatusReport(client *http.Client) EnvoyStatusReportFunc {
+	rb := remoteBootstrap{client: NewBootstrapServerClient(client)}
+	return rb.ReportStatus
+}
+
+func (b *remoteBootstrap) ReportStatus(url string, cfg kuma_dp.Config, status Status) error {
+	token, err := ReadDataplaneToken(cfg.DataplaneRuntime)
+	if err != nil {
+		return err
+	}
+	return b.post(url, cfg.ControlPlane.BootstrapServer, "/envoy-status", types.EnvoyStatusRequest{
+		Mesh:              cfg.Dataplane.Mesh,
+		Name:              cfg.Dataplane.Name,
+		DataplaneToken:    token,
+		Restarts:          status.Restarts,
+		LastRestartTime:   status.LastRestartTime,
+		LastRestartReason: status.LastRestartReason,
+		CrashLooping:      status.CrashLooping,
+	})
+}
+
+func (b *remoteBootstrap) post(url string, cfg kuma_dp.BootstrapServer, path string, request interface{}) error {
+	requestUrl, err := net_url.Parse(url)
+	if err != nil {
+		return err
+	}
+	requestUrl.Path = path
+	jsonBytes, err := json.Marshal(request)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal request to json")
+	}
+	client, err := b.client.For(cfg)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Post(requestUrl.String(), "application/json", bytes.NewReader(jsonBytes))
+	if err != nil {
+		return errors.Wrap(err, "request to bootstrap server failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotImplemented {
+		return &notSupportedError{unexpectedStatusError(resp)}
+	}
+	if resp.StatusCode != 200 {
+		return unexpectedStatusError(resp)
+	}
+	return nil
+}
+
+// BootstrapServerClient configures an HTTP client to verify the Bootstrap Server served over TLS
+// with a CA certificate kuma-dp is configured with.
+type BootstrapServerClient struct {
+	client *http.Client
+
+	once       sync.Once
+	configured *http.Client
+	err        error
+}
+
+func NewBootstrapServerClient(client *http.Client) *BootstrapServerClient {
+	return &BootstrapServerClient{client: client}
+}
+
+// For returns an HTTP client of the Bootstrap Server. The client is configured only once, so that connections
+// to the Bootstrap Server are reused. If no CA certificate is configured, system CA certificates are used.
+func (c *BootstrapServerClient) For(cfg kuma_dp.BootstrapServer) (*http.Client, error) {
+	c.once.Do(func() {
+		c.configured, c.err = configureBootstrapServerClient(c.client, cfg)
+	})
+	return c.configured, c.err
+}
+
+func configureBootstrapServerClient(client *http.Client, cfg kuma_dp.BootstrapServer) (*http.Client, error) {
+	caCert := []byte(cfg.CaCert)
+	if cfg.CaCertFile != "" {
+		bytes, err := ioutil.ReadFile(cfg.CaCertFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read CA certificate of the Bootstrap Server from %q", cfg.CaCertFile)
+		}
+		caCert = bytes
+	}
+	if len(caCert) == 0 {
+		return client, nil
+	}
+	caCerts := x509.NewCertPool()
+	if !caCerts.AppendCertsFromPEM(caCert) {
+		return nil, errors.New("could not parse CA certificate of the Bootstrap Server: no PEM-encoded certificates found")
+	}
+	configured := *client
+	configured.Transport = &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{
+			RootCAs: caCerts,
+		},
+	}
+	return &configured, nil
+}
+
+// notSupportedError means that the Control Plane does not handle a request in its environment,
+// e.g. Dataplanes on Kubernetes are managed by the Control Plane itself.
+type notSupportedError struct {
+	error
+}
+
+func isNotSupported(err error) bool {
+	_, ok := errors.Cause(err).(*notSupportedError)
+	return ok
+}
+
+// ReadDataplaneToken returns dataplane token from the file it is configured to be read from or empty string if not configured.
+func ReadDataplaneToken(cfg kuma_dp.DataplaneRuntime) (string, error) {
+	if cfg.TokenPath == "" {
+		return "", nil
+	}
+	token, err := ioutil.ReadFile(cfg.TokenPath)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not read dataplane token from %q", cfg.TokenPath)
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+func unexpectedStatusError(resp *http.Response) error {
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil || len(body) == 0 {
+		return errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return errors.Errorf("unexpected status code: %d. %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
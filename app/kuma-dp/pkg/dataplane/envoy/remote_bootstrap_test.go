@@ -1,6 +1,7 @@
 package envoy
 
 import (
+	"encoding/pem"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -8,6 +9,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -59,7 +61,7 @@ var _ = Describe("Remote Bootstrap", func() {
 				cfg.Dataplane.Mesh = "demo"
 				cfg.Dataplane.Name = "sample"
 				cfg.Dataplane.AdminPort = config_types.MustExactPort(4321) // exact port
-				cfg.DataplaneRuntime.TokenPath = "/tmp/token"
+				cfg.DataplaneRuntime.TokenPath = filepath.Join("testdata", "token")
 
 				return testCase{
 					config: cfg,
@@ -68,7 +70,10 @@ var _ = Describe("Remote Bootstrap", func() {
                       "mesh": "demo",
                       "name": "sample",
                       "adminPort": 4321,
-                      "dataplaneTokenPath": "/tmp/token"
+                      "dataplaneTokenPath": "testdata/token",
+                      "dataplaneToken": "sample-token",
+                      "undrain": true,
+                      "xdsApiVersion": "v2"
                     }
 `,
 				}
@@ -80,7 +85,7 @@ var _ = Describe("Remote Bootstrap", func() {
 				cfg.Dataplane.Mesh = "demo"
 				cfg.Dataplane.Name = "sample"
 				cfg.Dataplane.AdminPort = config_types.MustPortRange(4321, 8765) // port range
-				cfg.DataplaneRuntime.TokenPath = "/tmp/token"
+				cfg.DataplaneRuntime.TokenPath = filepath.Join("testdata", "token")
 
 				return testCase{
 					config: cfg,
@@ -89,7 +94,35 @@ var _ = Describe("Remote Bootstrap", func() {
                       "mesh": "demo",
                       "name": "sample",
                       "adminPort": 4321,
-                      "dataplaneTokenPath": "/tmp/token"
+                      "dataplaneTokenPath": "testdata/token",
+                      "dataplaneToken": "sample-token",
+                      "undrain": true,
+                      "xdsApiVersion": "v2"
+                    }
+`,
+				}
+			}()),
+		Entry("should report port of the metrics endpoint",
+			func() testCase {
+				cfg := kuma_dp.DefaultConfig()
+				cfg.Dataplane.Mesh = "demo"
+				cfg.Dataplane.Name = "sample"
+				cfg.Dataplane.AdminPort = config_types.MustExactPort(4321)
+				cfg.DataplaneRuntime.TokenPath = filepath.Join("testdata", "token")
+				cfg.DataplaneRuntime.Metrics.Address = "127.0.0.1:9902"
+
+				return testCase{
+					config: cfg,
+					expectedBootstrapRequest: `
+                    {
+                      "mesh": "demo",
+                      "name": "sample",
+                      "adminPort": 4321,
+                      "metricsPort": 9902,
+                      "dataplaneTokenPath": "testdata/token",
+                      "dataplaneToken": "sample-token",
+                      "undrain": true,
+                      "xdsApiVersion": "v2"
                     }
 `,
 				}
@@ -100,7 +133,35 @@ var _ = Describe("Remote Bootstrap", func() {
 				cfg.Dataplane.Mesh = "demo"
 				cfg.Dataplane.Name = "sample"
 				cfg.Dataplane.AdminPort = config_types.PortRange{} // empty port range
-				cfg.DataplaneRuntime.TokenPath = "/tmp/token"
+				cfg.DataplaneRuntime.TokenPath = filepath.Join("testdata", "token")
+				cfg.DataplaneRuntime.XdsApiVersion = "v3"
+
+				return testCase{
+					config: cfg,
+					expectedBootstrapRequest: `
+                    {
+                      "mesh": "demo",
+                      "name": "sample",
+                      "dataplaneTokenPath": "testdata/token",
+                      "dataplaneToken": "sample-token",
+                      "undrain": true,
+                      "xdsApiVersion": "v3"
+                    }
+`,
+				}
+			}()),
+		Entry("should send a Dataplane resource rendered from a template",
+			func() testCase {
+				cfg := kuma_dp.DefaultConfig()
+				cfg.Dataplane.Mesh = "demo"
+				cfg.Dataplane.Name = "sample"
+				cfg.Dataplane.AdminPort = config_types.MustExactPort(4321)
+				cfg.DataplaneRuntime.TokenPath = filepath.Join("testdata", "token")
+				cfg.DataplaneRuntime.DataplaneFile = filepath.Join("testdata", "dataplane.yaml")
+				cfg.DataplaneRuntime.DataplaneVars = map[string]string{
+					"address": "192.168.0.1",
+					"port":    "8080",
+				}
 
 				return testCase{
 					config: cfg,
@@ -108,10 +169,236 @@ var _ = Describe("Remote Bootstrap", func() {
                     {
                       "mesh": "demo",
                       "name": "sample",
-                      "dataplaneTokenPath": "/tmp/token"
+                      "adminPort": 4321,
+                      "dataplaneTokenPath": "testdata/token",
+                      "dataplaneToken": "sample-token",
+                      "undrain": true,
+                      "xdsApiVersion": "v2",
+                      "dataplaneResource": "type: Dataplane\nmesh: demo\nname: sample\nnetworking:\n  address: 192.168.0.1\n  inbound:\n  - port: 8080\n    tags:\n      service: backend\n"
                     }
 `,
 				}
 			}()),
 	)
+
+	It("should undrain a Dataplane only on the first bootstrap request", func() {
+		// given
+		var requests []string
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+		mux.HandleFunc("/bootstrap", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			body, err := ioutil.ReadAll(req.Body)
+			Expect(err).ToNot(HaveOccurred())
+			requests = append(requests, string(body))
+
+			response, err := ioutil.ReadFile(filepath.Join("testdata", "remote-bootstrap-config.golden.yaml"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = writer.Write(response)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		// and
+		cfg := kuma_dp.DefaultConfig()
+		cfg.Dataplane.Mesh = "demo"
+		cfg.Dataplane.Name = "sample"
+		cfg.DataplaneRuntime.TokenPath = filepath.Join("testdata", "token")
+		generator := NewRemoteBootstrapGenerator(http.DefaultClient)
+
+		// when
+		_, err := generator(server.URL, cfg)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = generator(server.URL, cfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[0]).To(ContainSubstring(`"undrain":true`))
+		Expect(requests[1]).ToNot(ContainSubstring(`"undrain"`))
+	})
+
+	It("should verify the Bootstrap Server served over TLS with a configured CA certificate", func() {
+		// given
+		mux := http.NewServeMux()
+		server := httptest.NewTLSServer(mux)
+		defer server.Close()
+		mux.HandleFunc("/bootstrap", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			response, err := ioutil.ReadFile(filepath.Join("testdata", "remote-bootstrap-config.golden.yaml"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = writer.Write(response)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		// and
+		cfg := kuma_dp.DefaultConfig()
+		cfg.Dataplane.Mesh = "demo"
+		cfg.Dataplane.Name = "sample"
+		cfg.DataplaneRuntime.TokenPath = filepath.Join("testdata", "token")
+
+		By("rejecting the Bootstrap Server that cannot be verified with system CA certificates")
+		// when
+		_, err := NewRemoteBootstrapGenerator(http.DefaultClient)(server.URL, cfg)
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("certificate"))
+
+		By("accepting the Bootstrap Server that is verified with a configured CA certificate")
+		// given
+		cfg.ControlPlane.BootstrapServer.CaCert = string(pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: server.Certificate().Raw,
+		}))
+
+		// when
+		config, err := NewRemoteBootstrapGenerator(http.DefaultClient)(server.URL, cfg)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config).ToNot(BeNil())
+	})
+
+	It("should return an error with a reason from the Control Plane", func() {
+		// given
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+		mux.HandleFunc("/bootstrap", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			writer.WriteHeader(http.StatusForbidden)
+			_, err := writer.Write([]byte("dataplane token does not allow to manage the Dataplane: token was issued for a different Dataplane"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		// and
+		cfg := kuma_dp.DefaultConfig()
+		cfg.Dataplane.Mesh = "demo"
+		cfg.Dataplane.Name = "sample"
+		cfg.DataplaneRuntime.DataplaneFile = filepath.Join("testdata", "dataplane.yaml")
+		generator := NewRemoteBootstrapGenerator(http.DefaultClient)
+
+		// when
+		_, err := generator(server.URL, cfg)
+
+		// then
+		Expect(err).To(MatchError("unexpected status code: 403. dataplane token does not allow to manage the Dataplane: token was issued for a different Dataplane"))
+	})
+
+	It("should unregister a Dataplane", func() {
+		// given
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+		mux.HandleFunc("/unregister", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			body, err := ioutil.ReadAll(req.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`{"mesh": "demo", "name": "sample", "dataplaneToken": "sample-token"}`))
+		})
+
+		// and
+		cfg := kuma_dp.DefaultConfig()
+		cfg.Dataplane.Mesh = "demo"
+		cfg.Dataplane.Name = "sample"
+		cfg.DataplaneRuntime.TokenPath = filepath.Join("testdata", "token")
+		unregister := NewRemoteDataplaneUnregister(http.DefaultClient)
+
+		// when
+		err := unregister(server.URL, cfg)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should drain a Dataplane", func() {
+		// given
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+		mux.HandleFunc("/drain", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.Method).To(Equal(http.MethodPost))
+			body, err := ioutil.ReadAll(req.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`{"mesh": "demo", "name": "sample", "dataplaneToken": "sample-token"}`))
+		})
+
+		// and
+		cfg := kuma_dp.DefaultConfig()
+		cfg.Dataplane.Mesh = "demo"
+		cfg.Dataplane.Name = "sample"
+		cfg.DataplaneRuntime.TokenPath = filepath.Join("testdata", "token")
+		drain := NewRemoteDataplaneDrain(http.DefaultClient)
+
+		// when
+		err := drain(server.URL, cfg)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should report status of Envoy", func() {
+		// given
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+		mux.HandleFunc("/envoy-status", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			body, err := ioutil.ReadAll(req.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`{
+              "mesh": "demo",
+              "name": "sample",
+              "dataplaneToken": "sample-token",
+              "restarts": 2,
+              "lastRestartTime": "2020-03-20T10:00:00Z",
+              "lastRestartReason": "exit status 1",
+              "crashLooping": false
+            }`))
+		})
+
+		// and
+		cfg := kuma_dp.DefaultConfig()
+		cfg.Dataplane.Mesh = "demo"
+		cfg.Dataplane.Name = "sample"
+		cfg.DataplaneRuntime.TokenPath = filepath.Join("testdata", "token")
+		report := NewRemoteEnvoyStatusReport(http.DefaultClient)
+
+		// when
+		err := report(server.URL, cfg, Status{
+			Restarts:          2,
+			LastRestartTime:   time.Date(2020, 3, 20, 10, 0, 0, 0, time.UTC),
+			LastRestartReason: "exit status 1",
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should tell when the Control Plane does not support status of Envoy", func() {
+		// given
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+		mux.HandleFunc("/envoy-status", func(writer http.ResponseWriter, req *http.Request) {
+			writer.WriteHeader(http.StatusNotImplemented)
+			_, err := writer.Write([]byte("status of Envoy cannot be reported to the Control Plane in this environment"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		// and
+		cfg := kuma_dp.DefaultConfig()
+		cfg.Dataplane.Mesh = "demo"
+		cfg.Dataplane.Name = "sample"
+		report := NewRemoteEnvoyStatusReport(http.DefaultClient)
+
+		// when
+		err := report(server.URL, cfg, Status{})
+
+		// then
+		Expect(isNotSupported(err)).To(BeTrue())
+		Expect(err.Error()).To(Equal("unexpected status code: 501. status of Envoy cannot be reported to the Control Plane in this environment"))
+	})
 })
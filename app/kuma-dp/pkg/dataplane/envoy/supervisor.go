@@ -0,0 +1,284 @@
+package envoy
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/pkg/errors"
+
+	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+)
+
+// Status describes Envoy supervised by kuma-dp.
+type Status struct {
+	// Restarts is the number of times Envoy has been restarted.
+	Restarts uint32
+	// LastRestartTime is the time of the most recent restart.
+	LastRestartTime time.Time
+	// LastRestartReason is the reason of the most recent restart.
+	LastRestartReason string
+	// CrashLooping is true if Envoy keeps crashing shortly after being started.
+	CrashLooping bool
+}
+
+// Run starts Envoy and supervises it until the Stop channel is closed.
+//
+// Envoy that terminates unexpectedly is restarted with a back-off, unless restarts are disabled,
+// in which case Run returns the reason of termination.
+// If bootstrap config refresh is enabled, Envoy is hot restarted every time its bootstrap config changes.
+// If dataplane token watching is enabled, Envoy is restarted every time dataplane token changes,
+// so it could reconnect to the Control Plane with the new token. Hot restart is used if enabled,
+// so connections of the application are not dropped.
+func (e *Envoy) Run(stop <-chan struct{}) error {
+	runtime := e.opts.Config.DataplaneRuntime
+	policy := runtime.Restart
+	if e.hotRestartEnabled() && e.opts.Config.Dataplane.AdminPort.Empty() {
+		return errors.New("hot restart of Envoy requires Envoy Admin API to be exposed over TCP")
+	}
+
+	// dataplane token is read before bootstrap config is generated so that a change in between is not missed
+	token, err := ReadDataplaneToken(runtime)
+	if err != nil {
+		return err
+	}
+	bootstrapConfig, bootstrap, err := e.generateBootstrap()
+	if err != nil {
+		return err
+	}
+	current, err := e.start(bootstrapConfig, bootstrap, 0)
+	if err != nil {
+		return err
+	}
+	// parents are Envoy processes that are still draining after a hot restart
+	var parents []*process
+	defer func() {
+		current.kill()
+		for _, parent := range parents {
+			parent.kill()
+		}
+	}()
+
+	var refresh <-chan time.Time
+	if e.hotRestartEnabled() {
+		ticker := time.NewTicker(policy.BootstrapRefreshInterval)
+		defer ticker.Stop()
+		refresh = ticker.C
+	}
+	var tokenCheck <-chan time.Time
+	if runtime.TokenPath != "" && runtime.TokenWatchInterval > 0 {
+		ticker := time.NewTicker(runtime.TokenWatchInterval)
+		defer ticker.Stop()
+		tokenCheck = ticker.C
+	}
+	// stable fires once Envoy that was crash looping has been running for long enough
+	var stable <-chan time.Time
+
+	status := Status{}
+	crashes := newCrashTracker(policy)
+	for {
+		select {
+		case <-stop:
+			e.drain(current.done)
+			return nil
+		case <-stable:
+			stable = nil
+			runLog.Info("Envoy is no longer crash looping")
+			status.CrashLooping = false
+			e.reportStatus(status)
+		case <-refresh:
+			bootstrapConfig, bootstrap, err := e.generateBootstrap()
+			if err != nil {
+				runLog.Error(err, "could not refresh Envoy bootstrap config")
+				continue
+			}
+			if bytes.Equal(bootstrap, current.bootstrap) {
+				continue
+			}
+			runLog.Info("bootstrap config has changed, hot restarting Envoy", "epoch", current.epoch+1)
+			next, err := e.start(bootstrapConfig, bootstrap, current.epoch+1)
+			if err != nil {
+				runLog.Error(err, "could not hot restart Envoy")
+				continue
+			}
+			parents = append(withoutTerminated(parents), current)
+			current = next
+			status.restarted("bootstrap config has changed")
+			e.reportStatus(status)
+		case <-tokenCheck:
+			newToken, err := ReadDataplaneToken(runtime)
+			if err != nil {
+				runLog.Error(err, "could not check whether dataplane token has changed")
+				continue
+			}
+			if newToken == token {
+				continue
+			}
+			// Envoy reads the token from the file only when it opens a connection to the Control Plane,
+			// so it has to be restarted even if bootstrap config is the same
+			bootstrapConfig, bootstrap, err := e.generateBootstrap()
+			if err != nil {
+				runLog.Error(err, "could not generate Envoy bootstrap config with the new dataplane token")
+				continue
+			}
+			const reason = "dataplane token has changed"
+			if e.hotRestartEnabled() {
+				runLog.Info("dataplane token has changed, hot restarting Envoy", "epoch", current.epoch+1)
+				next, err := e.start(bootstrapConfig, bootstrap, current.epoch+1)
+				if err != nil {
+					runLog.Error(err, "could not hot restart Envoy")
+					continue
+				}
+				parents = append(withoutTerminated(parents), current)
+				current = next
+				status.restarted(reason)
+			} else {
+				// without hot restart, existing connections are dropped
+				runLog.Info("dataplane token has changed, restarting Envoy")
+				current.kill()
+				<-current.done
+				next, err := e.start(bootstrapConfig, bootstrap, 0)
+				if err != nil {
+					runLog.Error(err, "could not restart Envoy")
+					if !policy.Enabled {
+						return err
+					}
+					if next = e.restartAfterCrash(stop, crashes, &status, err.Error()); next == nil {
+						return nil
+					}
+				} else {
+					status.restarted(reason)
+				}
+				current = next
+			}
+			token = newToken
+			e.reportStatus(status)
+		case err := <-current.done:
+			if err != nil {
+				runLog.Error(err, "Envoy terminated with an error")
+			} else {
+				runLog.Info("Envoy terminated successfully")
+			}
+			if !policy.Enabled {
+				return err
+			}
+			// a new Envoy cannot take over from parents that belong to a crashed hot restart chain
+			for _, parent := range parents {
+				parent.kill()
+			}
+			parents = nil
+			stable = nil
+
+			reason := "Envoy terminated"
+			if err != nil {
+				reason = err.Error()
+			}
+			next := e.restartAfterCrash(stop, crashes, &status, reason)
+			if next == nil {
+				return nil
+			}
+			current = next
+			e.reportStatus(status)
+			if status.CrashLooping {
+				stable = time.After(policy.CrashLoopPeriod)
+			}
+		}
+	}
+}
+
+// withoutTerminated returns processes that are still running, so parents of many hot restarts do not pile up.
+func withoutTerminated(processes []*process) []*process {
+	var running []*process
+	for _, p := range processes {
+		if !p.hasTerminated() {
+			running = append(running, p)
+		}
+	}
+	return running
+}
+
+// restartAfterCrash keeps restarting Envoy with a back-off until it starts successfully.
+// It returns nil if the Stop channel gets closed in the meantime.
+func (e *Envoy) restartAfterCrash(stop <-chan struct{}, crashes *crashTracker, status *Status, reason string) *process {
+	for {
+		backoff, crashLooping := crashes.record(time.Now())
+		if crashLooping && !status.CrashLooping {
+			runLog.Info("Envoy is crash looping", "restarts", status.Restarts)
+		}
+		status.CrashLooping = crashLooping
+		runLog.Info("restarting Envoy", "backoff", backoff, "reason", reason)
+		select {
+		case <-stop:
+			return nil
+		case <-time.After(backoff):
+		}
+		next, err := e.restart()
+		if err == nil {
+			status.restarted(reason)
+			return next
+		}
+		runLog.Error(err, "could not restart Envoy")
+		reason = err.Error()
+	}
+}
+
+// restart starts Envoy from scratch with a fresh bootstrap config.
+func (e *Envoy) restart() (*process, error) {
+	bootstrapConfig, bootstrap, err := e.generateBootstrap()
+	if err != nil {
+		return nil, err
+	}
+	return e.start(bootstrapConfig, bootstrap, 0)
+}
+
+func (e *Envoy) reportStatus(status Status) {
+	if e.opts.StatusReport == nil || e.statusReportNotSupported {
+		return
+	}
+	if err := e.opts.StatusReport(e.opts.Catalog.Apis.Bootstrap.Url, e.opts.Config, status); err != nil {
+		if isNotSupported(err) {
+			runLog.V(1).Info("status of Envoy is not reported since the Control Plane does not support it", "reason", err.Error())
+			e.statusReportNotSupported = true
+			return
+		}
+		runLog.Error(err, "could not report status of Envoy to the Control Plane")
+	}
+}
+
+func (s *Status) restarted(reason string) {
+	s.Restarts++
+	s.LastRestartTime = time.Now()
+	s.LastRestartReason = reason
+}
+
+// crashTracker keeps track of recent crashes of Envoy to compute restart back-off and to detect crash loops.
+type crashTracker struct {
+	policy  kuma_dp.EnvoyRestart
+	crashes []time.Time
+}
+
+func newCrashTracker(policy kuma_dp.EnvoyRestart) *crashTracker {
+	return &crashTracker{policy: policy}
+}
+
+// record registers a crash and returns a back-off before the next restart
+// and whether Envoy is crash looping.
+//
+// Back-off starts at InitialBackoff and doubles with every crash within CrashLoopPeriod up to MaxBackoff.
+func (t *crashTracker) record(now time.Time) (time.Duration, bool) {
+	recent := t.crashes[:0]
+	for _, crash := range t.crashes {
+		if now.Sub(crash) < t.policy.CrashLoopPeriod {
+			recent = append(recent, crash)
+		}
+	}
+	t.crashes = append(recent, now)
+
+	backoff := t.policy.InitialBackoff
+	for i := 1; i < len(t.crashes) && backoff < t.policy.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > t.policy.MaxBackoff {
+		backoff = t.policy.MaxBackoff
+	}
+	return backoff, len(t.crashes) >= t.policy.CrashLoopThreshold
+}
@@ -0,0 +1,364 @@
+//go:build !windows
+// +build !windows
+
+package envoy
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_bootstrap "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v2"
+	"github.com/golang/protobuf/proto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+	config_types "github.com/Kong/kuma/pkg/config/types"
+	"github.com/Kong/kuma/pkg/test"
+)
+
+var _ = Describe("crashTracker", func() {
+
+	policy := kuma_dp.EnvoyRestart{
+		Enabled:            true,
+		InitialBackoff:     1 * time.Second,
+		MaxBackoff:         5 * time.Second,
+		CrashLoopThreshold: 3,
+		CrashLoopPeriod:    1 * time.Minute,
+	}
+
+	type result struct {
+		backoff      time.Duration
+		crashLooping bool
+	}
+
+	DescribeTable("should compute back-off and detect crash loops",
+		func(crashes []time.Duration, expected []result) {
+			// given
+			tracker := newCrashTracker(policy)
+			start := time.Now()
+
+			// when
+			var actual []result
+			for _, crash := range crashes {
+				backoff, crashLooping := tracker.record(start.Add(crash))
+				actual = append(actual, result{backoff: backoff, crashLooping: crashLooping})
+			}
+
+			// then
+			Expect(actual).To(Equal(expected))
+		},
+		Entry("single crash", []time.Duration{0}, []result{
+			{backoff: 1 * time.Second},
+		}),
+		Entry("consecutive crashes", []time.Duration{0, 2 * time.Second, 5 * time.Second, 10 * time.Second}, []result{
+			{backoff: 1 * time.Second},
+			{backoff: 2 * time.Second},
+			{backoff: 4 * time.Second, crashLooping: true},
+			{backoff: 5 * time.Second, crashLooping: true},
+		}),
+		Entry("crashes outside of crash loop period", []time.Duration{0, 2 * time.Second, 5 * time.Minute, 7 * time.Minute}, []result{
+			{backoff: 1 * time.Second},
+			{backoff: 2 * time.Second},
+			{backoff: 1 * time.Second},
+			{backoff: 1 * time.Second},
+		}),
+	)
+})
+
+var _ = Describe("withoutTerminated", func() {
+
+	It("should drop processes that have terminated", func() {
+		// given
+		newProcess := func(epoch uint32, terminated bool) *process {
+			p := &process{epoch: epoch, terminated: make(chan struct{})}
+			if terminated {
+				close(p.terminated)
+			}
+			return p
+		}
+		processes := []*process{newProcess(0, true), newProcess(1, false), newProcess(2, true), newProcess(3, false)}
+
+		// when
+		running := withoutTerminated(processes)
+
+		// then
+		var epochs []uint32
+		for _, p := range running {
+			epochs = append(epochs, p.epoch)
+		}
+		Expect(epochs).To(Equal([]uint32{1, 3}))
+	})
+})
+
+// syncBuffer is a buffer that can be written by multiple Envoy processes at the same time.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+var _ = Describe("Supervisor", func() {
+
+	var configDir string
+
+	BeforeEach(func() {
+		var err error
+		configDir, err = ioutil.TempDir("", "")
+		Expect(err).ToNot(HaveOccurred())
+	})
+	AfterEach(func() {
+		Expect(os.RemoveAll(configDir)).To(Succeed())
+	})
+
+	var stopCh chan struct{}
+	var errCh chan error
+
+	BeforeEach(func() {
+		stopCh = make(chan struct{})
+		errCh = make(chan error, 1)
+	})
+
+	var mu sync.Mutex
+	var generated int
+	var statuses []Status
+
+	BeforeEach(func() {
+		generated = 0
+		statuses = nil
+	})
+
+	generator := func(string, kuma_dp.Config) (proto.Message, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		generated++
+		return &envoy_bootstrap.Bootstrap{
+			Node: &envoy_core.Node{
+				Id: fmt.Sprintf("example-%d", generated),
+			},
+		}, nil
+	}
+	statusReport := func(_ string, _ kuma_dp.Config, status Status) error {
+		mu.Lock()
+		defer mu.Unlock()
+		statuses = append(statuses, status)
+		return nil
+	}
+	reportedStatuses := func() []Status {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]Status(nil), statuses...)
+	}
+
+	It("should restart crashed Envoy with a fresh bootstrap config and report a crash loop", func(done Done) {
+		// given
+		cfg := kuma_dp.Config{
+			Dataplane: kuma_dp.Dataplane{
+				DrainTime: 10 * time.Millisecond,
+			},
+			DataplaneRuntime: kuma_dp.DataplaneRuntime{
+				BinaryPath: filepath.Join("testdata", "envoy-mock.exit-1.sh"),
+				ConfigDir:  configDir,
+				Restart: kuma_dp.EnvoyRestart{
+					Enabled:            true,
+					InitialBackoff:     10 * time.Millisecond,
+					MaxBackoff:         40 * time.Millisecond,
+					CrashLoopThreshold: 3,
+					CrashLoopPeriod:    time.Minute,
+				},
+			},
+		}
+		dataplane := New(Opts{
+			Config:       cfg,
+			Generator:    generator,
+			StatusReport: statusReport,
+			Stdout:       &bytes.Buffer{},
+			Stderr:       &bytes.Buffer{},
+		})
+
+		// when
+		go func() {
+			errCh <- dataplane.Run(stopCh)
+		}()
+
+		// then
+		Eventually(reportedStatuses, "5s", "10ms").Should(HaveLen(3))
+		// and
+		actual := reportedStatuses()
+		Expect(actual[0].Restarts).To(Equal(uint32(1)))
+		Expect(actual[0].LastRestartReason).To(Equal("exit status 1"))
+		Expect(actual[0].CrashLooping).To(BeFalse())
+		Expect(actual[1].CrashLooping).To(BeFalse())
+		Expect(actual[2].Restarts).To(Equal(uint32(3)))
+		Expect(actual[2].CrashLooping).To(BeTrue())
+
+		// and bootstrap config is regenerated on every restart
+		mu.Lock()
+		Expect(generated).To(BeNumerically(">=", 4))
+		mu.Unlock()
+
+		// when
+		close(stopCh)
+
+		// then
+		Expect(<-errCh).ToNot(HaveOccurred())
+
+		// complete
+		close(done)
+	}, 10)
+
+	It("should hot restart Envoy once bootstrap config changes", func(done Done) {
+		// setup
+		port, err := test.GetFreePort()
+		Expect(err).ToNot(HaveOccurred())
+
+		// given
+		cfg := kuma_dp.Config{
+			Dataplane: kuma_dp.Dataplane{
+				AdminPort: config_types.MustExactPort(uint32(port)),
+				DrainTime: 10 * time.Millisecond,
+			},
+			DataplaneRuntime: kuma_dp.DataplaneRuntime{
+				BinaryPath: filepath.Join("testdata", "envoy-mock.sleep.sh"),
+				ConfigDir:  configDir,
+				Restart: kuma_dp.EnvoyRestart{
+					BootstrapRefreshInterval: 50 * time.Millisecond,
+				},
+			},
+		}
+		stdout := &syncBuffer{}
+		dataplane := New(Opts{
+			Config:       cfg,
+			Generator:    generator,
+			StatusReport: statusReport,
+			Stdout:       stdout,
+			Stderr:       &syncBuffer{},
+		})
+
+		// when
+		go func() {
+			errCh <- dataplane.Run(stopCh)
+		}()
+
+		// then
+		Eventually(reportedStatuses, "5s", "10ms").ShouldNot(BeEmpty())
+		Expect(reportedStatuses()[0].LastRestartReason).To(Equal("bootstrap config has changed"))
+		// and
+		Eventually(func() []string {
+			return strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		}, "5s", "10ms").Should(ContainElement(
+			fmt.Sprintf("-c %s --drain-time-s 0 --base-id %d --restart-epoch 1 --parent-shutdown-time-s 15", filepath.Join(configDir, "bootstrap.yaml"), port),
+		))
+
+		// when
+		close(stopCh)
+
+		// then
+		Expect(<-errCh).ToNot(HaveOccurred())
+
+		// complete
+		close(done)
+	}, 10)
+
+	It("should restart Envoy once dataplane token changes", func(done Done) {
+		// setup
+		tokenPath := filepath.Join(configDir, "token")
+		Expect(ioutil.WriteFile(tokenPath, []byte("token-1"), 0600)).To(Succeed())
+
+		// given
+		cfg := kuma_dp.Config{
+			Dataplane: kuma_dp.Dataplane{
+				DrainTime: 10 * time.Millisecond,
+			},
+			DataplaneRuntime: kuma_dp.DataplaneRuntime{
+				BinaryPath:         filepath.Join("testdata", "envoy-mock.sleep.sh"),
+				ConfigDir:          configDir,
+				TokenPath:          tokenPath,
+				TokenWatchInterval: 10 * time.Millisecond,
+				Restart: kuma_dp.EnvoyRestart{
+					Enabled:            true,
+					InitialBackoff:     10 * time.Millisecond,
+					MaxBackoff:         10 * time.Millisecond,
+					CrashLoopThreshold: 3,
+					CrashLoopPeriod:    time.Minute,
+				},
+			},
+		}
+		// bootstrap config does not embed dataplane token, Envoy reads it from the file
+		dataplane := New(Opts{
+			Config:       cfg,
+			Generator:    generator,
+			StatusReport: statusReport,
+			Stdout:       &syncBuffer{},
+			Stderr:       &syncBuffer{},
+		})
+
+		// when
+		go func() {
+			errCh <- dataplane.Run(stopCh)
+		}()
+
+		// then
+		Consistently(reportedStatuses, "100ms", "10ms").Should(BeEmpty())
+
+		// when
+		Expect(ioutil.WriteFile(tokenPath, []byte("token-2"), 0600)).To(Succeed())
+
+		// then
+		Eventually(reportedStatuses, "5s", "10ms").Should(HaveLen(1))
+		Expect(reportedStatuses()[0].Restarts).To(Equal(uint32(1)))
+		Expect(reportedStatuses()[0].LastRestartReason).To(Equal("dataplane token has changed"))
+		Expect(reportedStatuses()[0].CrashLooping).To(BeFalse())
+		// and Envoy is not restarted again with the same token
+		Consistently(reportedStatuses, "100ms", "10ms").Should(HaveLen(1))
+
+		// when
+		close(stopCh)
+
+		// then
+		Expect(<-errCh).ToNot(HaveOccurred())
+
+		// complete
+		close(done)
+	}, 10)
+
+	It("should refuse to hot restart Envoy without Envoy Admin API", func() {
+		// given
+		cfg := kuma_dp.Config{
+			DataplaneRuntime: kuma_dp.DataplaneRuntime{
+				Restart: kuma_dp.EnvoyRestart{
+					BootstrapRefreshInterval: time.Second,
+				},
+			},
+		}
+		dataplane := New(Opts{
+			Config:    cfg,
+			Generator: generator,
+		})
+
+		// when
+		err := dataplane.Run(stopCh)
+
+		// then
+		Expect(err).To(MatchError("hot restart of Envoy requires Envoy Admin API to be exposed over TCP"))
+	})
+})
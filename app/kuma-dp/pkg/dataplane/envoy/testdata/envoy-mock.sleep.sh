@@ -0,0 +1,6 @@
+#!/bin/sh
+
+# print arguments to verify in the test
+echo $@
+
+exec sleep 86400
@@ -0,0 +1,123 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+
+	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+)
+
+const scrapeTimeout = 5 * time.Second
+
+// aggregatedHandler serves metrics of Envoy, of the application and of kuma-dp itself as a single Prometheus page.
+//
+// The application endpoint comes from the kuma-dp configuration rather than from a scrape request,
+// so that a scraper cannot make kuma-dp scrape an arbitrary port.
+// Both Envoy and the application are scraped on the loopback interface only.
+//
+// If several sources expose a metric with the same name, the first one wins in the order above.
+// A source that cannot be scraped is skipped, so that metrics of the other sources are still available.
+type aggregatedHandler struct {
+	envoyAdminPort uint32
+	appMetricsURL  string
+	gatherer       prometheus.Gatherer
+	client         *http.Client
+}
+
+func newAggregatedHandler(cfg kuma_dp.Metrics, envoyAdminPort uint32, gatherer prometheus.Gatherer) *aggregatedHandler {
+	return &aggregatedHandler{
+		envoyAdminPort: envoyAdminPort,
+		appMetricsURL:  appMetricsURL(cfg),
+		gatherer:       gatherer,
+		client: &http.Client{
+			Timeout: scrapeTimeout,
+		},
+	}
+}
+
+func (h *aggregatedHandler) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
+	families := map[string]*io_prometheus_client.MetricFamily{}
+	add := func(source string, scraped []*io_prometheus_client.MetricFamily) {
+		for _, family := range scraped {
+			if _, exists := families[family.GetName()]; exists {
+				log.V(1).Info("skipping a metric that has already been exposed by another source", "source", source, "metric", family.GetName())
+				continue
+			}
+			families[family.GetName()] = family
+		}
+	}
+
+	if h.envoyAdminPort != 0 {
+		scraped, err := h.scrape(fmt.Sprintf("http://127.0.0.1:%d/stats/prometheus", h.envoyAdminPort))
+		if err != nil {
+			log.Error(err, "could not scrape metrics of Envoy")
+		}
+		add("envoy", scraped)
+	}
+	if h.appMetricsURL != "" {
+		scraped, err := h.scrape(h.appMetricsURL)
+		if err != nil {
+			log.Error(err, "could not scrape metrics of the application", "url", h.appMetricsURL)
+		}
+		add("app", scraped)
+	}
+	gathered, err := h.gatherer.Gather()
+	if err != nil {
+		// Gather returns as many metrics as possible even in case of an error
+		log.Error(err, "could not gather metrics of kuma-dp")
+	}
+	add("kuma-dp", gathered)
+
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	format := expfmt.Negotiate(req.Header)
+	writer.Header().Set("Content-Type", string(format))
+	encoder := expfmt.NewEncoder(writer, format)
+	for _, name := range names {
+		if err := encoder.Encode(families[name]); err != nil {
+			log.Error(err, "could not write metrics", "metric", name)
+			return
+		}
+	}
+}
+
+// appMetricsURL returns URL of the application metrics endpoint or an empty string if the application does not expose metrics.
+func appMetricsURL(cfg kuma_dp.Metrics) string {
+	if cfg.AppPort == 0 {
+		return ""
+	}
+	return fmt.Sprintf("http://127.0.0.1:%d%s", cfg.AppPort, cfg.AppPath)
+}
+
+func (h *aggregatedHandler) scrape(url string) ([]*io_prometheus_client.MetricFamily, error) {
+	resp, err := h.client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	var parser expfmt.TextParser
+	parsed, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "metrics are not in Prometheus text format")
+	}
+	families := make([]*io_prometheus_client.MetricFamily, 0, len(parsed))
+	for _, family := range parsed {
+		families = append(families, family)
+	}
+	return families, nil
+}
@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+	"github.com/Kong/kuma/pkg/core"
+	bootstrap_types "github.com/Kong/kuma/pkg/xds/bootstrap/types"
+)
+
+var log = core.Log.WithName("metrics-server")
+
+// Server exposes metrics of kuma-dp itself, e.g. of access log delivery, in Prometheus format.
+//
+// It also exposes metrics of kuma-dp merged with metrics of Envoy and of the application,
+// so that a single scrape of the Prometheus endpoint of a dataplane covers all of them.
+type Server struct {
+	cfg            kuma_dp.Metrics
+	envoyAdminPort uint32
+	gatherer       prometheus.Gatherer
+}
+
+// NewServer returns a metrics server. If envoyAdminPort is 0, aggregated metrics do not include metrics of Envoy.
+func NewServer(cfg kuma_dp.Metrics, envoyAdminPort uint32, gatherer prometheus.Gatherer) *Server {
+	return &Server{
+		cfg:            cfg,
+		envoyAdminPort: envoyAdminPort,
+		gatherer:       gatherer,
+	}
+}
+
+// Start serves `/metrics` and `/metrics/aggregate` until the Stop channel is closed.
+func (s *Server) Start(stop <-chan struct{}) error {
+	lis, err := net.Listen("tcp", s.cfg.Address)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(s.gatherer, promhttp.HandlerOpts{}))
+	mux.Handle(bootstrap_types.AggregatedMetricsPath, newAggregatedHandler(s.cfg, s.envoyAdminPort, s.gatherer))
+	httpServer := &http.Server{Handler: mux}
+
+	errChan := make(chan error)
+	go func() {
+		defer close(errChan)
+		if err := httpServer.Serve(lis); err != nil && err != http.ErrServerClosed {
+			errChan <- err
+		}
+	}()
+	log.Info("starting", "address", lis.Addr())
+
+	select {
+	case <-stop:
+		log.Info("stopping")
+		return httpServer.Shutdown(context.Background())
+	case err := <-errChan:
+		return err
+	}
+}
@@ -0,0 +1,142 @@
+package metrics_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+
+	. "github.com/Kong/kuma/app/kuma-dp/pkg/dataplane/metrics"
+	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+	"github.com/Kong/kuma/pkg/test"
+)
+
+var _ = Describe("Server", func() {
+
+	It("should serve metrics in Prometheus format", func(done Done) {
+		// setup
+		port, err := test.GetFreePort()
+		Expect(err).ToNot(HaveOccurred())
+		address := fmt.Sprintf("127.0.0.1:%d", port)
+
+		// given
+		registry := prometheus.NewRegistry()
+		counter := prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "example_total",
+			Help: "Example counter",
+		})
+		Expect(registry.Register(counter)).To(Succeed())
+		counter.Add(3)
+		// and
+		server := NewServer(kuma_dp.Metrics{Address: address}, 0, registry)
+
+		// when
+		stop := make(chan struct{})
+		errCh := make(chan error)
+		go func() {
+			errCh <- server.Start(stop)
+		}()
+
+		// then
+		Eventually(func() (string, error) {
+			resp, err := http.Get(fmt.Sprintf("http://%s/metrics", address))
+			if err != nil {
+				return "", err
+			}
+			defer resp.Body.Close()
+			body, err := ioutil.ReadAll(resp.Body)
+			return string(body), err
+		}, "5s", "10ms").Should(ContainSubstring("example_total 3"))
+
+		// when
+		close(stop)
+
+		// then
+		Expect(<-errCh).ToNot(HaveOccurred())
+
+		// complete
+		close(done)
+	}, 10)
+
+	It("should serve metrics of Envoy, of the application and of kuma-dp together", func(done Done) {
+		// setup
+		port, err := test.GetFreePort()
+		Expect(err).ToNot(HaveOccurred())
+		address := fmt.Sprintf("127.0.0.1:%d", port)
+		// and
+		envoyAdmin := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.Path).To(Equal("/stats/prometheus"))
+			_, err := writer.Write([]byte("# TYPE envoy_server_live gauge\nenvoy_server_live 1\n"))
+			Expect(err).ToNot(HaveOccurred())
+		}))
+		defer envoyAdmin.Close()
+		// and
+		app := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.URL.Path).To(Equal("/app-metrics"))
+			_, err := writer.Write([]byte("# TYPE orders_total counter\norders_total 7\n# TYPE envoy_server_live gauge\nenvoy_server_live 0\n"))
+			Expect(err).ToNot(HaveOccurred())
+		}))
+		defer app.Close()
+
+		// given
+		registry := prometheus.NewRegistry()
+		counter := prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "example_total",
+			Help: "Example counter",
+		})
+		Expect(registry.Register(counter)).To(Succeed())
+		counter.Add(3)
+		// and
+		server := NewServer(kuma_dp.Metrics{
+			Address: address,
+			AppPort: portOf(app),
+			AppPath: "/app-metrics",
+		}, portOf(envoyAdmin), registry)
+
+		// when
+		stop := make(chan struct{})
+		errCh := make(chan error)
+		go func() {
+			errCh <- server.Start(stop)
+		}()
+
+		// then
+		Eventually(func() (string, error) {
+			resp, err := http.Get(fmt.Sprintf("http://%s/metrics/aggregate", address))
+			if err != nil {
+				return "", err
+			}
+			defer resp.Body.Close()
+			body, err := ioutil.ReadAll(resp.Body)
+			return string(body), err
+		}, "5s", "10ms").Should(And(
+			ContainSubstring("envoy_server_live 1"),
+			Not(ContainSubstring("envoy_server_live 0")), // metrics of Envoy take precedence
+			ContainSubstring("orders_total 7"),
+			ContainSubstring("example_total 3"),
+		))
+
+		// when
+		close(stop)
+
+		// then
+		Expect(<-errCh).ToNot(HaveOccurred())
+
+		// complete
+		close(done)
+	}, 10)
+})
+
+func portOf(server *httptest.Server) uint32 {
+	port, err := strconv.Atoi(strings.Split(server.Listener.Addr().String(), ":")[1])
+	Expect(err).ToNot(HaveOccurred())
+	return uint32(port)
+}
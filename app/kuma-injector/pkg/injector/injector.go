@@ -90,7 +90,7 @@ func (i *KumaInjector) meshFor(pod *kube_core.Pod) (*mesh_core.MeshResource, err
 
 func (i *KumaInjector) NewSidecarContainer(pod *kube_core.Pod) kube_core.Container {
 	mesh := metadata.GetMesh(pod) // either user-defined value or default
-	return kube_core.Container{
+	container := kube_core.Container{
 		Name:            KumaSidecarContainerName,
 		Image:           i.cfg.SidecarContainer.Image,
 		ImagePullPolicy: kube_core.PullIfNotPresent,
@@ -205,6 +205,33 @@ func (i *KumaInjector) NewSidecarContainer(pod *kube_core.Pod) kube_core.Contain
 		// ServiceAccount volume mount into containers it creates.
 		VolumeMounts: i.NewVolumeMounts(pod),
 	}
+	if appPort, appPath := metadata.GetPrometheusApp(pod); appPort != 0 {
+		// metrics of the application are merged by kuma-dp, therefore its metrics endpoint has to be enabled
+		container.Env = append(container.Env,
+			kube_core.EnvVar{
+				Name:  "KUMA_DATAPLANE_RUNTIME_METRICS_ADDRESS",
+				Value: fmt.Sprintf("127.0.0.1:%d", i.cfg.SidecarContainer.MetricsPort),
+			},
+			kube_core.EnvVar{
+				Name:  "KUMA_DATAPLANE_RUNTIME_METRICS_APP_PORT",
+				Value: strconv.Itoa(int(appPort)),
+			},
+		)
+		if appPath != "" {
+			container.Env = append(container.Env, kube_core.EnvVar{
+				Name:  "KUMA_DATAPLANE_RUNTIME_METRICS_APP_PATH",
+				Value: appPath,
+			})
+		}
+	}
+	if i.cfg.ControlPlane.BootstrapServer.CaCert != "" {
+		// the Bootstrap Server is served over TLS, so kuma-dp has to verify it before it sends a ServiceAccount token
+		container.Env = append(container.Env, kube_core.EnvVar{
+			Name:  "KUMA_CONTROL_PLANE_BOOTSTRAP_SERVER_CA_CERT",
+			Value: i.cfg.ControlPlane.BootstrapServer.CaCert,
+		})
+	}
+	return container
 }
 
 func (i *KumaInjector) NewVolumeMounts(pod *kube_core.Pod) []kube_core.VolumeMount {
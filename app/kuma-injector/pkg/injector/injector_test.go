@@ -192,5 +192,18 @@ var _ = Describe("Injector", func() {
                 name: default
               spec: {}`,
 		}),
+		Entry("12. Pod with application metrics annotations", testCase{
+			num: "12",
+			mesh: `
+              apiVersion: kuma.io/v1alpha1
+              kind: Mesh
+              metadata:
+                name: default
+              spec:
+                metrics:
+                  prometheus:
+                    port: 1234
+                    path: /metrics`,
+		}),
 	)
 })
@@ -31,4 +31,9 @@ const (
 
 	KumaMetricsPrometheusPort = "prometheus.metrics.kuma.io/port"
 	KumaMetricsPrometheusPath = "prometheus.metrics.kuma.io/path"
+
+	// KumaMetricsPrometheusAppPort and KumaMetricsPrometheusAppPath define an endpoint on which the application
+	// exposes its own metrics, so that they are served together with metrics of the dataplane.
+	KumaMetricsPrometheusAppPort = "prometheus.metrics.kuma.io/app-port"
+	KumaMetricsPrometheusAppPath = "prometheus.metrics.kuma.io/app-path"
 )
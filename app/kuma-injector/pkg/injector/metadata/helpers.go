@@ -34,3 +34,13 @@ func GetTransparentProxyingPort(pod *kube_core.Pod) uint32 {
 	}
 	return uint32(port)
 }
+
+// GetPrometheusApp returns port and path on which the application exposes its own metrics.
+// Zero port means the application does not expose metrics.
+func GetPrometheusApp(pod *kube_core.Pod) (uint32, string) {
+	port, err := strconv.ParseUint(pod.Annotations[KumaMetricsPrometheusAppPort], 10, 16)
+	if err != nil {
+		return 0, ""
+	}
+	return uint32(port), pod.Annotations[KumaMetricsPrometheusAppPath]
+}
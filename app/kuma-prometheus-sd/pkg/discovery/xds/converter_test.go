@@ -69,6 +69,40 @@ var _ = Describe("Converter", func() {
 					},
 				}},
 			}),
+			Entry("1 Dataplane per assignment, protected by mTLS", testCase{
+				input: &observability_proto.MonitoringAssignment{
+					Name: "/meshes/default/dataplanes/backend-01",
+					Targets: []*observability_proto.MonitoringAssignment_Target{{
+						Labels: map[string]string{
+							"__address__": "192.168.0.1:8080",
+						},
+					}},
+					Labels: map[string]string{
+						"__scheme__":            "https",
+						"__metrics_path__":      "/metrics",
+						"__meta_kuma_mtls":      "true",
+						"__meta_kuma_spiffe_id": "spiffe://default/backend",
+						"job":                   "backend",
+						"instance":              "backend-01",
+					},
+				},
+				expected: []*targetgroup.Group{{
+					Source: "/meshes/default/dataplanes/backend-01/0",
+					Targets: []model.LabelSet{
+						{
+							"__address__": "192.168.0.1:8080",
+						},
+					},
+					Labels: model.LabelSet{
+						"__scheme__":            "https",
+						"__metrics_path__":      "/metrics",
+						"__meta_kuma_mtls":      "true",
+						"__meta_kuma_spiffe_id": "spiffe://default/backend",
+						"job":                   "backend",
+						"instance":              "backend-01",
+					},
+				}},
+			}),
 			Entry("1 Dataplane per assignment, in a free format", testCase{
 				input: &observability_proto.MonitoringAssignment{
 					Name: "/meshes/default/dataplanes/backend-01",
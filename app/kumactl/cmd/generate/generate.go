@@ -15,5 +15,6 @@ func NewGenerateCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	// sub-commands
 	cmd.AddCommand(NewGenerateDataplaneTokenCmd(pctx))
 	cmd.AddCommand(NewGenerateCertificateCmd(pctx))
+	cmd.AddCommand(NewGenerateClientCertificateCmd(pctx))
 	return cmd
 }
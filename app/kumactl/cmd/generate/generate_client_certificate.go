@@ -0,0 +1,66 @@
+package generate
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
+)
+
+type generateClientCertificateContext struct {
+	*kumactl_cmd.RootContext
+
+	args struct {
+		name   string
+		key    string
+		cert   string
+		caCert string
+	}
+}
+
+func NewGenerateClientCertificateCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &generateClientCertificateContext{RootContext: pctx}
+	cmd := &cobra.Command{
+		Use:   "client-certificate",
+		Short: "Generate a client certificate issued by the CA of a Mesh",
+		Long:  `Generate a client certificate issued by the CA of a Mesh that can be used for example by Prometheus to scrape dataplanes protected by mTLS.`,
+		Example: `
+  # Generate a certificate for Prometheus to scrape dataplanes of the 'default' Mesh
+  kumactl generate client-certificate --name=prometheus --mesh=default`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := pctx.CurrentClientCertClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create client certificate client")
+			}
+			clientCert, err := client.Generate(pctx.Args.Mesh, ctx.args.name)
+			if err != nil {
+				return errors.Wrap(err, "failed to generate a client certificate")
+			}
+			if err := ioutil.WriteFile(ctx.args.key, []byte(clientCert.Key), 0400); err != nil {
+				return errors.Wrap(err, "could not write the key file")
+			}
+			if err := ioutil.WriteFile(ctx.args.cert, []byte(clientCert.Cert), 0644); err != nil {
+				return errors.Wrap(err, "could not write the cert file")
+			}
+			if err := ioutil.WriteFile(ctx.args.caCert, []byte(strings.Join(clientCert.CaCerts, "")), 0644); err != nil {
+				return errors.Wrap(err, "could not write the CA cert file")
+			}
+			_, err = cmd.OutOrStdout().Write([]byte(fmt.Sprintf(`Certificates generated
+Key was saved in: %s
+Cert was saved in: %s
+CA cert was saved in: %s
+`, ctx.args.key, ctx.args.cert, ctx.args.caCert)))
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&ctx.args.name, "name", "", "name of the client, e.g. prometheus")
+	cmd.Flags().StringVar(&ctx.args.key, "key-file", "key.pem", "path to a file with a generated private key")
+	cmd.Flags().StringVar(&ctx.args.cert, "cert-file", "cert.pem", "path to a file with a generated TLS certificate")
+	cmd.Flags().StringVar(&ctx.args.caCert, "ca-cert-file", "ca.pem", "path to a file with root certificates of the CA of the Mesh")
+	_ = cmd.MarkFlagRequired("name")
+	return cmd
+}
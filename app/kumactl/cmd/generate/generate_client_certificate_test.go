@@ -0,0 +1,142 @@
+package generate_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/Kong/kuma/app/kumactl/cmd"
+	"github.com/Kong/kuma/app/kumactl/pkg/ca"
+	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
+	"github.com/Kong/kuma/pkg/catalog"
+	catalog_client "github.com/Kong/kuma/pkg/catalog/client"
+	config_kumactl "github.com/Kong/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/ca/rest/types"
+	test_catalog "github.com/Kong/kuma/pkg/test/catalog"
+)
+
+type staticClientCertClient struct {
+	mesh string
+	name string
+	err  error
+}
+
+var _ ca.ClientCertClient = &staticClientCertClient{}
+
+func (s *staticClientCertClient) Generate(mesh string, name string) (types.ClientCert, error) {
+	s.mesh = mesh
+	s.name = name
+	if s.err != nil {
+		return types.ClientCert{}, s.err
+	}
+	return types.ClientCert{
+		Cert:    "CERT",
+		Key:     "KEY",
+		CaCerts: []string{"CA-1\n", "CA-2\n"},
+	}, nil
+}
+
+var _ = Describe("kumactl generate client-certificate", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var client *staticClientCertClient
+
+	var keyFile *os.File
+	var certFile *os.File
+	var caCertFile *os.File
+
+	BeforeEach(func() {
+		client = &staticClientCertClient{}
+		ctx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				NewClientCertClient: func(string, *config_kumactl.Context_AdminApiCredentials) (ca.ClientCertClient, error) {
+					return client, nil
+				},
+				NewCatalogClient: func(s string) (catalog_client.CatalogClient, error) {
+					return &test_catalog.StaticCatalogClient{
+						Resp: catalog.Catalog{
+							Apis: catalog.Apis{
+								Admin: catalog.AdminApi{
+									LocalUrl: "http://localhost:1234",
+								},
+							},
+						},
+					}, nil
+				},
+			},
+		}
+
+		rootCmd = cmd.NewRootCmd(ctx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+
+		for _, file := range []**os.File{&keyFile, &certFile, &caCertFile} {
+			f, err := ioutil.TempFile("", "")
+			Expect(err).ToNot(HaveOccurred())
+			*file = f
+		}
+	})
+
+	AfterEach(func() {
+		for _, file := range []*os.File{keyFile, certFile, caCertFile} {
+			Expect(os.Remove(file.Name())).To(Succeed())
+		}
+	})
+
+	It("should generate a client certificate", func() {
+		// given
+		rootCmd.SetArgs([]string{"generate", "client-certificate",
+			"--name", "prometheus",
+			"--mesh", "demo",
+			"--key-file", keyFile.Name(),
+			"--cert-file", certFile.Name(),
+			"--ca-cert-file", caCertFile.Name(),
+		})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.mesh).To(Equal("demo"))
+		Expect(client.name).To(Equal("prometheus"))
+
+		// and
+		keyBytes, err := ioutil.ReadAll(keyFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(keyBytes)).To(Equal("KEY"))
+
+		// and
+		certBytes, err := ioutil.ReadAll(certFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(certBytes)).To(Equal("CERT"))
+
+		// and
+		caCertBytes, err := ioutil.ReadAll(caCertFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(caCertBytes)).To(Equal("CA-1\nCA-2\n"))
+	})
+
+	It("should write error when generating a certificate fails", func() {
+		// setup
+		client.err = errors.New("could not connect to API")
+
+		// given
+		rootCmd.SetArgs([]string{"generate", "client-certificate", "--name", "prometheus"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(HaveOccurred())
+
+		// and
+		Expect(buf.String()).To(Equal("Error: failed to generate a client certificate: could not connect to API\n"))
+	})
+})
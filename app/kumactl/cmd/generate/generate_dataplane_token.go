@@ -1,6 +1,8 @@
 package generate
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
@@ -12,6 +14,7 @@ type generateDataplaneTokenContext struct {
 
 	args struct {
 		dataplane string
+		validFor  time.Duration
 	}
 }
 
@@ -27,7 +30,10 @@ func NewGenerateDataplaneTokenCmd(pctx *kumactl_cmd.RootContext) *cobra.Command
 				return errors.Wrap(err, "failed to create dataplane token client")
 			}
 
-			token, err := client.Generate(ctx.args.dataplane, pctx.Args.Mesh)
+			if ctx.args.validFor < 0 {
+				return errors.New("--valid-for cannot be negative")
+			}
+			token, err := client.Generate(ctx.args.dataplane, pctx.Args.Mesh, ctx.args.validFor)
 			if err != nil {
 				return errors.Wrap(err, "failed to generate a dataplane token")
 			}
@@ -37,5 +43,6 @@ func NewGenerateDataplaneTokenCmd(pctx *kumactl_cmd.RootContext) *cobra.Command
 	}
 	cmd.Flags().StringVar(&ctx.args.dataplane, "dataplane", "", "name of the Dataplane")
 	_ = cmd.MarkFlagRequired("dataplane")
+	cmd.Flags().DurationVar(&ctx.args.validFor, "valid-for", 0, "how long the token is valid for, e.g. 720h (by default the token never expires)")
 	return cmd
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -24,13 +25,20 @@ type staticDataplaneTokenGenerator struct {
 
 var _ tokens.DataplaneTokenClient = &staticDataplaneTokenGenerator{}
 
-func (s *staticDataplaneTokenGenerator) Generate(name string, mesh string) (string, error) {
+func (s *staticDataplaneTokenGenerator) Generate(name string, mesh string, validFor time.Duration) (string, error) {
 	if s.err != nil {
 		return "", s.err
 	}
+	if validFor != 0 {
+		return fmt.Sprintf("token-for-%s-%s-valid-for-%s", name, mesh, validFor), nil
+	}
 	return fmt.Sprintf("token-for-%s-%s", name, mesh), nil
 }
 
+func (s *staticDataplaneTokenGenerator) Revoke(string) error {
+	return errors.New("not implemented")
+}
+
 var _ = Describe("kumactl generate dataplane-token", func() {
 
 	var rootCmd *cobra.Command
@@ -88,6 +96,30 @@ var _ = Describe("kumactl generate dataplane-token", func() {
 		Expect(buf.String()).To(Equal("token-for-example-default"))
 	})
 
+	It("should generate a token with expiration time", func() {
+		// when
+		rootCmd.SetArgs([]string{"generate", "dataplane-token", "--dataplane=example", "--valid-for=24h"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		Expect(buf.String()).To(Equal("token-for-example-default-valid-for-24h0m0s"))
+	})
+
+	It("should reject negative validity period", func() {
+		// when
+		rootCmd.SetArgs([]string{"generate", "dataplane-token", "--dataplane=example", "--valid-for=-1h"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(HaveOccurred())
+
+		// and
+		Expect(buf.String()).To(Equal("Error: --valid-for cannot be negative\n"))
+	})
+
 	It("should write error when generating token fails", func() {
 		// setup
 		generator.err = errors.New("could not connect to API")
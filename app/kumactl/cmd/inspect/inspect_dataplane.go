@@ -0,0 +1,129 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/Kong/kuma/app/kumactl/pkg/output"
+	"github.com/Kong/kuma/app/kumactl/pkg/output/printers"
+	"github.com/Kong/kuma/pkg/api-server/types"
+)
+
+type inspectDataplaneContext struct {
+	*inspectContext
+
+	args struct {
+		configDump bool
+	}
+}
+
+func newInspectDataplaneCmd(pctx *inspectContext) *cobra.Command {
+	ctx := inspectDataplaneContext{
+		inspectContext: pctx,
+	}
+	cmd := &cobra.Command{
+		Use:   "dataplane NAME",
+		Short: "Inspect Dataplane",
+		Long:  `Inspect Dataplane. By default, shows policies applied to the Dataplane along with their match scores.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := pctx.CurrentDataplaneInspectClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create a dataplane client")
+			}
+			format := output.Format(pctx.args.outputFormat)
+
+			if ctx.args.configDump {
+				dump, err := client.ConfigDump(context.Background(), pctx.CurrentMesh(), args[0])
+				if err != nil {
+					return err
+				}
+				if format == output.TableFormat {
+					// Envoy resources don't fit into a table
+					format = output.JSONFormat
+				}
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(dump, cmd.OutOrStdout())
+			}
+
+			policies, err := client.Policies(context.Background(), pctx.CurrentMesh(), args[0])
+			if err != nil {
+				return err
+			}
+			switch format {
+			case output.TableFormat:
+				return printDataplanePolicies(policies, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(policies, cmd.OutOrStdout())
+			}
+		},
+	}
+	cmd.PersistentFlags().BoolVar(&ctx.args.configDump, "config-dump", false, "print Envoy resources (listeners, routes, clusters, endpoints) generated for the Dataplane")
+	return cmd
+}
+
+func printDataplanePolicies(policies *types.DataplanePolicies, out io.Writer) error {
+	var rows [][]string
+	addRow := func(appliesTo string, policy *types.MatchedPolicy) {
+		if policy == nil {
+			return
+		}
+		rows = append(rows, []string{
+			policy.Type, // TYPE
+			policy.Name, // NAME
+			appliesTo,   // APPLIES TO
+			fmt.Sprintf("exact=%d wildcard=%d", policy.Score.ExactMatches, policy.Score.WildcardMatches), // SCORE
+		})
+	}
+	for _, inbound := range policies.Inbound {
+		appliesTo := fmt.Sprintf("inbound %s (%s)", inbound.Interface, tagsString(inbound.Tags))
+		for i := range inbound.TrafficPermissions {
+			addRow(appliesTo, &inbound.TrafficPermissions[i])
+		}
+	}
+	for _, outbound := range policies.Outbound {
+		appliesTo := fmt.Sprintf("outbound %s", outbound.Service)
+		addRow(appliesTo, outbound.TrafficRoute)
+		addRow(appliesTo, outbound.TrafficLog)
+		addRow(appliesTo, outbound.HealthCheck)
+	}
+	addRow("dataplane", policies.TrafficTrace)
+	addRow("dataplane", policies.ProxyTemplate)
+
+	data := printers.Table{
+		Headers: []string{"TYPE", "NAME", "APPLIES TO", "SCORE"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(rows) <= i {
+					return nil
+				}
+				return rows[i]
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
+
+func tagsString(tags map[string]string) string {
+	var pairs []string
+	for tag, value := range tags {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", tag, value))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, " ")
+}
@@ -0,0 +1,224 @@
+package inspect_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	gomega_types "github.com/onsi/gomega/types"
+	"github.com/spf13/cobra"
+
+	"github.com/Kong/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
+	"github.com/Kong/kuma/app/kumactl/pkg/resources"
+	"github.com/Kong/kuma/pkg/api-server/types"
+	config_proto "github.com/Kong/kuma/pkg/config/app/kumactl/v1alpha1"
+)
+
+type testDataplaneInspectClient struct {
+	receivedMesh string
+	receivedName string
+	dump         *types.ConfigDump
+	policies     *types.DataplanePolicies
+}
+
+func (c *testDataplaneInspectClient) ConfigDump(_ context.Context, meshName string, name string) (*types.ConfigDump, error) {
+	c.receivedMesh = meshName
+	c.receivedName = name
+	return c.dump, nil
+}
+
+func (c *testDataplaneInspectClient) Policies(_ context.Context, meshName string, name string) (*types.DataplanePolicies, error) {
+	c.receivedMesh = meshName
+	c.receivedName = name
+	return c.policies, nil
+}
+
+var _ resources.DataplaneInspectClient = &testDataplaneInspectClient{}
+
+var _ = Describe("kumactl inspect dataplane", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var testClient *testDataplaneInspectClient
+
+	BeforeEach(func() {
+		// setup
+		testClient = &testDataplaneInspectClient{
+			dump: &types.ConfigDump{
+				Source: "cache",
+				Listeners: types.ConfigDumpResources{
+					Version:    "1",
+					ApiVersion: "v3",
+					Items: []json.RawMessage{
+						json.RawMessage(`{"name":"inbound:127.0.0.1:9090","address":{"socketAddress":{"address":"127.0.0.1","portValue":9090}}}`),
+					},
+				},
+				Routes: types.ConfigDumpResources{
+					Version:    "1",
+					ApiVersion: "v2",
+					Items:      []json.RawMessage{},
+				},
+				Clusters: types.ConfigDumpResources{
+					Version:    "1",
+					ApiVersion: "v2",
+					Items: []json.RawMessage{
+						json.RawMessage(`{"name":"localhost:9091","type":"STATIC"}`),
+					},
+				},
+				Endpoints: types.ConfigDumpResources{
+					Version:    "1",
+					ApiVersion: "v2",
+					Items:      []json.RawMessage{},
+				},
+			},
+			policies: &types.DataplanePolicies{
+				Inbound: []types.InboundPolicies{
+					{
+						Interface: "127.0.0.1:9090:9091",
+						Tags: map[string]string{
+							"service": "web",
+							"version": "v1",
+						},
+						TrafficPermissions: []types.MatchedPolicy{
+							{
+								Type:  "TrafficPermission",
+								Name:  "allow-all",
+								Score: types.MatchScore{WildcardMatches: 1},
+							},
+							{
+								Type:  "TrafficPermission",
+								Name:  "allow-web-v1",
+								Score: types.MatchScore{ExactMatches: 2},
+							},
+						},
+					},
+				},
+				Outbound: []types.OutboundPolicies{
+					{
+						Service: "backend",
+						TrafficRoute: &types.MatchedPolicy{
+							Type:  "TrafficRoute",
+							Name:  "web-to-backend",
+							Score: types.MatchScore{ExactMatches: 2},
+						},
+						HealthCheck: &types.MatchedPolicy{
+							Type:  "HealthCheck",
+							Name:  "hc-all",
+							Score: types.MatchScore{WildcardMatches: 2},
+						},
+					},
+				},
+				TrafficTrace: &types.MatchedPolicy{
+					Type:  "TrafficTrace",
+					Name:  "trace-web",
+					Score: types.MatchScore{ExactMatches: 1, WildcardMatches: 1},
+				},
+			},
+		}
+
+		rootCtx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				NewDataplaneInspectClient: func(*config_proto.ControlPlaneCoordinates_ApiServer) (resources.DataplaneInspectClient, error) {
+					return testClient, nil
+				},
+			},
+		}
+
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	type testCase struct {
+		outputFormat string
+		goldenFile   string
+		matcher      func(interface{}) gomega_types.GomegaMatcher
+	}
+
+	DescribeTable("kumactl inspect dataplane NAME --config-dump -o table|json|yaml",
+		func(given testCase) {
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "dataplane", "backend-01", "--config-dump", "--mesh", "demo"}, given.outputFormat))
+
+			// when
+			err := rootCmd.Execute()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(testClient.receivedMesh).To(Equal("demo"))
+			Expect(testClient.receivedName).To(Equal("backend-01"))
+
+			// when
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", given.goldenFile))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(buf.String()).To(given.matcher(expected))
+		},
+		Entry("should print JSON instead of a table", testCase{
+			outputFormat: "-otable",
+			goldenFile:   "inspect-dataplane-config-dump.golden.json",
+			matcher:      MatchJSON,
+		}),
+		Entry("should support JSON output", testCase{
+			outputFormat: "-ojson",
+			goldenFile:   "inspect-dataplane-config-dump.golden.json",
+			matcher:      MatchJSON,
+		}),
+		Entry("should support YAML output", testCase{
+			outputFormat: "-oyaml",
+			goldenFile:   "inspect-dataplane-config-dump.golden.yaml",
+			matcher:      MatchYAML,
+		}),
+	)
+
+	DescribeTable("kumactl inspect dataplane NAME -o table|json|yaml",
+		func(given testCase) {
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "dataplane", "web-01"}, given.outputFormat))
+
+			// when
+			err := rootCmd.Execute()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(testClient.receivedMesh).To(Equal("default"))
+			Expect(testClient.receivedName).To(Equal("web-01"))
+
+			// when
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", given.goldenFile))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(buf.String()).To(given.matcher(expected))
+		},
+		Entry("should support Table output", testCase{
+			outputFormat: "-otable",
+			goldenFile:   "inspect-dataplane-policies.golden.txt",
+			matcher: func(expected interface{}) gomega_types.GomegaMatcher {
+				return WithTransform(strings.TrimSpace, Equal(strings.TrimSpace(string(expected.([]byte)))))
+			},
+		}),
+		Entry("should support JSON output", testCase{
+			outputFormat: "-ojson",
+			goldenFile:   "inspect-dataplane-policies.golden.json",
+			matcher:      MatchJSON,
+		}),
+		Entry("should support YAML output", testCase{
+			outputFormat: "-oyaml",
+			goldenFile:   "inspect-dataplane-policies.golden.yaml",
+			matcher:      MatchYAML,
+		}),
+	)
+})
@@ -9,11 +9,12 @@ import (
 func NewInstallCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "install",
-		Short: "Install Kuma on Kubernetes",
-		Long:  `Install Kuma on Kubernetes.`,
+		Short: "Install various Kuma components.",
+		Long:  `Install various Kuma components.`,
 	}
 	// sub-commands
 	cmd.AddCommand(newInstallControlPlaneCmd(pctx))
-	cmd.AddCommand(newInstallMetrics())
+	cmd.AddCommand(newInstallMetrics(pctx))
+	cmd.AddCommand(newInstallTransparentProxy(pctx))
 	return cmd
 }
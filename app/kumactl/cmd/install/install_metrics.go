@@ -1,17 +1,34 @@
 package install
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
 	"github.com/Kong/kuma/app/kumactl/pkg/install/data"
 	"github.com/Kong/kuma/app/kumactl/pkg/install/k8s"
 	"github.com/Kong/kuma/app/kumactl/pkg/install/k8s/metrics"
+	"github.com/Kong/kuma/app/kumactl/pkg/install/k8s/metrics/dashboards"
+	kuma_cmd "github.com/Kong/kuma/pkg/cmd"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
 	kuma_version "github.com/Kong/kuma/pkg/version"
 )
 
+const (
+	generatedDashboardsNone      = "none"
+	generatedDashboardsConfigMap = "configmap"
+	generatedDashboardsJson      = "json"
+)
+
 type metricsTemplateArgs struct {
 	Namespace                 string
 	KumaPrometheusSdImage     string
@@ -20,31 +37,75 @@ type metricsTemplateArgs struct {
 	DashboardDataplane        string
 	DashboardMesh             string
 	DashboardServiceToService string
+	// GeneratedDashboards holds generated dashboards grouped by Mesh
+	GeneratedDashboards []generatedDashboards
+}
+
+// generatedDashboards are dashboards of a single Mesh that are saved in a separate ConfigMap,
+// so that dashboards of all meshes together do not exceed the size limit of a ConfigMap.
+type generatedDashboards struct {
+	Mesh string
+	// Name is a name of the ConfigMap, of the volume it is mounted as and of the directory it is mounted to
+	Name string
+	// Dashboards holds contents of dashboards by file names
+	Dashboards map[string]string
 }
 
-func newInstallMetrics() *cobra.Command {
+func newInstallMetrics(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	args := struct {
 		Namespace               string
 		KumaPrometheusSdImage   string
 		KumaPrometheusSdVersion string
 		KumaCpAddress           string
+		GeneratedDashboards     string
 	}{
 		Namespace:               "kuma-metrics",
 		KumaPrometheusSdImage:   "kong-docker-kuma-docker.bintray.io/kuma-prometheus-sd",
 		KumaPrometheusSdVersion: kuma_version.Build.Version,
 		KumaCpAddress:           "http://kuma-control-plane.kuma-system:5681",
+		GeneratedDashboards:     generatedDashboardsNone,
 	}
 	cmd := &cobra.Command{
 		Use:   "metrics",
 		Short: "Install Metrics backend in Kubernetes cluster",
-		Long:  `Install Metrics backend (Prometheus and Grafana) in Kubernetes cluster.`,
+		Long: `Install Metrics backend (Prometheus and Grafana) in Kubernetes cluster.
+
+Optionally, it generates Grafana dashboards with golden signals per mesh and per service
+out of Dataplanes and TrafficRoutes of the current Control Plane.`,
+		Example: `
+  # Install Metrics backend with dashboards generated for the current state of the mesh
+  kumactl install metrics --generated-dashboards=configmap | kubectl apply -f -
+
+  # Generate dashboards only, e.g. to import them into an existing Grafana
+  kumactl install metrics --generated-dashboards=json`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			var generated []dashboards.Dashboard
+			switch args.GeneratedDashboards {
+			case generatedDashboardsNone:
+			case generatedDashboardsConfigMap, generatedDashboardsJson:
+				var err error
+				if generated, err = generateDashboards(pctx); err != nil {
+					return err
+				}
+			default:
+				return errors.Errorf("--generated-dashboards has to be one of %q, %q or %q", generatedDashboardsNone, generatedDashboardsConfigMap, generatedDashboardsJson)
+			}
+
+			if args.GeneratedDashboards == generatedDashboardsJson {
+				return printDashboards(generated, cmd)
+			}
+
 			templateArgs := metricsTemplateArgs{
 				Namespace:               args.Namespace,
 				KumaPrometheusSdImage:   args.KumaPrometheusSdImage,
 				KumaPrometheusSdVersion: args.KumaPrometheusSdVersion,
 				KumaCpAddress:           args.KumaCpAddress,
 			}
+			generatedByMesh, err := groupDashboardsByMesh(generated)
+			if err != nil {
+				return err
+			}
+			templateArgs.GeneratedDashboards = generatedByMesh
 
 			templateFiles, err := data.ReadFiles(metrics.Templates)
 			if err != nil {
@@ -91,5 +152,82 @@ func newInstallMetrics() *cobra.Command {
 	cmd.Flags().StringVar(&args.KumaPrometheusSdImage, "kuma-prometheus-sd-image", args.KumaPrometheusSdImage, "image name of Kuma Prometheus SD")
 	cmd.Flags().StringVar(&args.KumaPrometheusSdVersion, "kuma-prometheus-sd-version", args.KumaPrometheusSdVersion, "version of Kuma Prometheus SD")
 	cmd.Flags().StringVar(&args.KumaCpAddress, "kuma-cp-address", args.KumaCpAddress, "the address of Kuma CP")
+	cmd.Flags().StringVar(&args.GeneratedDashboards, "generated-dashboards", args.GeneratedDashboards, kuma_cmd.UsageOptions("whether to generate Grafana dashboards per mesh and per service out of resources of the current Control Plane", generatedDashboardsNone, generatedDashboardsConfigMap, generatedDashboardsJson))
 	return cmd
 }
+
+func generateDashboards(pctx *kumactl_cmd.RootContext) ([]dashboards.Dashboard, error) {
+	rs, err := pctx.CurrentResourceStore()
+	if err != nil {
+		return nil, err
+	}
+	meshes := &mesh_core.MeshResourceList{}
+	if err := rs.List(context.Background(), meshes); err != nil {
+		return nil, errors.Wrap(err, "failed to list Meshes")
+	}
+	dataplanes := &mesh_core.DataplaneResourceList{}
+	routes := &mesh_core.TrafficRouteResourceList{}
+	for _, mesh := range meshes.Items {
+		meshDataplanes := &mesh_core.DataplaneResourceList{}
+		if err := rs.List(context.Background(), meshDataplanes, core_store.ListByMesh(mesh.Meta.GetName())); err != nil {
+			return nil, errors.Wrap(err, "failed to list Dataplanes")
+		}
+		dataplanes.Items = append(dataplanes.Items, meshDataplanes.Items...)
+
+		meshRoutes := &mesh_core.TrafficRouteResourceList{}
+		if err := rs.List(context.Background(), meshRoutes, core_store.ListByMesh(mesh.Meta.GetName())); err != nil {
+			return nil, errors.Wrap(err, "failed to list TrafficRoutes")
+		}
+		routes.Items = append(routes.Items, meshRoutes.Items...)
+	}
+	return dashboards.Generate(meshes, dataplanes, routes)
+}
+
+// groupDashboardsByMesh groups dashboards by Mesh preserving the order of meshes.
+func groupDashboardsByMesh(generated []dashboards.Dashboard) ([]generatedDashboards, error) {
+	var groups []generatedDashboards
+	fileNames := map[string]bool{}
+	for _, dashboard := range generated {
+		if fileNames[dashboard.FileName] {
+			return nil, errors.Errorf("more than one dashboard has been generated with file name %q", dashboard.FileName)
+		}
+		fileNames[dashboard.FileName] = true
+		if len(groups) == 0 || groups[len(groups)-1].Mesh != dashboard.Mesh {
+			groups = append(groups, generatedDashboards{
+				Mesh:       dashboard.Mesh,
+				Name:       generatedDashboardsName(dashboard.Mesh),
+				Dashboards: map[string]string{},
+			})
+		}
+		groups[len(groups)-1].Dashboards[dashboard.FileName] = string(dashboard.Json)
+	}
+	return groups, nil
+}
+
+var illegalNameChars = regexp.MustCompile(`[^-a-z0-9]`)
+
+// generatedDashboardsName returns a name that is a valid name of both a ConfigMap and a volume (DNS-1123 label),
+// e.g. `dashboards-default-37a8eec1`. It is suffixed with a hash since sanitized names of meshes may collide.
+func generatedDashboardsName(mesh string) string {
+	hash := sha256.Sum256([]byte(mesh))
+	readable := illegalNameChars.ReplaceAllString(strings.ToLower(mesh), "-")
+	if len(readable) > 40 {
+		readable = readable[:40]
+	}
+	return fmt.Sprintf("dashboards-%s-%s", strings.Trim(readable, "-"), hex.EncodeToString(hash[:])[:8])
+}
+
+func printDashboards(generated []dashboards.Dashboard, cmd *cobra.Command) error {
+	list := make([]json.RawMessage, len(generated))
+	for i, dashboard := range generated {
+		list[i] = dashboard.Json
+	}
+	bytes, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return err
+	}
+	if _, err := cmd.OutOrStdout().Write(append(bytes, '\n')); err != nil {
+		return errors.Wrap(err, "Failed to output generated dashboards")
+	}
+	return nil
+}
@@ -2,15 +2,24 @@ package install_test
 
 import (
 	"bytes"
+	"context"
 	"io/ioutil"
 	"path/filepath"
 
+	"github.com/ghodss/yaml"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 
 	"github.com/Kong/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
 	"github.com/Kong/kuma/app/kumactl/pkg/install/data"
+	config_proto "github.com/Kong/kuma/pkg/config/app/kumactl/v1alpha1"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	memory_resources "github.com/Kong/kuma/pkg/plugins/resources/memory"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
 )
 
 var _ = Describe("kumactl install metrics", func() {
@@ -78,4 +87,170 @@ var _ = Describe("kumactl install metrics", func() {
 			goldenFile: "install-metrics.overrides.golden.yaml",
 		}),
 	)
+
+	Describe("with generated dashboards", func() {
+
+		var rootCtx *kumactl_cmd.RootContext
+		var store core_store.ResourceStore
+
+		create := func(resource core_model.Resource, mesh, name, spec string) {
+			Expect(util_proto.FromYAML([]byte(spec), resource.GetSpec())).To(Succeed())
+			err := store.Create(context.Background(), resource, core_store.CreateByKey(name, mesh))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			store = memory_resources.NewStore()
+			rootCtx = &kumactl_cmd.RootContext{
+				Runtime: kumactl_cmd.RootRuntime{
+					NewResourceStore: func(*config_proto.ControlPlaneCoordinates_ApiServer) (core_store.ResourceStore, error) {
+						return store, nil
+					},
+				},
+			}
+
+			create(&mesh_core.MeshResource{}, "default", "default", `{}`)
+			create(&mesh_core.DataplaneResource{}, "default", "web-01", `
+            networking:
+              address: 192.168.0.1
+              inbound:
+              - port: 8080
+                tags:
+                  service: web
+              outbound:
+              - port: 10001
+                service: backend`)
+			create(&mesh_core.DataplaneResource{}, "default", "backend-01", `
+            networking:
+              address: 192.168.0.2
+              inbound:
+              - port: 8080
+                tags:
+                  service: backend
+                  version: v1`)
+			create(&mesh_core.TrafficRouteResource{}, "default", "web-to-backend", `
+            sources:
+            - match:
+                service: web
+            destinations:
+            - match:
+                service: backend
+            conf:
+            - weight: 100
+              destination:
+                service: backend
+                version: v1`)
+		})
+
+		execute := func(extraArgs ...string) error {
+			rootCmd := cmd.NewRootCmd(rootCtx)
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"install", "metrics"}, extraArgs...))
+			rootCmd.SetOut(stdout)
+			rootCmd.SetErr(stderr)
+			return rootCmd.Execute()
+		}
+
+		It("should print generated dashboards as JSON", func() {
+			// when
+			err := execute("--generated-dashboards", "json")
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", "install-metrics.generated-dashboards.golden.json"))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(stdout.Bytes()).To(MatchJSON(expected))
+		})
+
+		It("should add generated dashboards of every mesh to a separate ConfigMap", func() {
+			// given
+			create(&mesh_core.MeshResource{}, "demo", "demo", `{}`)
+
+			// when
+			err := execute("--generated-dashboards", "configmap")
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			configMaps := map[string]map[string]string{}
+			var deployment struct {
+				Spec struct {
+					Template struct {
+						Spec struct {
+							Containers []struct {
+								VolumeMounts []struct {
+									Name      string `json:"name"`
+									MountPath string `json:"mountPath"`
+								} `json:"volumeMounts"`
+							} `json:"containers"`
+							Volumes []struct {
+								Name      string `json:"name"`
+								ConfigMap struct {
+									Name string `json:"name"`
+								} `json:"configMap"`
+							} `json:"volumes"`
+						} `json:"spec"`
+					} `json:"template"`
+				} `json:"spec"`
+			}
+			for _, manifest := range data.SplitYAML(data.File{Data: stdout.Bytes()}) {
+				resource := struct {
+					Kind     string `json:"kind"`
+					Metadata struct {
+						Name string `json:"name"`
+					} `json:"metadata"`
+					Data map[string]string `json:"data"`
+				}{}
+				Expect(yaml.Unmarshal(manifest.Data, &resource)).To(Succeed())
+				switch resource.Kind {
+				case "ConfigMap":
+					configMaps[resource.Metadata.Name] = resource.Data
+				case "Deployment":
+					if resource.Metadata.Name != "grafana" {
+						continue
+					}
+					Expect(yaml.Unmarshal(manifest.Data, &deployment)).To(Succeed())
+				}
+			}
+			// then
+			Expect(configMaps["provisioning-dashboards"]).To(HaveKey("kuma-mesh.json"))
+			Expect(configMaps["provisioning-dashboards"]).To(HaveLen(4))
+			Expect(configMaps["provisioning-dashboards"]["dashboards.yaml"]).To(ContainSubstring("path: /etc/grafana/dashboards\n"))
+			// and
+			Expect(configMaps["dashboards-demo-2a97516c"]).To(HaveLen(1))
+			Expect(configMaps["dashboards-demo-2a97516c"]).To(HaveKey("kuma-mesh-demo-409a3551.json"))
+			// and
+			defaultDashboards := configMaps["dashboards-default-37a8eec1"]
+			Expect(defaultDashboards).To(HaveLen(3))
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", "install-metrics.generated-dashboards.golden.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(`[` + defaultDashboards["kuma-mesh-default-bd4a970a.json"] + `,` + defaultDashboards["kuma-service-default-backend-02d4df0b.json"] + `,` + defaultDashboards["kuma-service-default-web-69a01018.json"] + `]`).To(MatchJSON(expected))
+
+			// when
+			mountPaths := map[string]string{}
+			for _, mount := range deployment.Spec.Template.Spec.Containers[0].VolumeMounts {
+				mountPaths[mount.Name] = mount.MountPath
+			}
+			volumes := map[string]string{}
+			for _, volume := range deployment.Spec.Template.Spec.Volumes {
+				volumes[volume.Name] = volume.ConfigMap.Name
+			}
+			// then
+			Expect(mountPaths).To(HaveKeyWithValue("dashboards-default-37a8eec1", "/etc/grafana/dashboards/dashboards-default-37a8eec1"))
+			Expect(mountPaths).To(HaveKeyWithValue("dashboards-demo-2a97516c", "/etc/grafana/dashboards/dashboards-demo-2a97516c"))
+			Expect(volumes).To(HaveKeyWithValue("dashboards-default-37a8eec1", "dashboards-default-37a8eec1"))
+			Expect(volumes).To(HaveKeyWithValue("dashboards-demo-2a97516c", "dashboards-demo-2a97516c"))
+		})
+
+		It("should reject unknown kind of generated dashboards", func() {
+			// when
+			err := execute("--generated-dashboards", "html")
+			// then
+			Expect(err).To(MatchError(`--generated-dashboards has to be one of "none", "configmap" or "json"`))
+		})
+	})
 })
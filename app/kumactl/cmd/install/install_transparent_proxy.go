@@ -0,0 +1,114 @@
+package install
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
+	"github.com/Kong/kuma/app/kumactl/pkg/transparentproxy"
+)
+
+func newInstallTransparentProxy(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	args := struct {
+		RedirectPort         uint32
+		RedirectDNSPort      uint32
+		DNSServers           []string
+		ResolvConf           string
+		ExcludeInboundPorts  []uint
+		ExcludeOutboundPorts []uint
+		KumaDpUser           string
+		IPv6                 bool
+		DryRun               bool
+	}{
+		RedirectPort:        15001,
+		ResolvConf:          "/etc/resolv.conf",
+		ExcludeInboundPorts: []uint{22}, // do not cut off SSH access to the host until kuma-dp is up
+	}
+	cmd := &cobra.Command{
+		Use:   "transparent-proxy",
+		Short: "Install Transparent Proxy on a universal host",
+		Long: `Install Transparent Proxy on a universal host.
+
+Creates iptables rules that redirect inbound and outbound TCP traffic of the host to Envoy
+that listens on the port set in 'networking.transparentProxying.redirectPort' of the Dataplane.
+IPv6 traffic is redirected by ip6tables rules only with '--ipv6', since Envoy listens for it only if the Dataplane
+has an IPv6 address.
+With '--redirect-dns-port', DNS queries (UDP port 53) to nameservers of the host are redirected to DNS server
+embedded in kuma-dp, so services of the mesh can be resolved. It requires kuma-dp to run with '--dns-enabled'.
+Outbound traffic of the user that kuma-dp runs as is not redirected.
+If any of the rules cannot be created, the ones created so far are deleted.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if args.RedirectDNSPort != 0 && len(args.DNSServers) == 0 {
+				servers, err := transparentproxy.NameserversFromResolvConf(args.ResolvConf)
+				if err != nil {
+					return err
+				}
+				args.DNSServers = servers
+			}
+			cfg := transparentproxy.Config{
+				RedirectPort:         args.RedirectPort,
+				RedirectDNSPort:      args.RedirectDNSPort,
+				DNSServers:           args.DNSServers,
+				ExcludeInboundPorts:  toPorts(args.ExcludeInboundPorts),
+				ExcludeOutboundPorts: toPorts(args.ExcludeOutboundPorts),
+				KumaDpUser:           args.KumaDpUser,
+				IPv6:                 args.IPv6,
+			}
+			if err := cfg.Validate(); err != nil {
+				return err
+			}
+			commands := transparentproxy.InstallCommands(cfg)
+			if args.DryRun {
+				for _, command := range commands {
+					if _, err := fmt.Fprintln(cmd.OutOrStdout(), command.String()); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			executor := pctx.Runtime.NewTransparentProxyExecutor()
+			for i, command := range commands {
+				if err := executor.Execute(command); err != nil {
+					if rollbackErr := rollback(executor, commands[:i]); rollbackErr != nil {
+						return errors.Wrapf(err, "could not install transparent proxy nor delete rules created so far (%s). Use 'kumactl uninstall transparent-proxy' to clean up", rollbackErr)
+					}
+					return errors.Wrap(err, "could not install transparent proxy. Rules created so far have been deleted")
+				}
+			}
+			_, err := fmt.Fprintln(cmd.OutOrStdout(), "Transparent proxy has been installed")
+			return err
+		},
+	}
+	cmd.Flags().Uint32Var(&args.RedirectPort, "redirect-port", args.RedirectPort, "port that Envoy listens on for redirected traffic (networking.transparentProxying.redirectPort of the Dataplane)")
+	cmd.Flags().Uint32Var(&args.RedirectDNSPort, "redirect-dns-port", args.RedirectDNSPort, "port that DNS server embedded in kuma-dp listens on (dataplaneRuntime.dns.address of kuma-dp). DNS queries are redirected to it if set")
+	cmd.Flags().StringSliceVar(&args.DNSServers, "redirect-dns-servers", args.DNSServers, "comma separated list of IPv4 addresses of nameservers which queries are redirected. If empty, nameservers from --resolv-conf are used")
+	cmd.Flags().StringVar(&args.ResolvConf, "resolv-conf", args.ResolvConf, "path to resolv.conf file to read nameservers from")
+	cmd.Flags().UintSliceVar(&args.ExcludeInboundPorts, "exclude-inbound-ports", args.ExcludeInboundPorts, "comma separated list of inbound ports to exclude from redirection to Envoy. Overrides the default exclusion of SSH port")
+	cmd.Flags().UintSliceVar(&args.ExcludeOutboundPorts, "exclude-outbound-ports", args.ExcludeOutboundPorts, "comma separated list of outbound ports to exclude from redirection to Envoy")
+	cmd.Flags().StringVar(&args.KumaDpUser, "kuma-dp-user", args.KumaDpUser, "name or UID of the user that kuma-dp runs as. Its outbound traffic is not redirected")
+	cmd.Flags().BoolVar(&args.IPv6, "ipv6", args.IPv6, "redirect IPv6 traffic as well (ip6tables). Requires the Dataplane to have an IPv6 address")
+	cmd.Flags().BoolVar(&args.DryRun, "dry-run", args.DryRun, "print iptables commands instead of running them")
+	return cmd
+}
+
+// rollback deletes rules created by executed commands. It keeps going on errors to delete as much as possible
+// and returns the first error.
+func rollback(executor transparentproxy.Executor, executed []transparentproxy.Command) error {
+	var firstErr error
+	for _, command := range transparentproxy.RollbackCommands(executed) {
+		if err := executor.Execute(command); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func toPorts(ports []uint) []uint32 {
+	var result []uint32
+	for _, port := range ports {
+		result = append(result, uint32(port))
+	}
+	return result
+}
@@ -0,0 +1,188 @@
+package install_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
+	"github.com/Kong/kuma/app/kumactl/pkg/transparentproxy"
+)
+
+type recordingExecutor struct {
+	executed []string
+	failOn   string
+}
+
+func (e *recordingExecutor) Execute(command transparentproxy.Command) error {
+	if command.String() == e.failOn {
+		return errors.New("iptables: Chain already exists.")
+	}
+	e.executed = append(e.executed, command.String())
+	return nil
+}
+
+var _ = Describe("kumactl install transparent-proxy", func() {
+
+	var stdout *bytes.Buffer
+	var stderr *bytes.Buffer
+	var executor *recordingExecutor
+	var rootCtx *kumactl_cmd.RootContext
+
+	BeforeEach(func() {
+		stdout = &bytes.Buffer{}
+		stderr = &bytes.Buffer{}
+		executor = &recordingExecutor{}
+		rootCtx = kumactl_cmd.DefaultRootContext()
+		rootCtx.Runtime.NewTransparentProxyExecutor = func() transparentproxy.Executor {
+			return executor
+		}
+	})
+
+	type testCase struct {
+		extraArgs  []string
+		goldenFile string
+	}
+
+	DescribeTable("should generate iptables rules",
+		func(given testCase) {
+			// given
+			rootCmd := cmd.NewRootCmd(rootCtx)
+			rootCmd.SetArgs(append([]string{"install", "transparent-proxy", "--dry-run"}, given.extraArgs...))
+			rootCmd.SetOut(stdout)
+			rootCmd.SetErr(stderr)
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stderr.Bytes()).To(BeNil())
+			// and
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", given.goldenFile))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stdout.String()).To(Equal(string(expected)))
+			// and nothing is applied
+			Expect(executor.executed).To(BeEmpty())
+		},
+		Entry("should generate rules with default settings", testCase{
+			extraArgs: []string{
+				"--kuma-dp-user", "kuma-dp",
+			},
+			goldenFile: "install-transparent-proxy.defaults.golden.txt",
+		}),
+		Entry("should generate rules with overridden settings", testCase{
+			extraArgs: []string{
+				"--kuma-dp-user", "5678",
+				"--redirect-port", "15002",
+				"--redirect-dns-port", "5353",
+				"--redirect-dns-servers", "10.0.0.2,10.0.0.3",
+				"--exclude-inbound-ports", "2222,8080",
+				"--exclude-outbound-ports", "5432",
+				"--ipv6",
+			},
+			goldenFile: "install-transparent-proxy.overrides.golden.txt",
+		}),
+		Entry("should generate rules redirecting only DNS queries to nameservers from resolv.conf", testCase{
+			extraArgs: []string{
+				"--kuma-dp-user", "kuma-dp",
+				"--redirect-dns-port", "15053",
+				"--resolv-conf", filepath.Join("testdata", "resolv.conf"),
+			},
+			goldenFile: "install-transparent-proxy.dns.golden.txt",
+		}),
+	)
+
+	It("should apply iptables rules", func() {
+		// given
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetArgs([]string{"install", "transparent-proxy", "--kuma-dp-user", "kuma-dp"})
+		rootCmd.SetOut(stdout)
+		rootCmd.SetErr(stderr)
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stdout.String()).To(Equal("Transparent proxy has been installed\n"))
+		// and
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "install-transparent-proxy.defaults.golden.txt"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(executor.executed).To(Equal(lines(expected)))
+	})
+
+	It("should stop on the first failed command", func() {
+		// given
+		executor.failOn = "iptables -t nat -N KUMA_REDIRECT"
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetArgs([]string{"install", "transparent-proxy", "--kuma-dp-user", "kuma-dp"})
+		rootCmd.SetOut(stdout)
+		rootCmd.SetErr(stderr)
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("could not install transparent proxy. Rules created so far have been deleted: iptables: Chain already exists."))
+		Expect(executor.executed).To(BeEmpty())
+	})
+
+	It("should delete rules created before a failed command", func() {
+		// given
+		executor.failOn = "iptables -t nat -N KUMA_OUTPUT"
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetArgs([]string{"install", "transparent-proxy", "--kuma-dp-user", "kuma-dp"})
+		rootCmd.SetOut(stdout)
+		rootCmd.SetErr(stderr)
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("could not install transparent proxy. Rules created so far have been deleted: iptables: Chain already exists."))
+		Expect(executor.executed).To(Equal([]string{
+			"iptables -t nat -N KUMA_REDIRECT",
+			"iptables -t nat -A KUMA_REDIRECT -p tcp -j REDIRECT --to-ports 15001",
+			"iptables -t nat -N KUMA_INBOUND",
+			"iptables -t nat -A KUMA_INBOUND -p tcp --dport 22 -j RETURN",
+			"iptables -t nat -A KUMA_INBOUND -p tcp -j KUMA_REDIRECT",
+			"iptables -t nat -A PREROUTING -p tcp -j KUMA_INBOUND",
+			// rollback
+			"iptables -t nat -D PREROUTING -p tcp -j KUMA_INBOUND",
+			"iptables -t nat -D KUMA_INBOUND -p tcp -j KUMA_REDIRECT",
+			"iptables -t nat -D KUMA_INBOUND -p tcp --dport 22 -j RETURN",
+			"iptables -t nat -X KUMA_INBOUND",
+			"iptables -t nat -D KUMA_REDIRECT -p tcp -j REDIRECT --to-ports 15001",
+			"iptables -t nat -X KUMA_REDIRECT",
+		}))
+	})
+
+	It("should require the user that kuma-dp runs as", func() {
+		// given
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetArgs([]string{"install", "transparent-proxy", "--dry-run"})
+		rootCmd.SetOut(stdout)
+		rootCmd.SetErr(stderr)
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("user that kuma-dp runs as must be set, otherwise traffic of Envoy would be redirected back to Envoy"))
+	})
+})
+
+func lines(text []byte) []string {
+	var lines []string
+	for _, line := range bytes.Split(bytes.TrimSpace(text), []byte("\n")) {
+		lines = append(lines, string(line))
+	}
+	return lines
+}
@@ -0,0 +1,18 @@
+package revoke
+
+import (
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
+)
+
+func NewRevokeCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke tokens",
+		Long:  `Revoke tokens.`,
+	}
+	// sub-commands
+	cmd.AddCommand(NewRevokeDataplaneTokenCmd(pctx))
+	return cmd
+}
@@ -0,0 +1,48 @@
+package revoke
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
+)
+
+type revokeDataplaneTokenContext struct {
+	*kumactl_cmd.RootContext
+
+	args struct {
+		tokenFile string
+	}
+}
+
+func NewRevokeDataplaneTokenCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &revokeDataplaneTokenContext{RootContext: pctx}
+	cmd := &cobra.Command{
+		Use:   "dataplane-token",
+		Short: "Revoke Dataplane Token",
+		Long:  `Revoke Dataplane Token so it can no longer be used to prove Dataplane identity.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			token, err := ioutil.ReadFile(ctx.args.tokenFile)
+			if err != nil {
+				return errors.Wrapf(err, "could not read dataplane token from %q", ctx.args.tokenFile)
+			}
+
+			client, err := pctx.CurrentDataplaneTokenClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create dataplane token client")
+			}
+
+			if err := client.Revoke(strings.TrimSpace(string(token))); err != nil {
+				return errors.Wrap(err, "failed to revoke a dataplane token")
+			}
+			cmd.Println("dataplane token has been revoked")
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&ctx.args.tokenFile, "token-file", "", "path to a file with the Dataplane Token to revoke")
+	_ = cmd.MarkFlagRequired("token-file")
+	return cmd
+}
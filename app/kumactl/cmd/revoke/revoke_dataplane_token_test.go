@@ -0,0 +1,113 @@
+package revoke_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/Kong/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
+	"github.com/Kong/kuma/app/kumactl/pkg/tokens"
+	"github.com/Kong/kuma/pkg/catalog"
+	catalog_client "github.com/Kong/kuma/pkg/catalog/client"
+	config_kumactl "github.com/Kong/kuma/pkg/config/app/kumactl/v1alpha1"
+	test_catalog "github.com/Kong/kuma/pkg/test/catalog"
+)
+
+type staticDataplaneTokenRevoker struct {
+	revoked []string
+	err     error
+}
+
+var _ tokens.DataplaneTokenClient = &staticDataplaneTokenRevoker{}
+
+func (s *staticDataplaneTokenRevoker) Generate(string, string, time.Duration) (string, error) {
+	return "", errors.New("not implemented")
+}
+
+func (s *staticDataplaneTokenRevoker) Revoke(token string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.revoked = append(s.revoked, token)
+	return nil
+}
+
+var _ = Describe("kumactl revoke dataplane-token", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var revoker *staticDataplaneTokenRevoker
+	var tokenFile string
+
+	BeforeEach(func() {
+		revoker = &staticDataplaneTokenRevoker{}
+		ctx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				NewDataplaneTokenClient: func(string, *config_kumactl.Context_AdminApiCredentials) (tokens.DataplaneTokenClient, error) {
+					return revoker, nil
+				},
+				NewCatalogClient: func(s string) (catalog_client.CatalogClient, error) {
+					return &test_catalog.StaticCatalogClient{
+						Resp: catalog.Catalog{
+							Apis: catalog.Apis{
+								DataplaneToken: catalog.DataplaneTokenApi{
+									LocalUrl: "http://localhost:1234",
+								},
+							},
+						},
+					}, nil
+				},
+			},
+		}
+
+		rootCmd = cmd.NewRootCmd(ctx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	BeforeEach(func() {
+		dir, err := ioutil.TempDir("", "")
+		Expect(err).ToNot(HaveOccurred())
+		tokenFile = filepath.Join(dir, "token")
+		Expect(ioutil.WriteFile(tokenFile, []byte("token-for-example-default\n"), 0600)).To(Succeed())
+	})
+	AfterEach(func() {
+		Expect(os.RemoveAll(filepath.Dir(tokenFile))).To(Succeed())
+	})
+
+	It("should revoke a token", func() {
+		// when
+		rootCmd.SetArgs([]string{"revoke", "dataplane-token", "--token-file", tokenFile})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		Expect(revoker.revoked).To(Equal([]string{"token-for-example-default"}))
+		Expect(buf.String()).To(Equal("dataplane token has been revoked\n"))
+	})
+
+	It("should write error when revoking token fails", func() {
+		// setup
+		revoker.err = errors.New("could not connect to API")
+
+		// when
+		rootCmd.SetArgs([]string{"revoke", "dataplane-token", "--token-file", tokenFile})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(HaveOccurred())
+
+		// and
+		Expect(buf.String()).To(Equal("Error: failed to revoke a dataplane token: could not connect to API\n"))
+	})
+})
@@ -13,6 +13,9 @@ import (
 	"github.com/Kong/kuma/app/kumactl/cmd/inspect"
 	"github.com/Kong/kuma/app/kumactl/cmd/install"
 	"github.com/Kong/kuma/app/kumactl/cmd/manage"
+	"github.com/Kong/kuma/app/kumactl/cmd/revoke"
+	"github.com/Kong/kuma/app/kumactl/cmd/simulate"
+	"github.com/Kong/kuma/app/kumactl/cmd/uninstall"
 	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
 	kumactl_config "github.com/Kong/kuma/app/kumactl/pkg/config"
 	kumactl_errors "github.com/Kong/kuma/app/kumactl/pkg/errors"
@@ -57,6 +60,7 @@ func NewRootCmd(root *kumactl_cmd.RootContext) *cobra.Command {
 	cmd.PersistentFlags().StringVar(&args.logLevel, "log-level", kuma_log.OffLevel.String(), kuma_cmd.UsageOptions("log level", kuma_log.OffLevel, kuma_log.InfoLevel, kuma_log.DebugLevel))
 	// sub-commands
 	cmd.AddCommand(install.NewInstallCmd(root))
+	cmd.AddCommand(uninstall.NewUninstallCmd(root))
 	cmd.AddCommand(config.NewConfigCmd(root))
 	cmd.AddCommand(get.NewGetCmd(root))
 	cmd.AddCommand(delete.NewDeleteCmd(root))
@@ -64,7 +68,9 @@ func NewRootCmd(root *kumactl_cmd.RootContext) *cobra.Command {
 	cmd.AddCommand(apply.NewApplyCmd(root))
 	cmd.AddCommand(version.NewVersionCmd())
 	cmd.AddCommand(generate.NewGenerateCmd(root))
+	cmd.AddCommand(revoke.NewRevokeCmd(root))
 	cmd.AddCommand(manage.NewManageCmd(root))
+	cmd.AddCommand(simulate.NewSimulateCmd(root))
 	kumactl_cmd.WrapRunnables(cmd, kumactl_errors.FormatErrorWrapper)
 	return cmd
 }
@@ -0,0 +1,132 @@
+package simulate
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
+	"github.com/Kong/kuma/app/kumactl/pkg/install/data"
+	"github.com/Kong/kuma/app/kumactl/pkg/output"
+	"github.com/Kong/kuma/app/kumactl/pkg/output/printers"
+	"github.com/Kong/kuma/app/kumactl/pkg/resources"
+	"github.com/Kong/kuma/pkg/api-server/types"
+	kuma_cp "github.com/Kong/kuma/pkg/config/app/kuma-cp"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+	xds_context "github.com/Kong/kuma/pkg/xds/context"
+	xds_server "github.com/Kong/kuma/pkg/xds/server"
+)
+
+type simulateContext struct {
+	*kumactl_cmd.RootContext
+
+	args struct {
+		files        []string
+		dataplane    string
+		outputFormat string
+	}
+}
+
+func NewSimulateCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &simulateContext{RootContext: pctx}
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate Envoy configuration of a Dataplane",
+		Long: `Simulate Envoy configuration of a Dataplane.
+
+Loads Mesh, Dataplane and policy resources from files into an in-memory store
+and generates Envoy resources (listeners, routes, clusters, endpoints) for a given Dataplane
+the same way Control Plane would. No Control Plane is needed.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if len(ctx.args.files) == 0 {
+				return errors.New("at least one file with resources has to be provided")
+			}
+			if ctx.args.dataplane == "" {
+				return errors.New("name of a Dataplane has to be provided")
+			}
+
+			resourceStore := memory.NewStore()
+			for _, file := range ctx.args.files {
+				if err := load(resourceStore, file); err != nil {
+					return errors.Wrapf(err, "could not load resources from %q", file)
+				}
+			}
+
+			cfg := kuma_cp.DefaultConfig()
+			cpContext := &xds_context.ControlPlaneContext{
+				SdsLocation: fmt.Sprintf("%s:%d", cfg.General.AdvertisedHostname, cfg.SdsServer.GrpcPort),
+			}
+			// nothing is connected, so the cache is always empty and resources are always generated
+			resManager := core_manager.NewResourceManager(resourceStore)
+			if err := resManager.Get(context.Background(), &mesh_core.MeshResource{}, store.GetByKey(pctx.CurrentMesh(), pctx.CurrentMesh())); err != nil {
+				if store.IsResourceNotFound(err) {
+					return errors.Errorf("there is no Mesh %q", pctx.CurrentMesh())
+				}
+				return err
+			}
+			xdsContext := core_xds.NewXdsContext()
+			configDumper := xds_server.NewConfigDumper(resManager, &xds_server.DataplaneProxyBuilder{ResourceManager: resManager}, xdsContext.Cache(), xdsContext.ApiVersions(), cpContext)
+			dump, err := configDumper.Dump(context.Background(), model.ResourceKey{Mesh: pctx.CurrentMesh(), Name: ctx.args.dataplane})
+			if err != nil {
+				if store.IsResourceNotFound(err) {
+					return errors.Errorf("there is no Dataplane %q in Mesh %q", ctx.args.dataplane, pctx.CurrentMesh())
+				}
+				return err
+			}
+			res, err := types.NewConfigDump(string(dump.Source), dump.Snapshot, dump.ApiVersions)
+			if err != nil {
+				return err
+			}
+
+			printer, err := printers.NewGenericPrinter(output.Format(ctx.args.outputFormat))
+			if err != nil {
+				return err
+			}
+			return printer.Print(res, cmd.OutOrStdout())
+		},
+	}
+	cmd.PersistentFlags().StringSliceVarP(&ctx.args.files, "file", "f", nil, "Path to a file with resources. Multiple resources in a single file have to be separated with '---'")
+	cmd.PersistentFlags().StringVar(&ctx.args.dataplane, "dataplane", "", "name of a Dataplane to simulate Envoy configuration for")
+	cmd.PersistentFlags().StringVarP(&ctx.args.outputFormat, "output", "o", string(output.YAMLFormat), "output format: one of yaml|json")
+	return cmd
+}
+
+func load(resourceStore store.ResourceStore, file string) error {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	for _, doc := range data.SplitYAML(data.File{Data: b}) {
+		resource, key, err := parseResource(doc.Data)
+		if err != nil {
+			return errors.Wrap(err, "YAML contains invalid resource")
+		}
+		if err := resourceStore.Create(context.Background(), resource, store.CreateBy(key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseResource(bytes []byte) (model.Resource, model.ResourceKey, error) {
+	resource, err := resources.ParseResource(bytes)
+	if err != nil {
+		return nil, model.ResourceKey{}, err
+	}
+	key := model.MetaToResourceKey(resource.GetMeta())
+	if resource.GetType() == mesh_core.MeshType {
+		key.Mesh = key.Name
+	}
+	if err := resource.Validate(); err != nil {
+		return nil, model.ResourceKey{}, err
+	}
+	return resource, key, nil
+}
@@ -0,0 +1,13 @@
+package simulate_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSimulateCmd(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Simulate Cmd Suite")
+}
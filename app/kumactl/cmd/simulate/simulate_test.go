@@ -0,0 +1,86 @@
+package simulate_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/Kong/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
+)
+
+var _ = Describe("kumactl simulate", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+
+	BeforeEach(func() {
+		rootCmd = cmd.NewRootCmd(&kumactl_cmd.RootContext{})
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+		rootCmd.SetErr(&bytes.Buffer{})
+	})
+
+	It("should print Envoy resources generated for a Dataplane", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"simulate", "-f", filepath.Join("testdata", "resources.yaml"), "--dataplane", "web-1"})
+
+		// when
+		err := rootCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "simulate.golden.yaml"))
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(buf.String()).To(MatchYAML(expected))
+	})
+
+	It("should fail when Dataplane is not defined", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"simulate", "-f", filepath.Join("testdata", "resources.yaml"), "--dataplane", "web-2"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`there is no Dataplane "web-2" in Mesh "default"`))
+	})
+
+	It("should fail when Mesh is not defined", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"--mesh", "demo",
+			"simulate", "-f", filepath.Join("testdata", "resources.yaml"), "--dataplane", "web-1"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`there is no Mesh "demo"`))
+	})
+
+	It("should fail on invalid resource", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"simulate", "-f", filepath.Join("testdata", "invalid-resources.yaml"), "--dataplane", "web-1"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`could not load resources from "testdata/invalid-resources.yaml": YAML contains invalid resource: Mesh field cannot be empty`))
+	})
+})
@@ -0,0 +1,50 @@
+package uninstall
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
+	"github.com/Kong/kuma/app/kumactl/pkg/transparentproxy"
+)
+
+func newUninstallTransparentProxy(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	args := struct {
+		IPv6   bool
+		DryRun bool
+	}{}
+	cmd := &cobra.Command{
+		Use:   "transparent-proxy",
+		Short: "Uninstall Transparent Proxy from a universal host",
+		Long: `Uninstall Transparent Proxy from a universal host.
+
+Deletes iptables rules created by 'kumactl install transparent-proxy'. ip6tables rules are deleted only with '--ipv6'.
+Rules that do not exist are skipped, so it is safe to run it after a partially failed installation.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			commands := transparentproxy.UninstallCommands(args.IPv6)
+			if args.DryRun {
+				for _, command := range commands {
+					if _, err := fmt.Fprintln(cmd.OutOrStdout(), command.String()); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			executor := pctx.Runtime.NewTransparentProxyExecutor()
+			for _, command := range commands {
+				if err := executor.Execute(command); err != nil {
+					// rules might have not been created in the first place
+					if _, err := fmt.Fprintf(cmd.ErrOrStderr(), "skipping: %s\n", err); err != nil {
+						return err
+					}
+				}
+			}
+			_, err := fmt.Fprintln(cmd.OutOrStdout(), "Transparent proxy has been uninstalled")
+			return err
+		},
+	}
+	cmd.Flags().BoolVar(&args.IPv6, "ipv6", args.IPv6, "delete IPv6 rules as well (ip6tables)")
+	cmd.Flags().BoolVar(&args.DryRun, "dry-run", args.DryRun, "print iptables commands instead of running them")
+	return cmd
+}
@@ -0,0 +1,82 @@
+package uninstall_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
+	"github.com/Kong/kuma/app/kumactl/pkg/transparentproxy"
+)
+
+type recordingExecutor struct {
+	executed []string
+}
+
+func (e *recordingExecutor) Execute(command transparentproxy.Command) error {
+	e.executed = append(e.executed, command.String())
+	if strings.HasPrefix(command.Name, "ip6tables") {
+		return errors.New("ip6tables: No chain/target/match by that name.")
+	}
+	return nil
+}
+
+var _ = Describe("kumactl uninstall transparent-proxy", func() {
+
+	var stdout *bytes.Buffer
+	var stderr *bytes.Buffer
+	var executor *recordingExecutor
+	var rootCtx *kumactl_cmd.RootContext
+
+	BeforeEach(func() {
+		stdout = &bytes.Buffer{}
+		stderr = &bytes.Buffer{}
+		executor = &recordingExecutor{}
+		rootCtx = kumactl_cmd.DefaultRootContext()
+		rootCtx.Runtime.NewTransparentProxyExecutor = func() transparentproxy.Executor {
+			return executor
+		}
+	})
+
+	It("should print iptables commands in dry-run mode", func() {
+		// given
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetArgs([]string{"uninstall", "transparent-proxy", "--dry-run"})
+		rootCmd.SetOut(stdout)
+		rootCmd.SetErr(stderr)
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "uninstall-transparent-proxy.golden.txt"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stdout.String()).To(Equal(string(expected)))
+		// and nothing is applied
+		Expect(executor.executed).To(BeEmpty())
+	})
+
+	It("should delete all rules skipping the ones that do not exist", func() {
+		// given
+		rootCmd := cmd.NewRootCmd(rootCtx)
+		rootCmd.SetArgs([]string{"uninstall", "transparent-proxy", "--ipv6"})
+		rootCmd.SetOut(stdout)
+		rootCmd.SetErr(stderr)
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stdout.String()).To(Equal("Transparent proxy has been uninstalled\n"))
+		Expect(executor.executed).To(HaveLen(19))
+		Expect(stderr.String()).To(ContainSubstring("skipping: ip6tables: No chain/target/match by that name."))
+	})
+})
@@ -33,23 +33,10 @@ type httpProvidedCaClient struct {
 }
 
 func NewProvidedCaClient(address string, config *kumactl_config.Context_AdminApiCredentials) (ProvidedCaClient, error) {
-	baseURL, err := url.Parse(address)
+	client, err := newHttpClient(address, config)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to parse the server URL")
-	}
-	httpClient := &http.Client{
-		Timeout: timeout,
-	}
-	if baseURL.Scheme == "https" {
-		if !config.HasClientCert() {
-			return nil, errors.New("certificates has to be configured to use https destination")
-		}
-		// Since we're not going to pass any secrets to the server, we can skip validating its identity.
-		if err := util_http.ConfigureTlsWithoutServerVerification(httpClient, config.ClientCert, config.ClientKey); err != nil {
-			return nil, errors.Wrap(err, "could not configure tls for provided ca client")
-		}
+		return nil, err
 	}
-	client := util_http.ClientWithBaseURL(httpClient, baseURL)
 	return &httpProvidedCaClient{
 		client: client,
 	}, nil
@@ -111,8 +98,32 @@ func (h *httpProvidedCaClient) DeleteSigningCertificate(mesh string, id string)
 }
 
 func (h *httpProvidedCaClient) doRequest(req *http.Request) ([]byte, error) {
+	return doRequest(h.client, req)
+}
+
+func newHttpClient(address string, config *kumactl_config.Context_AdminApiCredentials) (util_http.Client, error) {
+	baseURL, err := url.Parse(address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the server URL")
+	}
+	httpClient := &http.Client{
+		Timeout: timeout,
+	}
+	if baseURL.Scheme == "https" {
+		if !config.HasClientCert() {
+			return nil, errors.New("certificates has to be configured to use https destination")
+		}
+		// Since we're not going to pass any secrets to the server, we can skip validating its identity.
+		if err := util_http.ConfigureTlsWithoutServerVerification(httpClient, config.ClientCert, config.ClientKey); err != nil {
+			return nil, errors.Wrap(err, "could not configure tls for ca client")
+		}
+	}
+	return util_http.ClientWithBaseURL(httpClient, baseURL), nil
+}
+
+func doRequest(client util_http.Client, req *http.Request) ([]byte, error) {
 	req.Header.Set("Accept", "application/json")
-	resp, err := h.client.Do(req)
+	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,53 @@
+package ca
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	kumactl_config "github.com/Kong/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/ca/rest/types"
+	util_http "github.com/Kong/kuma/pkg/util/http"
+)
+
+type ClientCertClient interface {
+	// Generate issues a certificate for a given client signed by the CA of a given Mesh.
+	Generate(mesh string, name string) (types.ClientCert, error)
+}
+
+type httpClientCertClient struct {
+	client util_http.Client
+}
+
+func NewClientCertClient(address string, config *kumactl_config.Context_AdminApiCredentials) (ClientCertClient, error) {
+	client, err := newHttpClient(address, config)
+	if err != nil {
+		return nil, err
+	}
+	return &httpClientCertClient{
+		client: client,
+	}, nil
+}
+
+var _ ClientCertClient = &httpClientCertClient{}
+
+func (h *httpClientCertClient) Generate(mesh string, name string) (types.ClientCert, error) {
+	reqBytes, err := json.Marshal(types.ClientCertRequest{Mesh: mesh, Name: name})
+	if err != nil {
+		return types.ClientCert{}, err
+	}
+	req, err := http.NewRequest("POST", "/client-certificates", bytes.NewReader(reqBytes))
+	if err != nil {
+		return types.ClientCert{}, err
+	}
+	req.Header.Add("content-type", "application/json")
+	respBytes, err := doRequest(h.client, req)
+	if err != nil {
+		return types.ClientCert{}, err
+	}
+	clientCert := types.ClientCert{}
+	if err := json.Unmarshal(respBytes, &clientCert); err != nil {
+		return types.ClientCert{}, err
+	}
+	return clientCert, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/Kong/kuma/app/kumactl/pkg/config"
 	kumactl_resources "github.com/Kong/kuma/app/kumactl/pkg/resources"
 	"github.com/Kong/kuma/app/kumactl/pkg/tokens"
+	"github.com/Kong/kuma/app/kumactl/pkg/transparentproxy"
 	"github.com/Kong/kuma/pkg/catalog"
 	catalog_client "github.com/Kong/kuma/pkg/catalog/client"
 	config_proto "github.com/Kong/kuma/pkg/config/app/kumactl/v1alpha1"
@@ -27,13 +28,16 @@ type RootArgs struct {
 }
 
 type RootRuntime struct {
-	Config                     config_proto.Configuration
-	Now                        func() time.Time
-	NewResourceStore           func(*config_proto.ControlPlaneCoordinates_ApiServer) (core_store.ResourceStore, error)
-	NewDataplaneOverviewClient func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.DataplaneOverviewClient, error)
-	NewDataplaneTokenClient    func(string, *kumactl_config.Context_AdminApiCredentials) (tokens.DataplaneTokenClient, error)
-	NewCatalogClient           func(string) (catalog_client.CatalogClient, error)
-	NewProvidedCaClient        func(string, *kumactl_config.Context_AdminApiCredentials) (ca.ProvidedCaClient, error)
+	Config                      config_proto.Configuration
+	Now                         func() time.Time
+	NewResourceStore            func(*config_proto.ControlPlaneCoordinates_ApiServer) (core_store.ResourceStore, error)
+	NewDataplaneOverviewClient  func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.DataplaneOverviewClient, error)
+	NewDataplaneInspectClient   func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.DataplaneInspectClient, error)
+	NewDataplaneTokenClient     func(string, *kumactl_config.Context_AdminApiCredentials) (tokens.DataplaneTokenClient, error)
+	NewCatalogClient            func(string) (catalog_client.CatalogClient, error)
+	NewProvidedCaClient         func(string, *kumactl_config.Context_AdminApiCredentials) (ca.ProvidedCaClient, error)
+	NewClientCertClient         func(string, *kumactl_config.Context_AdminApiCredentials) (ca.ClientCertClient, error)
+	NewTransparentProxyExecutor func() transparentproxy.Executor
 }
 
 type RootContext struct {
@@ -44,12 +48,15 @@ type RootContext struct {
 func DefaultRootContext() *RootContext {
 	return &RootContext{
 		Runtime: RootRuntime{
-			Now:                        time.Now,
-			NewResourceStore:           kumactl_resources.NewResourceStore,
-			NewDataplaneOverviewClient: kumactl_resources.NewDataplaneOverviewClient,
-			NewDataplaneTokenClient:    tokens.NewDataplaneTokenClient,
-			NewCatalogClient:           catalog_client.NewCatalogClient,
-			NewProvidedCaClient:        ca.NewProvidedCaClient,
+			Now:                         time.Now,
+			NewResourceStore:            kumactl_resources.NewResourceStore,
+			NewDataplaneOverviewClient:  kumactl_resources.NewDataplaneOverviewClient,
+			NewDataplaneInspectClient:   kumactl_resources.NewDataplaneInspectClient,
+			NewDataplaneTokenClient:     tokens.NewDataplaneTokenClient,
+			NewCatalogClient:            catalog_client.NewCatalogClient,
+			NewProvidedCaClient:         ca.NewProvidedCaClient,
+			NewClientCertClient:         ca.NewClientCertClient,
+			NewTransparentProxyExecutor: transparentproxy.NewExecutor,
 		},
 	}
 }
@@ -120,6 +127,14 @@ func (rc *RootContext) CurrentDataplaneOverviewClient() (kumactl_resources.Datap
 	return rc.Runtime.NewDataplaneOverviewClient(controlPlane.Coordinates.ApiServer)
 }
 
+func (rc *RootContext) CurrentDataplaneInspectClient() (kumactl_resources.DataplaneInspectClient, error) {
+	controlPlane, err := rc.CurrentControlPlane()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewDataplaneInspectClient(controlPlane.Coordinates.ApiServer)
+}
+
 func (rc *RootContext) catalog() (catalog.Catalog, error) {
 	controlPlane, err := rc.CurrentControlPlane()
 	if err != nil {
@@ -238,3 +253,16 @@ func (rc *RootContext) CurrentProvidedCaClient() (ca.ProvidedCaClient, error) {
 	}
 	return rc.Runtime.NewProvidedCaClient(adminServerUrl, ctx.GetCredentials().GetAdminApi())
 }
+
+func (rc *RootContext) CurrentClientCertClient() (ca.ClientCertClient, error) {
+	ctx, err := rc.CurrentContext()
+	if err != nil {
+		return nil, err
+	}
+
+	adminServerUrl, err := rc.adminServerUrl()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewClientCertClient(adminServerUrl, ctx.GetCredentials().GetAdminApi())
+}
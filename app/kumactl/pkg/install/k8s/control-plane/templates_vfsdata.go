@@ -96,17 +96,17 @@ var Templates = func() http.FileSystem {
 		},
 		"/kuma-cp/app.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "app.yaml",
-			modTime:          time.Date(2020, 2, 20, 14, 20, 8, 698646833, time.UTC),
-			uncompressedSize: 5704,
+			modTime:          time.Date(2026, 10, 19, 3, 9, 15, 196777000, time.UTC),
+			uncompressedSize: 5943,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x5b\x73\x9b\x38\x14\x7e\xf7\xaf\xd0\x74\x9f\xb1\xe3\x34\x4d\x5d\x66\xfa\xe0\xda\x34\xeb\x49\x7c\x19\x70\xb2\x9b\x27\x57\x86\x63\xac\x89\x40\xac\x24\xd8\x7a\xda\xfc\xf7\x1d\x01\xc2\x60\x03\x26\xdd\xe6\x29\x9c\xcb\xa7\x73\xf9\x24\x1d\xd9\x30\x8c\x1e\x8e\xc8\x13\x70\x41\x58\x68\xa2\x64\xd8\x7b\x21\xa1\x67\x22\x07\x78\x42\x5c\xe8\x05\x20\xb1\x87\x25\x36\x7b\x08\x85\x38\x00\x13\xfd\xf8\x81\xfa\x13\x16\x4a\xce\xe8\x8a\xe2\x10\x72\xcb\x05\x0e\x00\xbd\xbe\xe6\x66\x22\xc2\x6e\x6e\xbb\xd0\x9f\x4a\x2b\x22\x70\x15\x54\xc4\xb8\x14\xea\x1f\x23\xfd\xd7\x44\x37\x37\xef\x7b\x08\xe9\x35\xf6\x52\x46\xc2\xc0\x5e\x40\x84\x8a\xcb\x10\xc0\x13\xe0\xa9\x81\xc4\xdc\x07\xb9\x4a\x9d\x3e\x64\x5e\x1a\xe3\xc3\xed\xc7\xdb\x12\x48\x80\x3d\x71\xf4\x2c\x19\x7d\x2c\x19\xf9\x3c\x72\x0d\xe1\x89\xaa\xc5\xe8\xd4\xe2\xfb\xa9\xc5\xa7\x93\x68\xcf\x2c\x46\xc3\x53\x0b\x1c\x91\xba\x70\x46\xd7\xa7\x86\x5b\xc6\xa4\x90\x1c\x47\xb5\xe6\xe5\x3a\xf9\x71\x09\x52\x00\x05\x57\x32\x6e\xa6\x06\x38\x8a\x4c\xf4\x12\x07\xd8\x70\xb3\x66\x19\x91\xea\x56\xef\x52\xc7\xc7\xae\xcb\xe2\x50\xd6\x34\xbe\x06\xac\xbd\xd9\x2d\x4b\xb9\x1c\x64\x4f\x1e\xa2\x14\x76\x0b\x3c\x04\x09\xa2\x4f\xd8\x40\x52\xd1\xb4\xb4\xf0\x84\x21\xa9\x30\x5c\xe0\xf2\xc2\xca\xda\x5b\x52\xd1\x77\x55\xdd\x94\x85\xe3\x89\x35\x15\x13\xe0\x12\xfd\x44\xdb\xdb\x1b\x08\xdd\x8c\xb0\xca\xea\x05\x0e\x65\xab\x7b\x38\x54\x8c\x7e\x73\x2a\xa7\xcc\xfe\x5f\x79\x8d\x35\x98\x93\x62\x75\xc8\xf1\xdc\xa3\x73\xbe\x13\x16\xee\x88\x3f\xc7\x51\x27\x82\xa8\xaf\x1d\xf1\x3b\x66\x95\x19\xf7\x0f\x38\xa0\x26\xfa\x99\xb2\xf8\x0f\x14\x0b\x40\x72\x4f\x04\xda\x11\x0a\x48\x32\xc4\x12\xe0\x9c\x78\x80\x3c\xd8\xe1\x98\xca\xdc\x2d\xe6\x58\x12\x16\x22\xb6\x43\xdf\xb2\x40\xa2\x6f\x19\x44\x0e\x24\x00\x52\xd3\x41\xae\xed\xab\x0f\xb4\x63\x1c\xe1\x04\x13\x8a\xb7\x14\x90\x00\x29\x49\xe8\x8b\xb3\xfc\x71\x14\x89\x41\x51\x84\x29\x44\x94\x1d\x02\xf8\x3d\xdb\x04\x21\x8a\xb7\x40\x45\xfb\xbe\xd5\x27\xa7\x3a\x18\x24\xf8\x87\xcc\x9a\x33\x4a\x49\xe8\x3f\x46\x1e\x96\x90\x89\x10\x0a\xf0\x77\x27\xe6\x3e\x98\x68\x78\x94\x3c\x86\x45\x9a\x26\xba\x3a\x3b\x2e\x02\x2c\xdd\xfd\x43\x29\x8e\xe6\x48\x10\x92\x10\x44\xb4\x58\xb0\x5c\x02\xf5\x47\x2b\x28\x6d\x38\x08\xe9\xac\xd2\xff\x2b\x07\xd0\xa2\xb9\x98\xea\x4f\xc9\x30\x09\x81\x17\x0b\x19\x79\xfd\xeb\xac\x11\x22\x01\xf6\x6b\x2e\xaf\x99\x12\xa3\xd7\x57\xf3\x54\x91\x77\x3e\xeb\x4f\x09\x62\x15\x53\xba\x62\x94\xb8\xf9\x56\x9a\x55\x85\x65\x7b\x08\x93\x63\x11\x74\x74\xf7\x8f\xf3\xf1\xc6\x5a\x3c\xcd\xec\xe5\x62\x6e\x2d\xd6\x85\x01\x42\x09\xa6\x31\x98\xe8\xdd\xf1\x14\x79\x57\xef\xee\xac\x97\xb6\xb5\x59\x3f\xaf\xac\x5f\xf7\xbe\x7f\xfc\x62\xd9\x0b\x6b\x6d\x39\x1b\xe7\xd9\x59\x5b\xf3\xcd\x62\x3c\xb7\x9c\xd5\x78\x52\x03\x5a\x43\xd9\x1a\xe0\x3b\x6b\x61\xd9\xe3\x87\xcd\x78\xfa\x64\xd9\xeb\x99\x63\x4d\x37\x7f\x2e\x9d\xb5\xc2\xad\x87\x6c\x9e\x22\xfa\xdd\x56\x74\xa6\xce\xc6\xb1\xec\x27\xcb\xde\xdc\xd9\xab\xc9\x66\xb5\xb4\xeb\x0a\xaa\xae\xfc\x86\x62\xfc\xdd\x19\x61\xd4\x80\x30\x5e\xcd\x34\x42\xa3\xf3\x68\xd8\xe0\xfc\x65\xb9\x5c\x3b\x6b\x7b\xbc\xba\x0c\x71\xdd\xd4\xce\x63\x06\xeb\x07\x67\x33\xb1\xec\xf5\xe6\xeb\xec\xa1\xa6\xe4\x83\x04\xf3\x01\x8f\xc3\x81\x48\xef\x2c\x91\x1e\x84\xea\xa2\xd2\xb7\xeb\x40\xdf\x42\x83\xfc\x7e\xe9\xb4\xe2\xbd\xf5\xfc\x7b\x16\x7c\x81\x43\xfd\x82\x25\xae\x8e\xa7\xf3\x99\xe3\xcc\x96\x8b\x4b\x05\xbb\xb9\x79\xdf\x50\xb0\x36\xb4\xb4\x7a\xd3\x99\xfd\xd6\x5c\x4e\xef\xf3\x41\xe9\x3e\x6f\xe7\x8c\x6d\x8d\xa7\x9b\xe5\xe2\xe1\xb9\x26\x09\xc9\x63\x38\x26\x81\xb9\x2f\xca\xe7\x09\x8f\xc3\xd2\x97\x61\x50\xe6\x1b\x14\x12\xa0\x9f\x49\xb8\x63\x15\x55\x76\x43\x1a\xea\x06\xfd\x3c\x00\xe9\x56\x83\xaf\x1c\x98\x83\xd2\x25\x5c\x60\x14\xd3\xba\x86\x2c\x4e\xdf\xca\x1c\xde\xa4\xd5\x13\x77\x93\x76\xd4\xaa\xfd\xd4\xa6\x1d\x0d\x5b\xb5\xd7\xad\xda\x63\xcc\x94\x24\x10\x82\x10\x2b\xce\xb6\x60\x96\x5a\xa1\xe6\xf1\x3b\x90\x65\x11\x42\x11\x96\x7b\x13\x0d\xf6\x80\xa9\xdc\x1f\xaa\x2a\x8d\x7d\x55\x88\x39\x60\x8f\xbc\x19\x5c\x79\x75\x80\x16\x2c\xe6\x2e\x88\x32\x04\x87\x7f\x62\x10\x52\x54\x61\xdd\x28\x36\xd1\xf0\xea\x2a\xa8\x48\x03\x08\x18\x3f\x98\xe8\xfa\xc3\xed\x9c\x14\x9a\x84\xd1\x38\x80\xb9\xba\x85\xc5\xf9\x0d\x56\x37\x8b\x17\x78\xca\x67\x95\x65\xd0\x79\xf3\x57\x62\xc7\xde\x32\xa4\x07\x13\x29\xee\xd7\x2f\xdd\x36\x3b\xbf\x39\x8e\xcb\x1b\xb7\x5b\x50\x0d\x53\x6f\x5d\x3c\xed\xfb\xef\xd2\xba\x59\x6f\xce\x86\x9e\xe6\xa6\x64\x69\x97\xc9\x90\x49\x16\xad\x7e\x6f\xac\x78\x87\x45\x2e\x81\x74\x2f\xa7\xab\x9f\x20\xe5\xf5\x2e\x39\x9f\x0d\xf4\x3a\x1c\x0e\x3e\x49\x67\x6a\xc2\xc2\xfe\xcb\x28\x7d\xb9\x25\xc3\x2d\x48\xac\xa7\xfd\x79\x2c\xb1\x7a\x15\xfc\x05\xdb\x3d\x63\x2f\x93\xf2\x73\xe3\xf2\x03\x2f\xc8\xbd\x8d\x7f\x33\x77\xa3\xf2\x5c\xe9\xe5\x52\x61\xf6\x74\x01\x02\x10\xfb\x7e\xfe\xb6\x01\xde\xaf\xc2\xf5\x73\xe6\xf4\x10\xda\x61\x42\x63\x0e\x7a\x18\xfd\x8a\x89\x3a\xb0\x5d\x4a\x20\x94\x59\x8c\x59\x7d\x5c\xfc\x25\x0e\x3d\x0a\x6f\x78\x2c\x16\xb3\xb8\xae\x70\xfb\xf3\xe5\x58\xff\x8b\xbf\x0d\xa1\xe3\x09\x97\xa7\x68\xa4\x09\x12\x66\x24\x43\x4c\xa3\x3d\x1e\x1a\xaa\x00\x3d\x84\x78\x4c\x21\xff\x89\x08\x47\xe4\x8e\xb3\x38\xca\x69\x6f\xa0\x63\x15\xd4\xf3\x42\x77\xb5\x50\x6b\xa8\xf4\x93\x45\x90\xd5\xba\x50\x4f\x6c\x6b\xbc\xb6\xf2\x8f\xc7\xd5\x54\x7f\x9c\x1c\xa7\x46\xda\x0a\xa8\x79\x0c\x76\xe7\xce\x13\xa6\xc4\x7b\x33\x7b\x92\xc2\xeb\x22\x6b\x8e\x1b\x27\x77\x62\x2d\x94\x69\x22\x4d\x1d\x6d\x7e\x91\x38\x67\xd4\xe9\x42\x9e\x37\xd1\xa7\x20\x50\x9e\x30\x9c\x31\x28\x6b\xa6\xa6\x4f\xd6\xca\x13\x0a\x69\x71\xb9\x36\xb5\x64\xd2\x86\x15\xec\x3a\x5a\x69\xc3\x12\xb9\xb4\xa8\x44\xb1\x1a\x9a\x69\x2b\xc9\xf1\x6e\x47\x5c\xca\x7c\x51\x27\x8f\x80\xe7\x0d\xa8\x55\x73\x16\x4b\xa8\xd5\x48\x8e\xdd\x13\x8d\xa2\x5c\x7a\x3a\x56\xc5\xd9\x40\xe3\xee\xc1\x7d\xa9\x2a\xf2\x7d\x50\x16\x45\x9c\x7d\x3f\xe8\xdf\x01\x44\xef\xbf\x00\x00\x00\xff\xff\x4e\x3d\x4a\x58\x48\x16\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xbd\x58\xdd\x73\xda\x38\x10\x7f\xe7\xaf\xd0\xf4\x9e\x0d\x21\xd7\xa6\x94\x99\x3e\xd0\xe0\xe6\x98\x84\x8f\xc1\x24\x77\x79\xa2\xc2\x2c\xe0\x89\x6c\xf9\x24\x99\x0b\xd3\xf6\x7f\xbf\x95\x6d\x19\x1b\x6c\x03\x6d\xef\xf2\x12\x4b\xbb\xfb\xdb\x4f\x49\xbb\x58\x96\xd5\xa0\xa1\xf7\x04\x42\x7a\x3c\xe8\x92\x6d\xbb\xf1\xe2\x05\xcb\x2e\x71\x40\x6c\x3d\x17\x1a\x3e\x28\xba\xa4\x8a\x76\x1b\x84\x04\xd4\x87\x2e\xf9\xfa\x95\x34\x6f\x79\xa0\x04\x67\x13\x46\x03\x48\x39\x47\x48\x24\xdf\xbf\xa7\x6c\x32\xa4\x6e\xca\x3b\x32\x4b\x4d\x95\x21\xb8\x1a\x2a\xe4\x42\x49\xfd\x61\xc5\x9f\x5d\xf2\xf6\xed\xef\xb8\x32\x3a\x36\x4a\x85\xd2\xa2\x4b\xdf\x93\xda\x2e\x4b\xa2\x0e\x10\x31\x83\xa2\x62\x0d\x6a\x12\x0b\xbd\x4b\xa4\x0c\xc6\xbb\x9b\xf7\x37\x39\x10\x9f\x2e\xe5\x5e\x32\xc7\xf4\x3e\xc7\xb4\x16\xa1\x6b\xc9\xa5\x2c\x72\x74\x0e\x39\x5e\x0f\x39\x3e\x1c\x58\x7b\xc4\xd1\x69\x1f\x72\x60\x9c\xcb\xcc\xe9\x5c\x1f\x32\x2e\x38\x57\x52\x09\x1a\x96\xb2\xe7\xe3\xb4\x8e\x72\x90\x12\x18\xb8\x8a\x8b\x6e\xcc\x40\xc3\xb0\x4b\x5e\x22\x9f\x5a\x6e\x92\x2c\x2b\xd4\xd9\x6a\x58\x27\x32\xde\x73\x5d\x1e\x05\xaa\x24\xf1\x25\x60\xf5\xc9\xae\x51\xe5\x0a\x50\x0d\xb5\x0b\x63\xd8\x05\x88\x00\x14\xc8\xa6\xc7\x5b\x8a\xc9\x2a\xd5\x98\x25\x0b\xc9\x96\x0b\x42\x9d\xd0\x6c\xa4\x91\xbd\xe9\xea\xb8\x69\x0e\x67\x29\x67\x4c\xde\xa2\x34\xf9\x46\x16\x37\x6f\x21\x70\x93\x82\xd5\x5c\x2f\xb0\xcb\x73\xdd\xc3\xae\xc0\xf4\x8b\x5d\x39\xac\xec\x9f\xf2\xab\x67\xc0\x9c\x18\xeb\x0c\x1f\x8f\x25\xce\xf6\x17\x4f\xfe\xca\x5b\x0f\x69\x78\x56\x81\xe8\x15\xb2\x9f\xe9\x55\xc2\xdc\xdc\x51\x9f\x75\xc9\xb7\xb8\x8a\x7f\x23\x91\x04\xa2\x36\x9e\x24\x2b\x8f\xe1\x17\x27\x1c\x2d\x16\xde\x12\xc8\x12\x56\x34\x62\x2a\x15\x8b\x04\x55\x68\x2a\xe1\x2b\xf2\x25\x31\x24\xfc\x92\x40\xa4\x40\x12\x20\x66\x6d\xa5\xd4\xa6\x5e\x90\x15\x17\x84\x6e\xa9\xc7\xe8\x02\xe1\x25\x28\xe5\x05\x6b\x79\xe4\x3f\x9e\x26\xd9\xca\x82\xd0\x87\x90\xf1\x9d\x0f\xbf\xe6\x98\x10\x82\xca\x81\xc9\xfa\x73\x6b\x6e\x4e\x7d\x31\x28\x58\xef\x12\x6e\xa4\x33\x34\xf8\x31\x44\x1b\x20\xd9\x22\x78\xf1\xbd\x3a\x11\xde\x92\x5d\xd2\xde\xef\x3c\x06\x99\x9b\x5d\x72\x75\x74\x5d\xf8\x54\xb9\x9b\x87\x9c\x1d\xd5\x96\x60\x31\x81\x8f\x9f\x46\x61\x3e\x04\xfa\x8f\x15\x50\xea\x70\xd0\x88\xd4\xab\xf8\xbb\x70\x01\x8d\xaa\x83\xa9\xff\xf4\x1e\xf5\x02\xcc\x8f\x11\xb7\xd2\xf8\x97\x71\x13\xe2\xf9\x74\x5d\xf2\x78\x0d\xf4\x36\x26\xa1\x7b\x48\x48\x33\x9f\xe4\x27\x07\x31\x89\x18\x9b\x70\xe6\xb9\xe9\x51\x1a\x14\x37\xf3\xfc\x10\x6c\xf7\x41\x30\xd6\xdd\x3f\x0e\x7b\x73\x7b\xf4\x34\x98\x8e\x47\x43\x7b\x34\xcb\x18\x08\xd9\x52\x16\x21\xc7\x9b\xfd\x2d\xf2\xa6\x5c\xdc\x99\x8d\xa7\xf6\x7c\xf6\x3c\xb1\x7f\x5c\xfa\xfe\xf1\x93\x3d\x1d\xd9\x33\xdb\x99\x3b\xcf\xce\xcc\x1e\xce\x47\xbd\xa1\xed\x4c\x7a\xb7\x25\xa0\x25\x25\x5b\x02\x7c\x67\x8f\xec\x69\xef\x61\xde\xeb\x3f\xd9\xd3\xd9\xc0\xb1\xfb\xf3\x3f\xc6\xce\x4c\xe3\x96\x43\x56\x77\x11\xcd\xf3\x34\x3a\x7d\xb4\xde\x9e\xa2\xba\xf9\xdd\x74\x72\x3b\x9f\x8c\xa7\x65\x01\xd5\x4f\x7e\x45\x30\xfe\x3a\x1b\xa1\x53\x81\xd0\x9b\x0c\x0c\x42\xa5\x70\xa7\x5d\x21\xfc\x69\x3c\x9e\x39\xb3\x69\x6f\x72\x1a\xe2\xfa\x5c\x88\xd9\x83\x33\xbf\xc5\x04\xcc\x3f\x0f\x1e\x4a\x02\xdf\xda\x52\xd1\x12\x51\xd0\x92\xf1\xcb\x25\xe3\xeb\x50\x3f\x57\xe6\x8d\x6d\x99\xb7\xa8\x95\xbe\x32\x17\xe8\xbd\xb7\x9f\x7f\x8d\x5a\x7c\xaa\x4e\xa6\xfc\x7f\x72\xf4\x40\xe3\x7f\xef\x62\xee\x68\xf6\xfa\xc3\x81\xe3\x0c\xc6\xa3\x53\xf5\x81\x8d\xf0\x9b\xcb\xd1\xe2\xe8\xf5\x07\xd3\x4b\x7d\x39\x6c\x5f\x5a\xb9\xf6\xa5\xfe\x88\x4c\xed\x5e\x7f\x3e\x1e\x3d\x3c\x97\x38\xa1\x44\x04\x7b\x27\xb0\xcd\x97\xf9\xeb\x13\x8d\xc9\xad\x2c\x8b\xf1\xb5\xc5\x60\x0b\xec\xa3\x17\xac\x78\x81\x94\x34\x04\x96\x6e\x18\x3e\xb6\x40\xb9\x45\xe3\x0b\xef\x43\x2b\xd7\x73\x64\x18\xd9\x70\x62\x20\xb3\xc7\xa6\x30\x76\x54\x51\xcd\x80\x51\x45\xed\xd4\x52\x3f\xd4\x51\x3b\xed\x5a\xea\x75\x2d\x75\x6f\x33\xf3\xb6\x10\x80\x94\x13\xc1\x17\xd0\xcd\xa5\x42\x8f\x1f\x77\xa0\xf2\x5b\x18\x0e\xaa\x36\x58\x11\x1b\xa0\x4c\x6d\x76\x45\x92\xc1\xbe\xca\xb6\x05\xd0\xa5\x77\x31\xb8\x96\x3a\x03\x5a\xf2\x48\xb8\x20\xf3\x10\x02\xfe\x8e\x40\x2a\x59\x84\x75\xc3\x08\xbb\x9f\xab\x2b\xbf\xb0\xeb\x83\xcf\x05\x3e\xdc\xd7\xef\x6e\x86\x5e\x46\xd9\x72\x16\xf9\x30\xd4\x4d\x87\x3c\x7e\xb0\xcb\x46\x8f\x0c\x4f\xcb\x4c\x12\x0f\xce\x3e\xfc\x05\xdb\xe9\x72\x1c\x30\xb4\x48\xd7\x7e\xb9\xea\xba\x51\xe1\x62\x3b\x4e\x1f\xdc\xf3\x8c\xaa\x68\xf2\xcb\xec\xa9\x3f\x7f\xa7\xf4\x26\xb9\x39\xea\xf1\xaa\x93\x92\xb8\x9d\x2f\x86\x64\x67\x54\x2b\x77\x61\xc4\xcf\x50\x72\x0a\xe4\xfc\x70\xba\x66\xe2\xca\xeb\x3b\x25\x7c\x34\xbf\x18\x73\x04\xac\xbd\x78\x84\xc0\xef\xe6\x4b\x27\x1e\x54\xb7\xed\x05\xb6\xf0\x66\xb8\x19\x46\x8a\xea\x21\xe8\x4f\x58\x6c\x38\x7f\xb9\xcd\x4f\x57\xa7\xe7\x59\x3f\x95\xb6\xfe\x49\xc4\xad\xc2\x74\xd6\x48\x77\x31\xa1\x26\x00\x98\xdd\x4d\x33\x1d\xe5\x40\x34\x8b\x70\xcd\xb4\x72\x50\xdb\x0a\x87\x97\x48\x80\xe9\xbd\x3f\xe3\x52\x8f\x8c\xcc\xc3\x29\x2c\xb1\x31\x89\x8f\x4b\x3f\x45\xc1\x92\xc1\x05\xb3\x71\x36\x7a\x98\x08\xd7\x4f\x6b\xfb\xf8\x9f\xfc\x29\x2c\x77\xc3\xa5\x2e\x5a\xb1\x83\x1e\xb7\xb6\x6d\xca\xc2\x0d\x6d\x5b\x3a\x00\xc8\x2a\x22\x06\xe9\x2f\x62\x98\xb8\x3b\xc1\xa3\x30\x2d\x7b\x8b\xec\xa3\xa0\xa7\x29\x93\xd5\x8c\x6c\xa0\xe2\x25\x0f\x21\x89\x75\x46\xbe\xc5\x47\x77\x66\xa7\x8b\xc7\x49\xdf\x2c\x0e\xae\x53\x2b\x4e\x05\xc8\x9f\xa9\x9d\x27\xca\xbc\xe5\xc5\xd5\xb3\xcd\xa4\x4e\x56\xcd\xfe\xe0\xa4\x42\xbc\xa6\x64\xaa\x8a\xa6\xac\x6c\x7e\xb0\x70\x8e\x4a\xe7\x9c\xe2\xb9\xa8\x7c\xb2\x02\x4a\x1d\x86\xa3\x0a\x4a\x92\x69\xca\x27\x49\xe5\x41\x09\x99\xed\x7c\x6c\x4a\x8b\xc9\x30\x16\xb0\xcb\xca\xca\x30\xe6\x8a\xcb\x6c\xe5\x4a\xac\xf2\xd5\xb6\xf0\x92\xa7\xab\x95\xe7\x62\x1b\x27\xcb\xf6\x51\x5d\x9a\x80\x52\x32\xfa\x86\x13\x6e\x19\x05\xff\xb9\x07\x14\x5d\x72\xf1\xed\x58\xdc\x4e\x1a\x1a\x77\x03\xee\x4b\x91\x90\x9e\x83\xfc\x56\x28\xf8\xeb\xce\xfc\xec\x21\x1b\xff\x02\x18\x95\x2e\x6a\x37\x17\x00\x00"),
 		},
 		"/kuma-cp/rbac.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "rbac.yaml",
-			modTime:          time.Date(2020, 2, 20, 14, 20, 8, 699114889, time.UTC),
-			uncompressedSize: 2077,
+			modTime:          time.Date(2026, 10, 18, 21, 5, 3, 751765986, time.UTC),
+			uncompressedSize: 2267,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\xcd\x72\xd3\x30\x10\xbe\xfb\x29\x76\xca\xd9\xe9\x70\xeb\xf8\x06\x1c\xb8\x30\x1c\x5a\x86\xfb\x46\xde\xc4\x8b\x65\x49\xb3\xbb\x4a\x81\x4e\xdf\x9d\x51\x9c\x90\x3f\x08\x49\x48\xa7\x27\xaf\x64\xe9\xfb\x91\xd6\x9f\xab\xba\xae\x2b\x4c\xfc\x95\x44\x39\x86\x06\x64\x8a\x6e\x82\xd9\xba\x28\xfc\x13\x8d\x63\x98\xf4\x77\x3a\xe1\x78\xbb\x78\x5b\xf5\x1c\xda\x06\x3e\xf8\xac\x46\x72\x1f\x3d\x55\x03\x19\xb6\x68\xd8\x54\x00\x01\x07\x6a\xa0\xcf\x03\x36\x2e\x06\x93\xe8\xeb\xe4\x31\x50\x25\xd9\x93\x36\x55\x0d\x98\xf8\xa3\xc4\x9c\xb4\x2c\xaf\xe1\xe6\xa6\x02\x10\xd2\x98\xc5\xd1\x6a\xae\x80\x68\x42\x47\xba\x1c\xa6\xd8\x8e\x85\x92\x2c\x78\x9c\x5d\x90\x4c\x57\xab\xe7\x64\xcb\xa7\x67\x1d\x8b\x47\x34\xd7\x1d\x32\x15\x51\x13\x8e\x87\x74\x45\xfb\x52\xa4\xee\x0e\x39\x28\xcf\x3b\x1b\x67\x07\xd2\xee\x44\xe6\x52\x39\x21\x34\x5a\x96\x39\xb5\xeb\x32\xfd\x7e\xdf\x92\x27\xa3\x33\x44\x76\x84\xde\x3a\xd7\x91\xeb\xaf\xed\x3f\x49\xfc\xfe\xc3\x68\x48\x1e\xed\x35\x2d\xee\xea\xb8\x55\x43\xcb\x7f\x91\x73\x40\x78\x3a\x8b\x09\xce\x66\xec\x12\xc9\xc0\x5a\xfa\xfd\xda\xc7\xb9\x22\xf0\x71\xfe\x42\xc8\x12\xf3\xa9\xf7\xb4\x87\x7d\x04\x7d\x0b\xdf\x04\xf7\x3e\xb2\x0d\xc3\x16\xc7\x86\xe5\x0d\x2c\xd0\x73\xb9\x11\xe8\xef\x14\x2c\xf6\x14\x60\x4a\xb3\x28\x04\xac\x9a\x89\xc3\x1c\x86\x2f\x9f\x1e\xc0\x91\xd8\xa1\xe1\x12\x35\x14\x8c\xdd\x76\xd6\xfc\xc1\x7e\xc1\x15\x5a\x30\x3d\xee\xb9\x5f\xb5\xe2\xff\xe5\xd8\x7b\x0e\x2d\x87\xf9\x89\x71\x16\x3d\xdd\xd3\xac\xac\x59\x9b\x39\xc2\x57\x01\x1c\xc6\xe6\x11\x74\xcd\xd3\x6f\xe4\x6c\x99\x97\xe3\xc6\x87\x31\xfa\xde\x39\x17\x73\xb0\x9d\xbd\xf5\xee\x5e\xd8\xc4\x67\x03\x4f\x4f\x30\xf9\xbc\x1e\xc2\xf3\xf3\x25\x47\x74\x7a\xc6\x1f\xa7\x3e\xe7\x0f\xa0\xe4\x84\xec\xfa\x59\x74\x99\xfb\xb3\x3a\xe3\x1f\x87\x70\x59\xdf\xbc\x5e\xc3\xfc\x0a\x00\x00\xff\xff\xa0\x41\x73\x9f\x1d\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc4\x55\x41\x6f\xdb\x3c\x0c\xbd\xfb\x57\x10\xed\xd9\x29\xbe\x5b\xe1\xdb\xb7\x1e\x76\x19\x86\xa1\x1d\x76\x67\x64\xc6\xe6\x2c\x4b\x02\x49\xa5\xdb\x8a\xfe\xf7\xc1\x76\xba\x26\xf5\x96\x25\x59\x8a\x9e\x42\x29\x12\xdf\x7b\xd2\xd3\x73\x51\x96\x65\x81\x89\xbf\x90\x28\xc7\x50\x81\x2c\xd1\x2d\x30\x5b\x1b\x85\x7f\xa0\x71\x0c\x8b\xee\x5a\x17\x1c\xaf\xd6\xff\x15\x1d\x87\xba\x82\x1b\x9f\xd5\x48\x6e\xa3\xa7\xa2\x27\xc3\x1a\x0d\xab\x02\x20\x60\x4f\x15\x74\xb9\xc7\xca\xc5\x60\x12\x7d\x99\x3c\x06\x2a\x24\x7b\xd2\xaa\x28\x01\x13\xbf\x97\x98\x93\x0e\xcb\x4b\xb8\xb8\x28\x00\x84\x34\x66\x71\xb4\x99\x1b\x9a\x68\x42\x47\x3a\x0e\x53\xac\xa7\x42\x49\xd6\x3c\xcd\xae\x49\x96\x9b\xd5\x0d\xd9\xf8\xeb\x59\xa7\xe2\x1e\xcd\xb5\x73\xa4\x81\xd4\x82\xe3\x1c\x6e\xe0\x3e\x92\xd4\xdd\x21\x07\xe5\xa6\xb5\x69\xb6\x27\x6d\x0f\x44\x1e\x2a\x27\x84\x46\x63\x99\x53\xfd\x54\xa6\x5f\xff\xd7\xe4\xc9\xe8\x08\x92\x2d\xa1\xb7\xd6\xb5\xe4\xba\x73\xeb\x4f\x12\xbf\x7d\x37\xea\x93\x47\x7b\x4b\x89\xbb\x3c\xae\xd4\xd0\xf2\x1f\xe8\xcc\x00\x0f\x47\x31\xc1\xd5\x8a\x5d\x22\xe9\x59\x07\xbf\x9f\xfb\x38\x37\x00\x3e\x36\xaf\xd4\x59\x62\xb6\xd3\x1e\xc1\x9e\xee\x5b\xfd\x4d\xf0\xc5\x23\x7b\x46\xd8\xc2\x78\x46\xb9\x84\x35\x7a\x1e\x6e\x04\xba\x6b\x05\x8b\x1d\x05\x58\xd2\x2a\x0a\x01\xab\x66\xe2\xd0\x40\xff\xf9\xc3\x1d\x38\x12\x9b\x0b\x1e\xa2\x86\x82\xb1\xdb\xce\x9a\xdf\xc8\x1f\xfa\x0a\xad\x99\xee\x5f\xa8\xdf\x58\xf1\xdf\x72\xec\x1d\x87\x9a\x43\x73\x60\x9c\x45\x4f\xb7\xb4\x1a\xd6\x3c\x89\xd9\x83\x57\x00\xcc\xe0\xf6\x75\xd7\xbc\xfc\x4a\xce\xc6\xbc\x9c\x36\xde\x4d\xd1\xf7\xbf\x73\x31\x07\xdb\xd9\x5b\xee\xee\x85\xe7\xf8\xac\xe0\xe1\x01\x16\x1f\x9f\x86\xf0\xf8\x78\xca\x11\x1d\x9e\xf1\xfb\xa1\x8f\xf9\x02\x28\x39\x21\x3b\x7b\x16\x5d\x82\x27\xac\x49\x80\x3c\xb9\x41\x2c\x60\x1f\x43\x03\x42\xc9\xb3\x43\x85\xb8\x82\x9b\x49\x12\x7c\x1a\x25\xcd\xd8\xba\x18\xa5\xe6\xb0\xdf\xa9\x9e\x50\x5f\x21\x49\x4f\xbb\xbb\xa3\x7c\xfd\x97\x2b\x3c\xcd\xf5\x6f\x67\xf7\x9f\x03\x00\x25\x93\x5f\x9f\xdb\x08\x00\x00"),
 		},
 		"/kuma-injector": &vfsgen۰DirInfo{
 			name:    "kuma-injector",
@@ -114,10 +114,10 @@ var Templates = func() http.FileSystem {
 		},
 		"/kuma-injector/app.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "app.yaml",
-			modTime:          time.Date(2020, 2, 4, 11, 1, 27, 303944560, time.UTC),
-			uncompressedSize: 4073,
+			modTime:          time.Date(2026, 10, 19, 3, 9, 15, 389459000, time.UTC),
+			uncompressedSize: 4255,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\xdd\x73\x9b\x38\x10\x7f\xe7\xaf\xd0\xe4\x1d\x7f\xb4\x71\x2e\x65\xa6\x0f\xae\xe3\x4b\x7d\x89\x3f\x06\x93\xf6\xd1\x23\x8b\x0d\xd6\x45\x48\x9c\x24\xb8\x7a\xda\xfc\xef\x37\x42\x40\x20\x18\x3b\xbe\x94\x27\xd8\xcf\xdf\xee\x4a\xbb\x8b\xeb\xba\x0e\x4e\xe8\x37\x90\x8a\x0a\xee\xa1\x6c\xe8\x3c\x51\x1e\x7a\x68\x0d\x32\xa3\x04\xc6\x84\x88\x94\x6b\x27\x06\x8d\x43\xac\xb1\xe7\x20\xc4\x71\x0c\x1e\x7a\x4a\x63\xec\x52\xfe\x37\x10\x2d\x64\x41\x55\x09\x26\xe0\xa1\x9f\x3f\x51\x6f\x51\x7e\xa2\xe7\x67\xe7\x94\x97\x77\x9a\x57\x09\x10\xa3\x99\x08\xa9\x95\x79\x71\xf3\x57\x0f\x5d\x5e\x7e\x74\x10\x2a\x4d\xee\xb4\x4e\x54\xfe\xad\xb1\x8c\x40\xaf\x72\x99\x6b\x2b\xa4\x80\xe5\xae\xbc\x5c\x00\x27\xc9\x6b\x08\x47\x62\x20\x12\xb4\xa3\xf7\x49\x0e\x7b\x0b\x92\x83\x06\xd5\xa3\xa2\xaf\x99\x3a\x15\x9a\xab\x99\x72\x09\x48\x7d\x22\xc6\xd2\x84\x66\xaa\x47\x0c\x6e\x23\x31\x2b\x8c\x04\x4c\x4d\x40\x6a\xf4\x0b\x6d\xaf\x2e\x81\x13\xa3\x60\x45\x9f\x60\xdf\x12\xbd\x83\x7d\x43\xb2\x33\xb2\x89\xe0\x8f\x34\x9a\xe3\xe4\x64\x10\x24\x97\x7c\x63\x08\x56\xb8\xb7\xc7\x31\xf3\xd0\xaf\x3c\xdf\xa5\x21\x9b\x7d\x84\x14\x0d\x81\x60\x39\x11\x5c\x63\xca\xa1\xa2\x23\x24\x21\xa4\x12\x48\x51\xbc\xe1\x68\x30\x18\x56\xbc\x94\x86\x1e\x1a\x5d\xfd\x71\x5d\x51\xa2\x16\x05\x87\x31\xe5\x56\xf9\xd3\xa7\x9a\x6e\x28\x31\xe5\x01\x35\xa1\x7d\x1c\x28\xa7\xe6\x0f\x87\x94\x83\x52\x2b\x29\xb6\xf0\x82\xc3\x60\xa6\x9a\x62\x76\x03\x0c\xef\xd7\x40\x04\x0f\x95\x87\x86\x35\x01\x4d\x63\x10\xa9\xae\x78\xf9\xf3\xb1\x26\x90\x80\xa4\x22\x6c\xf2\xd1\xa8\x26\xa0\x52\x42\x40\xa9\x60\x27\x41\xed\x04\x0b\x73\x99\xba\x8b\x47\x4c\x59\x2a\xe1\x95\xc0\x87\x4a\x82\xd1\x0c\xde\x0e\xfe\x6a\xf0\x5b\xd1\x9f\x02\x27\x41\x89\x54\x12\x50\x75\x60\x12\xfe\x49\x41\xe9\x06\x0d\x21\x92\xa4\x1e\x1a\x0d\xe2\x06\x31\x86\x58\xc8\xbd\x87\xae\x2e\xe7\xb4\xc6\x60\x34\xa6\x07\xf5\x87\x83\x41\x87\x85\xd1\xf0\xc3\x9c\xb6\xee\x01\x4e\x12\xd5\xaf\x2e\xc3\x0d\x24\x4c\xec\x63\x78\x77\x33\x44\x88\xe1\x2d\x30\xd5\xd9\x69\xca\x76\xa6\xb4\xc4\x1a\xa2\xbd\x15\x94\x82\x31\xca\xa3\x87\x24\xc4\xba\x2a\x65\x8c\x7f\xac\x53\x19\xc1\xcb\xc1\x8b\xf1\x8f\x07\x8e\x33\x4c\x19\xde\x32\xf0\xd0\xa0\xd5\xdb\x62\xac\xc9\xee\xbe\x06\xe1\x20\x08\x84\x34\xc4\x09\xab\x7c\xd5\x63\xce\xb3\xdc\x30\xd0\x61\x02\xa1\x32\x96\xfc\xbd\x31\x52\x16\x07\x13\x97\x17\xab\xbc\xf5\x95\x79\xb7\x23\xcd\xc5\x59\x8e\x71\x04\xcd\x2e\x37\x33\x24\xf4\xfc\xec\x19\xa2\xe9\x22\x52\xb0\x15\xc3\x1c\x8a\xe2\xda\x3a\xd4\xd4\x57\x29\x63\x2b\xc1\x28\x29\xdb\x65\x93\x58\x97\x07\x9e\xbd\x84\x5d\x22\xbb\x7b\x98\x8f\x37\xb3\xc5\x5f\xd3\x49\xb0\xf4\x37\xdf\xa7\x5f\xbe\x2e\x97\x77\x9b\xf5\xd4\xff\x36\xf5\x37\xab\xa5\x1f\xd4\xce\x5d\x86\x59\x0a\x1e\xba\x30\x93\xe7\xe2\x3c\x4b\x93\xa9\x1f\x6c\x6e\x66\x7e\xdb\x5a\x3f\xc3\xb2\x2f\x53\xde\x57\xf9\x38\x52\x7d\x93\x2b\x33\x83\x1a\x39\xeb\xd7\xa6\xcd\x31\xb7\x93\xe5\x22\xf0\x97\xf7\x9b\xd5\xfd\x78\x31\xdd\x7c\x59\x2e\x83\x75\xe0\x8f\x57\x25\x8c\x07\xff\xbe\x8d\xc0\x8c\x57\xaf\x6f\xfd\x11\x9b\x72\x37\x31\x39\xef\xbd\xbe\x02\xde\xe8\xea\xfa\xc3\x59\x08\xc6\xab\xd9\x6f\xf4\x3d\x3c\xe1\x7b\x3d\xbb\x99\x4e\xc6\x16\xc3\x78\xb6\x98\xfa\x9b\xd9\x7c\x7c\x3b\x6d\xbb\x35\xc6\x6f\xb0\xc6\xb9\xab\x73\xce\xdc\x61\xbf\xb3\xc5\x2c\x38\xd7\x29\xa7\xfa\x1c\xc7\x58\x46\xaa\x7e\x7a\x65\xca\x6b\x5f\xae\xcb\x44\xe4\x32\xc8\x80\x7d\xa6\xfc\x51\x34\x58\x76\x6c\xbb\x8f\x94\xc1\xe7\x3e\x68\xd2\x71\xc2\x6a\xd3\xbd\x52\xaf\x16\xb3\xd2\x5a\x75\xc5\x1b\x3b\x98\x7d\x3a\x67\x96\x29\xf2\x2d\xe8\x66\x67\x4f\xb0\xde\x79\xa8\xbf\x03\xcc\xf4\x6e\xdf\x64\xb5\x6d\x9b\x47\x91\x1d\x98\xdc\x7f\x0d\x82\xd5\xfa\x0d\x73\xfe\x98\x5b\xa3\xf5\x2e\xa7\xe7\x4d\xc0\x61\xd7\x00\x6b\x8c\xc0\x4c\xb0\x34\x86\xb9\x69\xb2\xaa\xdd\xa9\x3a\xb7\xcf\xca\xa6\x51\x5c\xd9\xf8\xfe\x67\x53\xb1\xe9\x5c\x72\xb6\xf7\x90\x96\x29\x9c\x00\x51\x6d\x8f\x87\x20\x74\x9e\xb4\x53\xde\x6c\x1a\x8e\xcf\x90\x76\xfc\x36\xd2\x7a\xee\x2d\xe5\xc0\xb8\x7a\xad\xfc\xa6\xe0\x48\xb9\x53\xd7\x5d\x1c\xd1\x6b\x2d\x25\x61\x4c\x95\x79\x95\x10\xd1\x7c\x43\xa0\x82\xf7\x9e\xae\xf3\xff\x8d\x6c\xb8\x05\x8d\xcb\x8d\x65\x9e\x6a\xac\x29\x8f\xbe\xc3\x76\x27\xc4\x93\xdd\xe6\x53\xab\x71\x72\xa3\xff\xd7\x2a\x15\x30\x4a\xad\x82\xaa\x3c\xe7\x60\xb0\xbd\xa2\x50\xf5\x0d\x68\x7d\x6a\xf9\x28\x8b\x5b\xec\xfc\x85\xb1\x3c\x58\xe0\x66\x89\x09\x9d\x6a\x97\x6c\x8c\xe8\xc2\xe9\x9f\x75\x96\xed\x75\x84\x51\xe0\xda\x06\x6c\xdd\x10\xfc\x25\xe5\x21\x83\xb7\xfc\x37\x55\x9b\x4a\x89\xf0\xf8\x36\xd7\x51\xc0\x82\x53\x34\x0a\x4b\x75\x8b\x18\x1d\x84\x64\xca\xa0\xf8\x57\xc5\x09\xbd\x95\x22\x4d\x8a\x8c\xb8\xe8\xe2\xa2\x58\x0d\xcb\xb2\x57\x9c\xcc\x8e\x2e\x91\x80\x2d\x48\xc5\x98\xf8\xd3\x71\x60\x87\xc5\xab\xa6\x62\xfe\x85\x43\xe5\xfc\x17\x00\x00\xff\xff\x9e\x8c\x30\xf6\xe9\x0f\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xad\x57\x59\x73\xe2\x38\x10\x7e\xe7\x57\xb8\xf2\x6e\x8e\x5c\x9b\xa1\x6a\x1e\x08\x61\x32\x6c\xc2\x51\xe0\xcc\x3c\x52\xc2\xee\x80\x36\xb2\xe5\x95\x64\xcf\x52\x33\xf3\xdf\xb7\x25\xd9\x8e\xcd\x65\xd8\x2c\x4f\xa6\xcf\xaf\xbb\xa5\xee\x96\xeb\xba\x0d\x12\xd3\x6f\x20\x24\xe5\x51\xd7\x49\x3b\x8d\x37\x1a\x05\x5d\x67\x0e\x22\xa5\x3e\xf4\x7c\x9f\x27\x91\x6a\x84\xa0\x48\x40\x14\xe9\x36\x1c\x27\x22\x21\x74\x9d\xb7\x24\x24\x2e\x8d\xfe\x02\x5f\x71\x91\x51\x65\x4c\x7c\x64\xfd\xfc\xe9\x34\xc7\xf9\x5f\xe7\xf7\xef\x86\x5b\xe3\xe5\x83\xe6\x65\x0c\xbe\xd6\x8c\xb9\x50\x52\x7f\xb8\xe6\xb3\xeb\x5c\x5f\x5f\xe1\xbf\xdc\xe4\x5a\xa9\x58\x9a\xff\x8a\x88\x15\xa8\xa9\x91\xb9\xb3\x42\x12\x98\x71\xd5\x35\x02\x24\x8e\xb7\x21\x1c\x89\xc1\x17\xa0\x1a\x6a\x13\x1b\xd8\x4b\x10\x11\x28\x90\x4d\xca\x5b\x8a\xc9\xba\xd0\x5c\x94\x71\x7d\x10\xaa\x26\xc6\xdc\x04\x8a\x37\x7d\x8d\x5b\x4b\x0c\x33\x23\x1e\x93\x7d\x34\xe1\xfc\x72\x96\xb7\xd7\x10\xf9\x5a\xc1\x8a\xbe\xc1\x66\x47\xf4\x09\x36\x15\xc9\x83\x91\xf5\x79\xf4\x4a\x57\x23\x12\xd7\x06\xe1\x1b\xc9\x13\x43\xb0\xc2\xcd\x0d\x09\x59\xd7\xf9\x65\xf2\x9d\x1b\xb2\xd9\xc7\x6a\xd0\x00\x7c\x22\x10\x80\x22\x34\x82\x82\xee\x38\x02\x02\x2a\x50\xd6\x16\xaf\x73\xd3\x6e\x77\x0a\x5e\x42\x11\xf4\xcd\xed\x1f\x77\x05\x65\xb5\x43\x21\x41\x48\x23\xab\xfc\xe9\x53\x49\x37\x10\xe8\xc8\xa3\x3a\xb4\xab\xb6\x6c\x94\xfc\x91\x00\x11\x48\x39\x15\x7c\x09\xef\x38\x34\x66\xaa\x28\x61\x0f\xc0\xc8\x06\xcf\x00\x8f\x02\x89\x80\x4a\x02\x0a\xad\xf1\x44\x15\x3c\xf3\xbb\x2a\x09\xc4\x20\x28\x0f\xaa\x7c\xe7\xa6\x24\x20\x13\xdf\x47\xd7\xde\x5a\x80\x5c\x73\x16\x18\x99\xb2\x8b\x57\x42\x59\x22\x60\x4b\xe0\xb2\x90\x60\x34\x85\xd3\xc1\xdf\xb6\xff\x57\xf4\x75\xe0\x90\xcc\x13\x81\x01\x96\x81\x09\xf8\x3b\x01\xa9\x2a\x34\x3c\x32\x71\x82\x75\x6c\x87\x15\x62\x08\x21\x17\x78\xbc\x6f\xaf\x47\xb4\xc4\x60\x34\xa4\x7b\xf5\x3b\xed\xf6\x01\x0b\x37\x9d\x4b\x34\xb1\x7d\x0f\xb0\x09\xc8\x56\x71\x19\x1e\x20\x66\x7c\x13\xc2\x87\x9b\x21\x22\x24\x4b\x60\xf2\x60\xa7\xc9\xdb\x99\x54\x82\x28\x58\x6d\xac\xa0\xe0\x8c\xd1\x68\xf5\x12\xa3\xe7\xa2\x94\x21\xf9\x67\x9e\x60\x2f\x7b\x3f\x78\x48\x79\x89\x48\x8a\xa9\x27\x4b\x86\xf4\xf6\x4e\x6f\x0b\x89\xf2\xd7\xcf\x25\x08\x7b\x41\xe0\x01\x80\x30\x66\x85\xaf\x72\xcc\x26\xcb\x15\x03\x07\x4c\xa0\xeb\x2c\x16\xf3\x5d\x19\x29\xe3\xbd\x89\x33\xc5\xca\x6f\x7d\x61\xde\x3d\x90\xe6\xec\x2c\x87\x64\x05\xd5\x2e\x37\xd4\x24\x4c\x76\x57\x13\x75\x17\xc1\xec\x4d\x19\x89\x20\x2b\xae\xad\x43\x49\x7d\x9a\x30\x36\xe5\x8c\xfa\x79\xbb\xac\x12\xcb\xf2\x10\xa5\xef\x61\xe7\xc8\x9e\x5e\x46\xbd\xc5\x70\xfc\xe7\xa0\xef\x4d\x66\x8b\xef\x83\xfb\xaf\x93\xc9\xd3\x62\x3e\x98\x7d\x1b\xcc\x16\xd3\xc9\xcc\x2b\x9d\xbb\x94\xb0\x04\x55\x2e\xf4\xe4\xb9\x38\xcf\x52\x7f\x30\xf3\x16\x0f\xc3\xd9\xae\xb5\x56\x4a\x44\x4b\x24\x51\x4b\x9a\x71\x24\x5b\x3a\x57\x7a\x06\x55\x72\xd6\x2a\x4d\x9b\x63\x6e\xfb\x93\xb1\x37\x9b\x3c\x2f\xa6\xcf\xbd\xf1\x60\x71\x3f\x99\x78\x73\x6f\xd6\x9b\xe6\x30\x5e\x66\xcf\xbb\x08\xcc\x78\xed\xb6\xac\x43\xdf\xe6\xdc\x8d\x75\xd2\x9b\xdb\x77\xa0\x7b\x73\x7b\x77\xf9\x31\x08\xfd\x9e\x49\xc6\x36\x8c\x2f\x82\x87\xd5\xab\x6f\xf3\x81\x33\x6f\x06\xaf\x55\x4e\xe5\xf2\xca\x40\xba\x3b\xc9\xb1\x3f\x33\x43\xb3\xb9\x7b\x16\xe8\xde\x74\x58\x9b\xb1\xd3\x13\xd6\xa9\xf1\x3d\x1f\x3e\x0c\xfa\x3d\x8b\xa1\x37\x1c\xa3\xd3\xe1\xa8\xf7\x38\xd8\x75\xab\x8d\x3f\xe0\x55\x36\xae\xce\xb9\x29\xfb\xfd\x0e\xc7\x43\xef\x5c\xa7\x38\x7d\xce\x71\x8c\xeb\x9a\x2c\xdf\x39\x3c\xe7\xa5\x7f\xae\xcb\xf8\xca\x65\x90\x02\xfb\x4c\xa3\x57\x5e\x61\xd9\x65\xc3\x7d\xa5\x0c\x3e\xb7\x40\xf9\x07\xee\x45\x69\x27\x29\xd4\x8b\x75\x32\xb7\x56\x34\xa6\xca\xe6\x58\x33\x69\x75\x91\x1f\x41\x55\x8f\x5e\x4c\xd4\x1a\xef\xec\x1a\x08\x53\xeb\x4d\x95\xb5\x6b\xdb\x1c\x63\x7f\x0d\x3a\xf7\x5f\x3d\x6f\x3a\x3f\x61\x3b\x39\xe6\x56\x6b\x7d\xc8\xe9\x79\x73\xbb\xd3\x3e\x65\x70\xa7\x9c\x25\x21\x8c\xf4\x68\x90\xbb\xfd\xf5\xe0\xce\x5c\xd8\xd4\x8a\x53\x1b\xdf\x7f\x6c\x85\x36\x9d\x93\x88\xe9\xeb\x2e\x12\xa8\x01\x51\xec\xbc\xfb\x20\x1c\x3c\x69\x75\xde\x6c\x1a\x8e\x4f\xbe\xdd\xf8\x6d\xa4\xe5\xdc\x5b\xca\xb8\x5e\xf9\xa4\xe0\xfc\xfc\x25\x50\x76\x71\x44\x6f\x67\x95\xc2\xad\x5b\xea\x4f\x01\x2b\x6a\xf6\x1a\xfc\x6e\xbe\xdd\x99\x57\x52\xda\x59\xe2\x72\x91\xef\x59\xa3\x44\x21\x37\x5a\x7d\x87\xe5\x9a\xf3\x37\xfb\x06\x49\xac\x46\xed\x3b\xe4\x87\x55\xca\x60\xe4\x5a\x19\x15\x93\xba\x37\xd8\x66\x56\xa8\xf2\xde\x36\xaf\x5b\x99\xf2\xe2\x66\x2f\x95\xcc\x98\x09\x16\x22\xbd\x7a\x05\x8d\x62\x03\xae\x2c\x16\x99\xd3\x2f\x65\x96\xed\x75\x3e\xa3\xb8\x5d\xda\x80\xad\x1b\x9f\xdc\x27\x51\xc0\xe0\x94\xd7\x5e\xb1\x5f\xe5\x08\x8f\xef\xa0\x07\x0a\xd8\xa8\x34\x0a\x4b\x75\xb3\x18\x91\x27\x12\x06\xd9\x0b\x1b\xcb\xfb\x28\x78\x12\x67\x19\x71\x9d\x8b\x8b\x6c\xa1\xcd\xcb\x5e\x70\x52\x3b\xba\x38\xbe\x18\x4c\x41\x0a\x46\x7f\x36\xe8\x79\x76\x58\x6c\x35\x15\xfd\x82\x0f\x64\xe3\x5f\xd1\xc9\x59\x05\x9f\x10\x00\x00"),
 		},
 		"/kuma-injector/rbac.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "rbac.yaml",
@@ -0,0 +1,303 @@
+package dashboards
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	util_xds "github.com/Kong/kuma/pkg/util/xds"
+	envoy_names "github.com/Kong/kuma/pkg/xds/envoy/names"
+	"github.com/Kong/kuma/pkg/xds/topology"
+)
+
+// Datasource is a name of Prometheus datasource that is provisioned into Grafana by `kumactl install metrics`.
+const Datasource = "Prometheus"
+
+// Dashboard is a Grafana dashboard in JSON format.
+type Dashboard struct {
+	// Mesh is a name of the Mesh the dashboard has been generated for.
+	Mesh string
+	// FileName is a name of a file the dashboard should be saved to, e.g. a key in a ConfigMap.
+	FileName string
+	Json     []byte
+}
+
+// Generate generates golden-signal dashboards for every Mesh and for every service in a Mesh.
+//
+// Dashboards are derived from the actual mesh topology:
+//   - services are taken from inbound interfaces of Dataplanes (or gateway tags)
+//   - destinations of a service are resolved by applying TrafficRoutes to outbound interfaces of its Dataplanes
+//     the same way Control Plane does it, so that there is a graph for every Envoy cluster of that service
+func Generate(meshes *mesh_core.MeshResourceList, dataplanes *mesh_core.DataplaneResourceList, routes *mesh_core.TrafficRouteResourceList) ([]Dashboard, error) {
+	var dashboards []Dashboard
+	for _, mesh := range sortedMeshes(meshes) {
+		services := buildServiceMap(mesh.Meta.GetName(), dataplanes, routes)
+
+		dashboard, err := render(meshDashboard(mesh.Meta.GetName(), services))
+		if err != nil {
+			return nil, err
+		}
+		dashboard.Mesh = mesh.Meta.GetName()
+		dashboards = append(dashboards, dashboard)
+
+		for _, service := range services.Names() {
+			dashboard, err := render(serviceDashboard(mesh.Meta.GetName(), service, services[service]))
+			if err != nil {
+				return nil, err
+			}
+			dashboard.Mesh = mesh.Meta.GetName()
+			dashboards = append(dashboards, dashboard)
+		}
+	}
+	return dashboards, nil
+}
+
+// destination is an Envoy cluster that a service sends requests to.
+type destination struct {
+	// cluster is a name of Envoy cluster, e.g. `backend{version=v1}`.
+	cluster string
+	// statName is a name of Envoy cluster in metrics, e.g. `backend_version_v1_`.
+	statName string
+}
+
+// serviceMap holds destinations of every service in a Mesh.
+type serviceMap map[string][]destination
+
+func (m serviceMap) Names() []string {
+	var names []string
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func buildServiceMap(mesh string, dataplanes *mesh_core.DataplaneResourceList, routes *mesh_core.TrafficRouteResourceList) serviceMap {
+	var meshRoutes []*mesh_core.TrafficRouteResource
+	for _, route := range routes.Items {
+		if route.Meta.GetMesh() == mesh {
+			meshRoutes = append(meshRoutes, route)
+		}
+	}
+
+	clusters := map[string]map[string]bool{}
+	for _, dataplane := range dataplanes.Items {
+		if dataplane.Meta.GetMesh() != mesh {
+			continue
+		}
+		destinations := topology.BuildDestinationMap(dataplane, topology.BuildRouteMap(dataplane, meshRoutes))
+		for _, service := range dataplane.Spec.Tags().Values(mesh_proto.ServiceTag) {
+			if clusters[service] == nil {
+				clusters[service] = map[string]bool{}
+			}
+			for destinationService, selectors := range destinations {
+				for _, selector := range selectors {
+					clusters[service][envoy_names.GetDestinationClusterName(destinationService, selector)] = true
+				}
+			}
+		}
+	}
+
+	services := serviceMap{}
+	for service, serviceClusters := range clusters {
+		services[service] = []destination{}
+		for cluster := range serviceClusters {
+			services[service] = append(services[service], destination{
+				cluster:  cluster,
+				statName: util_xds.SanitizeMetric(cluster),
+			})
+		}
+		sort.Slice(services[service], func(i, j int) bool {
+			return services[service][i].cluster < services[service][j].cluster
+		})
+	}
+	return services
+}
+
+func sortedMeshes(meshes *mesh_core.MeshResourceList) []*mesh_core.MeshResource {
+	sorted := append([]*mesh_core.MeshResource{}, meshes.Items...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Meta.GetName() < sorted[j].Meta.GetName()
+	})
+	return sorted
+}
+
+func meshDashboard(mesh string, services serviceMap) (string, *dashboard) {
+	fileName := dashboardFileName("mesh", mesh)
+	b := newBuilder(fileName, fmt.Sprintf("Kuma Mesh %s - Golden Signals", mesh))
+	for _, service := range services.Names() {
+		b.row(fmt.Sprintf("Service %s", service))
+		b.goldenSignals(mesh, service)
+	}
+	return fileName, b.dashboard
+}
+
+func serviceDashboard(mesh string, service string, destinations []destination) (string, *dashboard) {
+	fileName := dashboardFileName("service", mesh, service)
+	b := newBuilder(fileName, fmt.Sprintf("Kuma Service %s (mesh %s) - Golden Signals", service, mesh))
+	b.row("Inbound traffic")
+	b.goldenSignals(mesh, service)
+	b.row("Outbound traffic")
+	b.upstreams(mesh, service, destinations)
+	return fileName, b.dashboard
+}
+
+var illegalFileNameChars = regexp.MustCompile(`[^-._a-zA-Z0-9]`)
+
+// dashboardFileName returns a file name that is a valid ConfigMap key, e.g. `kuma-service-default-web-1a2b3c4d.json`.
+//
+// Names are suffixed with a hash since neither `-` nor replacement of illegal characters keeps them unique,
+// e.g. service `b-c` in mesh `a` and service `c` in mesh `a-b`, or services `web:80` and `web_80`.
+func dashboardFileName(kind string, names ...string) string {
+	// NUL cannot appear in names of meshes and services
+	hash := sha256.Sum256([]byte(kind + "\x00" + strings.Join(names, "\x00")))
+	readable := illegalFileNameChars.ReplaceAllString(strings.Join(names, "-"), "_")
+	return fmt.Sprintf("kuma-%s-%s-%s.json", kind, readable, hex.EncodeToString(hash[:])[:8])
+}
+
+func render(fileName string, dashboard *dashboard) (Dashboard, error) {
+	bytes, err := json.MarshalIndent(dashboard, "", "  ")
+	if err != nil {
+		return Dashboard{}, err
+	}
+	return Dashboard{
+		FileName: fileName,
+		Json:     bytes,
+	}, nil
+}
+
+const (
+	panelHeight    = 8
+	dashboardWidth = 24
+)
+
+// builder lays out panels of a dashboard from top to bottom.
+type builder struct {
+	dashboard *dashboard
+	y         int
+}
+
+func newBuilder(fileName string, title string) *builder {
+	uid := sha256.Sum256([]byte(fileName))
+	return &builder{
+		dashboard: &dashboard{
+			// uid is limited to 40 characters, while it has to stay the same between re-generations
+			Uid:           "kuma-" + hex.EncodeToString(uid[:])[:16],
+			Title:         title,
+			Tags:          []string{"kuma", "generated"},
+			Editable:      true,
+			SchemaVersion: 22,
+			Refresh:       "10s",
+			Time: timeRange{
+				From: "now-30m",
+				To:   "now",
+			},
+			Panels: []*panel{},
+		},
+	}
+}
+
+func (b *builder) row(title string) {
+	b.add(&panel{
+		Type:    "row",
+		Title:   title,
+		GridPos: gridPos{H: 1, W: dashboardWidth, X: 0, Y: b.y},
+	})
+	b.y++
+}
+
+// goldenSignals adds request rate, error rate and latency of requests received by a service.
+func (b *builder) goldenSignals(mesh string, service string) {
+	inbound := inboundSelector(mesh, service)
+	width := dashboardWidth / 3
+	b.add(graph("Request rate", "reqps", gridPos{H: panelHeight, W: width, X: 0, Y: b.y},
+		&target{
+			Expr:         fmt.Sprintf(`sum(rate(envoy_cluster_upstream_rq_total{%s}[1m]))`, inbound),
+			LegendFormat: "Requests",
+		},
+	))
+	b.add(graph("Error rate", "percentunit", gridPos{H: panelHeight, W: width, X: width, Y: b.y},
+		&target{
+			Expr:         errorRate(inbound),
+			LegendFormat: "5xx",
+		},
+	))
+	var latencies []*target
+	for _, percentile := range []int{50, 95, 99} {
+		latencies = append(latencies, &target{
+			Expr:         fmt.Sprintf(`histogram_quantile(0.%d, sum(rate(envoy_cluster_upstream_rq_time_bucket{%s}[1m])) by (le))`, percentile, inbound),
+			LegendFormat: fmt.Sprintf("p%d", percentile),
+		})
+	}
+	b.add(graph("Latency", "ms", gridPos{H: panelHeight, W: width, X: 2 * width, Y: b.y}, latencies...))
+	b.y += panelHeight
+}
+
+// upstreams adds success rate of requests sent by a service, broken down by destination.
+func (b *builder) upstreams(mesh string, service string, destinations []destination) {
+	var successRates []*target
+	for _, destination := range destinations {
+		successRates = append(successRates, &target{
+			Expr:         fmt.Sprintf(`1 - %s`, errorRate(outboundSelector(mesh, service, destination))),
+			LegendFormat: destination.cluster,
+		})
+	}
+	b.add(graph("Upstream success rate by destination", "percentunit", gridPos{H: panelHeight, W: dashboardWidth, X: 0, Y: b.y}, successRates...))
+	b.y += panelHeight
+}
+
+func (b *builder) add(panel *panel) {
+	panel.Id = len(b.dashboard.Panels) + 1
+	b.dashboard.Panels = append(b.dashboard.Panels, panel)
+}
+
+func graph(title string, format string, pos gridPos, targets ...*target) *panel {
+	for i, target := range targets {
+		target.RefId = refId(i)
+	}
+	return &panel{
+		Type:       "graph",
+		Title:      title,
+		GridPos:    pos,
+		Datasource: Datasource,
+		Targets:    targets,
+		Yaxes: []*yaxis{
+			{Format: format, Show: true},
+			{Format: "short", Show: false},
+		},
+	}
+}
+
+// refId returns A, B, ..., Z, AA, AB, ... the same way Grafana does.
+func refId(i int) string {
+	if i < 26 {
+		return string(rune('A' + i))
+	}
+	return refId(i/26-1) + refId(i%26)
+}
+
+func errorRate(selector string) string {
+	return fmt.Sprintf(`sum(rate(envoy_cluster_upstream_rq_xx{%s,envoy_response_code_class="5"}[1m])) / sum(rate(envoy_cluster_upstream_rq_total{%s}[1m]))`, selector, selector)
+}
+
+// inboundSelector matches local clusters that forward requests received by a service to the application.
+func inboundSelector(mesh string, service string) string {
+	return fmt.Sprintf(`mesh=%s,services=~%s,envoy_cluster_name=~"localhost_.*"`, strconv.Quote(mesh), servicesRegex(service))
+}
+
+// outboundSelector matches a cluster that a service sends requests to.
+func outboundSelector(mesh string, service string, destination destination) string {
+	return fmt.Sprintf(`mesh=%s,services=~%s,envoy_cluster_name=%s`, strconv.Quote(mesh), servicesRegex(service), strconv.Quote(destination.statName))
+}
+
+// servicesRegex matches `services` label of a dataplane that has a given service among others, e.g. `,web,backend,`.
+func servicesRegex(service string) string {
+	return strconv.Quote(fmt.Sprintf(".*,%s,.*", regexp.QuoteMeta(service)))
+}
@@ -0,0 +1,219 @@
+package dashboards_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/app/kumactl/pkg/install/k8s/metrics/dashboards"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+
+	test_model "github.com/Kong/kuma/pkg/test/resources/model"
+)
+
+var _ = Describe("Generate()", func() {
+
+	dataplane := func(mesh, name, spec string) *mesh_core.DataplaneResource {
+		dataplane := &mesh_core.DataplaneResource{
+			Meta: &test_model.ResourceMeta{
+				Mesh: mesh,
+				Name: name,
+			},
+		}
+		Expect(util_proto.FromYAML([]byte(spec), &dataplane.Spec)).To(Succeed())
+		return dataplane
+	}
+
+	trafficRoute := func(mesh, name, spec string) *mesh_core.TrafficRouteResource {
+		route := &mesh_core.TrafficRouteResource{
+			Meta: &test_model.ResourceMeta{
+				Mesh: mesh,
+				Name: name,
+			},
+		}
+		Expect(util_proto.FromYAML([]byte(spec), &route.Spec)).To(Succeed())
+		return route
+	}
+
+	It("should generate dashboards per mesh and per service", func() {
+		// given
+		meshes := &mesh_core.MeshResourceList{
+			Items: []*mesh_core.MeshResource{
+				{Meta: &test_model.ResourceMeta{Name: "default", Mesh: "default"}},
+				{Meta: &test_model.ResourceMeta{Name: "demo", Mesh: "demo"}},
+			},
+		}
+		dataplanes := &mesh_core.DataplaneResourceList{
+			Items: []*mesh_core.DataplaneResource{
+				dataplane("default", "web-01", `
+                networking:
+                  address: 192.168.0.1
+                  inbound:
+                  - port: 8080
+                    tags:
+                      service: web
+                  outbound:
+                  - port: 10001
+                    service: backend
+                  - port: 10002
+                    service: redis`),
+				dataplane("default", "backend-01", `
+                networking:
+                  address: 192.168.0.2
+                  inbound:
+                  - port: 8080
+                    tags:
+                      service: backend
+                      version: v1`),
+				dataplane("default", "backend-02", `
+                networking:
+                  address: 192.168.0.3
+                  inbound:
+                  - port: 8080
+                    tags:
+                      service: backend
+                      version: v2`),
+				dataplane("demo", "gateway-01", `
+                networking:
+                  gateway:
+                    tags:
+                      service: gateway`),
+			},
+		}
+		routes := &mesh_core.TrafficRouteResourceList{
+			Items: []*mesh_core.TrafficRouteResource{
+				trafficRoute("default", "web-to-backend", `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                - weight: 90
+                  destination:
+                    service: backend
+                    version: v1
+                - weight: 10
+                  destination:
+                    service: backend
+                    version: v2`),
+			},
+		}
+
+		// when
+		generated, err := dashboards.Generate(meshes, dataplanes, routes)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		var fileNames []string
+		for _, dashboard := range generated {
+			fileNames = append(fileNames, dashboard.FileName)
+		}
+		Expect(fileNames).To(Equal([]string{
+			"kuma-mesh-default-bd4a970a.json",
+			"kuma-service-default-backend-02d4df0b.json",
+			"kuma-service-default-web-69a01018.json",
+			"kuma-mesh-demo-409a3551.json",
+			"kuma-service-demo-gateway-15dadfde.json",
+		}))
+
+		// and
+		for _, dashboard := range generated {
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", dashboard.FileName))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dashboard.Json).To(MatchJSON(expected), dashboard.FileName)
+		}
+	})
+
+	It("should turn names of services into valid file names", func() {
+		// given
+		meshes := &mesh_core.MeshResourceList{
+			Items: []*mesh_core.MeshResource{
+				{Meta: &test_model.ResourceMeta{Name: "default", Mesh: "default"}},
+			},
+		}
+		dataplanes := &mesh_core.DataplaneResourceList{
+			Items: []*mesh_core.DataplaneResource{
+				dataplane("default", "backend-01", `
+                networking:
+                  address: 192.168.0.1
+                  inbound:
+                  - port: 8080
+                    tags:
+                      service: backend.kuma-demo.svc:8080`),
+			},
+		}
+
+		// when
+		generated, err := dashboards.Generate(meshes, dataplanes, &mesh_core.TrafficRouteResourceList{})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(generated).To(HaveLen(2))
+		Expect(generated[1].FileName).To(MatchRegexp(`^kuma-service-default-backend\.kuma-demo\.svc_8080-[0-9a-f]{8}\.json$`))
+		// and
+		Expect(string(generated[1].Json)).To(ContainSubstring(`services=~\".*,backend\\\\.kuma-demo\\\\.svc:8080,.*\"`))
+	})
+
+	It("should generate distinct file names for names that are the same once joined or sanitized", func() {
+		// given
+		meshes := &mesh_core.MeshResourceList{
+			Items: []*mesh_core.MeshResource{
+				{Meta: &test_model.ResourceMeta{Name: "a", Mesh: "a"}},
+				{Meta: &test_model.ResourceMeta{Name: "a-b", Mesh: "a-b"}},
+			},
+		}
+		dataplanes := &mesh_core.DataplaneResourceList{
+			Items: []*mesh_core.DataplaneResource{
+				dataplane("a", "dp-01", `
+                networking:
+                  address: 192.168.0.1
+                  inbound:
+                  - port: 8080
+                    tags:
+                      service: b-c
+                  - port: 8081
+                    tags:
+                      service: web:80
+                  - port: 8082
+                    tags:
+                      service: web_80`),
+				dataplane("a-b", "dp-01", `
+                networking:
+                  address: 192.168.0.2
+                  inbound:
+                  - port: 8080
+                    tags:
+                      service: c`),
+			},
+		}
+
+		// when
+		generated, err := dashboards.Generate(meshes, dataplanes, &mesh_core.TrafficRouteResourceList{})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		fileNames := map[string]bool{}
+		uids := map[string]bool{}
+		for _, dashboard := range generated {
+			fileNames[dashboard.FileName] = true
+			var parsed struct {
+				Uid string `json:"uid"`
+			}
+			Expect(json.Unmarshal(dashboard.Json, &parsed)).To(Succeed())
+			uids[parsed.Uid] = true
+		}
+		Expect(generated).To(HaveLen(6))
+		Expect(fileNames).To(HaveLen(6))
+		Expect(uids).To(HaveLen(6))
+	})
+})
@@ -0,0 +1,48 @@
+package dashboards
+
+// A subset of Grafana dashboard JSON model that is enough to render golden signals.
+// See https://grafana.com/docs/grafana/latest/reference/dashboard/
+
+type dashboard struct {
+	Uid           string    `json:"uid"`
+	Title         string    `json:"title"`
+	Tags          []string  `json:"tags"`
+	Editable      bool      `json:"editable"`
+	SchemaVersion int       `json:"schemaVersion"`
+	Refresh       string    `json:"refresh"`
+	Time          timeRange `json:"time"`
+	Panels        []*panel  `json:"panels"`
+}
+
+type timeRange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type panel struct {
+	Id         int       `json:"id"`
+	Type       string    `json:"type"`
+	Title      string    `json:"title"`
+	GridPos    gridPos   `json:"gridPos"`
+	Datasource string    `json:"datasource,omitempty"`
+	Targets    []*target `json:"targets,omitempty"`
+	Yaxes      []*yaxis  `json:"yaxes,omitempty"`
+}
+
+type gridPos struct {
+	H int `json:"h"`
+	W int `json:"w"`
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+type target struct {
+	Expr         string `json:"expr"`
+	LegendFormat string `json:"legendFormat"`
+	RefId        string `json:"refId"`
+}
+
+type yaxis struct {
+	Format string `json:"format"`
+	Show   bool   `json:"show"`
+}
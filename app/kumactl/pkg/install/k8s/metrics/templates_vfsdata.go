@@ -29,10 +29,10 @@ var Templates = func() http.FileSystem {
 		},
 		"/grafana/grafana.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "grafana.yaml",
-			modTime:          time.Date(2020, 2, 27, 13, 24, 25, 384813538, time.UTC),
-			uncompressedSize: 7922,
+			modTime:          time.Date(2026, 10, 19, 1, 50, 16, 819440603, time.UTC),
+			uncompressedSize: 8863,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x6f\x6f\xdb\xba\xce\x7f\xdf\x4f\x41\xe4\x3c\x40\xdf\x9c\xfc\x6d\x92\x26\xc6\xb3\x01\x3d\x49\xce\x39\xb9\x67\x6d\x83\xb4\xdd\x70\x31\x0c\x85\x2c\xd3\x89\x56\x59\xf2\x95\xe4\x74\xb9\xdb\xbe\xfb\x85\x6c\xcb\x76\x12\xa7\xeb\xba\xcd\x6f\x5a\x89\xe4\x8f\x14\x49\x91\x92\xd2\x6c\x36\x4f\x48\xcc\xde\xa2\xd2\x4c\x0a\x0f\x62\xc9\x19\xdd\xb6\x37\x5d\x1f\x0d\xe9\x9e\x3c\x30\x11\x78\xb0\x90\xc1\x0d\xd2\x44\x31\xb3\x5d\xa4\xf4\x93\x08\x0d\x09\x88\x21\xde\x09\x80\x20\x11\x7a\xb0\x52\x24\x24\x82\xe4\x63\x1d\x13\x8a\x1e\x7c\xfe\x0c\xad\x2b\x37\x84\xaf\x5f\x4f\x00\x38\xf1\x91\x6b\x2b\x07\x40\xe2\xb8\x2a\x48\x84\x90\x86\x18\x26\x45\x4e\xd7\x48\xa9\x8c\xe2\x96\xce\x95\xb7\x08\x8f\xd7\xa4\xf5\x90\xf8\xa8\x04\x1a\xd4\x2d\x26\xdb\x84\x73\xf9\x88\xc1\x42\xc9\x90\x71\x4c\xb5\x79\x70\x1a\x48\xfa\x80\xaa\x1d\x60\x48\x12\x6e\x4e\x9f\x0f\x97\x4b\x54\xe0\x3c\xa8\x87\x23\x71\x4c\x54\x24\x55\x89\x67\x7d\xf6\x2c\xeb\x54\x22\x0c\x8b\xf0\x05\x78\xf5\xe6\x1d\xe0\xe9\x18\xa9\xf5\x61\xac\xd8\x86\x71\x5c\x61\xe0\x41\x48\xb8\x46\xeb\x66\x6b\xd2\xc2\x11\x66\x9a\x12\x9e\x3a\xbd\xe4\x50\xf8\x9f\x84\x29\x0c\xa6\x4a\xc6\x13\x12\x13\x9f\x71\x66\x18\xe6\x51\xf9\x0d\xa6\x99\x1a\xd0\x68\x20\x54\x32\x82\x69\xea\x9e\xdf\xe1\x91\x99\xb5\x4c\x0c\x4c\x2f\x26\xf7\xd7\x6f\x67\xcb\xe5\x7c\x3a\x03\xa9\x60\xf2\xf7\xf5\xbb\xab\x54\xb8\x09\x7f\x5e\xbf\xbb\x9a\x2d\xdd\xe0\x66\x76\x3b\x9f\xe6\x83\x7f\xe6\x6f\xde\xe4\xff\xde\xcc\x6e\xff\x2a\xe6\x6f\x66\xb7\x77\xd5\xc1\x62\x72\xb1\xc8\x47\x57\xb3\xdb\xfb\x3f\xe6\x57\xd3\xfb\x9b\xd9\xf2\xed\x7c\x32\xab\x4c\x2f\x2f\xde\x39\x91\x7f\xdf\xdc\x4f\xfe\x5e\x5e\x5f\xdf\xe6\x13\x97\xff\x5c\x5d\x3b\xbc\x8b\xbb\xe9\xfc\xf6\xfe\xdd\x72\x7e\x3b\x2b\x35\xfc\x99\x69\xd8\x48\x9e\x44\x6e\xd9\x4d\x38\xa5\x52\x84\x6c\x75\x49\xe2\x53\x37\x83\x51\x6c\xb6\x53\xa6\x8a\x89\x58\xc9\x8f\x48\x0d\x06\xc5\x8c\x46\xaa\xd0\x14\xc3\x40\x3e\x8a\x47\xa2\x82\x8b\xc5\xbc\x14\xb2\xbb\x4f\x1b\x14\xe6\x6d\xaa\x71\xc2\x09\x8b\x2c\x75\x2d\xb5\xb9\x42\xf3\x28\xd5\x43\x19\x1d\x3b\x39\x5f\x4c\x76\x27\x16\xf3\x69\x25\x7e\x89\xb8\xd0\x77\x1a\x55\x66\xb9\x4a\x38\x7a\x70\xba\xb4\xb3\x17\x62\x6b\x81\x35\xbe\x61\x22\xf9\x74\x9c\x9e\xc4\x31\xc7\x08\x85\x21\xfc\x2f\x25\x93\x58\x1f\x65\x0d\x75\xca\x70\x94\xae\x90\x04\xd7\x82\x6f\x97\x52\x9a\x3f\x19\x47\xbd\xd5\x06\x23\x67\xec\x7e\xfd\xd9\xb8\x9a\x33\x71\xbe\xfe\x15\xb5\xc6\xc1\xe5\xe3\x16\x13\xcc\x83\x2f\x29\xdf\x7b\x22\x08\xdf\x1a\x46\xf5\x87\x74\x4c\xd7\x48\x1f\xee\x43\xa9\xee\x93\x38\x20\x06\x35\xbc\x02\xa3\x12\xcc\x98\x73\x80\x7b\x81\x26\x63\x4f\x14\x87\x57\xb0\x36\x26\xd6\x5e\xbb\xed\xf0\x05\x9a\x8c\x9f\xcb\x55\xc6\x17\xc9\x00\xe1\x15\x50\x29\xb4\xe4\x39\x58\x4c\xcc\x3a\xd7\x6a\x0d\x84\x57\xd0\xde\x10\xd5\xe6\xcc\x77\x40\x6d\x3b\x9f\x32\x70\xb9\xd2\x05\x83\x5c\xb5\x4b\x97\x00\xc4\x3c\x59\x31\xa1\xeb\xe4\x73\x52\xc6\xa6\xe4\x86\x59\xaf\x33\xb1\xb2\xbc\x68\x68\xc9\x57\xa1\xbd\x2c\x46\x55\x84\xa6\x25\x69\x99\x28\x8a\x3f\x1e\xb3\x12\xab\xb5\x25\x11\x77\x71\xfb\x0d\xb2\xdd\x09\xb6\x2e\xc2\x26\xb3\x35\x47\x29\x4d\xef\x9e\xe4\xcc\x9c\x69\x03\x32\xac\xa0\x69\x30\x6b\x62\x40\xaf\x65\xc2\x03\xf0\x11\x02\xe4\x68\x30\xc8\x2a\x9c\x59\x63\xca\xeb\x13\x9d\x45\x2b\xa3\x4e\x4b\xf1\xcc\x64\xbb\x9f\x33\x07\x2c\x94\x8c\xd0\xac\x31\xd1\x39\x01\x40\xaa\xd5\x3c\xf8\x96\x11\x12\x98\xd0\xa8\x4c\x3b\xcb\x37\x08\x30\x46\x11\xd8\x38\x64\x42\x8f\x6b\x62\x34\x90\x0d\x61\x9c\xf8\x1c\x81\x89\x1a\xe3\x0e\xcd\xfa\x0d\xfe\x5f\x1b\xc5\xc4\xea\xf7\xa2\xbc\xbf\x4e\x2d\xb5\x06\x38\x80\xdc\xaf\xb0\xcc\x39\xbe\xbd\xa4\x5a\xd8\x12\x0a\xcc\x36\x3e\xc4\x83\x74\x3a\xcd\x91\x67\xe1\x11\x4a\x51\xeb\x74\xcf\xb4\x20\x60\x0a\xa9\xb1\x5d\x25\x56\xf2\xd3\xb6\x06\x3c\x63\xf7\x32\x7a\x15\x99\x09\xf3\xda\x06\x01\x58\xd0\x82\x47\xc6\x39\xe4\x0d\xd3\x7a\x3d\x0d\x0e\x74\x81\x85\x20\xa4\x01\xdb\x41\x59\xc8\x2a\xb0\x45\xf4\x0e\x6c\x7d\x6d\xb7\x7d\x31\x9d\x28\xee\xa5\x15\xc0\x6b\xb7\xcb\x15\x36\x35\xaa\x0d\xaa\x56\x4d\xca\x1f\xa0\xb9\x58\x42\x4c\xb4\x7e\x94\x2a\xf8\xdd\x5a\x95\xe8\x8a\x31\x8e\xe2\x3d\x29\x9f\x68\xdb\x95\xf7\x65\x93\xa2\x33\x1c\x93\xb3\xe1\x3e\x94\x73\xe4\x1d\x59\x5f\x4a\xfe\x1a\x50\xd8\x5c\x6c\x07\x4c\xa7\x39\xe9\x13\xcd\x28\x90\xc4\xac\x0b\xd6\x74\xea\x22\x31\xeb\xb2\x4d\x1d\xe8\x2f\xc5\x52\x1b\xeb\xad\x28\x80\xee\x8e\x2d\xa3\x02\x73\xdc\x81\x05\xcc\xa2\xce\x93\xb5\xab\xb2\xa7\x1b\xa0\x0a\x03\x14\x86\x11\xae\x61\x8d\x24\x40\x55\x26\xaf\x65\x98\x94\xf4\x1a\xc4\x88\xa8\x07\x20\xba\xc8\xbb\xea\xa6\xbb\x24\x9f\x40\x0a\x84\x18\x95\xcd\xb5\x42\x98\xe9\xfc\xbc\xe5\x95\x8d\x27\x07\x8d\x48\xfc\x1a\x42\x86\x3c\xc8\xab\x57\x9a\xd4\x3e\xda\x5a\xb8\x41\x65\xab\x97\x91\xf0\x51\x4b\x01\x44\x04\xa0\x8d\x54\x18\xd8\x8a\x61\xa7\xee\x8b\x66\x62\x3f\x3b\x33\xcd\x4b\xac\xfb\x56\x8a\xc4\x6b\x66\xb0\x28\x9f\x8d\x6e\xab\xdb\xa8\x30\x18\xae\xeb\x42\x5a\x10\xde\x59\x87\x5c\x4c\x50\x99\x27\xa2\x9e\x9a\x27\xfd\x8f\xe9\xae\xce\x8a\xe1\xee\x62\x50\x50\xb5\x8d\x0d\x06\xad\x42\x3c\x3d\x22\xe3\xbf\x6a\x6c\x36\x5c\x3b\x8d\x8d\x56\xab\xb5\x67\xed\x84\x33\x14\xe6\x1b\xe4\x7f\x70\xbb\x4f\xdd\x94\x0d\xe4\x20\xa6\xe9\x79\x3a\x4d\xd8\xb4\x7a\x63\xc0\xcc\x4e\x45\x2f\xfa\xc7\xdd\xbc\x5c\x80\xe5\xb2\x49\x95\xc7\xf4\x67\xb4\x58\xbd\xf6\x25\x51\x81\xfe\x19\x2d\xd6\x61\xed\xb4\xd8\x9a\x46\x9a\x9a\x60\xf7\x80\x3b\x23\x67\x96\x9d\x96\x9d\xe2\x34\x5f\xf2\x5e\xf5\x0c\x25\x0f\x50\x79\x70\xea\xe8\x59\x47\xb0\xcd\x3b\x9f\xc8\x77\xdd\xd4\xf6\xd9\x9d\xdb\x49\x8d\xfb\x72\x15\x71\xe5\xee\x98\x5a\x47\x6c\x6e\x1e\x3d\xd8\xb4\x77\x7c\xf6\x90\x44\x24\x3d\xa8\xc4\x9c\x08\x6c\xd9\xac\xb4\x0b\xb7\xfe\x9b\x3a\xbe\xa9\x23\xc3\x17\x50\x18\x73\xeb\xd2\xc6\xff\x7d\x9e\xde\xdc\x2f\x96\xd7\x97\xb3\xdb\xbf\x67\x77\x37\x5f\x1b\xd0\x28\x97\xdf\x80\x2f\xc0\x84\xad\x08\xd0\xcf\xbc\x9f\xea\x89\x50\xaf\xeb\x55\x5c\xa2\x5e\xff\x28\xba\x6d\x35\x8c\x62\xd3\x48\xf7\x6f\xbd\xae\x9b\x8c\x78\x2b\xf3\x7f\x5e\xac\xf7\x68\xfa\xe6\xc0\x17\x94\xca\x44\x98\x9d\x1c\x7e\xe2\x21\xe0\x7b\x4e\xf9\x56\x75\xbe\x55\x78\xa2\x0d\xaa\xa5\x3d\x4f\x57\x8d\x51\x3e\xa1\x2d\xdb\x0d\xa4\x62\xff\x4d\x6f\xba\xad\x87\x51\x7a\x99\xde\x74\x5f\x64\x51\x93\x66\x9a\x94\xd5\x64\x6f\x3c\xda\x83\xf7\x1f\xea\x2d\xf9\x83\x65\x67\xb7\x17\x19\x74\x54\xab\xcf\xdc\x89\xf0\xa8\xcd\x3a\x49\x2b\x6a\x4a\x6a\x42\x6d\x34\xac\xcc\xbe\xab\x9f\x76\xb6\xd5\xbd\xc4\xd0\x62\x1e\xfa\xfc\x29\x2f\xa5\xc5\x23\xbb\x27\x1e\x5f\xfe\x41\x1a\x3d\xe5\xa8\xea\xfb\x54\xaa\xfe\x17\x5c\x13\xb3\xd8\xa6\x0e\x74\xe6\x6b\x2f\x2b\x2d\xef\x4f\xf1\x93\x41\x61\xed\xd4\xa7\xd9\x9d\x4d\xa1\x3b\x72\x3b\x96\x58\x06\xee\x2d\x27\x7d\x57\x63\xe8\x78\x37\xa8\x7c\xc7\x97\xf1\x26\x1a\xf7\x70\xf2\xc7\xa2\xe2\x92\xf9\xe1\x87\xfc\xe3\x12\xf1\x99\x6e\xca\x0f\x28\x4f\x7a\xa7\x4c\x86\xe7\x04\xd7\xa5\x4c\x5d\xae\xfc\x9a\x6c\xfd\x56\x55\xfa\x15\x29\xe3\x1e\xde\xb2\x76\x96\x6f\x8f\xf9\xe2\x04\x20\x96\xca\xec\xb5\xc9\xbc\x38\xe7\xfd\xca\x32\x78\x30\xea\xb8\xa1\x92\x46\x52\xc9\x3d\xb8\x9d\x2c\x5c\x93\x24\x6a\x85\x66\x91\x32\x9e\x75\x3a\x9d\xf4\x25\x87\x23\x35\x52\xd5\xd8\xb2\xbf\x7e\x12\xc7\xba\x5d\x38\x61\x8a\x31\x97\xdb\x08\xf7\xca\xf2\x4f\xf6\x83\xed\x27\x8c\x12\x9d\x75\xfe\x5d\x63\x23\x62\xe8\xfa\x4d\x05\xe1\xa0\xec\x6a\xa3\x88\xc1\xd5\x36\x23\x67\x3e\x5d\x4a\xce\x99\x58\xdd\xa5\xb7\x63\xeb\x69\x8c\x62\x4e\x4c\x7e\x2b\xa9\x2e\xc5\x7e\x7c\x07\xfe\x40\x41\x3a\xb5\xff\xec\x0c\xee\xd9\x47\x27\x51\x3b\x7b\x55\xf0\xa0\x1f\xfa\x14\x87\x94\x9c\x8f\x47\x03\xdf\x3f\x3b\xeb\x8d\xc6\xe3\x5e\x0f\x87\x23\x42\xe9\x98\x84\xbd\xfe\x30\x3c\xeb\x74\x69\x38\x1e\x0e\x3a\x61\x67\xd8\x0d\x7d\x1a\x74\xba\x83\xc1\xb8\x37\x18\x0e\x07\x41\x78\x88\x5c\x9e\x3e\x9a\xb6\x3b\x37\x9d\xa6\x4e\xd7\x27\xfd\xf3\xee\x98\x8e\x3a\xfe\x30\xc4\x71\xb7\xeb\x77\xc6\x5d\x72\x4e\x3b\x83\x6e\xaf\xef\x0f\xfb\x88\x48\x71\x3c\xec\x63\x67\x4c\x3b\x83\x11\x86\xa3\x70\x3c\xea\x0c\x02\x42\xc9\xa0\x3f\xf4\x0f\x35\x69\x5a\x1e\x0f\x9b\xee\xc8\xf6\x2b\xf5\xa5\x4f\x9e\x1e\xf4\x46\xdd\x7e\xbf\x7f\x3e\x18\xf5\x06\x3d\xd2\xf7\x83\xa0\x77\xde\x1b\xf4\x87\xe7\x67\xbd\x20\xf4\x47\xd8\xf3\x71\x74\xee\xe3\x79\xd7\x3f\x1f\x0f\x82\x1e\x21\x01\x3d\x3b\xef\x0d\xf1\x6c\xd0\xeb\x87\xc3\x33\x3c\xcb\x5e\xed\xf3\x4c\x4a\xff\xdf\x29\x09\x57\x07\xd5\x20\xbf\x18\x30\xb3\x9d\x48\x61\xf0\x93\x29\x03\xea\x9e\x29\xa1\x7f\xde\x2b\x26\xcb\x87\xd2\xca\x34\x95\xc2\x10\x26\x8a\x43\x6d\x75\xc7\xee\x2a\xb3\x1f\x8b\xc8\x0a\x3d\x68\xb8\x03\x66\xfe\xd7\x1b\xb6\x86\xad\x4e\x63\x9f\x71\x91\x70\x9e\xfd\x84\xe2\xc1\x3c\xbc\x92\x66\xa1\x50\x63\x5e\xdd\xb2\x2f\x7b\x74\xbe\xb4\x0b\xd4\xd5\x8b\x4d\x69\x44\x16\xb8\x1d\x12\x40\x64\x05\x16\xe9\x81\xb7\xb1\x73\xe2\xad\xbc\x71\x36\xf6\x64\x74\xe2\x67\x12\x15\x9e\x3d\x16\xf7\x7a\xbb\x77\xf7\xac\x9a\x63\x6f\x95\x64\x85\x4f\xd9\xb3\xf7\x0c\xd9\xa8\xc5\x39\xfe\x62\x78\x04\xf7\xa9\x83\x7d\x71\xfd\xfa\xfe\xf5\x1c\xbf\x56\xbd\xc0\x8e\x23\xd2\x47\xcd\xa8\x74\x89\x03\x3f\xef\x74\x0b\xf7\x15\xd9\xba\xd8\x6d\x1f\x05\x60\x4d\x1b\xd9\xc5\x3d\xcc\xe9\x1a\xdc\xbc\xdb\x3c\x07\x99\xb3\x0d\x0a\xd4\x7a\xa1\xa4\x8f\xbb\x4b\x09\x09\xe3\x89\xc2\xdb\xb5\x42\xbd\x96\xdc\xde\x07\x77\x51\xd7\xc6\xc4\x7f\xa1\xf1\xf6\x55\x65\x7e\x26\x31\x6b\xaf\x91\xf0\xca\xb3\x52\xe9\xb5\x1a\x1b\x99\x60\x86\x11\x3e\x45\x4e\xb6\x37\x48\xa5\x08\xb4\x07\xc3\x5d\x1e\xc3\x22\x94\x89\x29\xc8\x67\x55\xb2\x0d\x13\x3b\xb2\x96\x9f\x69\x6a\x79\x74\xdc\xe1\xfe\xec\x5e\x07\x77\x7e\x88\x82\xa7\x4a\x41\xf1\x13\xd5\x2e\x52\x7d\xa4\x9f\xbf\xf3\x9e\x84\xfd\x96\xf8\xf3\x37\xd6\xf7\xa8\x39\x10\x3f\x5e\x8f\xdc\xaf\x74\x9e\xf5\xe8\xff\x02\x00\x00\xff\xff\x04\xed\xad\xf4\xf2\x1e\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xcd\x19\xdb\x6e\xdb\x3a\xf2\x3d\x5f\x41\xb8\x05\xf2\x52\x5f\x63\x3b\xb6\xb1\xa7\x40\x8e\x9d\xd3\x7a\xdb\x24\x86\x93\xb4\x58\x14\x45\x40\x49\x94\xcd\x46\x16\x75\x48\x2a\xa9\x37\xa7\xff\xbe\xc3\x9b\x2e\xb6\xec\xa4\x69\x03\xac\x5f\x2c\x71\x2e\x1c\xce\x95\x33\xaa\xd7\xeb\x07\x38\xa1\x9f\x08\x17\x94\xc5\x23\x94\xb0\x88\xfa\xeb\xe6\x5d\xdb\x23\x12\xb7\x0f\x6e\x69\x1c\x8c\xd0\x8c\x05\x97\xc4\x4f\x39\x95\xeb\x99\x86\x1f\xac\x00\x1a\x60\x89\x47\x07\x08\xc5\x78\x45\x46\x68\xc1\x71\x88\x63\x6c\xdf\x45\x82\x7d\x58\x7c\x78\x40\x8d\x73\xf7\x8a\x7e\xfc\x00\x68\x84\x3d\x12\x09\x45\x87\x10\x4e\x92\x22\x21\x8e\x63\x26\xb1\x04\x39\x2c\x5c\x10\xdf\x67\xab\xa4\x21\xec\xe6\x0d\x1c\x25\x4b\xdc\xb8\x4d\x3d\xc2\x63\x22\x89\x68\x50\xd6\xc4\x51\xc4\xee\x49\x30\xe3\x2c\xa4\x11\xd1\xbb\x8d\xd0\x61\xc0\xfc\x5b\xc2\x9b\x01\x09\x71\x1a\xc9\xc3\xa7\xb3\xb3\x14\x05\x76\x23\x54\xcd\x0e\xa4\xc7\x7c\xc5\x78\xce\x4f\xe9\xec\x49\xd2\xf1\x34\x96\x74\x45\x9e\xc1\xaf\x5a\xbc\x2d\x7e\x22\x21\xbe\xd2\x61\xc2\xe9\x1d\xe0\x2d\x08\x58\x31\xc4\x91\x20\x4a\xcd\x4a\xa4\x99\x03\x9c\x0a\x1f\x47\x5a\xe9\x39\x06\x27\x7f\xa7\x94\x93\x60\xc2\x59\x32\xc6\x09\xf6\x68\x44\x25\x25\xd6\x2a\xaf\xd0\xc4\x6c\x03\x0a\x95\x28\xe4\x6c\x85\x26\x5a\x3d\x6f\xd0\x3d\x95\x4b\x96\x4a\x34\x39\x19\xdf\x5c\x7c\x3a\x9d\xcf\xa7\x93\x53\xc4\x38\x1a\xbf\xbf\xf8\x7c\xae\x89\xeb\xe8\x2f\x78\x3c\x9d\xbb\x97\xcb\xd3\xab\xe9\xc4\xbe\x7c\x98\x7e\xfc\x68\x1f\x61\xf9\x5d\xb6\x0e\x2f\xd7\xc5\x97\xd9\xf8\x64\x66\xdf\xce\x4f\xaf\x6e\xfe\x9c\x9e\x4f\x6e\x2e\x4f\xe7\x9f\xa6\xe3\xd3\xc2\xf2\xfc\xe4\xb3\x23\xf9\xcf\xe5\xcd\xf8\xfd\xfc\xe2\xe2\xca\x2e\x9c\x7d\x38\xbf\x70\xfc\x4e\xae\x27\xd3\xab\x9b\xcf\xf3\xe9\xd5\x69\xbe\xc3\x5f\x66\x87\x3b\x16\xa5\x2b\x77\xec\x3a\x3a\xf4\x59\x1c\xd2\xc5\x19\x4e\x0e\xdd\x0a\x59\x25\x72\x3d\xa1\x3c\x5b\x48\x38\xfb\x46\x7c\x49\x82\x6c\x05\xac\xc9\x89\xcc\x5e\x03\x76\x1f\xdf\x63\x1e\x9c\xcc\xa6\x39\x91\x8a\x3e\x21\x49\x2c\x3f\xe9\x1d\xc7\x11\xa6\x2b\x05\x5d\x32\x21\xcf\x89\xbc\x67\xfc\x36\xb7\x8e\x5a\x9c\xce\xc6\xe5\x85\xd9\x74\x52\xb0\x5f\x1a\x9f\x88\x6b\x41\xb8\x91\x9c\xa7\x11\x78\xc9\xe1\x5c\xad\x9e\xc4\x6b\xc5\x58\x90\x8f\x34\x4e\xbf\xef\x86\xa7\x49\x12\x91\x15\x48\x84\xa3\x77\x9c\xa5\x89\xd8\x89\x1a\x0a\x8d\xb0\x13\xce\x09\x0e\x2e\xe2\x68\x3d\x67\x4c\xfe\x05\x2e\x27\xd6\x70\xd2\x95\x13\xb6\xbe\x91\x7f\xee\x5c\xce\x19\x3b\x5d\xbf\x44\xae\x71\xec\xec\x7b\x83\xc6\x74\x84\xfe\xd1\x78\x5f\xe0\x3d\x5a\x4b\xea\x8b\xaf\xfa\xdd\x5f\x12\xff\xf6\x26\x64\xfc\x26\x4d\x80\x8c\x08\xf4\x07\x92\x3c\x25\x06\xd9\x32\xb8\x81\xf8\x34\xe8\x29\x8f\x00\x61\x29\x25\x68\xac\xd9\x74\xfc\x01\x6c\xf0\x23\xb6\x30\x78\x2b\x16\x10\x40\x04\x8f\x12\x2c\xb2\xcc\x12\x2c\x97\x76\x57\x25\x20\x80\x9b\x77\x98\x37\x23\xea\x39\x46\x4d\xb5\xae\x11\x80\x91\xc8\x10\xd8\xa2\x99\xab\x04\x82\x3e\x4a\x17\x34\x16\x55\xf4\x16\x64\xd0\x38\xbb\xa3\x4a\xeb\x34\x5e\x28\x5c\x22\xfd\x1c\xaf\x00\x7b\x9e\x8d\x8a\x1c\xea\x0a\x24\x58\xca\x7d\xf2\xeb\x36\xcb\x79\x35\xd6\x78\x15\x39\xbb\xbd\x42\x26\x3a\x91\xca\x8b\xe8\xce\xc8\x6a\xb9\xe4\xa2\xb7\x0f\x2c\x72\x04\xf1\x86\x58\x58\xe0\x26\x90\x5c\x62\xc8\x69\x90\xc0\xa2\x00\x79\x04\x05\x24\x82\xac\x1b\x98\x0c\x27\x97\x44\xe3\x7a\x58\x18\x6b\x19\xe8\x24\x27\x37\x22\xab\x78\x36\x0a\x80\x14\x0d\x5a\x59\x92\x54\x58\x00\x82\x4c\xb8\x98\x06\x8f\x09\xc1\x10\x18\x88\x70\xd9\x34\xfe\x06\x1b\x25\x24\x0e\x94\x1d\x0c\xd1\x3d\x48\x29\x10\xbe\xc3\x14\x54\x05\x47\xa5\x71\x85\x70\xdb\x62\xbd\x42\xff\x12\x92\x03\x9b\x37\x59\x7a\x7f\xab\x25\x55\x02\x38\x06\x56\xaf\x68\x6e\x31\x1e\x3f\x52\x25\xdb\x9c\x15\x92\xeb\x64\x9b\x1f\xd2\xcb\xda\x47\x9e\xc4\x0f\xfb\x70\x10\xa1\x63\xa6\x81\x02\x58\xf3\xa5\xaa\x2a\x40\xfe\x7d\x5d\xc1\xdc\xa0\x8f\x0c\xbc\xc8\x99\xc6\xf2\xad\x32\x02\xa2\x41\x03\x6a\x55\x14\x21\x5b\x30\x95\xd6\xb5\x71\x50\x1b\xd1\x10\xc1\x35\x04\xa9\x0a\x4a\x43\x5a\x60\x9b\x59\x6f\x4b\xd6\xb7\x2a\xec\xb3\x65\x78\x1e\xe9\x0c\x00\x09\x20\x3f\x61\x1d\x6c\x0a\x5e\xd9\xa8\x70\xf9\x2d\x6e\xce\x96\x28\xc1\x42\x40\xf2\x0f\xde\x28\xa9\x52\x51\x10\xc6\x41\x46\x7b\xe9\x81\x84\x6f\xd3\xa6\x59\x65\xd8\x45\xa7\xcc\xbd\x4d\xe7\xc0\x25\x5a\x8f\xb1\xe8\x2d\x22\xb1\xf2\xc5\x66\x40\x85\xf6\x49\xc0\xa2\x3e\xc2\xa9\x5c\x66\xa8\x7a\xe9\x04\x56\xf2\x32\xb5\xb5\x7f\x4e\xa6\x65\xac\x96\x22\x63\x74\xbd\xeb\x18\x05\x36\xbb\x15\x98\xb1\x99\x55\x69\xb2\xf2\x54\xea\x76\x83\xa0\x98\x07\x50\x16\x29\x9c\x01\x2d\xa1\xb4\x41\x66\xc9\x08\x15\xc2\x38\x87\x57\x70\x5c\x61\x7e\x8b\xb0\xc8\xfc\xae\x18\x74\x67\xf8\x3b\x62\x31\x18\x9d\x70\xe5\x6b\x19\x31\x15\xf6\xbe\x35\xca\x0b\x8f\x65\xba\xc2\xc9\x5b\x48\x78\x24\x0a\x6c\xf6\xd2\x4e\x0d\xb9\x0b\x72\x21\x38\x9b\xca\x5e\xe0\xd9\xdf\x04\x8b\xe1\x72\x1d\x20\x21\x19\x48\xa7\x32\x86\x5a\xba\xc9\x8a\x89\xfa\xa9\x95\x89\x4d\xb1\xee\x07\xa9\x37\x59\x52\x49\xb2\xf4\x59\x6b\x37\xda\xb5\x02\x82\x8c\x44\x95\x49\x33\xc0\x67\xa5\x90\x93\x31\x48\xb2\xc7\xea\x5a\x3c\xe6\x7d\xd3\x51\x6d\x92\x61\xf9\x30\x24\xf6\xf9\x3a\x81\xc3\x34\x32\x72\x7d\x45\x26\xff\xae\x90\x19\x76\x76\x3b\xd6\x1a\x8d\xc6\x86\xb4\xe3\x88\x82\x71\x1e\x01\x7f\x20\xeb\x4d\xe8\x5d\x5e\x40\xb6\x6c\xaa\xef\xd3\xda\x61\x75\xf6\x26\x01\x95\xa5\x8c\x9e\xd5\x8f\xeb\x69\x7e\x00\x85\xa5\x9c\xca\xda\xf4\x77\x94\x58\xb1\xf4\x18\x5c\x2c\xc5\xef\x28\xb1\x8e\x57\xa9\xc4\x56\x14\x52\x2d\x82\x8a\x01\x77\x47\x36\x92\x1d\xe6\x95\xe2\xf0\xa0\x32\x7b\x86\x2c\x02\x32\xc0\x74\x70\x53\x11\x54\xf1\xb6\x0b\x36\xea\x26\xaa\xce\x96\xba\x93\x0a\xf5\xd9\x2d\x92\x42\xef\x68\x92\xa4\xf2\xcd\x9d\x17\x9b\x66\x41\x67\x0f\x0f\x75\x95\x22\x1a\xef\x48\x4c\x38\x94\xdd\x60\x92\xc1\x5c\x92\x7e\x85\x16\x0e\x58\xd0\x90\x72\x59\x02\xee\xb1\x46\x67\x44\x2c\x11\xe6\x04\xea\x14\xb4\x63\xee\xe2\x80\xc1\x57\xa1\xaf\x53\x95\x3c\xb3\x25\x44\x20\x38\x0a\x40\x52\xcf\x54\x33\xc6\xd7\x65\xfd\x65\x62\xfc\x9f\xa9\x6f\x43\x63\x70\x31\x31\xda\xb9\x4d\x57\x58\xdf\xf2\x92\x08\xc7\xa4\xa1\x42\x5a\x79\x8d\x72\xbe\x4c\x91\x13\x07\x46\xff\x40\x6d\x87\x27\xf0\xc7\xda\xeb\x87\xc9\xe5\xcd\x6c\x7e\x71\x76\x7a\xf5\xfe\xf4\xfa\xf2\x47\x0d\xd5\x72\xdf\xa9\x01\x26\x44\x01\x84\x24\xea\x16\xf6\x01\x67\x5e\x56\x6f\xa1\x4d\xf0\x8b\xdc\x55\x9d\xa6\x3e\xa9\x4b\xe6\x1e\xab\xf7\xba\x34\xc0\x2b\x66\x1f\x9e\xbd\xaf\x52\x24\xc7\xf1\x82\xec\xf4\xbe\x67\x65\x07\x17\xf8\xe6\x68\xe5\x8c\xf0\xfa\x57\xa6\x32\x4a\x4b\x6a\x14\xa1\xcc\x60\xb6\xb1\x6a\xff\x3b\x65\x52\xf3\x33\x82\xe4\xe7\x7a\xed\x66\x15\x6f\xd0\x6b\xa8\x4c\xaa\xd1\x45\xa3\x3f\x0a\xba\xb4\x31\xa6\x24\x73\xa8\xb0\x62\x55\x9e\x91\x6c\xab\xcd\xfa\x5f\xe1\x71\xa7\xaa\xac\x95\x4e\x7c\x5f\x45\x67\x49\x5f\x7b\x0e\xff\x33\xfd\xa6\xda\xda\x9a\x25\x4a\xa1\xc7\xe5\x73\xd5\xd9\x15\x85\xe1\x1e\xf6\x1b\xea\x5e\xc2\x38\xfd\xaf\x56\x69\xe3\x76\xa0\xc7\x3a\x20\xe6\x73\x24\xaa\xfb\x66\x27\xae\x76\x52\xbd\x37\xdc\x7a\xbf\x7c\xad\x96\xe4\x4f\x6a\xba\x88\x67\x09\xb4\x73\x57\x8f\xba\xde\x64\xa7\xcc\x90\xe5\x54\x6d\xd7\xa0\x3a\xaa\xb4\x86\xa2\xd9\x54\xf5\x7e\x65\xab\xbd\xe7\x24\x54\x3c\xb7\x75\xbe\x4f\x4b\xba\x8c\x99\x89\xc5\xee\xe3\x6f\xb9\xd1\x3e\x45\x15\x27\xa5\x7a\xfb\x17\x18\x58\x18\xdb\x6a\x05\x3a\xf1\xc1\xd4\xfa\xf7\xe5\x90\x7c\x87\xf0\x50\x72\x8a\x43\x33\x3d\xe0\xc4\x35\x7f\x0e\x25\x61\x81\x9b\x2a\xea\x09\x2f\x25\x0e\x17\x4a\x97\xe7\xf0\x0c\x2e\xdc\x64\x36\xf8\xd8\xb1\x65\x36\xee\xf8\xfa\x4b\xfa\x71\x8e\xf8\x44\x35\xd9\xab\xf2\x5e\xed\xe4\xce\xf0\x14\xe3\x3a\x97\xa9\xf2\x95\x97\xf1\xd6\xc7\xb2\xd2\x4b\xb8\x8c\x1b\x01\x9b\x9b\x81\x0d\x8f\xa9\x1a\x6b\x26\x8c\xcb\x8d\x0b\x9b\xad\x74\xb6\xf4\x2b\x84\x11\x1a\xb4\xdc\x2b\x67\x92\xf9\x0c\xee\x81\x57\xe3\x99\xbb\x6f\x60\xbe\x20\x72\xa6\x11\x8f\x5a\xad\x96\x9e\x29\x46\xfa\x2a\x53\x21\xcb\xe6\xf9\x01\x28\x9a\x99\x12\x26\x50\x36\xd9\x5a\x0d\x1b\x5f\x52\x0f\xaa\x38\x53\x1f\x0b\x73\x89\x2a\x0b\xbb\xc2\xd2\x5f\x7e\x2c\x70\xd8\x4a\xbb\xd0\xac\x40\x5d\x5e\xac\x0d\xd8\xe8\x14\xfc\x27\x02\x47\xbe\xd6\x73\x1a\xa5\x69\xb2\x82\xfa\x2f\x6d\x7f\x5c\x3c\x8a\x9e\xd8\x95\xd8\x6f\x6d\xa0\x97\x36\x4b\x6d\x36\x80\x14\xe9\xaa\x69\xe6\x5b\x23\xd4\x0d\x3d\x9f\xf4\x7d\x7c\x3c\x1c\xf4\x3c\xef\xe8\xa8\x33\x18\x0e\x3b\x1d\xd2\x1f\x60\xdf\x1f\xe2\xb0\xd3\xed\x87\x47\xad\xb6\x1f\x0e\xfb\xbd\x56\xd8\xea\xb7\x01\x3d\x68\xb5\x7b\xbd\x61\xa7\xd7\xef\xf7\x82\x70\x9b\x73\x7e\xab\xab\xab\xab\x4e\xdd\xed\xd4\x6a\x7b\xb8\x7b\xdc\x1e\xfa\x83\x96\xd7\x0f\xc9\xb0\xdd\xf6\x5a\xc3\x36\x3e\xf6\x5b\xbd\x76\xa7\xeb\xf5\xbb\x84\x10\x9f\x0c\xe1\xbf\x35\x84\xb5\x01\x09\x07\xe1\x70\xd0\xea\x05\xd8\xc7\xbd\x6e\xdf\xdb\xde\x49\xf8\x79\xa3\x52\x77\xcd\xc3\x4b\xee\xa7\x87\xef\x23\xd4\x19\xb4\xbb\xdd\xee\x71\x6f\xd0\xe9\x75\x70\xd7\x0b\x82\xce\x71\x07\x28\x8e\x8f\x3a\x41\xe8\x0d\x48\xc7\x23\x83\x63\x8f\x1c\xb7\xbd\xe3\x61\x2f\xe8\x60\x1c\xf8\x47\xc7\x9d\x3e\x39\xea\x75\xba\x61\xff\x88\x1c\x99\xef\x47\xd6\x93\x4c\x03\x5a\x4c\x09\xe7\x5b\xd9\xc0\xb6\xa8\x90\x6f\xc7\xea\xf6\xf2\x5d\xe6\x06\x75\x03\x73\xd4\x3d\xee\x64\x8b\xf9\xc8\xbe\xb0\xac\x2e\x3e\x98\xc6\x59\x7b\x55\x8c\xd8\xf2\x66\x7a\x4e\xb0\xc2\x0b\x00\xd4\xdc\x5d\xdd\xfe\x8f\xfa\x8d\x7e\xa3\x55\xdb\x44\x9c\xa5\x51\x64\x3e\xe6\x8d\xd0\x34\x3c\x67\x72\x06\xd9\x9e\xd8\xec\x66\x9b\x5e\xfd\x31\xe2\x4c\x1d\x50\x14\x5b\xec\x5c\x08\x63\xb8\x12\x08\x99\xf6\x67\xa6\x7b\x87\x5a\xa9\x79\x28\x4c\xdb\x6b\x1b\x34\x90\x75\x0d\x45\x01\x67\x03\xc5\x7d\x47\xd8\x98\x82\x94\xb2\x18\x04\x34\x1c\x6d\x9f\x3c\x1b\x03\xf1\x5a\x25\x9f\xdd\xb3\xeb\x1d\x7c\xf7\xb5\x98\xd9\x20\xe0\xe7\xcf\xb3\xbb\xc1\x7f\x86\x1c\x3b\xa8\x37\xc4\x78\xbc\x19\xa9\x12\xb4\xdc\x6b\x3c\x45\xb8\x5c\x9e\xe6\x6e\xe2\x0a\xd9\xb2\x8e\x33\x6b\x52\xf3\x62\xb6\xe5\x0e\xa5\xa2\x96\xe5\x06\x17\x54\xb3\x72\x95\xcb\x18\x56\x54\xbb\xc7\x42\xaf\x82\xaf\x2d\x8a\x4f\xe1\x1c\xd1\x3b\x50\xb5\x10\xd0\x22\x7a\xa4\x7c\x94\x10\xd3\x28\xe5\xe4\x6a\x09\xc1\xb9\x84\x9e\x1f\x8a\x57\x99\xab\x9a\x33\xbf\x23\x72\xb4\xb9\x95\xd1\x38\xd4\xdc\xe6\x92\xe0\xa8\x30\x87\x2d\x56\xf8\x2d\x19\x21\xea\xd4\xe4\x72\x42\x22\xbc\xbe\x24\x70\xa2\x00\xca\x65\xbf\x8c\xa3\x3e\x34\xb3\x54\x66\xe0\xa3\x22\x58\x59\x8c\xee\x38\xcb\xef\x14\x35\xbf\xe1\x96\xb0\x1f\x9c\x67\x94\xbe\xdc\xee\xcd\x58\xd9\x37\xdd\x32\xa7\x6a\x4b\x3f\x3d\x41\xec\x65\xfb\x18\xf9\xd3\xe3\xff\x67\xb6\x29\x4d\x6e\x9e\x16\xe3\xfb\xe3\x7b\xef\xe6\x45\x92\x8a\xc0\xdd\x9d\xb2\xdd\x27\xf5\x91\xb2\xe6\xff\x00\x00\x3e\xbd\xc6\x9f\x22\x00\x00"),
 		},
 		"/grafana/kuma-dataplane.json": &vfsgen۰CompressedFileInfo{
 			name:             "kuma-dataplane.json",
@@ -0,0 +1,64 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/pkg/api-server/types"
+	config_proto "github.com/Kong/kuma/pkg/config/app/kumactl/v1alpha1"
+	kuma_http "github.com/Kong/kuma/pkg/util/http"
+)
+
+type DataplaneInspectClient interface {
+	ConfigDump(ctx context.Context, meshName string, name string) (*types.ConfigDump, error)
+	Policies(ctx context.Context, meshName string, name string) (*types.DataplanePolicies, error)
+}
+
+func NewDataplaneInspectClient(coordinates *config_proto.ControlPlaneCoordinates_ApiServer) (DataplaneInspectClient, error) {
+	client, err := apiServerClient(coordinates.Url)
+	if err != nil {
+		return nil, err
+	}
+	return &httpDataplaneInspectClient{
+		Client: client,
+	}, nil
+}
+
+type httpDataplaneInspectClient struct {
+	Client kuma_http.Client
+}
+
+func (d *httpDataplaneInspectClient) ConfigDump(ctx context.Context, meshName string, name string) (*types.ConfigDump, error) {
+	dump := types.ConfigDump{}
+	if err := d.get(ctx, fmt.Sprintf("/meshes/%s/dataplanes/%s/config-dump", meshName, name), &dump); err != nil {
+		return nil, err
+	}
+	return &dump, nil
+}
+
+func (d *httpDataplaneInspectClient) Policies(ctx context.Context, meshName string, name string) (*types.DataplanePolicies, error) {
+	policies := types.DataplanePolicies{}
+	if err := d.get(ctx, fmt.Sprintf("/meshes/%s/dataplanes/%s/policies", meshName, name), &policies); err != nil {
+		return nil, err
+	}
+	return &policies, nil
+}
+
+func (d *httpDataplaneInspectClient) get(ctx context.Context, path string, result interface{}) error {
+	req, err := http.NewRequest("GET", path, nil)
+	if err != nil {
+		return err
+	}
+	statusCode, b, err := doRequest(ctx, d.Client, req)
+	if err != nil {
+		return err
+	}
+	if statusCode != 200 {
+		return errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	return json.Unmarshal(b, result)
+}
@@ -0,0 +1,123 @@
+package resources
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("httpDataplaneInspectClient", func() {
+	Describe("ConfigDump()", func() {
+		It("should request config dump of a dataplane and parse response", func() {
+			// given
+			client := httpDataplaneInspectClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						Expect(req.URL.String()).To(Equal("/meshes/default/dataplanes/backend-01/config-dump"))
+
+						file, err := os.Open(filepath.Join("testdata", "config-dump.json"))
+						if err != nil {
+							return nil, err
+						}
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body:       ioutil.NopCloser(bufio.NewReader(file)),
+						}, nil
+					}),
+				},
+			}
+
+			// when
+			dump, err := client.ConfigDump(context.Background(), "default", "backend-01")
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(dump.Source).To(Equal("cache"))
+			Expect(dump.Listeners.Items).To(HaveLen(1))
+			Expect(dump.Clusters.Items).To(HaveLen(1))
+			Expect(dump.Clusters.Items[0]).To(MatchJSON(`{"name": "localhost:9091", "type": "STATIC"}`))
+		})
+
+		It("should return error from the server", func() {
+			// given
+			client := httpDataplaneInspectClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: http.StatusBadRequest,
+							Body:       ioutil.NopCloser(strings.NewReader("some error from server")),
+						}, nil
+					}),
+				},
+			}
+
+			// when
+			_, err := client.ConfigDump(context.Background(), "mesh-1", "backend-01")
+
+			// then
+			Expect(err).To(MatchError("(400): some error from server"))
+		})
+	})
+
+	Describe("Policies()", func() {
+		It("should request policies of a dataplane and parse response", func() {
+			// given
+			client := httpDataplaneInspectClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						Expect(req.URL.String()).To(Equal("/meshes/default/dataplanes/web-01/policies"))
+
+						file, err := os.Open(filepath.Join("testdata", "dataplane-policies.json"))
+						if err != nil {
+							return nil, err
+						}
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body:       ioutil.NopCloser(bufio.NewReader(file)),
+						}, nil
+					}),
+				},
+			}
+
+			// when
+			policies, err := client.Policies(context.Background(), "default", "web-01")
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(policies.Inbound).To(HaveLen(1))
+			Expect(policies.Inbound[0].TrafficPermissions[0].Name).To(Equal("allow-all"))
+			Expect(policies.Inbound[0].TrafficPermissions[0].Score.WildcardMatches).To(Equal(1))
+			Expect(policies.Outbound).To(HaveLen(1))
+			Expect(policies.Outbound[0].TrafficRoute.Name).To(Equal("web-to-backend"))
+			Expect(policies.Outbound[0].TrafficLog).To(BeNil())
+			Expect(policies.TrafficTrace).To(BeNil())
+		})
+
+		It("should return error from the server", func() {
+			// given
+			client := httpDataplaneInspectClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: http.StatusNotFound,
+							Body:       ioutil.NopCloser(strings.NewReader("not found")),
+						}, nil
+					}),
+				},
+			}
+
+			// when
+			_, err := client.Policies(context.Background(), "mesh-1", "web-01")
+
+			// then
+			Expect(err).To(MatchError("(404): not found"))
+		})
+	})
+})
@@ -44,7 +44,7 @@ func (d *httpDataplaneOverviewClient) List(ctx context.Context, meshName string,
 	if err != nil {
 		return nil, err
 	}
-	statusCode, b, err := d.doRequest(ctx, req)
+	statusCode, b, err := doRequest(ctx, d.Client, req)
 	if err != nil {
 		return nil, err
 	}
@@ -71,8 +71,8 @@ func constructUrl(meshName string, tags map[string]string) (*url.URL, error) {
 	return result, err
 }
 
-func (d *httpDataplaneOverviewClient) doRequest(ctx context.Context, req *http.Request) (int, []byte, error) {
-	resp, err := d.Client.Do(req.WithContext(ctx))
+func doRequest(ctx context.Context, client kuma_http.Client, req *http.Request) (int, []byte, error) {
+	resp, err := client.Do(req.WithContext(ctx))
 	if err != nil {
 		return 0, nil, err
 	}
@@ -0,0 +1,72 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+	"github.com/Kong/kuma/pkg/core/resources/model/rest"
+	"github.com/Kong/kuma/pkg/core/resources/registry"
+	"github.com/Kong/kuma/pkg/util/proto"
+)
+
+// ParseResource parses a resource in YAML format, e.g. the one that is accepted by `kumactl apply`.
+// Only name and mesh of the resource are set in its meta.
+func ParseResource(bytes []byte) (model.Resource, error) {
+	resMeta := rest.ResourceMeta{}
+	if err := yaml.Unmarshal(bytes, &resMeta); err != nil {
+		return nil, err
+	}
+	if resMeta.Name == "" {
+		return nil, errors.New("Name field cannot be empty")
+	}
+	if resMeta.Mesh == "" && resMeta.Type != string(mesh.MeshType) {
+		return nil, errors.New("Mesh field cannot be empty")
+	}
+	resource, err := registry.Global().NewObject(model.ResourceType(resMeta.Type))
+	if err != nil {
+		return nil, err
+	}
+	if err := proto.FromYAML(bytes, resource.GetSpec()); err != nil {
+		return nil, err
+	}
+	resource.SetMeta(meta{
+		Name: resMeta.Name,
+		Mesh: resMeta.Mesh,
+	})
+	return resource, nil
+}
+
+var _ model.ResourceMeta = &meta{}
+
+type meta struct {
+	Name string
+	Mesh string
+}
+
+func (m meta) GetName() string {
+	return m.Name
+}
+
+func (m meta) GetNameExtensions() model.ResourceNameExtensions {
+	return model.ResourceNameExtensionsUnsupported
+}
+
+func (m meta) GetVersion() string {
+	return ""
+}
+
+func (m meta) GetMesh() string {
+	return m.Mesh
+}
+
+func (m meta) GetCreationTime() time.Time {
+	return time.Unix(0, 0) // the date doesn't matter since it is set on server side anyways
+}
+
+func (m meta) GetModificationTime() time.Time {
+	return time.Unix(0, 0) // the date doesn't matter since it is set on server side anyways
+}
@@ -0,0 +1,61 @@
+package resources
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+)
+
+var _ = Describe("ParseResource(..)", func() {
+
+	It("should parse a resource", func() {
+		// given
+		input := `
+        type: Dataplane
+        mesh: demo
+        name: backend-01
+        networking:
+          address: 192.168.0.1
+          inbound:
+          - port: 8080
+            tags:
+              service: backend
+`
+		// when
+		resource, err := ParseResource([]byte(input))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(resource.GetType()).To(Equal(mesh_core.DataplaneType))
+		Expect(resource.GetMeta().GetMesh()).To(Equal("demo"))
+		Expect(resource.GetMeta().GetName()).To(Equal("backend-01"))
+		Expect(resource.(*mesh_core.DataplaneResource).Spec.Networking.Address).To(Equal("192.168.0.1"))
+	})
+
+	It("should parse a Mesh without mesh field", func() {
+		// when
+		resource, err := ParseResource([]byte(`{"type": "Mesh", "name": "demo"}`))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(resource.GetType()).To(Equal(mesh_core.MeshType))
+		Expect(resource.GetMeta().GetName()).To(Equal("demo"))
+	})
+
+	DescribeTable("should reject invalid resources",
+		func(input string, expectedErr string) {
+			// when
+			_, err := ParseResource([]byte(input))
+
+			// then
+			Expect(err).To(MatchError(expectedErr))
+		},
+		Entry("no name", `{"type": "Dataplane", "mesh": "demo"}`, "Name field cannot be empty"),
+		Entry("no mesh", `{"type": "Dataplane", "name": "backend-01"}`, "Mesh field cannot be empty"),
+		Entry("unknown type", `{"type": "Unknown", "mesh": "demo", "name": "unknown"}`, "invalid type of resource type"),
+	)
+})
@@ -43,7 +43,10 @@ func NewDataplaneTokenClient(address string, config *kumactl_config.Context_Admi
 }
 
 type DataplaneTokenClient interface {
-	Generate(name string, mesh string) (string, error)
+	// Generate issues a token for a given Dataplane. The token never expires if validFor is 0.
+	Generate(name string, mesh string, validFor time.Duration) (string, error)
+	// Revoke makes the Control Plane reject a given token from now on.
+	Revoke(token string) error
 }
 
 type httpDataplaneTokenClient struct {
@@ -52,31 +55,50 @@ type httpDataplaneTokenClient struct {
 
 var _ DataplaneTokenClient = &httpDataplaneTokenClient{}
 
-func (h *httpDataplaneTokenClient) Generate(name string, mesh string) (string, error) {
+func (h *httpDataplaneTokenClient) Generate(name string, mesh string, validFor time.Duration) (string, error) {
 	tokenReq := &types.DataplaneTokenRequest{
 		Name: name,
 		Mesh: mesh,
 	}
-	reqBytes, err := json.Marshal(tokenReq)
+	if validFor != 0 {
+		tokenReq.ValidFor = validFor.String()
+	}
+	tokenBytes, err := h.post("/tokens", tokenReq)
+	if err != nil {
+		return "", err
+	}
+	return string(tokenBytes), nil
+}
+
+func (h *httpDataplaneTokenClient) Revoke(token string) error {
+	revReq := &types.DataplaneTokenRevocationRequest{
+		Token: token,
+	}
+	_, err := h.post("/tokens/revocations", revReq)
+	return err
+}
+
+func (h *httpDataplaneTokenClient) post(path string, request interface{}) ([]byte, error) {
+	reqBytes, err := json.Marshal(request)
 	if err != nil {
-		return "", errors.Wrap(err, "could not marshal token request to json")
+		return nil, errors.Wrap(err, "could not marshal token request to json")
 	}
-	req, err := http.NewRequest("POST", "/tokens", bytes.NewReader(reqBytes))
+	req, err := http.NewRequest("POST", path, bytes.NewReader(reqBytes))
 	if err != nil {
-		return "", errors.Wrap(err, "could not construct the request")
+		return nil, errors.Wrap(err, "could not construct the request")
 	}
 	req.Header.Set("content-type", "application/json")
 	resp, err := h.client.Do(req)
 	if err != nil {
-		return "", errors.Wrap(err, "could not execute the request")
+		return nil, errors.Wrap(err, "could not execute the request")
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != 200 {
-		return "", errors.Errorf("unexpected status code %d. Expected 200", resp.StatusCode)
+		return nil, errors.Errorf("unexpected status code %d. Expected 200", resp.StatusCode)
 	}
-	tokenBytes, err := ioutil.ReadAll(resp.Body)
+	respBytes, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return "", errors.Wrap(err, "could not read a body of the request")
+		return nil, errors.Wrap(err, "could not read a body of the request")
 	}
-	return string(tokenBytes), nil
+	return respBytes, nil
 }
@@ -2,9 +2,11 @@ package tokens_test
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -27,12 +29,12 @@ type staticTokenIssuer struct {
 
 var _ issuer.DataplaneTokenIssuer = &staticTokenIssuer{}
 
-func (s *staticTokenIssuer) Generate(proxyId xds.ProxyId) (auth.Credential, error) {
+func (s *staticTokenIssuer) Generate(proxyId xds.ProxyId, validFor time.Duration) (auth.Credential, error) {
 	return auth.Credential(fmt.Sprintf("token-for-%s-%s", proxyId.Name, proxyId.Mesh)), nil
 }
 
-func (s *staticTokenIssuer) Validate(credential auth.Credential) (xds.ProxyId, error) {
-	return xds.ProxyId{}, errors.New("not implemented")
+func (s *staticTokenIssuer) Validate(credential auth.Credential) (issuer.Token, error) {
+	return issuer.Token{}, errors.New("not implemented")
 }
 
 var _ = Describe("Tokens Client", func() {
@@ -66,7 +68,7 @@ var _ = Describe("Tokens Client", func() {
 				ClientCertsDir: filepath.Join("..", "..", "..", "..", "pkg", "admin-server", "testdata", "authorized-clients"),
 			},
 		}
-		srv := admin_server.NewAdminServer(adminCfg, server.NewWebservice(&staticTokenIssuer{}))
+		srv := admin_server.NewAdminServer(adminCfg, server.NewWebservice(&staticTokenIssuer{}, nil))
 
 		ch := make(chan struct{})
 		errCh := make(chan error)
@@ -88,12 +90,12 @@ var _ = Describe("Tokens Client", func() {
 
 			// wait for server
 			Eventually(func() error {
-				_, err := client.Generate("example", "default")
+				_, err := client.Generate("example", "default", 0)
 				return err
 			}, "5s", "100ms").ShouldNot(HaveOccurred())
 
 			// when
-			token, err := client.Generate("example", "default")
+			token, err := client.Generate("example", "default", 0)
 
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -128,9 +130,58 @@ var _ = Describe("Tokens Client", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		// when
-		_, err = client.Generate("example", "default")
+		_, err = client.Generate("example", "default", 0)
 
 		// then
 		Expect(err).To(MatchError("unexpected status code 500. Expected 200"))
 	})
+
+	It("should pass validity period of a token", func() {
+		// given
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+		var body []byte
+		mux.HandleFunc("/tokens", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			var err error
+			body, err = ioutil.ReadAll(req.Body)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = writer.Write([]byte("token"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+		client, err := tokens.NewDataplaneTokenClient(server.URL, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		token, err := client.Generate("example", "default", 24*time.Hour)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token).To(Equal("token"))
+		Expect(body).To(MatchJSON(`{"name": "example", "mesh": "default", "validFor": "24h0m0s"}`))
+	})
+
+	It("should revoke a token", func() {
+		// given
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+		var body []byte
+		mux.HandleFunc("/tokens/revocations", func(writer http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			var err error
+			body, err = ioutil.ReadAll(req.Body)
+			Expect(err).ToNot(HaveOccurred())
+		})
+		client, err := tokens.NewDataplaneTokenClient(server.URL, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = client.Revoke("token")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(MatchJSON(`{"token": "token"}`))
+	})
 })
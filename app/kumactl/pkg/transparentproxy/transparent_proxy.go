@@ -0,0 +1,257 @@
+package transparentproxy
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Names of chains in `nat` table that are created by kumactl.
+const (
+	InboundChain  = "KUMA_INBOUND"
+	OutboundChain = "KUMA_OUTPUT"
+	RedirectChain = "KUMA_REDIRECT"
+	DNSChain      = "KUMA_DNS"
+)
+
+// Config defines how traffic of a universal host is redirected to kuma-dp.
+type Config struct {
+	// RedirectPort is a port that Envoy listens on for redirected traffic,
+	// i.e. `networking.transparentProxying.redirectPort` of a Dataplane.
+	RedirectPort uint32
+	// RedirectDNSPort is a port that DNS server embedded in kuma-dp listens on, i.e. port of `dataplaneRuntime.dns.address`.
+	// DNS queries (UDP port 53) to DNSServers are redirected to it. Zero disables redirection of DNS queries.
+	RedirectDNSPort uint32
+	// DNSServers are IPv4 addresses of nameservers which queries are redirected to DNS server embedded in kuma-dp.
+	// Queries to other servers are left intact, e.g. upstream queries of a local resolver like systemd-resolved.
+	// Only IPv4 is supported since the DNS server listens on 127.0.0.1.
+	DNSServers []string
+	// ExcludeInboundPorts are ports of inbound traffic that is not redirected, e.g. SSH.
+	ExcludeInboundPorts []uint32
+	// ExcludeOutboundPorts are ports of outbound traffic that is not redirected.
+	ExcludeOutboundPorts []uint32
+	// KumaDpUser is a name or UID of the user that kuma-dp runs as.
+	// Outbound traffic of that user is not redirected, otherwise traffic of Envoy would loop back to Envoy.
+	KumaDpUser string
+	// IPv6 enables redirection of IPv6 traffic by ip6tables.
+	// It is disabled by default, since Envoy listens for redirected traffic on IPv4 only unless the Dataplane
+	// has an IPv6 address, so redirected IPv6 connections would be refused.
+	IPv6 bool
+}
+
+func (c Config) Validate() error {
+	if c.RedirectPort == 0 || c.RedirectPort > 65535 {
+		return errors.Errorf("redirect port must be in the range [1, 65535]")
+	}
+	if c.RedirectDNSPort > 65535 {
+		return errors.Errorf("DNS redirect port must be in the range [0, 65535]")
+	}
+	if c.RedirectDNSPort != 0 && len(c.DNSServers) == 0 {
+		return errors.Errorf("DNS servers must be set to redirect DNS queries")
+	}
+	for _, server := range c.DNSServers {
+		if ip := net.ParseIP(server); ip == nil || ip.To4() == nil {
+			return errors.Errorf("DNS server %q must be an IPv4 address", server)
+		}
+	}
+	if c.KumaDpUser == "" {
+		return errors.Errorf("user that kuma-dp runs as must be set, otherwise traffic of Envoy would be redirected back to Envoy")
+	}
+	for _, port := range append(append([]uint32{}, c.ExcludeInboundPorts...), c.ExcludeOutboundPorts...) {
+		if port == 0 || port > 65535 {
+			return errors.Errorf("excluded port %d must be in the range [1, 65535]", port)
+		}
+	}
+	return nil
+}
+
+// Command is an invocation of iptables or ip6tables.
+type Command struct {
+	Name string
+	Args []string
+}
+
+func (c Command) String() string {
+	return strings.Join(append([]string{c.Name}, c.Args...), " ")
+}
+
+// InstallCommands returns commands that create rules redirecting inbound and outbound TCP traffic to Envoy
+// and DNS queries to DNS server embedded in kuma-dp.
+func InstallCommands(cfg Config) []Command {
+	commands := installCommands("iptables", "127.0.0.1/32", cfg)
+	if cfg.RedirectDNSPort != 0 {
+		commands = append(commands, installDNSCommands("iptables", cfg)...)
+	}
+	if cfg.IPv6 {
+		commands = append(commands, installCommands("ip6tables", "::1/128", cfg)...)
+	}
+	return commands
+}
+
+func installCommands(iptables string, loopback string, cfg Config) []Command {
+	nat := func(args ...string) Command {
+		return Command{Name: iptables, Args: append([]string{"-t", "nat"}, args...)}
+	}
+	var commands []Command
+
+	// redirect to Envoy
+	commands = append(commands,
+		nat("-N", RedirectChain),
+		nat("-A", RedirectChain, "-p", "tcp", "-j", "REDIRECT", "--to-ports", fmt.Sprint(cfg.RedirectPort)),
+	)
+
+	// inbound traffic
+	commands = append(commands, nat("-N", InboundChain))
+	for _, port := range cfg.ExcludeInboundPorts {
+		commands = append(commands, nat("-A", InboundChain, "-p", "tcp", "--dport", fmt.Sprint(port), "-j", "RETURN"))
+	}
+	commands = append(commands,
+		nat("-A", InboundChain, "-p", "tcp", "-j", RedirectChain),
+		nat("-A", "PREROUTING", "-p", "tcp", "-j", InboundChain),
+	)
+
+	// outbound traffic
+	commands = append(commands,
+		nat("-N", OutboundChain),
+		nat("-A", OutboundChain, "-m", "owner", "--uid-owner", cfg.KumaDpUser, "-j", "RETURN"),
+		nat("-A", OutboundChain, "-d", loopback, "-j", "RETURN"),
+	)
+	for _, port := range cfg.ExcludeOutboundPorts {
+		commands = append(commands, nat("-A", OutboundChain, "-p", "tcp", "--dport", fmt.Sprint(port), "-j", "RETURN"))
+	}
+	commands = append(commands,
+		nat("-A", OutboundChain, "-p", "tcp", "-j", RedirectChain),
+		nat("-A", "OUTPUT", "-p", "tcp", "-j", OutboundChain),
+	)
+	return commands
+}
+
+func installDNSCommands(iptables string, cfg Config) []Command {
+	nat := func(args ...string) Command {
+		return Command{Name: iptables, Args: append([]string{"-t", "nat"}, args...)}
+	}
+	// DNS queries are redirected before they reach the rule that skips loopback,
+	// so queries to a local resolver like systemd-resolved are redirected as well.
+	// Queries of kuma-dp itself are not redirected, otherwise queries forwarded to the upstream DNS server would loop back.
+	// Only queries to the configured nameservers are redirected, so upstream queries of a local resolver,
+	// which runs as its own user, do not loop back either.
+	commands := []Command{
+		nat("-N", DNSChain),
+		nat("-A", DNSChain, "-m", "owner", "--uid-owner", cfg.KumaDpUser, "-j", "RETURN"),
+	}
+	for _, server := range cfg.DNSServers {
+		commands = append(commands, nat("-A", DNSChain, "-d", server+"/32", "-p", "udp", "--dport", "53", "-j", "REDIRECT", "--to-ports", fmt.Sprint(cfg.RedirectDNSPort)))
+	}
+	return append(commands, nat("-A", "OUTPUT", "-p", "udp", "--dport", "53", "-j", DNSChain))
+}
+
+// NameserversFromResolvConf returns IPv4 addresses of nameservers in a given resolv.conf file.
+func NameserversFromResolvConf(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read nameservers from %q", path)
+	}
+	defer file.Close()
+	var servers []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			if ip := net.ParseIP(fields[1]); ip != nil && ip.To4() != nil {
+				servers = append(servers, fields[1])
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "could not read nameservers from %q", path)
+	}
+	return servers, nil
+}
+
+// RollbackCommands returns commands that undo given commands of InstallCommands, e.g. ones that have been executed
+// before installation failed, so that no part of the rules is left behind.
+// Commands are undone in the reverse order, so rules are deleted before chains they refer to and belong to.
+func RollbackCommands(executed []Command) []Command {
+	var commands []Command
+	for i := len(executed) - 1; i >= 0; i-- {
+		command := executed[i]
+		// every command has the form of "-t nat <operation> <chain> ..."
+		if len(command.Args) < 4 {
+			continue
+		}
+		args := append([]string{}, command.Args...)
+		switch args[2] {
+		case "-A":
+			args[2] = "-D"
+		case "-N":
+			args[2] = "-X"
+		default:
+			continue
+		}
+		commands = append(commands, Command{Name: command.Name, Args: args})
+	}
+	return commands
+}
+
+// UninstallCommands returns commands that delete rules created by InstallCommands.
+func UninstallCommands(ipv6 bool) []Command {
+	commands := uninstallCommands("iptables")
+	commands = append(commands, uninstallDNSCommands("iptables")...)
+	if ipv6 {
+		commands = append(commands, uninstallCommands("ip6tables")...)
+	}
+	return commands
+}
+
+func uninstallCommands(iptables string) []Command {
+	nat := func(args ...string) Command {
+		return Command{Name: iptables, Args: append([]string{"-t", "nat"}, args...)}
+	}
+	var commands []Command
+	commands = append(commands,
+		nat("-D", "PREROUTING", "-p", "tcp", "-j", InboundChain),
+		nat("-D", "OUTPUT", "-p", "tcp", "-j", OutboundChain),
+	)
+	for _, chain := range []string{InboundChain, OutboundChain, RedirectChain} {
+		commands = append(commands,
+			nat("-F", chain),
+			nat("-X", chain),
+		)
+	}
+	return commands
+}
+
+func uninstallDNSCommands(iptables string) []Command {
+	nat := func(args ...string) Command {
+		return Command{Name: iptables, Args: append([]string{"-t", "nat"}, args...)}
+	}
+	return []Command{
+		nat("-D", "OUTPUT", "-p", "udp", "--dport", "53", "-j", DNSChain),
+		nat("-F", DNSChain),
+		nat("-X", DNSChain),
+	}
+}
+
+// Executor runs iptables commands.
+type Executor interface {
+	Execute(command Command) error
+}
+
+func NewExecutor() Executor {
+	return &osExecutor{}
+}
+
+type osExecutor struct{}
+
+func (e *osExecutor) Execute(command Command) error {
+	output, err := exec.Command(command.Name, command.Args...).CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "%q failed: %s", command.String(), strings.TrimSpace(string(output)))
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	config_core "github.com/Kong/kuma/pkg/config/core"
 	"github.com/Kong/kuma/pkg/core"
 	ca_provided_rest "github.com/Kong/kuma/pkg/core/ca/provided/rest"
+	ca_rest "github.com/Kong/kuma/pkg/core/ca/rest"
 	"github.com/Kong/kuma/pkg/core/runtime"
 	"github.com/Kong/kuma/pkg/tokens/builtin"
 	tokens_server "github.com/Kong/kuma/pkg/tokens/builtin/server"
@@ -160,6 +161,9 @@ func SetupServer(rt runtime.Runtime) error {
 	ws := ca_provided_rest.NewWebservice(rt.ProvidedCaManager(), rt.ResourceManager())
 	webservices = append(webservices, ws)
 
+	ws = ca_rest.NewWebservice(rt.ReadOnlyResourceManager(), rt.BuiltinCaManager(), rt.ProvidedCaManager())
+	webservices = append(webservices, ws)
+
 	ws, err := dataplaneTokenWs(rt)
 	if err != nil {
 		return err
@@ -186,7 +190,7 @@ func dataplaneTokenWs(rt runtime.Runtime) (*restful.WebService, error) {
 		if err != nil {
 			return nil, err
 		}
-		return tokens_server.NewWebservice(generator), nil
+		return tokens_server.NewWebservice(generator, builtin.NewRevocationList(rt)), nil
 	default:
 		return nil, errors.Errorf("unknown environment type %s", env)
 	}
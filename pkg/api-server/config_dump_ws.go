@@ -0,0 +1,45 @@
+package api_server
+
+import (
+	"github.com/emicklei/go-restful"
+
+	"github.com/Kong/kuma/pkg/api-server/types"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	rest_errors "github.com/Kong/kuma/pkg/core/rest/errors"
+	xds_server "github.com/Kong/kuma/pkg/xds/server"
+)
+
+type configDumpWs struct {
+	configDumper xds_server.ConfigDumper
+}
+
+func (r *configDumpWs) AddToWs(ws *restful.WebService) {
+	ws.Route(ws.GET("/{mesh}/dataplanes/{name}/config-dump").To(r.configDump).
+		Doc("Get Envoy resources generated for a dataplane").
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+}
+
+func (r *configDumpWs) configDump(request *restful.Request, response *restful.Response) {
+	key := core_model.ResourceKey{
+		Mesh: request.PathParameter("mesh"),
+		Name: request.PathParameter("name"),
+	}
+
+	dump, err := r.configDumper.Dump(request.Request.Context(), key)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve a config dump")
+		return
+	}
+
+	res, err := types.NewConfigDump(string(dump.Source), dump.Snapshot, dump.ApiVersions)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve a config dump")
+		return
+	}
+	if err := response.WriteAsJson(res); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve a config dump")
+	}
+}
@@ -0,0 +1,125 @@
+package api_server_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/api/mesh/v1alpha1"
+	api_server "github.com/Kong/kuma/pkg/api-server"
+	"github.com/Kong/kuma/pkg/api-server/types"
+	config "github.com/Kong/kuma/pkg/config/api-server"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Config Dump WS", func() {
+	var apiServer *api_server.ApiServer
+	var resourceStore store.ResourceStore
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+		apiServer = createTestApiServer(resourceStore, config.DefaultApiServerConfig())
+		client := resourceApiClient{
+			address: apiServer.Address(),
+			path:    "/meshes",
+		}
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		waitForServer(&client)
+	}, 5)
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	BeforeEach(func() {
+		err := resourceStore.Create(context.Background(), &mesh_core.MeshResource{}, store.CreateByKey("mesh1", "mesh1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		dpResource := mesh_core.DataplaneResource{
+			Spec: v1alpha1.Dataplane{
+				Networking: &v1alpha1.Dataplane_Networking{
+					Address: "127.0.0.1",
+					Inbound: []*v1alpha1.Dataplane_Networking_Inbound{
+						{
+							Port:        9090,
+							ServicePort: 9091,
+							Tags: map[string]string{
+								"service": "sample",
+							},
+						},
+					},
+				},
+			},
+		}
+		err = resourceStore.Create(context.Background(), &dpResource, store.CreateByKey("dp1", "mesh1"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should generate config for a disconnected dataplane", func() {
+		// when
+		response, err := http.Get(fmt.Sprintf("http://%s/meshes/mesh1/dataplanes/dp1/config-dump", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(200))
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		dump := types.ConfigDump{}
+		err = json.Unmarshal(body, &dump)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(dump.Source).To(Equal("generated"))
+		Expect(dump.Listeners.Items).To(HaveLen(1))
+		Expect(dump.Listeners.Items[0]).To(MatchJSON(`
+        {
+          "name": "inbound:127.0.0.1:9090",
+          "address": {
+            "socketAddress": {
+              "address": "127.0.0.1",
+              "portValue": 9090
+            }
+          },
+          "filterChains": [
+            {
+              "filters": [
+                {
+                  "name": "envoy.tcp_proxy",
+                  "typedConfig": {
+                    "@type": "type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy",
+                    "cluster": "localhost:9091",
+                    "statPrefix": "localhost_9091"
+                  }
+                }
+              ]
+            }
+          ],
+          "trafficDirection": "INBOUND"
+        }`))
+		Expect(dump.Clusters.Items).To(HaveLen(1))
+	})
+
+	It("should return 404 for a non-existing dataplane", func() {
+		// when
+		response, err := http.Get(fmt.Sprintf("http://%s/meshes/mesh1/dataplanes/non-existing/config-dump", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(404))
+	})
+})
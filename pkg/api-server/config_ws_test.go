@@ -64,7 +64,9 @@ var _ = Describe("Config WS", func() {
               "xdsHost": "",
               "xdsPort": 0
             },
-            "port": 5682
+            "port": 5682,
+            "tlsCertFile": "",
+            "tlsKeyFile": ""
           },
           "dataplaneTokenServer": {
             "enabled": true,
@@ -109,6 +111,13 @@ var _ = Describe("Config WS", func() {
             "port": 5683,
             "apiServerUrl": ""
           },
+          "dnsServer": {
+            "cidr": "240.0.0.0/4",
+            "domain": "mesh",
+            "enabled": false,
+            "vipPort": 80,
+            "vipRefreshInterval": "5s"
+          },
           "monitoringAssignmentServer": {
             "assignmentRefreshInterval": "1s",
             "grpcPort": 5676
@@ -123,6 +132,9 @@ var _ = Describe("Config WS", func() {
                 "certDir": "",
                 "port": 5443
               }
+            },
+            "universal": {
+              "dataplaneCleanupAge": "72h0m0s"
             }
           },
           "sdsServer": {
@@ -0,0 +1,83 @@
+package api_server
+
+import (
+	"github.com/emicklei/go-restful"
+
+	"github.com/Kong/kuma/pkg/api-server/types"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	rest_errors "github.com/Kong/kuma/pkg/core/rest/errors"
+	xds_server "github.com/Kong/kuma/pkg/xds/server"
+)
+
+type dataplanePoliciesWs struct {
+	policyInspector xds_server.PolicyInspector
+}
+
+func (r *dataplanePoliciesWs) AddToWs(ws *restful.WebService) {
+	ws.Route(ws.GET("/{mesh}/dataplanes/{name}/policies").To(r.inspectPolicies).
+		Doc("Get policies applied to a dataplane").
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+}
+
+func (r *dataplanePoliciesWs) inspectPolicies(request *restful.Request, response *restful.Response) {
+	key := core_model.ResourceKey{
+		Mesh: request.PathParameter("mesh"),
+		Name: request.PathParameter("name"),
+	}
+
+	policies, err := r.policyInspector.Inspect(request.Request.Context(), key)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve policies")
+		return
+	}
+
+	if err := response.WriteAsJson(toDataplanePolicies(policies)); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve policies")
+	}
+}
+
+func toDataplanePolicies(policies *xds_server.DataplanePolicies) *types.DataplanePolicies {
+	res := &types.DataplanePolicies{
+		Inbound:       []types.InboundPolicies{},
+		Outbound:      []types.OutboundPolicies{},
+		TrafficTrace:  toMatchedPolicy(policies.TrafficTrace),
+		ProxyTemplate: toMatchedPolicy(policies.ProxyTemplate),
+	}
+	for _, inbound := range policies.Inbound {
+		permissions := []types.MatchedPolicy{}
+		for i := range inbound.TrafficPermissions {
+			permissions = append(permissions, *toMatchedPolicy(&inbound.TrafficPermissions[i]))
+		}
+		res.Inbound = append(res.Inbound, types.InboundPolicies{
+			Interface:          inbound.Interface.String(),
+			Tags:               inbound.Tags,
+			TrafficPermissions: permissions,
+		})
+	}
+	for _, outbound := range policies.Outbound {
+		res.Outbound = append(res.Outbound, types.OutboundPolicies{
+			Service:      outbound.Service,
+			TrafficRoute: toMatchedPolicy(outbound.TrafficRoute),
+			TrafficLog:   toMatchedPolicy(outbound.TrafficLog),
+			HealthCheck:  toMatchedPolicy(outbound.HealthCheck),
+		})
+	}
+	return res
+}
+
+func toMatchedPolicy(matched *xds_server.MatchedPolicy) *types.MatchedPolicy {
+	if matched == nil {
+		return nil
+	}
+	return &types.MatchedPolicy{
+		Type: string(matched.Policy.GetType()),
+		Name: matched.Policy.GetMeta().GetName(),
+		Score: types.MatchScore{
+			ExactMatches:    matched.Rank.ExactMatches,
+			WildcardMatches: matched.Rank.WildcardMatches,
+		},
+	}
+}
@@ -0,0 +1,232 @@
+package api_server_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/api/mesh/v1alpha1"
+	api_server "github.com/Kong/kuma/pkg/api-server"
+	config "github.com/Kong/kuma/pkg/config/api-server"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Dataplane Policies WS", func() {
+	var apiServer *api_server.ApiServer
+	var resourceStore store.ResourceStore
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+		apiServer = createTestApiServer(resourceStore, config.DefaultApiServerConfig())
+		client := resourceApiClient{
+			address: apiServer.Address(),
+			path:    "/meshes",
+		}
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		waitForServer(&client)
+	}, 5)
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	create := func(resource model.Resource, name string) {
+		err := resourceStore.Create(context.Background(), resource, store.CreateByKey(name, "mesh1"))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		create(&mesh_core.MeshResource{}, "mesh1")
+		create(&mesh_core.DataplaneResource{
+			Spec: v1alpha1.Dataplane{
+				Networking: &v1alpha1.Dataplane_Networking{
+					Address: "127.0.0.1",
+					Inbound: []*v1alpha1.Dataplane_Networking_Inbound{
+						{
+							Port:        9090,
+							ServicePort: 9091,
+							Tags: map[string]string{
+								"service": "web",
+								"version": "v1",
+							},
+						},
+					},
+					Outbound: []*v1alpha1.Dataplane_Networking_Outbound{
+						{
+							Port:    10001,
+							Service: "backend",
+						},
+					},
+				},
+			},
+		}, "web-1")
+
+		anyService := []*v1alpha1.Selector{{Match: v1alpha1.MatchAnyService()}}
+		create(&mesh_core.TrafficPermissionResource{
+			Spec: v1alpha1.TrafficPermission{
+				Sources:      anyService,
+				Destinations: anyService,
+			},
+		}, "allow-all")
+		create(&mesh_core.TrafficPermissionResource{
+			Spec: v1alpha1.TrafficPermission{
+				Sources: anyService,
+				Destinations: []*v1alpha1.Selector{{Match: map[string]string{
+					"service": "web",
+					"version": "v1",
+				}}},
+			},
+		}, "allow-web-v1")
+		create(&mesh_core.TrafficPermissionResource{
+			Spec: v1alpha1.TrafficPermission{
+				Sources:      anyService,
+				Destinations: []*v1alpha1.Selector{{Match: v1alpha1.MatchService("backend")}},
+			},
+		}, "allow-backend")
+		create(&mesh_core.TrafficRouteResource{
+			Spec: v1alpha1.TrafficRoute{
+				Sources:      []*v1alpha1.Selector{{Match: v1alpha1.MatchService("web")}},
+				Destinations: []*v1alpha1.Selector{{Match: v1alpha1.MatchService("backend")}},
+				Conf: []*v1alpha1.TrafficRoute_WeightedDestination{{
+					Weight:      100,
+					Destination: v1alpha1.MatchService("backend"),
+				}},
+			},
+		}, "web-to-backend")
+		create(&mesh_core.HealthCheckResource{
+			Spec: v1alpha1.HealthCheck{
+				Sources:      anyService,
+				Destinations: anyService,
+			},
+		}, "hc-all")
+		create(&mesh_core.TrafficLogResource{
+			Spec: v1alpha1.TrafficLog{
+				Sources:      anyService,
+				Destinations: []*v1alpha1.Selector{{Match: v1alpha1.MatchService("backend")}},
+			},
+		}, "log-backend")
+		create(&mesh_core.TrafficTraceResource{
+			Spec: v1alpha1.TrafficTrace{
+				Selectors: []*v1alpha1.Selector{{Match: map[string]string{
+					"service": "web",
+					"version": "*",
+				}}},
+			},
+		}, "trace-web")
+		create(&mesh_core.ProxyTemplateResource{
+			Spec: v1alpha1.ProxyTemplate{
+				Selectors: anyService,
+			},
+		}, "custom-template")
+	})
+
+	It("should list policies applied to a dataplane with match scores", func() {
+		// when
+		response, err := http.Get(fmt.Sprintf("http://%s/meshes/mesh1/dataplanes/web-1/policies", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(200))
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		Expect(body).To(MatchJSON(`
+        {
+          "inbound": [
+            {
+              "interface": "127.0.0.1:9090:9091",
+              "tags": {
+                "service": "web",
+                "version": "v1"
+              },
+              "trafficPermissions": [
+                {
+                  "type": "TrafficPermission",
+                  "name": "allow-all",
+                  "score": {
+                    "exactMatches": 0,
+                    "wildcardMatches": 1
+                  }
+                },
+                {
+                  "type": "TrafficPermission",
+                  "name": "allow-web-v1",
+                  "score": {
+                    "exactMatches": 2,
+                    "wildcardMatches": 0
+                  }
+                }
+              ]
+            }
+          ],
+          "outbound": [
+            {
+              "service": "backend",
+              "trafficRoute": {
+                "type": "TrafficRoute",
+                "name": "web-to-backend",
+                "score": {
+                  "exactMatches": 2,
+                  "wildcardMatches": 0
+                }
+              },
+              "trafficLog": {
+                "type": "TrafficLog",
+                "name": "log-backend",
+                "score": {
+                  "exactMatches": 1,
+                  "wildcardMatches": 1
+                }
+              },
+              "healthCheck": {
+                "type": "HealthCheck",
+                "name": "hc-all",
+                "score": {
+                  "exactMatches": 0,
+                  "wildcardMatches": 2
+                }
+              }
+            }
+          ],
+          "trafficTrace": {
+            "type": "TrafficTrace",
+            "name": "trace-web",
+            "score": {
+              "exactMatches": 1,
+              "wildcardMatches": 1
+            }
+          },
+          "proxyTemplate": {
+            "type": "ProxyTemplate",
+            "name": "custom-template",
+            "score": {
+              "exactMatches": 0,
+              "wildcardMatches": 1
+            }
+          }
+        }`))
+	})
+
+	It("should return 404 for a non-existing dataplane", func() {
+		// when
+		response, err := http.Get(fmt.Sprintf("http://%s/meshes/mesh1/dataplanes/non-existing/policies", apiServer.Address()))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(404))
+	})
+})
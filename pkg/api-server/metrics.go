@@ -0,0 +1,41 @@
+package api_server
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/Kong/kuma/pkg/metrics"
+)
+
+// metricsFilter measures requests to the API Server.
+// Requests are labeled by a route template (e.g. /meshes/{mesh}/dataplanes/{name}) to keep cardinality of metrics low.
+func metricsFilter(metrics metrics.Metrics) (restful.FilterFunction, error) {
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "api_server_http_request_duration_seconds",
+		Help: "Duration of requests to the API Server",
+	}, []string{"method", "path", "status_code"})
+	if err := metrics.Register(duration); err != nil {
+		return nil, err
+	}
+	inflight := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "api_server_http_requests_inflight",
+		Help: "Number of requests to the API Server that are being processed",
+	})
+	if err := metrics.Register(inflight); err != nil {
+		return nil, err
+	}
+	return func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		inflight.Inc()
+		defer inflight.Dec()
+		start := time.Now()
+		chain.ProcessFilter(request, response)
+		duration.WithLabelValues(
+			request.Request.Method,
+			request.SelectedRoutePath(),
+			strconv.Itoa(response.StatusCode()),
+		).Observe(time.Since(start).Seconds())
+	}, nil
+}
@@ -0,0 +1,65 @@
+package api_server_test
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	config "github.com/Kong/kuma/pkg/config/api-server"
+	"github.com/Kong/kuma/pkg/metrics"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("API Server metrics", func() {
+
+	It("should measure requests", func(done Done) {
+		// setup
+		metrics, err := metrics.NewMetrics()
+		Expect(err).ToNot(HaveOccurred())
+		apiServer := createTestApiServerWithMetrics(memory.NewStore(), config.DefaultApiServerConfig(), metrics)
+
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// wait for the server
+		Eventually(func() error {
+			_, err := http.Get("http://localhost" + apiServer.Address())
+			return err
+		}, "3s").ShouldNot(HaveOccurred())
+
+		// when
+		resp, err := http.Get("http://localhost" + apiServer.Address() + "/meshes/default/dataplanes/backend-01")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Body.Close()).To(Succeed())
+
+		// then
+		families, err := metrics.Gather()
+		Expect(err).ToNot(HaveOccurred())
+		var labels []map[string]string
+		for _, family := range families {
+			if family.GetName() != "api_server_http_request_duration_seconds" {
+				continue
+			}
+			for _, metric := range family.GetMetric() {
+				pairs := map[string]string{}
+				for _, label := range metric.GetLabel() {
+					pairs[label.GetName()] = label.GetValue()
+				}
+				labels = append(labels, pairs)
+			}
+		}
+		Expect(labels).To(ContainElement(map[string]string{
+			"method":      "GET",
+			"path":        "/meshes/{mesh}/dataplanes/{name}",
+			"status_code": "404",
+		}))
+
+		close(done)
+	}, 5)
+})
@@ -10,9 +10,13 @@ import (
 	kuma_cp "github.com/Kong/kuma/pkg/config/app/kuma-cp"
 	"github.com/Kong/kuma/pkg/core/resources/manager"
 	"github.com/Kong/kuma/pkg/core/resources/store"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/metrics"
 	"github.com/Kong/kuma/pkg/test"
 	sample_proto "github.com/Kong/kuma/pkg/test/apis/sample/v1alpha1"
 	sample_model "github.com/Kong/kuma/pkg/test/resources/apis/sample"
+	xds_context "github.com/Kong/kuma/pkg/xds/context"
+	xds_server "github.com/Kong/kuma/pkg/xds/server"
 
 	"net/http"
 
@@ -99,6 +103,12 @@ func putSampleResourceIntoStore(resourceStore store.ResourceStore, name string,
 }
 
 func createTestApiServer(store store.ResourceStore, config *config_api_server.ApiServerConfig) *api_server.ApiServer {
+	metrics, err := metrics.NewMetrics()
+	Expect(err).ToNot(HaveOccurred())
+	return createTestApiServerWithMetrics(store, config, metrics)
+}
+
+func createTestApiServerWithMetrics(store store.ResourceStore, config *config_api_server.ApiServerConfig, metrics metrics.Metrics) *api_server.ApiServer {
 	// we have to manually search for port and put it into config. There is no way to retrieve port of running
 	// http.Server and we need it later for the client
 	port, err := test.GetFreePort()
@@ -108,7 +118,11 @@ func createTestApiServer(store store.ResourceStore, config *config_api_server.Ap
 	resources := manager.NewResourceManager(store)
 	cfg := kuma_cp.DefaultConfig()
 	cfg.ApiServer = config
-	apiServer, err := api_server.NewApiServer(resources, defs, cfg.ApiServer, &cfg)
+	xdsContext := core_xds.NewXdsContext()
+	proxyBuilder := &xds_server.DataplaneProxyBuilder{ResourceManager: resources}
+	configDumper := xds_server.NewConfigDumper(resources, proxyBuilder, xdsContext.Cache(), xdsContext.ApiVersions(), &xds_context.ControlPlaneContext{})
+	policyInspector := xds_server.NewPolicyInspector(resources, proxyBuilder)
+	apiServer, err := api_server.NewApiServer(resources, configDumper, policyInspector, defs, cfg.ApiServer, &cfg, metrics)
 	Expect(err).ToNot(HaveOccurred())
 	return apiServer
 }
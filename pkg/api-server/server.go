@@ -14,6 +14,8 @@ import (
 	"github.com/Kong/kuma/pkg/core"
 	"github.com/Kong/kuma/pkg/core/resources/manager"
 	"github.com/Kong/kuma/pkg/core/runtime"
+	"github.com/Kong/kuma/pkg/metrics"
+	xds_server "github.com/Kong/kuma/pkg/xds/server"
 )
 
 var (
@@ -28,7 +30,7 @@ func (a *ApiServer) Address() string {
 	return a.server.Addr
 }
 
-func NewApiServer(resManager manager.ResourceManager, defs []definitions.ResourceWsDefinition, serverConfig *api_server_config.ApiServerConfig, cfg config.Config) (*ApiServer, error) {
+func NewApiServer(resManager manager.ResourceManager, configDumper xds_server.ConfigDumper, policyInspector xds_server.PolicyInspector, defs []definitions.ResourceWsDefinition, serverConfig *api_server_config.ApiServerConfig, cfg config.Config, metrics metrics.Metrics) (*ApiServer, error) {
 	container := restful.NewContainer()
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%d", serverConfig.Port),
@@ -47,7 +49,7 @@ func NewApiServer(resManager manager.ResourceManager, defs []definitions.Resourc
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
 
-	addToWs(ws, defs, resManager, serverConfig)
+	addToWs(ws, defs, resManager, configDumper, policyInspector, serverConfig)
 	container.Add(ws)
 	container.Add(indexWs())
 	container.Add(catalogWs(*serverConfig.Catalog))
@@ -57,18 +59,33 @@ func NewApiServer(resManager manager.ResourceManager, defs []definitions.Resourc
 	}
 	container.Add(configWs)
 
+	metricsFilter, err := metricsFilter(metrics)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create metrics filter")
+	}
+	container.Filter(metricsFilter)
 	container.Filter(cors.Filter)
 	return &ApiServer{
 		server: srv,
 	}, nil
 }
 
-func addToWs(ws *restful.WebService, defs []definitions.ResourceWsDefinition, resManager manager.ResourceManager, config *api_server_config.ApiServerConfig) {
+func addToWs(ws *restful.WebService, defs []definitions.ResourceWsDefinition, resManager manager.ResourceManager, configDumper xds_server.ConfigDumper, policyInspector xds_server.PolicyInspector, config *api_server_config.ApiServerConfig) {
 	overviewWs := overviewWs{
 		resManager: resManager,
 	}
 	overviewWs.AddToWs(ws)
 
+	configDumpWs := configDumpWs{
+		configDumper: configDumper,
+	}
+	configDumpWs.AddToWs(ws)
+
+	dataplanePoliciesWs := dataplanePoliciesWs{
+		policyInspector: policyInspector,
+	}
+	dataplanePoliciesWs.AddToWs(ws)
+
 	for _, definition := range defs {
 		resourceWs := resourceWs{
 			resManager:           resManager,
@@ -105,7 +122,12 @@ func (a *ApiServer) Start(stop <-chan struct{}) error {
 
 func SetupServer(rt runtime.Runtime) error {
 	cfg := rt.Config()
-	apiServer, err := NewApiServer(rt.ResourceManager(), definitions.All, rt.Config().ApiServer, &cfg)
+	configDumper, err := xds_server.DefaultConfigDumper(rt)
+	if err != nil {
+		return err
+	}
+	policyInspector := xds_server.DefaultPolicyInspector(rt)
+	apiServer, err := NewApiServer(rt.ResourceManager(), configDumper, policyInspector, definitions.All, rt.Config().ApiServer, &cfg, rt.Metrics())
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"encoding/json"
+	"sort"
+
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v2"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v2"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	util_xds_v3 "github.com/Kong/kuma/pkg/util/xds/v3"
+)
+
+// ConfigDump represents Envoy resources the Control Plane generates for a Dataplane.
+type ConfigDump struct {
+	// Source is either "cache" for a connected Dataplane or "generated" for a disconnected one
+	Source    string              `json:"source"`
+	Listeners ConfigDumpResources `json:"listeners"`
+	Routes    ConfigDumpResources `json:"routes"`
+	Clusters  ConfigDumpResources `json:"clusters"`
+	Endpoints ConfigDumpResources `json:"endpoints"`
+}
+
+type ConfigDumpResources struct {
+	Version string `json:"version"`
+	// ApiVersion is a version of Envoy xDS API resources have been served in, i.e. either "v2" or "v3"
+	ApiVersion string            `json:"apiVersion"`
+	Items      []json.RawMessage `json:"items"`
+}
+
+// NewConfigDump returns resources of a given snapshot in versions of Envoy xDS API they have been served in.
+func NewConfigDump(source string, snapshot envoy_cache.Snapshot, apiVersions map[string]core_xds.ApiVersion) (*ConfigDump, error) {
+	res := &ConfigDump{
+		Source: source,
+	}
+	for _, item := range []struct {
+		from    envoy_cache.Resources
+		typeURL string
+		to      *ConfigDumpResources
+	}{
+		{snapshot.Resources[envoy_types.Listener], envoy_resource.ListenerType, &res.Listeners},
+		{snapshot.Resources[envoy_types.Route], envoy_resource.RouteType, &res.Routes},
+		{snapshot.Resources[envoy_types.Cluster], envoy_resource.ClusterType, &res.Clusters},
+		{snapshot.Resources[envoy_types.Endpoint], envoy_resource.EndpointType, &res.Endpoints},
+	} {
+		apiVersion := apiVersions[item.typeURL]
+		if apiVersion == "" {
+			apiVersion = core_xds.ApiVersionV2
+		}
+		resources, err := newConfigDumpResources(item.from, apiVersion)
+		if err != nil {
+			return nil, err
+		}
+		*item.to = resources
+	}
+	return res, nil
+}
+
+func newConfigDumpResources(resources envoy_cache.Resources, apiVersion core_xds.ApiVersion) (ConfigDumpResources, error) {
+	names := make([]string, 0, len(resources.Items))
+	for name := range resources.Items {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	items := make([]json.RawMessage, 0, len(names))
+	for _, name := range names {
+		resource := resources.Items[name]
+		if apiVersion == core_xds.ApiVersionV3 {
+			upgraded, err := upgradeResource(resource)
+			if err != nil {
+				return ConfigDumpResources{}, err
+			}
+			resource = upgraded
+		}
+		bytes, err := util_proto.ToJSON(resource)
+		if err != nil {
+			return ConfigDumpResources{}, err
+		}
+		items = append(items, bytes)
+	}
+	return ConfigDumpResources{
+		Version:    resources.Version,
+		ApiVersion: string(apiVersion),
+		Items:      items,
+	}, nil
+}
+
+// upgradeResource converts a resource into xDS v3 the same way it is converted when it is sent to Envoy.
+func upgradeResource(resource envoy_types.Resource) (proto.Message, error) {
+	v2, err := ptypes.MarshalAny(resource)
+	if err != nil {
+		return nil, err
+	}
+	v3, err := util_xds_v3.UpgradeResource(v2)
+	if err != nil {
+		return nil, err
+	}
+	upgraded := &ptypes.DynamicAny{}
+	if err := ptypes.UnmarshalAny(v3, upgraded); err != nil {
+		return nil, err
+	}
+	return upgraded.Message, nil
+}
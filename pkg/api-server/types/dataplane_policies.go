@@ -0,0 +1,36 @@
+package types
+
+// DataplanePolicies lists policies the Control Plane applies to a Dataplane.
+type DataplanePolicies struct {
+	Inbound       []InboundPolicies  `json:"inbound"`
+	Outbound      []OutboundPolicies `json:"outbound"`
+	TrafficTrace  *MatchedPolicy     `json:"trafficTrace,omitempty"`
+	ProxyTemplate *MatchedPolicy     `json:"proxyTemplate,omitempty"`
+}
+
+type InboundPolicies struct {
+	// Interface is an inbound interface in the format of DATAPLANE_IP:DATAPLANE_PORT:WORKLOAD_PORT
+	Interface          string            `json:"interface"`
+	Tags               map[string]string `json:"tags"`
+	TrafficPermissions []MatchedPolicy   `json:"trafficPermissions"`
+}
+
+type OutboundPolicies struct {
+	Service      string         `json:"service"`
+	TrafficRoute *MatchedPolicy `json:"trafficRoute,omitempty"`
+	TrafficLog   *MatchedPolicy `json:"trafficLog,omitempty"`
+	HealthCheck  *MatchedPolicy `json:"healthCheck,omitempty"`
+}
+
+type MatchedPolicy struct {
+	Type  string     `json:"type"`
+	Name  string     `json:"name"`
+	Score MatchScore `json:"score"`
+}
+
+// MatchScore tells how specific the selector that matched a policy is.
+// The more tags match, the higher the score. Exact matches win over wildcard ('*') ones.
+type MatchScore struct {
+	ExactMatches    int `json:"exactMatches"`
+	WildcardMatches int `json:"wildcardMatches"`
+}
@@ -7,6 +7,7 @@ import (
 	api_server "github.com/Kong/kuma/pkg/config/api-server"
 	"github.com/Kong/kuma/pkg/config/core"
 	"github.com/Kong/kuma/pkg/config/core/resources/store"
+	dns_server "github.com/Kong/kuma/pkg/config/dns-server"
 	gui_server "github.com/Kong/kuma/pkg/config/gui-server"
 	"github.com/Kong/kuma/pkg/config/mads"
 	"github.com/Kong/kuma/pkg/config/plugins/runtime"
@@ -73,6 +74,8 @@ type Config struct {
 	DataplaneTokenServer *token_server.DataplaneTokenServerConfig `yaml:"dataplaneTokenServer"`
 	// Monitoring Assignment Discovery Service (MADS) server configuration
 	MonitoringAssignmentServer *mads.MonitoringAssignmentServerConfig `yaml:"monitoringAssignmentServer"`
+	// DNS Server configuration
+	DNSServer *dns_server.DNSServerConfig `yaml:"dnsServer"`
 	// Admin server configuration
 	AdminServer *admin_server.AdminServerConfig `yaml:"adminServer"`
 	// API Server configuration
@@ -95,6 +98,7 @@ func (c *Config) Sanitize() {
 	c.SdsServer.Sanitize()
 	c.DataplaneTokenServer.Sanitize()
 	c.MonitoringAssignmentServer.Sanitize()
+	c.DNSServer.Sanitize()
 	c.AdminServer.Sanitize()
 	c.ApiServer.Sanitize()
 	c.Runtime.Sanitize()
@@ -110,6 +114,7 @@ func DefaultConfig() Config {
 		SdsServer:                  sds.DefaultSdsServerConfig(),
 		DataplaneTokenServer:       token_server.DefaultDataplaneTokenServerConfig(),
 		MonitoringAssignmentServer: mads.DefaultMonitoringAssignmentServerConfig(),
+		DNSServer:                  dns_server.DefaultDNSServerConfig(),
 		AdminServer:                admin_server.DefaultAdminServerConfig(),
 		ApiServer:                  api_server.DefaultApiServerConfig(),
 		BootstrapServer:            bootstrap.DefaultBootstrapServerConfig(),
@@ -146,6 +151,9 @@ func (c *Config) Validate() error {
 	if err := c.MonitoringAssignmentServer.Validate(); err != nil {
 		return errors.Wrap(err, "Monitoring Assignment Server validation failed")
 	}
+	if err := c.DNSServer.Validate(); err != nil {
+		return errors.Wrap(err, "DNS Server validation failed")
+	}
 	if err := c.AdminServer.Validate(); err != nil {
 		return errors.Wrap(err, "Admin Server validation failed")
 	}
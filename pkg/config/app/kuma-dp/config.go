@@ -1,7 +1,10 @@
 package kumadp
 
 import (
+	"net"
 	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -25,8 +28,34 @@ func DefaultConfig() Config {
 			DrainTime: 30 * time.Second,
 		},
 		DataplaneRuntime: DataplaneRuntime{
-			BinaryPath: "envoy",
-			ConfigDir:  "", // if left empty, a temporary directory will be generated automatically
+			BinaryPath:    "envoy",
+			ConfigDir:     "", // if left empty, a temporary directory will be generated automatically
+			XdsApiVersion: "v2",
+			Restart: EnvoyRestart{
+				Enabled:            true,
+				InitialBackoff:     1 * time.Second,
+				MaxBackoff:         30 * time.Second,
+				CrashLoopThreshold: 5,
+				CrashLoopPeriod:    5 * time.Minute,
+			},
+			DNS: DNS{
+				Enabled:         false,
+				Address:         "127.0.0.1:15053",
+				Upstream:        "", // if left empty, the first nameserver from /etc/resolv.conf is used
+				RefreshInterval: 5 * time.Second,
+			},
+			AccessLogs: AccessLogs{
+				QueueSize:      10000,
+				InitialBackoff: 1 * time.Second,
+				MaxBackoff:     30 * time.Second,
+				SpillDir:       "", // if left empty, log entries that do not fit into the queue are dropped
+				MaxSpillSize:   100 * 1024 * 1024,
+			},
+			Metrics: Metrics{
+				Address: "", // if left empty, metrics of kuma-dp are not exposed
+				AppPort: 0,  // if left empty, metrics of the application are not merged
+				AppPath: "/metrics",
+			},
 		},
 	}
 }
@@ -51,6 +80,17 @@ func (c *Config) Sanitize() {
 type ControlPlane struct {
 	// ApiServer defines coordinates of the Control Plane API Server
 	ApiServer ApiServer `yaml:"apiServer,omitempty"`
+	// BootstrapServer defines how the Control Plane Bootstrap Server is verified
+	BootstrapServer BootstrapServer `yaml:"bootstrapServer,omitempty"`
+}
+
+// BootstrapServer defines how the Control Plane Bootstrap Server is verified when it is served over TLS.
+// If neither CA certificate is set, the Bootstrap Server is verified with system CA certificates.
+type BootstrapServer struct {
+	// Path to a file with a PEM-encoded CA certificate that the Bootstrap Server is verified with.
+	CaCertFile string `yaml:"caCertFile,omitempty" envconfig:"kuma_control_plane_bootstrap_server_ca_cert_file"`
+	// PEM-encoded CA certificate that the Bootstrap Server is verified with. It is an alternative to CaCertFile.
+	CaCert string `yaml:"caCert,omitempty" envconfig:"kuma_control_plane_bootstrap_server_ca_cert"`
 }
 
 type ApiServer struct {
@@ -80,6 +120,86 @@ type DataplaneRuntime struct {
 	ConfigDir string `yaml:"configDir,omitempty" envconfig:"kuma_dataplane_runtime_config_dir"`
 	// Path to a file with dataplane token (use 'kumactl generate dataplane-token' to get one)
 	TokenPath string `yaml:"dataplaneTokenPath,omitempty" envconfig:"kuma_dataplane_runtime_token_path"`
+	// Interval of checking whether dataplane token has changed. Once it has changed, dataplane (Envoy) is restarted
+	// to reconnect to the Control Plane with the new token. Zero value (default) disables watching dataplane token.
+	// Enable it only for tokens that are rotated before they expire, since Envoy is restarted cold unless hot restart is enabled.
+	TokenWatchInterval time.Duration `yaml:"dataplaneTokenWatchInterval,omitempty" envconfig:"kuma_dataplane_runtime_token_watch_interval"`
+	// Version of Envoy xDS API that dataplane (Envoy) should use: "v2" or "v3".
+	// Envoy xDS v3 API requires Envoy 1.14+.
+	XdsApiVersion string `yaml:"xdsApiVersion,omitempty" envconfig:"kuma_dataplane_runtime_xds_api_version"`
+	// Path to a file with a Dataplane resource template. If set, Dataplane is created or updated on start.
+	DataplaneFile string `yaml:"dataplaneFile,omitempty" envconfig:"kuma_dataplane_runtime_dataplane_file"`
+	// Values of variables in a Dataplane resource template, e.g. `address: {{ address }}`.
+	DataplaneVars map[string]string `yaml:"dataplaneVars,omitempty" envconfig:"kuma_dataplane_runtime_dataplane_vars"`
+	// If true, Dataplane created from a Dataplane resource template is deleted on clean shutdown.
+	DeleteDataplaneOnExit bool `yaml:"deleteDataplaneOnExit,omitempty" envconfig:"kuma_dataplane_runtime_delete_dataplane_on_exit"`
+	// Restart defines how dataplane (Envoy) is restarted once it terminates unexpectedly.
+	Restart EnvoyRestart `yaml:"restart,omitempty"`
+	// DNS defines DNS server embedded into kuma-dp that resolves services of the mesh to their virtual IPs.
+	DNS DNS `yaml:"dns,omitempty"`
+	// AccessLogs defines how kuma-dp delivers access logs to TCP logging backends.
+	AccessLogs AccessLogs `yaml:"accessLogs,omitempty"`
+	// Metrics defines the endpoint that exposes metrics of kuma-dp itself.
+	Metrics Metrics `yaml:"metrics,omitempty"`
+}
+
+// AccessLogs defines how kuma-dp delivers access logs to TCP logging backends.
+//
+// Log entries are buffered in memory while a logging backend is unavailable
+// and kuma-dp keeps reconnecting to it with a back-off.
+type AccessLogs struct {
+	// Maximum number of log entries buffered in memory per logging backend.
+	QueueSize int `yaml:"queueSize,omitempty" envconfig:"kuma_dataplane_runtime_access_logs_queue_size"`
+	// Delay before the first reconnect to a logging backend. It doubles with every failed attempt.
+	InitialBackoff time.Duration `yaml:"initialBackoff,omitempty" envconfig:"kuma_dataplane_runtime_access_logs_initial_backoff"`
+	// Maximum delay before a reconnect to a logging backend.
+	MaxBackoff time.Duration `yaml:"maxBackoff,omitempty" envconfig:"kuma_dataplane_runtime_access_logs_max_backoff"`
+	// Directory to spill log entries that do not fit into the in-memory queue to. If empty, such log entries are dropped.
+	SpillDir string `yaml:"spillDir,omitempty" envconfig:"kuma_dataplane_runtime_access_logs_spill_dir"`
+	// Maximum size (in bytes) of spilled log entries per logging backend.
+	MaxSpillSize int64 `yaml:"maxSpillSize,omitempty" envconfig:"kuma_dataplane_runtime_access_logs_max_spill_size"`
+}
+
+// Metrics defines the endpoint that exposes metrics of kuma-dp itself in Prometheus format.
+type Metrics struct {
+	// Address for the metrics endpoint to listen on, e.g. "127.0.0.1:9902". If empty, the endpoint is disabled.
+	// It has to be a loopback address, since the endpoint is meant to be reached only through Envoy.
+	Address string `yaml:"address,omitempty" envconfig:"kuma_dataplane_runtime_metrics_address"`
+	// Port on which the application exposes its own metrics on the loopback interface.
+	// If set, metrics of the application are merged with metrics of Envoy and of kuma-dp.
+	AppPort uint32 `yaml:"appPort,omitempty" envconfig:"kuma_dataplane_runtime_metrics_app_port"`
+	// Path on which the application exposes its own metrics.
+	AppPath string `yaml:"appPath,omitempty" envconfig:"kuma_dataplane_runtime_metrics_app_path"`
+}
+
+// DNS defines DNS server embedded into kuma-dp that resolves `<service>.<domain>` to a virtual IP of the service.
+// Queries for other names are forwarded to the upstream DNS server.
+type DNS struct {
+	// If true, kuma-dp runs DNS server.
+	Enabled bool `yaml:"enabled,omitempty" envconfig:"kuma_dataplane_runtime_dns_enabled"`
+	// Address for DNS server to listen on (UDP and TCP).
+	Address string `yaml:"address,omitempty" envconfig:"kuma_dataplane_runtime_dns_address"`
+	// Address of the upstream DNS server, e.g. "8.8.8.8:53". If empty, the first nameserver from /etc/resolv.conf is used.
+	Upstream string `yaml:"upstream,omitempty" envconfig:"kuma_dataplane_runtime_dns_upstream"`
+	// Interval of fetching virtual IPs of services from the Control Plane.
+	RefreshInterval time.Duration `yaml:"refreshInterval,omitempty" envconfig:"kuma_dataplane_runtime_dns_refresh_interval"`
+}
+
+// EnvoyRestart defines how dataplane (Envoy) is restarted once it terminates unexpectedly.
+type EnvoyRestart struct {
+	// If false, kuma-dp exits once dataplane (Envoy) terminates.
+	Enabled bool `yaml:"enabled,omitempty" envconfig:"kuma_dataplane_runtime_restart_enabled"`
+	// Delay before the first restart. It doubles with every crash within CrashLoopPeriod.
+	InitialBackoff time.Duration `yaml:"initialBackoff,omitempty" envconfig:"kuma_dataplane_runtime_restart_initial_backoff"`
+	// Maximum delay before a restart.
+	MaxBackoff time.Duration `yaml:"maxBackoff,omitempty" envconfig:"kuma_dataplane_runtime_restart_max_backoff"`
+	// Number of crashes within CrashLoopPeriod after which dataplane (Envoy) is reported to the Control Plane as crash looping.
+	CrashLoopThreshold int `yaml:"crashLoopThreshold,omitempty" envconfig:"kuma_dataplane_runtime_restart_crash_loop_threshold"`
+	// Period in which crashes are counted.
+	CrashLoopPeriod time.Duration `yaml:"crashLoopPeriod,omitempty" envconfig:"kuma_dataplane_runtime_restart_crash_loop_period"`
+	// Interval of regenerating bootstrap configuration. If it has changed, dataplane (Envoy) is hot restarted to pick it up.
+	// Zero value disables hot restarts. Hot restarts require Envoy Admin API to be exposed over TCP.
+	BootstrapRefreshInterval time.Duration `yaml:"bootstrapRefreshInterval,omitempty" envconfig:"kuma_dataplane_runtime_restart_bootstrap_refresh_interval"`
 }
 
 var _ config.Config = &Config{}
@@ -101,12 +221,16 @@ var _ config.Config = &ControlPlane{}
 
 func (c *ControlPlane) Sanitize() {
 	c.ApiServer.Sanitize()
+	c.BootstrapServer.Sanitize()
 }
 
 func (c *ControlPlane) Validate() (errs error) {
 	if err := c.ApiServer.Validate(); err != nil {
 		errs = multierr.Append(errs, errors.Wrapf(err, ".ApiServer is not valid"))
 	}
+	if err := c.BootstrapServer.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".BootstrapServer is not valid"))
+	}
 	return
 }
 
@@ -138,6 +262,158 @@ func (d *DataplaneRuntime) Validate() (errs error) {
 	if d.BinaryPath == "" {
 		errs = multierr.Append(errs, errors.Errorf(".BinaryPath must be non-empty"))
 	}
+	if d.XdsApiVersion != "v2" && d.XdsApiVersion != "v3" {
+		errs = multierr.Append(errs, errors.Errorf(".XdsApiVersion must be either v2 or v3"))
+	}
+	if d.TokenWatchInterval < 0 {
+		errs = multierr.Append(errs, errors.Errorf(".TokenWatchInterval must not be negative"))
+	}
+	if d.DeleteDataplaneOnExit && d.DataplaneFile == "" {
+		errs = multierr.Append(errs, errors.Errorf(".DeleteDataplaneOnExit requires .DataplaneFile to be set"))
+	}
+	if err := d.Restart.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".Restart is not valid"))
+	}
+	if err := d.DNS.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".DNS is not valid"))
+	}
+	if err := d.AccessLogs.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".AccessLogs is not valid"))
+	}
+	if err := d.Metrics.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".Metrics is not valid"))
+	}
+	return
+}
+
+var _ config.Config = &AccessLogs{}
+
+func (a *AccessLogs) Sanitize() {
+}
+
+func (a *AccessLogs) Validate() (errs error) {
+	if a.QueueSize <= 0 {
+		errs = multierr.Append(errs, errors.Errorf(".QueueSize must be positive"))
+	}
+	if a.InitialBackoff <= 0 {
+		errs = multierr.Append(errs, errors.Errorf(".InitialBackoff must be positive"))
+	}
+	if a.MaxBackoff < a.InitialBackoff {
+		errs = multierr.Append(errs, errors.Errorf(".MaxBackoff must not be less than .InitialBackoff"))
+	}
+	if a.SpillDir != "" && a.MaxSpillSize <= 0 {
+		errs = multierr.Append(errs, errors.Errorf(".MaxSpillSize must be positive when .SpillDir is set"))
+	}
+	return
+}
+
+var _ config.Config = &Metrics{}
+
+func (m *Metrics) Sanitize() {
+}
+
+func (m *Metrics) Validate() (errs error) {
+	if m.Address != "" {
+		if host, _, err := net.SplitHostPort(m.Address); err != nil {
+			errs = multierr.Append(errs, errors.Errorf(".Address must be either empty or a valid host:port"))
+		} else if !isLoopback(host) {
+			errs = multierr.Append(errs, errors.Errorf(".Address must be a loopback address, e.g. 127.0.0.1:9902"))
+		}
+	}
+	if m.AppPort != 0 {
+		if m.Address == "" {
+			errs = multierr.Append(errs, errors.Errorf(".AppPort requires .Address to be set"))
+		}
+		if 65535 < m.AppPort {
+			errs = multierr.Append(errs, errors.Errorf(".AppPort must be in the range [0, 65535]"))
+		}
+		if !strings.HasPrefix(m.AppPath, "/") {
+			errs = multierr.Append(errs, errors.Errorf(".AppPath must start with '/'"))
+		}
+	}
+	return
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// Port returns the port of the metrics endpoint or 0 if the endpoint is disabled.
+func (m *Metrics) Port() uint32 {
+	_, value, err := net.SplitHostPort(m.Address)
+	if err != nil {
+		return 0
+	}
+	port, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		return 0
+	}
+	return uint32(port)
+}
+
+var _ config.Config = &DNS{}
+
+func (d *DNS) Sanitize() {
+}
+
+func (d *DNS) Validate() (errs error) {
+	if !d.Enabled {
+		return
+	}
+	if _, _, err := net.SplitHostPort(d.Address); err != nil {
+		errs = multierr.Append(errs, errors.Errorf(".Address must be a valid host:port"))
+	}
+	if d.Upstream != "" {
+		if _, _, err := net.SplitHostPort(d.Upstream); err != nil {
+			errs = multierr.Append(errs, errors.Errorf(".Upstream must be either empty or a valid host:port"))
+		}
+	}
+	if d.RefreshInterval <= 0 {
+		errs = multierr.Append(errs, errors.Errorf(".RefreshInterval must be positive"))
+	}
+	return
+}
+
+var _ config.Config = &EnvoyRestart{}
+
+func (r *EnvoyRestart) Sanitize() {
+}
+
+func (r *EnvoyRestart) Validate() (errs error) {
+	if r.BootstrapRefreshInterval < 0 {
+		errs = multierr.Append(errs, errors.Errorf(".BootstrapRefreshInterval must not be negative"))
+	}
+	if !r.Enabled {
+		return
+	}
+	if r.InitialBackoff <= 0 {
+		errs = multierr.Append(errs, errors.Errorf(".InitialBackoff must be positive"))
+	}
+	if r.MaxBackoff < r.InitialBackoff {
+		errs = multierr.Append(errs, errors.Errorf(".MaxBackoff must not be less than .InitialBackoff"))
+	}
+	if r.CrashLoopThreshold <= 0 {
+		errs = multierr.Append(errs, errors.Errorf(".CrashLoopThreshold must be positive"))
+	}
+	if r.CrashLoopPeriod <= 0 {
+		errs = multierr.Append(errs, errors.Errorf(".CrashLoopPeriod must be positive"))
+	}
+	return
+}
+
+var _ config.Config = &BootstrapServer{}
+
+func (b *BootstrapServer) Sanitize() {
+}
+
+func (b *BootstrapServer) Validate() (errs error) {
+	if b.CaCertFile != "" && b.CaCert != "" {
+		errs = multierr.Append(errs, errors.Errorf(".CaCertFile and .CaCert cannot be set at the same time"))
+	}
 	return
 }
 
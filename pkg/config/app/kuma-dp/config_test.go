@@ -28,8 +28,37 @@ var _ = Describe("Config", func() {
 
 		// and
 		Expect(cfg.ControlPlane.ApiServer.URL).To(Equal("https://kuma-control-plane.internal:5682"))
+		Expect(cfg.ControlPlane.BootstrapServer.CaCertFile).To(Equal("/tmp/ca.crt"))
 		Expect(cfg.Dataplane.AdminPort).To(Equal(config_types.MustExactPort(2345)))
 		Expect(cfg.Dataplane.DrainTime).To(Equal(60 * time.Second))
+		Expect(cfg.DataplaneRuntime.XdsApiVersion).To(Equal("v3"))
+		Expect(cfg.DataplaneRuntime.TokenWatchInterval).To(Equal(time.Minute))
+		Expect(cfg.DataplaneRuntime.Restart).To(Equal(kuma_dp.EnvoyRestart{
+			Enabled:                  true,
+			InitialBackoff:           2 * time.Second,
+			MaxBackoff:               time.Minute,
+			CrashLoopThreshold:       3,
+			CrashLoopPeriod:          10 * time.Minute,
+			BootstrapRefreshInterval: 30 * time.Second,
+		}))
+		Expect(cfg.DataplaneRuntime.DNS).To(Equal(kuma_dp.DNS{
+			Enabled:         true,
+			Address:         "127.0.0.1:5353",
+			Upstream:        "8.8.8.8:53",
+			RefreshInterval: 10 * time.Second,
+		}))
+		Expect(cfg.DataplaneRuntime.AccessLogs).To(Equal(kuma_dp.AccessLogs{
+			QueueSize:      500,
+			InitialBackoff: 100 * time.Millisecond,
+			MaxBackoff:     10 * time.Second,
+			SpillDir:       "/var/spool/kuma-dp",
+			MaxSpillSize:   1048576,
+		}))
+		Expect(cfg.DataplaneRuntime.Metrics).To(Equal(kuma_dp.Metrics{
+			Address: "127.0.0.1:9902",
+			AppPort: 8080,
+			AppPath: "/stats",
+		}))
 	})
 
 	Context("with modified environment variables", func() {
@@ -51,14 +80,38 @@ var _ = Describe("Config", func() {
 		It("should be loadable from environment variables", func() {
 			// setup
 			env := map[string]string{
-				"KUMA_CONTROL_PLANE_API_SERVER_URL":  "https://kuma-control-plane.internal:5682",
-				"KUMA_DATAPLANE_MESH":                "demo",
-				"KUMA_DATAPLANE_NAME":                "example",
-				"KUMA_DATAPLANE_ADMIN_PORT":          "2345",
-				"KUMA_DATAPLANE_DRAIN_TIME":          "60s",
-				"KUMA_DATAPLANE_RUNTIME_BINARY_PATH": "envoy.sh",
-				"KUMA_DATAPLANE_RUNTIME_CONFIG_DIR":  "/var/run/envoy",
-				"KUMA_DATAPLANE_RUNTIME_TOKEN_PATH":  "/tmp/token",
+				"KUMA_CONTROL_PLANE_API_SERVER_URL":                         "https://kuma-control-plane.internal:5682",
+				"KUMA_CONTROL_PLANE_BOOTSTRAP_SERVER_CA_CERT":               "-----BEGIN CERTIFICATE-----",
+				"KUMA_DATAPLANE_MESH":                                       "demo",
+				"KUMA_DATAPLANE_NAME":                                       "example",
+				"KUMA_DATAPLANE_ADMIN_PORT":                                 "2345",
+				"KUMA_DATAPLANE_DRAIN_TIME":                                 "60s",
+				"KUMA_DATAPLANE_RUNTIME_BINARY_PATH":                        "envoy.sh",
+				"KUMA_DATAPLANE_RUNTIME_CONFIG_DIR":                         "/var/run/envoy",
+				"KUMA_DATAPLANE_RUNTIME_TOKEN_PATH":                         "/tmp/token",
+				"KUMA_DATAPLANE_RUNTIME_TOKEN_WATCH_INTERVAL":               "1m",
+				"KUMA_DATAPLANE_RUNTIME_XDS_API_VERSION":                    "v3",
+				"KUMA_DATAPLANE_RUNTIME_DATAPLANE_FILE":                     "/tmp/dataplane.yaml",
+				"KUMA_DATAPLANE_RUNTIME_DATAPLANE_VARS":                     "address:192.168.0.1,port:8080",
+				"KUMA_DATAPLANE_RUNTIME_DELETE_DATAPLANE_ON_EXIT":           "true",
+				"KUMA_DATAPLANE_RUNTIME_RESTART_ENABLED":                    "true",
+				"KUMA_DATAPLANE_RUNTIME_RESTART_INITIAL_BACKOFF":            "2s",
+				"KUMA_DATAPLANE_RUNTIME_RESTART_MAX_BACKOFF":                "1m",
+				"KUMA_DATAPLANE_RUNTIME_RESTART_CRASH_LOOP_THRESHOLD":       "3",
+				"KUMA_DATAPLANE_RUNTIME_RESTART_CRASH_LOOP_PERIOD":          "10m",
+				"KUMA_DATAPLANE_RUNTIME_RESTART_BOOTSTRAP_REFRESH_INTERVAL": "30s",
+				"KUMA_DATAPLANE_RUNTIME_DNS_ENABLED":                        "true",
+				"KUMA_DATAPLANE_RUNTIME_DNS_ADDRESS":                        "127.0.0.1:5353",
+				"KUMA_DATAPLANE_RUNTIME_DNS_UPSTREAM":                       "8.8.8.8:53",
+				"KUMA_DATAPLANE_RUNTIME_DNS_REFRESH_INTERVAL":               "10s",
+				"KUMA_DATAPLANE_RUNTIME_ACCESS_LOGS_QUEUE_SIZE":             "500",
+				"KUMA_DATAPLANE_RUNTIME_ACCESS_LOGS_INITIAL_BACKOFF":        "100ms",
+				"KUMA_DATAPLANE_RUNTIME_ACCESS_LOGS_MAX_BACKOFF":            "10s",
+				"KUMA_DATAPLANE_RUNTIME_ACCESS_LOGS_SPILL_DIR":              "/var/spool/kuma-dp",
+				"KUMA_DATAPLANE_RUNTIME_ACCESS_LOGS_MAX_SPILL_SIZE":         "1048576",
+				"KUMA_DATAPLANE_RUNTIME_METRICS_ADDRESS":                    "127.0.0.1:9902",
+				"KUMA_DATAPLANE_RUNTIME_METRICS_APP_PORT":                   "8080",
+				"KUMA_DATAPLANE_RUNTIME_METRICS_APP_PATH":                   "/stats",
 			}
 			for key, value := range env {
 				os.Setenv(key, value)
@@ -75,6 +128,7 @@ var _ = Describe("Config", func() {
 
 			// and
 			Expect(cfg.ControlPlane.ApiServer.URL).To(Equal("https://kuma-control-plane.internal:5682"))
+			Expect(cfg.ControlPlane.BootstrapServer.CaCert).To(Equal("-----BEGIN CERTIFICATE-----"))
 			Expect(cfg.Dataplane.Mesh).To(Equal("demo"))
 			Expect(cfg.Dataplane.Name).To(Equal("example"))
 			Expect(cfg.Dataplane.AdminPort).To(Equal(config_types.MustExactPort(2345)))
@@ -82,6 +136,37 @@ var _ = Describe("Config", func() {
 			Expect(cfg.DataplaneRuntime.BinaryPath).To(Equal("envoy.sh"))
 			Expect(cfg.DataplaneRuntime.ConfigDir).To(Equal("/var/run/envoy"))
 			Expect(cfg.DataplaneRuntime.TokenPath).To(Equal("/tmp/token"))
+			Expect(cfg.DataplaneRuntime.TokenWatchInterval).To(Equal(time.Minute))
+			Expect(cfg.DataplaneRuntime.XdsApiVersion).To(Equal("v3"))
+			Expect(cfg.DataplaneRuntime.DataplaneFile).To(Equal("/tmp/dataplane.yaml"))
+			Expect(cfg.DataplaneRuntime.DataplaneVars).To(Equal(map[string]string{"address": "192.168.0.1", "port": "8080"}))
+			Expect(cfg.DataplaneRuntime.DeleteDataplaneOnExit).To(BeTrue())
+			Expect(cfg.DataplaneRuntime.Restart).To(Equal(kuma_dp.EnvoyRestart{
+				Enabled:                  true,
+				InitialBackoff:           2 * time.Second,
+				MaxBackoff:               time.Minute,
+				CrashLoopThreshold:       3,
+				CrashLoopPeriod:          10 * time.Minute,
+				BootstrapRefreshInterval: 30 * time.Second,
+			}))
+			Expect(cfg.DataplaneRuntime.DNS).To(Equal(kuma_dp.DNS{
+				Enabled:         true,
+				Address:         "127.0.0.1:5353",
+				Upstream:        "8.8.8.8:53",
+				RefreshInterval: 10 * time.Second,
+			}))
+			Expect(cfg.DataplaneRuntime.AccessLogs).To(Equal(kuma_dp.AccessLogs{
+				QueueSize:      500,
+				InitialBackoff: 100 * time.Millisecond,
+				MaxBackoff:     10 * time.Second,
+				SpillDir:       "/var/spool/kuma-dp",
+				MaxSpillSize:   1048576,
+			}))
+			Expect(cfg.DataplaneRuntime.Metrics).To(Equal(kuma_dp.Metrics{
+				Address: "127.0.0.1:9902",
+				AppPort: 8080,
+				AppPath: "/stats",
+			}))
 		})
 	})
 
@@ -110,6 +195,6 @@ var _ = Describe("Config", func() {
 		err := config.Load(filepath.Join("testdata", "invalid-config.input.yaml"), &cfg)
 
 		// then
-		Expect(err).To(MatchError(`Invalid configuration: .ControlPlane is not valid: .ApiServer is not valid: .URL must be a valid absolute URI; .Dataplane is not valid: .Mesh must be non-empty; .Name must be non-empty; .DrainTime must be positive; .DataplaneRuntime is not valid: .BinaryPath must be non-empty`))
+		Expect(err).To(MatchError(`Invalid configuration: .ControlPlane is not valid: .ApiServer is not valid: .URL must be a valid absolute URI; .BootstrapServer is not valid: .CaCertFile and .CaCert cannot be set at the same time; .Dataplane is not valid: .Mesh must be non-empty; .Name must be non-empty; .DrainTime must be positive; .DataplaneRuntime is not valid: .BinaryPath must be non-empty; .XdsApiVersion must be either v2 or v3; .TokenWatchInterval must not be negative; .DeleteDataplaneOnExit requires .DataplaneFile to be set; .Restart is not valid: .InitialBackoff must be positive; .MaxBackoff must not be less than .InitialBackoff; .CrashLoopThreshold must be positive; .CrashLoopPeriod must be positive; .DNS is not valid: .Address must be a valid host:port; .Upstream must be either empty or a valid host:port; .RefreshInterval must be positive; .AccessLogs is not valid: .QueueSize must be positive; .InitialBackoff must be positive; .MaxBackoff must not be less than .InitialBackoff; .MaxSpillSize must be positive when .SpillDir is set; .Metrics is not valid: .Address must be a loopback address, e.g. 127.0.0.1:9902; .AppPort must be in the range [0, 65535]; .AppPath must start with '/'`))
 	})
 })
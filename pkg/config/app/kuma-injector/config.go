@@ -35,6 +35,7 @@ func DefaultConfig() Config {
 				UID:          5678,
 				GID:          5678,
 				AdminPort:    9901,
+				MetricsPort:  9902,
 				DrainTime:    30 * time.Second,
 
 				ReadinessProbe: SidecarReadinessProbe{
@@ -105,6 +106,14 @@ type Injector struct {
 type ControlPlane struct {
 	// ApiServer defines coordinates of the Control Plane API Server.
 	ApiServer ApiServer `yaml:"apiServer,omitempty"`
+	// BootstrapServer defines how Kuma sidecars verify the Control Plane Bootstrap Server.
+	BootstrapServer BootstrapServer `yaml:"bootstrapServer,omitempty"`
+}
+
+// BootstrapServer defines how Kuma sidecars verify the Control Plane Bootstrap Server served over TLS.
+type BootstrapServer struct {
+	// PEM-encoded CA certificate that the Bootstrap Server is verified with.
+	CaCert string `yaml:"caCert,omitempty" envconfig:"kuma_injector_control_plane_bootstrap_server_ca_cert"`
 }
 
 // ApiServer defines coordinates of the Control Plane API Server.
@@ -125,6 +134,8 @@ type SidecarContainer struct {
 	GID int64 `yaml:"gid,omitempty" envconfig:"kuma_injector_sidecar_container_gui"`
 	// Admin port.
 	AdminPort uint32 `yaml:"adminPort,omitempty" envconfig:"kuma_injector_sidecar_container_admin_port"`
+	// Port of the kuma-dp metrics endpoint. It is enabled only for Pods that expose metrics of the application.
+	MetricsPort uint32 `yaml:"metricsPort,omitempty" envconfig:"kuma_injector_sidecar_container_metrics_port"`
 	// Drain time for listeners.
 	DrainTime time.Duration `yaml:"drainTime,omitempty" envconfig:"kuma_injector_sidecar_container_drain_time"`
 	// Readiness probe.
@@ -292,6 +303,9 @@ func (c *SidecarContainer) Validate() (errs error) {
 	if 65535 < c.AdminPort {
 		errs = multierr.Append(errs, errors.Errorf(".AdminPort must be in the range [0, 65535]"))
 	}
+	if 65535 < c.MetricsPort {
+		errs = multierr.Append(errs, errors.Errorf(".MetricsPort must be in the range [0, 65535]"))
+	}
 	if c.DrainTime <= 0 {
 		errs = multierr.Append(errs, errors.Errorf(".DrainTime must be positive"))
 	}
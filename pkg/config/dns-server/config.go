@@ -0,0 +1,61 @@
+package dns_server
+
+import (
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/Kong/kuma/pkg/config"
+)
+
+func DefaultDNSServerConfig() *DNSServerConfig {
+	return &DNSServerConfig{
+		Enabled:            false,
+		Domain:             "mesh",
+		CIDR:               "240.0.0.0/4",
+		VIPPort:            80,
+		VIPRefreshInterval: 5 * time.Second,
+	}
+}
+
+// DNS Server configuration.
+//
+// DNS Server is embedded into kuma-dp. It resolves `<service>.<domain>` to virtual IPs allocated by the Control Plane.
+type DNSServerConfig struct {
+	// If true, the Control Plane allocates virtual IPs to services and generates outbound listeners on them
+	// for Dataplanes with transparent proxying. Not supported on Kubernetes, where services have their own ClusterIPs.
+	Enabled bool `yaml:"enabled" envconfig:"kuma_dns_server_enabled"`
+	// Domain that services in a mesh are resolved in, e.g. `backend.mesh`.
+	Domain string `yaml:"domain" envconfig:"kuma_dns_server_domain"`
+	// CIDR that virtual IPs of services are allocated from.
+	CIDR string `yaml:"cidr" envconfig:"kuma_dns_server_cidr"`
+	// Port that services are available on at their virtual IPs.
+	VIPPort uint32 `yaml:"vipPort" envconfig:"kuma_dns_server_vip_port"`
+	// Interval of allocating virtual IPs to services that have been added to a mesh.
+	VIPRefreshInterval time.Duration `yaml:"vipRefreshInterval" envconfig:"kuma_dns_server_vip_refresh_interval"`
+}
+
+var _ config.Config = &DNSServerConfig{}
+
+func (c *DNSServerConfig) Sanitize() {
+}
+
+func (c *DNSServerConfig) Validate() (errs error) {
+	if c.Domain == "" {
+		errs = multierr.Append(errs, errors.New(".Domain must be non-empty"))
+	}
+	if _, cidr, err := net.ParseCIDR(c.CIDR); err != nil {
+		errs = multierr.Append(errs, errors.Wrap(err, ".CIDR must be a valid CIDR"))
+	} else if cidr.IP.To4() == nil {
+		errs = multierr.Append(errs, errors.New(".CIDR must be an IPv4 CIDR"))
+	}
+	if c.VIPPort == 0 || c.VIPPort > 65535 {
+		errs = multierr.Append(errs, errors.New(".VIPPort must be in the range [1, 65535]"))
+	}
+	if c.VIPRefreshInterval <= 0 {
+		errs = multierr.Append(errs, errors.New(".VIPRefreshInterval must be positive"))
+	}
+	return
+}
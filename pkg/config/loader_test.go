@@ -67,6 +67,8 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.XdsServer.DiagnosticsPort).To(Equal(5003))
 
 			Expect(cfg.BootstrapServer.Port).To(Equal(uint32(5004)))
+			Expect(cfg.BootstrapServer.TlsCertFile).To(Equal("/tmp/bootstrap/cert"))
+			Expect(cfg.BootstrapServer.TlsKeyFile).To(Equal("/tmp/bootstrap/key"))
 			Expect(cfg.BootstrapServer.Params.AdminPort).To(Equal(uint32(1234)))
 			Expect(cfg.BootstrapServer.Params.XdsHost).To(Equal("kuma-control-plane"))
 			Expect(cfg.BootstrapServer.Params.XdsPort).To(Equal(uint32(4321)))
@@ -106,6 +108,12 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.MonitoringAssignmentServer.GrpcPort).To(Equal(uint32(3333)))
 			Expect(cfg.MonitoringAssignmentServer.AssignmentRefreshInterval).To(Equal(12 * time.Second))
 
+			Expect(cfg.DNSServer.Enabled).To(BeTrue())
+			Expect(cfg.DNSServer.Domain).To(Equal("kuma"))
+			Expect(cfg.DNSServer.CIDR).To(Equal("250.0.0.0/8"))
+			Expect(cfg.DNSServer.VIPPort).To(Equal(uint32(8080)))
+			Expect(cfg.DNSServer.VIPRefreshInterval).To(Equal(7 * time.Second))
+
 			Expect(cfg.AdminServer.Apis.DataplaneToken.Enabled).To(BeTrue())
 			Expect(cfg.AdminServer.Local.Port).To(Equal(uint32(1111)))
 			Expect(cfg.AdminServer.Public.Enabled).To(BeTrue())
@@ -118,6 +126,7 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Runtime.Kubernetes.AdmissionServer.Address).To(Equal("127.0.0.2"))
 			Expect(cfg.Runtime.Kubernetes.AdmissionServer.Port).To(Equal(uint32(9443)))
 			Expect(cfg.Runtime.Kubernetes.AdmissionServer.CertDir).To(Equal("/var/run/secrets/kuma.io/kuma-admission-server/tls-cert"))
+			Expect(cfg.Runtime.Universal.DataplaneCleanupAge).To(Equal(24 * time.Hour))
 
 			Expect(cfg.Reports.Enabled).To(BeFalse())
 
@@ -153,6 +162,8 @@ xdsServer:
   diagnosticsPort: 5003
 bootstrapServer:
   port: 5004
+  tlsCertFile: /tmp/bootstrap/cert
+  tlsKeyFile: /tmp/bootstrap/key
   params:
     adminPort: 1234
     xdsHost: kuma-control-plane
@@ -177,6 +188,12 @@ dataplaneTokenServer:
 monitoringAssignmentServer:
   grpcPort: 3333
   assignmentRefreshInterval: 12s
+dnsServer:
+  enabled: true
+  domain: kuma
+  cidr: 250.0.0.0/8
+  vipPort: 8080
+  vipRefreshInterval: 7s
 adminServer:
   local:
     port: 1111
@@ -196,6 +213,8 @@ runtime:
       address: 127.0.0.2
       port: 9443
       certDir: /var/run/secrets/kuma.io/kuma-admission-server/tls-cert
+  universal:
+    dataplaneCleanupAge: 24h
 reports:
   enabled: false
 general:
@@ -210,6 +229,8 @@ guiServer:
 				"KUMA_XDS_SERVER_GRPC_PORT":                                     "5000",
 				"KUMA_XDS_SERVER_DIAGNOSTICS_PORT":                              "5003",
 				"KUMA_BOOTSTRAP_SERVER_PORT":                                    "5004",
+				"KUMA_BOOTSTRAP_SERVER_TLS_CERT_FILE":                           "/tmp/bootstrap/cert",
+				"KUMA_BOOTSTRAP_SERVER_TLS_KEY_FILE":                            "/tmp/bootstrap/key",
 				"KUMA_BOOTSTRAP_SERVER_PARAMS_ADMIN_PORT":                       "1234",
 				"KUMA_BOOTSTRAP_SERVER_PARAMS_XDS_HOST":                         "kuma-control-plane",
 				"KUMA_BOOTSTRAP_SERVER_PARAMS_XDS_PORT":                         "4321",
@@ -240,6 +261,11 @@ guiServer:
 				"KUMA_DATAPLANE_TOKEN_SERVER_PUBLIC_CLIENT_CERTS_DIR":           "/tmp/certs",
 				"KUMA_MONITORING_ASSIGNMENT_SERVER_GRPC_PORT":                   "3333",
 				"KUMA_MONITORING_ASSIGNMENT_SERVER_ASSIGNMENT_REFRESH_INTERVAL": "12s",
+				"KUMA_DNS_SERVER_ENABLED":                                       "true",
+				"KUMA_DNS_SERVER_DOMAIN":                                        "kuma",
+				"KUMA_DNS_SERVER_CIDR":                                          "250.0.0.0/8",
+				"KUMA_DNS_SERVER_VIP_PORT":                                      "8080",
+				"KUMA_DNS_SERVER_VIP_REFRESH_INTERVAL":                          "7s",
 				"KUMA_ADMIN_SERVER_APIS_DATAPLANE_TOKEN_ENABLED":                "true",
 				"KUMA_ADMIN_SERVER_LOCAL_PORT":                                  "1111",
 				"KUMA_ADMIN_SERVER_PUBLIC_ENABLED":                              "true",
@@ -252,6 +278,7 @@ guiServer:
 				"KUMA_KUBERNETES_ADMISSION_SERVER_ADDRESS":                      "127.0.0.2",
 				"KUMA_KUBERNETES_ADMISSION_SERVER_PORT":                         "9443",
 				"KUMA_KUBERNETES_ADMISSION_SERVER_CERT_DIR":                     "/var/run/secrets/kuma.io/kuma-admission-server/tls-cert",
+				"KUMA_UNIVERSAL_DATAPLANE_CLEANUP_AGE":                          "24h",
 				"KUMA_GENERAL_ADVERTISED_HOSTNAME":                              "kuma.internal",
 				"KUMA_API_SERVER_CORS_ALLOWED_DOMAINS":                          "https://kuma,https://someapi",
 				"KUMA_GUI_SERVER_PORT":                                          "8888",
@@ -5,11 +5,13 @@ import (
 
 	"github.com/Kong/kuma/pkg/config/core"
 	"github.com/Kong/kuma/pkg/config/plugins/runtime/k8s"
+	"github.com/Kong/kuma/pkg/config/plugins/runtime/universal"
 )
 
 func DefaultRuntimeConfig() *RuntimeConfig {
 	return &RuntimeConfig{
 		Kubernetes: k8s.DefaultKubernetesRuntimeConfig(),
+		Universal:  universal.DefaultUniversalRuntimeConfig(),
 	}
 }
 
@@ -17,10 +19,13 @@ func DefaultRuntimeConfig() *RuntimeConfig {
 type RuntimeConfig struct {
 	// Kubernetes-specific configuration
 	Kubernetes *k8s.KubernetesRuntimeConfig `yaml:"kubernetes"`
+	// Universal-specific configuration
+	Universal *universal.UniversalRuntimeConfig `yaml:"universal"`
 }
 
 func (c *RuntimeConfig) Sanitize() {
 	c.Kubernetes.Sanitize()
+	c.Universal.Sanitize()
 }
 
 func (c *RuntimeConfig) Validate(env core.EnvironmentType) error {
@@ -30,6 +35,9 @@ func (c *RuntimeConfig) Validate(env core.EnvironmentType) error {
 			return errors.Wrap(err, "Kubernetes validation failed")
 		}
 	case core.UniversalEnvironment:
+		if err := c.Universal.Validate(); err != nil {
+			return errors.Wrap(err, "Universal validation failed")
+		}
 	default:
 		return errors.Errorf("unknown environment type %q", env)
 	}
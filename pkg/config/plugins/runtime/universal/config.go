@@ -0,0 +1,35 @@
+package universal
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/pkg/config"
+)
+
+func DefaultUniversalRuntimeConfig() *UniversalRuntimeConfig {
+	return &UniversalRuntimeConfig{
+		DataplaneCleanupAge: 72 * time.Hour,
+	}
+}
+
+// Universal-specific configuration
+type UniversalRuntimeConfig struct {
+	// Dataplanes that have been offline for longer than this period are deleted together with their insights.
+	// Dataplanes with `cleanup.disabled: true` are never deleted.
+	// 0 disables the cleanup.
+	DataplaneCleanupAge time.Duration `yaml:"dataplaneCleanupAge" envconfig:"kuma_universal_dataplane_cleanup_age"`
+}
+
+var _ config.Config = &UniversalRuntimeConfig{}
+
+func (c *UniversalRuntimeConfig) Sanitize() {
+}
+
+func (c *UniversalRuntimeConfig) Validate() error {
+	if c.DataplaneCleanupAge < 0 {
+		return errors.New("DataplaneCleanupAge must be non-negative")
+	}
+	return nil
+}
@@ -16,7 +16,7 @@ func DefaultSdsServerConfig() *SdsServerConfig {
 type SdsServerConfig struct {
 	// Port of GRPC server that Envoy connects to
 	GrpcPort int `yaml:"grpcPort" envconfig:"kuma_sds_server_grpc_port"`
-	// TlsCertFile defines a path to a file with PEM-encoded TLS cert.
+	// TlsCertFile defines a path to a file with PEM-encoded TLS cert. The same cert is used by XDS server.
 	TlsCertFile string `yaml:"tlsCertFile" envconfig:"kuma_sds_server_tls_cert_file"`
 	// TlsKeyFile defines a path to a file with PEM-encoded TLS key.
 	TlsKeyFile string `yaml:"tlsKeyFile" envconfig:"kuma_sds_server_tls_key_file"`
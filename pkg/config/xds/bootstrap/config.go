@@ -14,6 +14,11 @@ var _ config.Config = &BootstrapServerConfig{}
 type BootstrapServerConfig struct {
 	// Port of Server that provides bootstrap configuration for dataplanes
 	Port uint32 `yaml:"port" envconfig:"kuma_bootstrap_server_port"`
+	// TlsCertFile defines a path to a file with PEM-encoded TLS cert. If set, Server is served over TLS,
+	// so that dataplane tokens and bootstrap configuration are never exchanged in plaintext.
+	TlsCertFile string `yaml:"tlsCertFile" envconfig:"kuma_bootstrap_server_tls_cert_file"`
+	// TlsKeyFile defines a path to a file with PEM-encoded TLS key.
+	TlsKeyFile string `yaml:"tlsKeyFile" envconfig:"kuma_bootstrap_server_tls_key_file"`
 	// Parameters of bootstrap configuration
 	Params *BootstrapParamsConfig `yaml:"params"`
 }
@@ -26,6 +31,12 @@ func (b *BootstrapServerConfig) Validate() error {
 	if b.Port > 65535 {
 		return errors.New("Port must be in the range [0, 65535]")
 	}
+	if b.TlsCertFile == "" && b.TlsKeyFile != "" {
+		return errors.New("TlsCertFile cannot be empty if TlsKeyFile has been set")
+	}
+	if b.TlsKeyFile == "" && b.TlsCertFile != "" {
+		return errors.New("TlsKeyFile cannot be empty if TlsCertFile has been set")
+	}
 	if err := b.Params.Validate(); err != nil {
 		return errors.Wrap(err, "Params validation failed")
 	}
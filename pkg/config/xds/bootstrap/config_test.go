@@ -27,6 +27,8 @@ var _ = Describe("BootstrappServerConfig", func() {
 
 		// and
 		Expect(cfg.Port).To(Equal(uint32(1234)))
+		Expect(cfg.TlsCertFile).To(Equal("/tmp/cert.pem"))
+		Expect(cfg.TlsKeyFile).To(Equal("/tmp/key.pem"))
 		Expect(cfg.Params.AdminAddress).To(Equal("192.168.0.1"))
 		Expect(cfg.Params.AdminPort).To(Equal(uint32(4321)))
 		Expect(cfg.Params.AdminAccessLogPath).To(Equal("/var/log"))
@@ -55,6 +57,8 @@ var _ = Describe("BootstrappServerConfig", func() {
 			// setup
 			env := map[string]string{
 				"KUMA_BOOTSTRAP_SERVER_PORT":                         "1234",
+				"KUMA_BOOTSTRAP_SERVER_TLS_CERT_FILE":                "/tmp/cert.pem",
+				"KUMA_BOOTSTRAP_SERVER_TLS_KEY_FILE":                 "/tmp/key.pem",
 				"KUMA_BOOTSTRAP_SERVER_PARAMS_ADMIN_ADDRESS":         "192.168.0.1",
 				"KUMA_BOOTSTRAP_SERVER_PARAMS_ADMIN_PORT":            "4321",
 				"KUMA_BOOTSTRAP_SERVER_PARAMS_ADMIN_ACCESS_LOG_PATH": "/var/log",
@@ -77,6 +81,8 @@ var _ = Describe("BootstrappServerConfig", func() {
 
 			// and
 			Expect(cfg.Port).To(Equal(uint32(1234)))
+			Expect(cfg.TlsCertFile).To(Equal("/tmp/cert.pem"))
+			Expect(cfg.TlsKeyFile).To(Equal("/tmp/key.pem"))
 			Expect(cfg.Params.AdminAddress).To(Equal("192.168.0.1"))
 			Expect(cfg.Params.AdminPort).To(Equal(uint32(4321)))
 			Expect(cfg.Params.AdminAccessLogPath).To(Equal("/var/log"))
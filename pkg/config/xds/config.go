@@ -14,7 +14,7 @@ var _ config.Config = &XdsServerConfig{}
 type XdsServerConfig struct {
 	// Port of GRPC server that Envoy connects to
 	GrpcPort int `yaml:"grpcPort" envconfig:"kuma_xds_server_grpc_port"`
-	// Port of Diagnostic Server for checking health and readiness of the Control Plane
+	// Port of Diagnostic Server for checking liveness (/healthy) and readiness (/ready) of the Control Plane and for scraping its Prometheus metrics (/metrics)
 	DiagnosticsPort int `yaml:"diagnosticsPort" envconfig:"kuma_xds_server_diagnostics_port"`
 
 	// Interval for re-genarting configuration for Dataplanes connected to the Control Plane
@@ -32,7 +32,7 @@ func autoconfigure(cfg *kuma_cp.Config) error {
 func autoconfigureCatalog(cfg *kuma_cp.Config) {
 	cat := &catalog.CatalogConfig{
 		Bootstrap: catalog.BootstrapApiConfig{
-			Url: fmt.Sprintf("http://%s:%d", cfg.General.AdvertisedHostname, cfg.BootstrapServer.Port),
+			Url: fmt.Sprintf("%s://%s:%d", bootstrapServerScheme(cfg), cfg.General.AdvertisedHostname, cfg.BootstrapServer.Port),
 		},
 		Admin: catalog.AdminApiConfig{
 			LocalUrl: fmt.Sprintf("http://localhost:%d", cfg.AdminServer.Local.Port),
@@ -53,6 +53,13 @@ func autoconfigureCatalog(cfg *kuma_cp.Config) {
 	cfg.ApiServer.Catalog = cat
 }
 
+func bootstrapServerScheme(cfg *kuma_cp.Config) string {
+	if cfg.BootstrapServer.TlsCertFile != "" {
+		return "https"
+	}
+	return "http"
+}
+
 func autoconfigureSds(cfg *kuma_cp.Config) error {
 	// to improve UX, we want to auto-generate TLS cert for SDS if possible
 	if cfg.Environment == config_core.UniversalEnvironment {
@@ -111,6 +111,31 @@ var _ = Describe("Auto configuration", func() {
 				},
 			},
 		}),
+		Entry("with bootstrap server over TLS", testCase{
+			cpConfig: func() kuma_cp.Config {
+				cfg := kuma_cp.DefaultConfig()
+				cfg.DataplaneTokenServer.Enabled = false
+				cfg.BootstrapServer.TlsCertFile = "/tmp/cert.pem"
+				cfg.BootstrapServer.TlsKeyFile = "/tmp/key.pem"
+				return cfg
+			},
+			expectedCatalogConfig: catalog.CatalogConfig{
+				Bootstrap: catalog.BootstrapApiConfig{
+					Url: "https://localhost:5682",
+				},
+				DataplaneToken: catalog.DataplaneTokenApiConfig{
+					LocalUrl:  "",
+					PublicUrl: "",
+				},
+				Admin: catalog.AdminApiConfig{
+					LocalUrl:  "http://localhost:5679",
+					PublicUrl: "",
+				},
+				MonitoringAssignment: catalog.MonitoringAssignmentApiConfig{
+					Url: "grpc://localhost:5676",
+				},
+			},
+		}),
 		Entry("without dataplane token server", testCase{
 			cpConfig: func() kuma_cp.Config {
 				cfg := kuma_cp.DefaultConfig()
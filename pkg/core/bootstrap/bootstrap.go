@@ -1,6 +1,8 @@
 package bootstrap
 
 import (
+	"sync/atomic"
+
 	"github.com/pkg/errors"
 
 	kuma_cp "github.com/Kong/kuma/pkg/config/app/kuma-cp"
@@ -14,11 +16,13 @@ import (
 	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
 	core_model "github.com/Kong/kuma/pkg/core/resources/model"
 	"github.com/Kong/kuma/pkg/core/resources/registry"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
 	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
 	runtime_reports "github.com/Kong/kuma/pkg/core/runtime/reports"
 	secret_cipher "github.com/Kong/kuma/pkg/core/secrets/cipher"
 	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/metrics"
 	builtin_issuer "github.com/Kong/kuma/pkg/tokens/builtin/issuer"
 )
 
@@ -27,6 +31,9 @@ func buildRuntime(cfg kuma_cp.Config) (core_runtime.Runtime, error) {
 		return nil, err
 	}
 	builder := core_runtime.BuilderFor(cfg)
+	if err := initializeMetrics(builder); err != nil {
+		return nil, err
+	}
 	if err := initializeBootstrap(cfg, builder); err != nil {
 		return nil, err
 	}
@@ -72,13 +79,58 @@ func Bootstrap(cfg kuma_cp.Config) (core_runtime.Runtime, error) {
 }
 
 func onStartup(runtime core_runtime.Runtime) error {
+	defaults := &defaultResourcesTracker{runtime: runtime}
+	runtime.Health().AddReadinessCheck("defaults", defaults)
+	// defaults are created by a single instance of the Control Plane to avoid a race between instances
+	return core_runtime.Add(runtime,
+		defaults,
+		newReporter(runtime),
+	)
+}
+
+func createDefaultResources(runtime core_runtime.Runtime) error {
 	if err := createDefaultMesh(runtime); err != nil {
 		return err
 	}
-	if err := createDefaultSigningKey(runtime); err != nil {
+	return createDefaultSigningKey(runtime)
+}
+
+// defaultResourcesTracker creates default resources on a leader and reports the Control Plane as not ready
+// until either they are created or another instance of the Control Plane is seen to be a leader,
+// which creates them instead.
+//
+// Readiness never depends on whether defaults exist at the moment, since a user might remove them on purpose,
+// e.g. delete the default Mesh, and then no instance started afterwards would ever become ready.
+type defaultResourcesTracker struct {
+	runtime core_runtime.Runtime
+	ready   int32
+}
+
+var _ core_runtime.HealthChecker = &defaultResourcesTracker{}
+var _ core_runtime.LeaderObserver = &defaultResourcesTracker{}
+
+func (t *defaultResourcesTracker) CheckHealth() error {
+	if atomic.LoadInt32(&t.ready) == 0 {
+		return errors.New("default resources have not been created yet")
+	}
+	return nil
+}
+
+func (t *defaultResourcesTracker) Start(_ <-chan struct{}) error {
+	if err := createDefaultResources(t.runtime); err != nil {
+		// the Control Plane stays not ready, since defaults might be missing
 		return err
 	}
-	return startReporter(runtime)
+	atomic.StoreInt32(&t.ready, 1)
+	return nil
+}
+
+func (t *defaultResourcesTracker) NeedLeaderElection() bool {
+	return true
+}
+
+func (t *defaultResourcesTracker) OnObservedOtherLeader() {
+	atomic.StoreInt32(&t.ready, 1)
 }
 
 func createDefaultSigningKey(runtime core_runtime.Runtime) error {
@@ -105,12 +157,21 @@ func createDefaultMesh(runtime core_runtime.Runtime) error {
 	}
 }
 
-func startReporter(runtime core_runtime.Runtime) error {
-	return runtime.Add(core_runtime.ComponentFunc(func(stop <-chan struct{}) error {
-		runtime_reports.Init(runtime, runtime.Config())
+func newReporter(runtime core_runtime.Runtime) core_runtime.Component {
+	return core_runtime.LeaderComponentFunc(func(stop <-chan struct{}) error {
+		runtime_reports.Init(runtime, runtime.Config(), stop)
 		<-stop
 		return nil
-	}))
+	})
+}
+
+func initializeMetrics(builder *core_runtime.Builder) error {
+	metrics, err := metrics.NewMetrics()
+	if err != nil {
+		return err
+	}
+	builder.WithMetrics(metrics)
+	return nil
 }
 
 func initializeBootstrap(cfg kuma_cp.Config, builder *core_runtime.Builder) error {
@@ -153,12 +214,19 @@ func initializeResourceStore(cfg kuma_cp.Config, builder *core_runtime.Builder)
 	if err != nil {
 		return errors.Wrapf(err, "could not retrieve store %s plugin", pluginName)
 	}
-	if rs, err := plugin.NewResourceStore(builder, pluginConfig); err != nil {
+	rs, err := plugin.NewResourceStore(builder, pluginConfig)
+	if err != nil {
 		return err
-	} else {
-		builder.WithResourceStore(rs)
-		return nil
 	}
+	if checker, ok := rs.(core_runtime.HealthChecker); ok {
+		builder.Health().AddReadinessCheck("store", checker)
+	}
+	meteredStore, err := core_store.NewMeteredStore(rs, builder.Metrics())
+	if err != nil {
+		return err
+	}
+	builder.WithResourceStore(meteredStore)
+	return nil
 }
 
 func initializeSecretManager(cfg kuma_cp.Config, builder *core_runtime.Builder) error {
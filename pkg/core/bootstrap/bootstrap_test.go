@@ -81,15 +81,30 @@ var _ = Describe("Bootstrap", func() {
 		rt, err := Bootstrap(cfg)
 		Expect(err).ToNot(HaveOccurred())
 
-		// when
-		key, err := builtin_issuer.GetSigningKey(rt.SecretManager())
+		// then control plane is not ready until it is started
+		Expect(rt.Health().CheckReadiness().Healthy()).To(BeFalse())
 
-		// then
-		Expect(err).ToNot(HaveOccurred())
+		// when control plane is started
+		ch := make(chan struct{})
+		defer func() {
+			close(ch)
+		}()
+		go func() {
+			defer GinkgoRecover()
+			err := rt.Start(ch)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// then wait until signing key is created
+		var key []byte
+		Eventually(func() error {
+			key, err = builtin_issuer.GetSigningKey(rt.SecretManager())
+			return err
+		}, "5s").Should(Succeed())
 		Expect(key).ToNot(HaveLen(0))
 
 		// when kuma-cp is run again
-		err = onStartup(rt)
+		err = createDefaultResources(rt)
 		Expect(err).ToNot(HaveOccurred())
 		key2, err := builtin_issuer.GetSigningKey(rt.SecretManager())
 
@@ -97,4 +112,69 @@ var _ = Describe("Bootstrap", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(key).To(Equal(key2))
 	})
+
+	It("should report readiness once default resources are created", func() {
+		// given
+		cfg := kuma_cp.DefaultConfig()
+		rt, err := Bootstrap(cfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when control plane is started
+		ch := make(chan struct{})
+		defer func() {
+			close(ch)
+		}()
+		go func() {
+			defer GinkgoRecover()
+			err := rt.Start(ch)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		// then
+		Eventually(func() string {
+			return rt.Health().CheckReadiness().String()
+		}, "5s").Should(Equal("[+] components: ok\n[+] defaults: ok\n"))
+	})
+
+	It("should report readiness once another instance is seen to be a leader", func() {
+		// given
+		cfg := kuma_cp.DefaultConfig()
+		rt, err := Bootstrap(cfg)
+		Expect(err).ToNot(HaveOccurred())
+		defaults := &defaultResourcesTracker{runtime: rt}
+
+		// then
+		Expect(defaults.CheckHealth()).To(MatchError("default resources have not been created yet"))
+
+		// when
+		defaults.OnObservedOtherLeader()
+
+		// then
+		Expect(defaults.CheckHealth()).To(Succeed())
+	})
+
+	It("should report readiness once default resources have been created regardless of whether they still exist", func() {
+		// given
+		cfg := kuma_cp.DefaultConfig()
+		rt, err := Bootstrap(cfg)
+		Expect(err).ToNot(HaveOccurred())
+		defaults := &defaultResourcesTracker{runtime: rt}
+
+		// then
+		Expect(defaults.CheckHealth()).ToNot(Succeed())
+
+		// when
+		err = defaults.Start(nil)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(defaults.CheckHealth()).To(Succeed())
+
+		// when default Mesh is deleted by a user
+		err = rt.ResourceManager().Delete(context.Background(), &mesh.MeshResource{}, core_store.DeleteByKey(core_model.DefaultMesh, core_model.DefaultMesh))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(defaults.CheckHealth()).To(Succeed())
+	})
 })
@@ -7,6 +7,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"fmt"
 	"math/big"
 	"net/url"
 	"time"
@@ -24,6 +25,12 @@ const (
 	DefaultWorkloadCertValidityPeriod = 90 * 24 * time.Hour
 )
 
+// MeshDNSName returns a DNS name that every workload certificate of a given Mesh carries next to its SPIFFE ID.
+// It lets clients that verify only DNS names, e.g. Prometheus, check that a certificate belongs to the Mesh.
+func MeshDNSName(mesh string) string {
+	return fmt.Sprintf("%s.mesh.kuma.io", mesh)
+}
+
 func NewRootCA(mesh string) (*util_tls.KeyPair, error) {
 	key, err := rsa.GenerateKey(rand.Reader, DefaultRsaBits)
 	if err != nil {
@@ -94,6 +101,7 @@ func newWorkloadCert(signer crypto.PrivateKey, parent *x509.Certificate, trustDo
 	if err != nil {
 		return nil, err
 	}
+	template.DNSNames = []string{MeshDNSName(trustDomain)}
 
 	return x509.CreateCertificate(rand.Reader, template, parent, publicKey, signer)
 }
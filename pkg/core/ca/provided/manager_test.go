@@ -2,6 +2,9 @@ package provided_test
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -192,6 +195,17 @@ var _ = Describe("CA Provided Manager", func() {
 			// and
 			Expect(pair.CertPEM).ToNot(HaveLen(0))
 			Expect(pair.KeyPEM).ToNot(HaveLen(0))
+
+			// when
+			block, _ := pem.Decode(pair.CertPEM)
+			Expect(block).ToNot(BeNil())
+			cert, err := x509.ParseCertificate(block.Bytes)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(cert.URIs).To(HaveLen(1))
+			Expect(cert.URIs[0].String()).To(Equal(fmt.Sprintf("spiffe://%s/backend", meshName)))
+			Expect(cert.DNSNames).To(Equal([]string{fmt.Sprintf("%s.mesh.kuma.io", meshName)}))
 		})
 
 		It("should throw an error for mesh without a signing cert", func() {
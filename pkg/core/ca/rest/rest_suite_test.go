@@ -0,0 +1,13 @@
+package rest_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCaRest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Rest CA Suite")
+}
@@ -0,0 +1,14 @@
+package types
+
+type ClientCertRequest struct {
+	// Mesh which CA issues the certificate.
+	Mesh string `json:"mesh"`
+	// Name of the client, e.g. `prometheus`. It becomes a part of SPIFFE ID of the certificate.
+	Name string `json:"name"`
+}
+
+type ClientCert struct {
+	Cert    string   `json:"cert"`
+	Key     string   `json:"key"`
+	CaCerts []string `json:"caCerts"`
+}
@@ -0,0 +1,122 @@
+package rest
+
+import (
+	"context"
+
+	"github.com/emicklei/go-restful"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	builtin_ca "github.com/Kong/kuma/pkg/core/ca/builtin"
+	provided_ca "github.com/Kong/kuma/pkg/core/ca/provided"
+	"github.com/Kong/kuma/pkg/core/ca/rest/types"
+	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/manager"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	rest_errors "github.com/Kong/kuma/pkg/core/rest/errors"
+	"github.com/Kong/kuma/pkg/core/validators"
+	"github.com/Kong/kuma/pkg/tls"
+)
+
+// caWebservice issues client certificates signed by the CA of a Mesh,
+// e.g. for Prometheus to scrape dataplanes that protect their metrics with mTLS.
+type caWebservice struct {
+	resourceManager   manager.ReadOnlyResourceManager
+	builtinCaManager  builtin_ca.BuiltinCaManager
+	providedCaManager provided_ca.ProvidedCaManager
+}
+
+func NewWebservice(resourceManager manager.ReadOnlyResourceManager, builtinCaManager builtin_ca.BuiltinCaManager, providedCaManager provided_ca.ProvidedCaManager) *restful.WebService {
+	caWs := caWebservice{
+		resourceManager:   resourceManager,
+		builtinCaManager:  builtinCaManager,
+		providedCaManager: providedCaManager,
+	}
+	return caWs.createWs()
+}
+
+func (c *caWebservice) createWs() *restful.WebService {
+	ws := new(restful.WebService).
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON)
+	ws.Path("/client-certificates").
+		Route(ws.POST("").To(c.generateClientCert))
+	return ws
+}
+
+func (c *caWebservice) generateClientCert(request *restful.Request, response *restful.Response) {
+	certReq := types.ClientCertRequest{}
+	if err := request.ReadEntity(&certReq); err != nil {
+		rest_errors.HandleError(response, err, "Could not process the request")
+		return
+	}
+	verr := validators.ValidationError{}
+	if certReq.Mesh == "" {
+		verr.AddViolation("mesh", "cannot be empty")
+	}
+	if certReq.Name == "" {
+		verr.AddViolation("name", "cannot be empty")
+	}
+	if verr.HasViolations() {
+		rest_errors.HandleError(response, verr.OrNil(), "Could not generate a client certificate")
+		return
+	}
+
+	keyPair, caCerts, err := c.issue(request.Request.Context(), certReq.Mesh, certReq.Name)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not generate a client certificate")
+		return
+	}
+
+	certResp := types.ClientCert{
+		Cert: string(keyPair.CertPEM),
+		Key:  string(keyPair.KeyPEM),
+	}
+	for _, caCert := range caCerts {
+		certResp.CaCerts = append(certResp.CaCerts, string(caCert))
+	}
+	if err := response.WriteAsJson(certResp); err != nil {
+		rest_errors.HandleError(response, err, "Could not generate a client certificate")
+	}
+}
+
+// issue returns a certificate for a given client signed by the CA of a given Mesh together with root certificates of that CA.
+func (c *caWebservice) issue(ctx context.Context, mesh string, name string) (*tls.KeyPair, [][]byte, error) {
+	meshRes := &core_mesh.MeshResource{}
+	if err := c.resourceManager.Get(ctx, meshRes, store.GetByKey(mesh, mesh)); err != nil {
+		return nil, nil, err
+	}
+	if !meshRes.Spec.GetMtls().GetEnabled() {
+		verr := validators.ValidationError{}
+		verr.AddViolation("mesh", "has to have mTLS enabled")
+		return nil, nil, verr.OrNil()
+	}
+	switch meshRes.Spec.GetMtls().GetCa().GetType().(type) {
+	case *mesh_proto.CertificateAuthority_Builtin_:
+		keyPair, err := c.builtinCaManager.GenerateWorkloadCert(ctx, mesh, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		caCerts, err := c.builtinCaManager.GetRootCerts(ctx, mesh)
+		if err != nil {
+			return nil, nil, err
+		}
+		return keyPair, caCerts, nil
+	case *mesh_proto.CertificateAuthority_Provided_:
+		keyPair, err := c.providedCaManager.GenerateWorkloadCert(ctx, mesh, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		signingCerts, err := c.providedCaManager.GetSigningCerts(ctx, mesh)
+		if err != nil {
+			return nil, nil, err
+		}
+		caCerts := make([][]byte, len(signingCerts))
+		for i, signingCert := range signingCerts {
+			caCerts[i] = signingCert.Cert
+		}
+		return keyPair, caCerts, nil
+	default:
+		return nil, nil, errors.Errorf("Mesh %q has unsupported CA type", mesh)
+	}
+}
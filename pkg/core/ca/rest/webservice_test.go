@@ -0,0 +1,145 @@
+package rest_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/app/kumactl/pkg/ca"
+	builtin_ca "github.com/Kong/kuma/pkg/core/ca/builtin"
+	"github.com/Kong/kuma/pkg/core/ca/provided"
+	"github.com/Kong/kuma/pkg/core/ca/rest"
+	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	resources_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	"github.com/Kong/kuma/pkg/core/rest/errors/types"
+	"github.com/Kong/kuma/pkg/core/secrets/cipher"
+	"github.com/Kong/kuma/pkg/core/secrets/manager"
+	"github.com/Kong/kuma/pkg/core/secrets/store"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("CA WS", func() {
+
+	var client ca.ClientCertClient
+	var srv *httptest.Server
+	var resManager resources_manager.ResourceManager
+	var builtinCaManager builtin_ca.BuiltinCaManager
+
+	BeforeEach(func() {
+		memStore := memory.NewStore()
+		resManager = resources_manager.NewResourceManager(memStore)
+		secretManager := manager.NewSecretManager(store.NewSecretStore(memStore), cipher.None())
+		builtinCaManager = builtin_ca.NewBuiltinCaManager(secretManager)
+		ws := rest.NewWebservice(resManager, builtinCaManager, provided.NewProvidedCaManager(secretManager))
+		container := restful.NewContainer()
+		container.Add(ws)
+		srv = httptest.NewServer(container)
+
+		c, err := ca.NewClientCertClient(srv.URL, nil)
+		Expect(err).ToNot(HaveOccurred())
+		client = c
+	})
+
+	AfterEach(func() {
+		srv.Close()
+	})
+
+	createMesh := func(name string, mtls *mesh_proto.Mesh_Mtls) {
+		mesh := &core_mesh.MeshResource{
+			Spec: mesh_proto.Mesh{
+				Mtls: mtls,
+			},
+		}
+		err := resManager.Create(context.Background(), mesh, core_store.CreateByKey(name, name))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	It("should generate a client certificate issued by the CA of a Mesh", func() {
+		// given
+		createMesh("demo", &mesh_proto.Mesh_Mtls{
+			Enabled: true,
+			Ca: &mesh_proto.CertificateAuthority{
+				Type: &mesh_proto.CertificateAuthority_Builtin_{
+					Builtin: &mesh_proto.CertificateAuthority_Builtin{},
+				},
+			},
+		})
+		Expect(builtinCaManager.Create(context.Background(), "demo")).To(Succeed())
+
+		// when
+		clientCert, err := client.Generate("demo", "prometheus")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(clientCert.Key).ToNot(BeEmpty())
+		Expect(clientCert.CaCerts).To(HaveLen(1))
+
+		// when
+		roots := x509.NewCertPool()
+		Expect(roots.AppendCertsFromPEM([]byte(clientCert.CaCerts[0]))).To(BeTrue())
+		block, _ := pem.Decode([]byte(clientCert.Cert))
+		Expect(block).ToNot(BeNil())
+		cert, err := x509.ParseCertificate(block.Bytes)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		_, err = cert.Verify(x509.VerifyOptions{
+			Roots:     roots,
+			KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(cert.URIs).To(HaveLen(1))
+		Expect(cert.URIs[0].String()).To(Equal("spiffe://demo/prometheus"))
+	})
+
+	It("should not generate a client certificate for a Mesh without mTLS", func() {
+		// given
+		createMesh("demo", nil)
+
+		// when
+		_, err := client.Generate("demo", "prometheus")
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(*err.(*types.Error)).To(Equal(types.Error{
+			Title:   "Could not generate a client certificate",
+			Details: "Resource is not valid",
+			Causes: []types.Cause{
+				{
+					Field:   "mesh",
+					Message: "has to have mTLS enabled",
+				},
+			},
+		}))
+	})
+
+	It("should not generate a client certificate without a mesh and a name", func() {
+		// when
+		_, err := client.Generate("", "")
+
+		// then
+		Expect(err).To(HaveOccurred())
+		Expect(*err.(*types.Error)).To(Equal(types.Error{
+			Title:   "Could not generate a client certificate",
+			Details: "Resource is not valid",
+			Causes: []types.Cause{
+				{
+					Field:   "mesh",
+					Message: "cannot be empty",
+				},
+				{
+					Field:   "name",
+					Message: "cannot be empty",
+				},
+			},
+		}))
+	})
+})
@@ -14,6 +14,12 @@ import (
 // DataplanePolicy with an empty selector (one that has no tags) is considered a match with a rank (score) of 0.
 // In case if there are multiple DataplanePolicies with the same rank (score), the policy created last is chosen.
 func SelectDataplanePolicy(dataplane *mesh.DataplaneResource, policies []DataplanePolicy) DataplanePolicy {
+	policy, _ := RankDataplanePolicy(dataplane, policies)
+	return policy
+}
+
+// RankDataplanePolicy works the same way as SelectDataplanePolicy but also returns a rank (score) of the "best matching" DataplanePolicy.
+func RankDataplanePolicy(dataplane *mesh.DataplaneResource, policies []DataplanePolicy) (DataplanePolicy, mesh_proto.TagSelectorRank) {
 	sort.Stable(DataplanePolicyByName(policies)) // sort to avoid flakiness
 
 	var bestPolicy DataplanePolicy
@@ -46,7 +52,7 @@ func SelectDataplanePolicy(dataplane *mesh.DataplaneResource, policies []Datapla
 			}
 		}
 	}
-	return bestPolicy
+	return bestPolicy, bestRank
 }
 
 type DataplanePolicyByName []DataplanePolicy
@@ -379,4 +379,54 @@ var _ = Describe("Dataplane matcher", func() {
 			}),
 		)
 	})
+
+	Describe("RankDataplanePolicy()", func() {
+		It("should return the rank of the most specific matching selector", func() {
+			// given
+			dataplane := &mesh_core.DataplaneResource{
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Tags: map[string]string{
+									"service": "backend",
+									"version": "1.0",
+								},
+							},
+						},
+					},
+				},
+			}
+			policies := []policy.DataplanePolicy{
+				&mesh_core.ProxyTemplateResource{
+					Meta: &test_model.ResourceMeta{
+						Mesh: "demo",
+						Name: "first",
+					},
+					Spec: mesh_proto.ProxyTemplate{
+						Selectors: []*mesh_proto.Selector{
+							{
+								Match: map[string]string{
+									"service": "*",
+								},
+							},
+							{
+								Match: map[string]string{
+									"service": "backend",
+									"version": "*",
+								},
+							},
+						},
+					},
+				},
+			}
+
+			// when
+			actual, rank := policy.RankDataplanePolicy(dataplane, policies)
+
+			// then
+			Expect(actual).To(Equal(policies[0]))
+			Expect(rank).To(Equal(mesh_proto.TagSelectorRank{ExactMatches: 1, WildcardMatches: 1}))
+		})
+	})
 })
@@ -61,6 +61,15 @@ func SelectOutboundConnectionPolicies(dataplane *mesh_core.DataplaneResource, po
 
 // SelectConnectionPolicies picks a single the most specific policy applicable to a connection between a given dataplane and given destination services.
 func SelectConnectionPolicies(dataplane *mesh_core.DataplaneResource, destinations ServiceIterator, policies []ConnectionPolicy) ConnectionPolicyMap {
+	policyMap := ConnectionPolicyMap{}
+	for service, ranked := range RankConnectionPolicies(dataplane, destinations, policies) {
+		policyMap[service] = ranked.Policy
+	}
+	return policyMap
+}
+
+// RankConnectionPolicies works the same way as SelectConnectionPolicies but also returns an aggregate rank (score) of every selected policy.
+func RankConnectionPolicies(dataplane *mesh_core.DataplaneResource, destinations ServiceIterator, policies []ConnectionPolicy) RankedConnectionPolicyMap {
 	sort.Stable(ConnectionPolicyByName(policies)) // sort to avoid flakiness
 
 	// First, select only those ConnectionPolicies that have a `source` selector matching a given Dataplane.
@@ -131,9 +140,12 @@ func SelectConnectionPolicies(dataplane *mesh_core.DataplaneResource, destinatio
 		}
 	}
 
-	policyMap := ConnectionPolicyMap{}
+	policyMap := RankedConnectionPolicyMap{}
 	for service, candidate := range candidatesByDestination {
-		policyMap[service] = candidate.policy
+		policyMap[service] = RankedConnectionPolicy{
+			Policy: candidate.policy,
+			Rank:   candidate.bestAggregateRank,
+		}
 	}
 	return policyMap
 }
@@ -16,6 +16,15 @@ type ConnectionPolicy interface {
 // ConnectionPolicyMap holds the most specific ConnectionPolicy for each outbound interface of a Dataplane.
 type ConnectionPolicyMap map[core_xds.ServiceName]ConnectionPolicy
 
+// RankedConnectionPolicy is a ConnectionPolicy along with the aggregate rank of its most specific `source` and `destination` selectors.
+type RankedConnectionPolicy struct {
+	Policy ConnectionPolicy
+	Rank   mesh_proto.TagSelectorRank
+}
+
+// RankedConnectionPolicyMap holds the most specific ConnectionPolicy for each outbound interface of a Dataplane along with its rank.
+type RankedConnectionPolicyMap map[core_xds.ServiceName]RankedConnectionPolicy
+
 // DataplanePolicy is a Policy that is applied on a selected Dataplane
 type DataplanePolicy interface {
 	core_model.Resource
@@ -112,6 +112,18 @@ func (d *DataplaneResource) GetPrometheusEndpoint(mesh *MeshResource) *mesh_prot
 	return result
 }
 
+// GetStatsdSink returns the effective StatsD configuration of a Dataplane.
+// StatsD has to be enabled Mesh-wide, while a Dataplane can override particular settings.
+func (d *DataplaneResource) GetStatsdSink(mesh *MeshResource) *mesh_proto.Metrics_StatsD {
+	if d == nil || mesh == nil || mesh.Meta.GetName() != d.Meta.GetMesh() || !mesh.HasStatsdMetricsEnabled() {
+		return nil
+	}
+	result := &mesh_proto.Metrics_StatsD{}
+	proto.Merge(result, mesh.Spec.GetMetrics().GetStatsd())
+	proto.Merge(result, d.Spec.GetMetrics().GetStatsd())
+	return result
+}
+
 func (d *DataplaneResource) GetIP() string {
 	if d == nil {
 		return ""
@@ -366,6 +366,95 @@ var _ = Describe("Dataplane", func() {
 		)
 	})
 
+	Describe("GetStatsdSink()", func() {
+
+		type testCase struct {
+			dataplaneMesh string
+			dataplaneSpec string
+			meshName      string
+			meshSpec      string
+			expected      *mesh_proto.Metrics_StatsD
+		}
+
+		DescribeTable("should correctly determine effective StatsD config for given Dataplane and Mesh",
+			func(given testCase) {
+				// given
+				dataplane := &DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "backend-01",
+						Mesh: given.dataplaneMesh,
+					},
+				}
+				Expect(util_proto.FromYAML([]byte(given.dataplaneSpec), &dataplane.Spec)).To(Succeed())
+				// and
+				mesh := &MeshResource{
+					Meta: &test_model.ResourceMeta{
+						Name: given.meshName,
+					},
+				}
+				Expect(util_proto.FromYAML([]byte(given.meshSpec), &mesh.Spec)).To(Succeed())
+
+				// then
+				Expect(dataplane.GetStatsdSink(mesh)).To(Equal(given.expected))
+			},
+			Entry("dataplane.mesh != mesh", testCase{
+				dataplaneMesh: "default",
+				meshName:      "demo",
+				meshSpec: `
+                metrics:
+                  statsd:
+                    address: 127.0.0.1:8125
+`,
+				expected: nil,
+			}),
+			Entry("dataplane.mesh == mesh && mesh.metrics.statsd == nil", testCase{
+				dataplaneMesh: "demo",
+				dataplaneSpec: `
+                metrics:
+                  statsd:
+                    address: 127.0.0.1:8125
+`,
+				meshName: "demo",
+				expected: nil,
+			}),
+			Entry("dataplane.mesh == mesh && dataplane.metrics.statsd == nil && mesh.metrics.statsd != nil", testCase{
+				dataplaneMesh: "demo",
+				meshName:      "demo",
+				meshSpec: `
+                metrics:
+                  statsd:
+                    address: 127.0.0.1:8125
+                    prefix: kuma
+`,
+				expected: &mesh_proto.Metrics_StatsD{
+					Address: "127.0.0.1:8125",
+					Prefix:  "kuma",
+				},
+			}),
+			Entry("dataplane.mesh == mesh && dataplane.metrics.statsd != nil && mesh.metrics.statsd != nil", testCase{
+				dataplaneMesh: "demo",
+				dataplaneSpec: `
+                metrics:
+                  statsd:
+                    address: 10.0.0.1:8125
+                    tagFormat: dogstatsd
+`,
+				meshName: "demo",
+				meshSpec: `
+                metrics:
+                  statsd:
+                    address: 127.0.0.1:8125
+                    prefix: kuma
+`,
+				expected: &mesh_proto.Metrics_StatsD{
+					Address:   "10.0.0.1:8125",
+					Prefix:    "kuma",
+					TagFormat: "dogstatsd",
+				},
+			}),
+		)
+	})
+
 	Describe("GetIP()", func() {
 
 		type testCase struct {
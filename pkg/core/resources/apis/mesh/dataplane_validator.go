@@ -11,6 +11,9 @@ import (
 func (d *DataplaneResource) Validate() error {
 	var err validators.ValidationError
 	err.Add(validateNetworking(d.Spec.GetNetworking()))
+	if statsd := d.Spec.GetMetrics().GetStatsd(); statsd != nil {
+		err.AddError("metrics.statsd", validateStatsd(statsd))
+	}
 	return err.OrNil()
 }
 
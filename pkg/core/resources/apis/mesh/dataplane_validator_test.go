@@ -90,6 +90,20 @@ var _ = Describe("Dataplane", func() {
                 - port: 3333
                   service: redis`,
 		),
+		Entry("dataplane with statsd override", `
+            type: Dataplane
+            name: dp-1
+            mesh: default
+            networking:
+              address: 192.168.0.1
+              inbound:
+                - port: 8080
+                  tags:
+                    service: backend
+            metrics:
+              statsd:
+                tagFormat: dogstatsd`,
+		),
 	)
 
 	type testCase struct {
@@ -555,6 +569,28 @@ var _ = Describe("Dataplane", func() {
                 - field: networking.outbound[0].interface
                   message: interface cannot be defined with address. Replace it with port and address`,
 		}),
+		Entry("metrics.statsd: invalid address and tag format", testCase{
+			dataplane: `
+                type: Dataplane
+                name: dp-1
+                mesh: default
+                networking:
+                  address: 192.168.0.1
+                  inbound:
+                    - port: 8080
+                      tags:
+                        service: backend
+                metrics:
+                  statsd:
+                    address: 127.0.0.1
+                    tagFormat: influxdb`,
+			expected: `
+                violations:
+                - field: metrics.statsd.address
+                  message: has to be in format of IP:PORT
+                - field: metrics.statsd.tagFormat
+                  message: 'has invalid value. Allowed values: none, dogstatsd'`,
+		}),
 	)
 
 })
@@ -26,6 +26,10 @@ func (m *MeshResource) HasPrometheusMetricsEnabled() bool {
 	return m != nil && m.Spec.GetMetrics().GetPrometheus() != nil
 }
 
+func (m *MeshResource) HasStatsdMetricsEnabled() bool {
+	return m != nil && m.Spec.GetMetrics().GetStatsd() != nil
+}
+
 func (m *MeshResource) GetTracingBackend(name string) *mesh_proto.TracingBackend {
 	backends := map[string]*mesh_proto.TracingBackend{}
 	for _, backend := range m.Spec.GetTracing().GetBackends() {
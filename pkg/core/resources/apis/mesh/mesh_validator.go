@@ -4,6 +4,10 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes"
 
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	"github.com/Kong/kuma/pkg/core/validators"
@@ -15,6 +19,7 @@ func (m *MeshResource) Validate() error {
 	verr.AddError("mtls", validateMtls(m.Spec.Mtls))
 	verr.AddError("logging", validateLogging(m.Spec.Logging))
 	verr.AddError("tracing", validateTracing(m.Spec.Tracing))
+	verr.AddError("metrics", validateMetrics(m.Spec.Metrics, m.Spec.Mtls))
 	return verr.OrNil()
 }
 
@@ -56,10 +61,63 @@ func validateLoggingBackend(backend *mesh_proto.LoggingBackend) validators.Valid
 	if err := accesslog.ValidateFormat(backend.Format); err != nil {
 		verr.AddViolation("format", err.Error())
 	}
+	if len(backend.JsonFormat) > 0 {
+		if backend.Format != "" {
+			verr.AddViolation("jsonFormat", "cannot be used together with format")
+		}
+		if err := accesslog.ValidateJsonFormat(backend.JsonFormat); err != nil {
+			verr.AddViolation("jsonFormat", err.Error())
+		}
+	}
 	if file, ok := backend.GetType().(*mesh_proto.LoggingBackend_File_); ok {
 		verr.AddError("file", validateLoggingFile(file))
 	} else if tcp, ok := backend.GetType().(*mesh_proto.LoggingBackend_Tcp_); ok {
 		verr.AddError("tcp", validateLoggingTcp(tcp))
+	} else if syslog, ok := backend.GetType().(*mesh_proto.LoggingBackend_Syslog_); ok {
+		verr.AddError("syslog", validateLoggingSyslog(syslog))
+	} else if http, ok := backend.GetType().(*mesh_proto.LoggingBackend_Http_); ok {
+		if len(backend.JsonFormat) == 0 {
+			verr.AddViolation("jsonFormat", "has to be defined for a backend of type http")
+		}
+		verr.AddError("http", validateLoggingHttp(http))
+	}
+	return verr
+}
+
+func validateLoggingSyslog(syslog *mesh_proto.LoggingBackend_Syslog_) validators.ValidationError {
+	var verr validators.ValidationError
+	if syslog.Syslog.Address == "" {
+		verr.AddViolation("address", "cannot be empty")
+	} else {
+		host, port, err := net.SplitHostPort(syslog.Syslog.Address)
+		if host == "" || port == "" || err != nil {
+			verr.AddViolation("address", "has to be in format of HOST:PORT")
+		}
+	}
+	switch syslog.Syslog.Transport {
+	case "", accesslog.SyslogTransportUDP, accesslog.SyslogTransportTCP, accesslog.SyslogTransportTLS:
+	default:
+		verr.AddViolation("transport", fmt.Sprintf(`has invalid value. %s`, AllowedValuesHint(accesslog.SyslogTransportUDP, accesslog.SyslogTransportTCP, accesslog.SyslogTransportTLS)))
+	}
+	if _, ok := accesslog.SyslogFacilities[syslog.Syslog.Facility]; syslog.Syslog.Facility != "" && !ok {
+		verr.AddViolation("facility", "has to be one of the syslog facilities, e.g. local0")
+	}
+	return verr
+}
+
+func validateLoggingHttp(http *mesh_proto.LoggingBackend_Http_) validators.ValidationError {
+	var verr validators.ValidationError
+	if http.Http.Url == "" {
+		verr.AddViolation("url", "cannot be empty")
+	} else if u, err := url.ParseRequestURI(http.Http.Url); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		verr.AddViolation("url", "has to be a valid http or https url")
+	} else if strings.ContainsAny(http.Http.Url, "#;") {
+		verr.AddViolation("url", "cannot contain '#' or ';'")
+	}
+	if http.Http.FlushInterval != nil {
+		if flushInterval, err := ptypes.Duration(http.Http.FlushInterval); err != nil || flushInterval <= 0 {
+			verr.AddViolation("flushInterval", "has to be positive")
+		}
 	}
 	return verr
 }
@@ -114,24 +172,102 @@ func validateTracingBackend(backend *mesh_proto.TracingBackend) validators.Valid
 	}
 	if zipkin, ok := backend.GetType().(*mesh_proto.TracingBackend_Zipkin_); ok {
 		verr.AddError("zipkin", validateZipkin(zipkin.Zipkin))
+	} else if jaeger, ok := backend.GetType().(*mesh_proto.TracingBackend_Jaeger_); ok {
+		verr.AddError("jaeger", validateJaeger(jaeger.Jaeger))
+	} else if datadog, ok := backend.GetType().(*mesh_proto.TracingBackend_Datadog_); ok {
+		verr.AddError("datadog", validateDatadog(datadog.Datadog))
+	}
+	return verr
+}
+
+func validateJaeger(jaeger *mesh_proto.TracingBackend_Jaeger) validators.ValidationError {
+	var verr validators.ValidationError
+	switch {
+	case jaeger.AgentAddress != "" && jaeger.CollectorUrl != "":
+		verr.AddViolation("agentAddress", "cannot be used together with collectorUrl")
+	case jaeger.AgentAddress != "":
+		verr.Add(validateHostPort("agentAddress", jaeger.AgentAddress))
+	case jaeger.CollectorUrl != "":
+		verr.Add(validateCollectorUrl("collectorUrl", jaeger.CollectorUrl))
+		if jaeger.Library != "" {
+			verr.AddViolation("library", "can be used only with agentAddress")
+		}
+	default:
+		verr.AddViolation("collectorUrl", "either collectorUrl or agentAddress has to be defined")
+	}
+	return verr
+}
+
+func validateDatadog(datadog *mesh_proto.TracingBackend_Datadog) validators.ValidationError {
+	return validateHostPort("address", datadog.Address)
+}
+
+func validateHostPort(field string, address string) validators.ValidationError {
+	var verr validators.ValidationError
+	if address == "" {
+		verr.AddViolation(field, "cannot be empty")
+		return verr
+	}
+	host, port, err := net.SplitHostPort(address)
+	if host == "" || err != nil {
+		verr.AddViolation(field, "has to be in format of HOST:PORT")
+	} else if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		verr.AddViolation(field, "has to be in format of HOST:PORT")
 	}
 	return verr
 }
 
 func validateZipkin(zipkin *mesh_proto.TracingBackend_Zipkin) validators.ValidationError {
+	verr := validateCollectorUrl("url", zipkin.Url)
+	if zipkin.ApiVersion != "" && zipkin.ApiVersion != "httpJsonV1" && zipkin.ApiVersion != "httpJson" && zipkin.ApiVersion != "httpProto" {
+		verr.AddViolation("apiVersion", fmt.Sprintf(`has invalid value. %s`, AllowedValuesHint("httpJsonV1", "httpJson", "httpProto")))
+	}
+	return verr
+}
+
+func validateCollectorUrl(field string, collectorUrl string) validators.ValidationError {
 	var verr validators.ValidationError
-	if zipkin.Url == "" {
-		verr.AddViolation("url", "cannot be empty")
+	if collectorUrl == "" {
+		verr.AddViolation(field, "cannot be empty")
 	} else {
-		uri, err := url.ParseRequestURI(zipkin.Url)
+		uri, err := url.ParseRequestURI(collectorUrl)
 		if err != nil {
-			verr.AddViolation("url", "invalid URL")
+			verr.AddViolation(field, "invalid URL")
 		} else if uri.Port() == "" {
-			verr.AddViolation("url", "port has to be explicitly specified")
+			verr.AddViolation(field, "port has to be explicitly specified")
 		}
 	}
-	if zipkin.ApiVersion != "" && zipkin.ApiVersion != "httpJsonV1" && zipkin.ApiVersion != "httpJson" && zipkin.ApiVersion != "httpProto" {
-		verr.AddViolation("apiVersion", fmt.Sprintf(`has invalid value. %s`, AllowedValuesHint("httpJsonV1", "httpJson", "httpProto")))
+	return verr
+}
+
+func validateMetrics(metrics *mesh_proto.Metrics, mtls *mesh_proto.Mesh_Mtls) validators.ValidationError {
+	var verr validators.ValidationError
+	if metrics.GetPrometheus().GetMtls().GetValue() && !mtls.GetEnabled() {
+		verr.AddViolation("prometheus.mtls", "can only be enabled when mTLS is enabled on the Mesh")
+	}
+	if metrics.GetStatsd() != nil {
+		if metrics.Statsd.Address == "" {
+			verr.AddViolation("statsd.address", "cannot be empty")
+		}
+		verr.AddError("statsd", validateStatsd(metrics.Statsd))
+	}
+	return verr
+}
+
+// validateStatsd validates StatsD settings that can be defined both in a Mesh and in a Dataplane.
+func validateStatsd(statsd *mesh_proto.Metrics_StatsD) validators.ValidationError {
+	var verr validators.ValidationError
+	if statsd.Address != "" {
+		// Envoy sends metrics over UDP and does not resolve hostnames of StatsD sinks
+		host, port, err := net.SplitHostPort(statsd.Address)
+		if err != nil || net.ParseIP(host) == nil {
+			verr.AddViolation("address", "has to be in format of IP:PORT")
+		} else if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			verr.AddViolation("address", "has to be in format of IP:PORT")
+		}
+	}
+	if statsd.TagFormat != "" && statsd.TagFormat != "none" && statsd.TagFormat != "dogstatsd" {
+		verr.AddViolation("tagFormat", fmt.Sprintf(`has invalid value. %s`, AllowedValuesHint("none", "dogstatsd")))
 	}
 	return verr
 }
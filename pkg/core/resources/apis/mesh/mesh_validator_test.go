@@ -37,6 +37,28 @@ var _ = Describe("Mesh", func() {
                 format: '%START_TIME% %KUMA_DESTINATION_SERVICE%'
                 tcp:
                   address: kibana:1234
+              - name: tcp-3
+                jsonFormat:
+                  status: '%RESPONSE_CODE%'
+                  path: '%REQ(:PATH)%'
+                tcp:
+                  address: kibana:1234
+              - name: syslog-1
+                syslog:
+                  address: rsyslog:514
+              - name: syslog-2
+                syslog:
+                  address: rsyslog:6514
+                  transport: tls
+                  facility: local3
+                  appName: web
+              - name: http-1
+                jsonFormat:
+                  status: '%RESPONSE_CODE%'
+                http:
+                  url: https://collector.local/logs
+                  batchSize: 50
+                  flushInterval: 500ms
               defaultBackend: tcp-1
             tracing:
               backends:
@@ -49,7 +71,27 @@ var _ = Describe("Mesh", func() {
               - name: zipkin-eu
                 zipkin:
                   url: http://zipkin.local:9411/v2/spans
+              - name: jaeger
+                jaeger:
+                  collectorUrl: http://jaeger-collector:9411/api/v2/spans
+                  serviceName: web
+              - name: jaeger-agent
+                jaeger:
+                  agentAddress: jaeger-agent:6831
+                  library: /usr/local/lib/libjaegertracing_plugin.so
+              - name: datadog
+                datadog:
+                  address: datadog-agent:8126
               defaultBackend: zipkin-us
+            metrics:
+              prometheus:
+                port: 5670
+                path: /metrics
+                mtls: true
+              statsd:
+                address: 127.0.0.1:8125
+                prefix: kuma
+                tagFormat: dogstatsd
 `
 			mesh := MeshResource{}
 
@@ -174,6 +216,112 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: logging.backends[0].format
                   message: 'format string is not valid: expected a command operator to start at position 14, instead got: "%sent_bytes%"'`,
+			}),
+			Entry("invalid access log JSON format", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    jsonFormat:
+                      bytes: "%sent_bytes%"
+                    file:
+                      path: /var/logs
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].jsonFormat
+                  message: 'format string of a field "bytes" is not valid: format string is not valid: expected a command operator to start at position 1, instead got: "%sent_bytes%"'`,
+			}),
+			Entry("access log format and JSON format used together", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    format: "%START_TIME%"
+                    jsonFormat:
+                      start: "%START_TIME%"
+                    file:
+                      path: /var/logs
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].jsonFormat
+                  message: cannot be used together with format`,
+			}),
+			Entry("syslog logging with invalid address, transport and facility", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    syslog:
+                      address: rsyslog
+                      transport: quic
+                      facility: local9
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].syslog.address
+                  message: has to be in format of HOST:PORT
+                - field: logging.backends[0].syslog.transport
+                  message: 'has invalid value. Allowed values: udp, tcp, tls'
+                - field: logging.backends[0].syslog.facility
+                  message: has to be one of the syslog facilities, e.g. local0`,
+			}),
+			Entry("syslog logging address is empty", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    syslog: {}
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].syslog.address
+                  message: cannot be empty`,
+			}),
+			Entry("http logging without JSON format", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    http:
+                      url: https://collector.local/logs
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].jsonFormat
+                  message: has to be defined for a backend of type http`,
+			}),
+			Entry("http logging with invalid url and flush interval", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    jsonFormat:
+                      status: '%RESPONSE_CODE%'
+                    http:
+                      url: tcp://collector.local:1234
+                      flushInterval: 0s
+                  - name: backend-2
+                    jsonFormat:
+                      status: '%RESPONSE_CODE%'
+                    http:
+                      url: https://collector.local/logs;v2
+                  - name: backend-3
+                    jsonFormat:
+                      status: '%RESPONSE_CODE%'
+                    http: {}
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].http.url
+                  message: has to be a valid http or https url
+                - field: logging.backends[0].http.flushInterval
+                  message: has to be positive
+                - field: logging.backends[1].http.url
+                  message: cannot contain '#' or ';'
+                - field: logging.backends[2].http.url
+                  message: cannot be empty`,
 			}),
 			Entry("default backend has to be set to one of the backends", testCase{
 				mesh: `
@@ -276,6 +424,85 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: tracing.backends[0].zipkin.apiVersion
                   message: 'has invalid value. Allowed values: httpJsonV1, httpJson, httpProto'`,
+			}),
+			Entry("tracing with jaeger without collector URL and agent address", testCase{
+				mesh: `
+                tracing:
+                  backends:
+                  - name: jaeger
+                    jaeger:
+                      traceId128bit: true`,
+				expected: `
+                violations:
+                - field: tracing.backends[0].jaeger.collectorUrl
+                  message: either collectorUrl or agentAddress has to be defined`,
+			}),
+			Entry("tracing with jaeger with both collector URL and agent address", testCase{
+				mesh: `
+                tracing:
+                  backends:
+                  - name: jaeger
+                    jaeger:
+                      collectorUrl: http://jaeger-collector:9411/api/v2/spans
+                      agentAddress: jaeger-agent:6831`,
+				expected: `
+                violations:
+                - field: tracing.backends[0].jaeger.agentAddress
+                  message: cannot be used together with collectorUrl`,
+			}),
+			Entry("tracing with jaeger with invalid agent address", testCase{
+				mesh: `
+                tracing:
+                  backends:
+                  - name: jaeger
+                    jaeger:
+                      agentAddress: jaeger-agent`,
+				expected: `
+                violations:
+                - field: tracing.backends[0].jaeger.agentAddress
+                  message: has to be in format of HOST:PORT`,
+			}),
+			Entry("tracing with jaeger with library without agent address", testCase{
+				mesh: `
+                tracing:
+                  backends:
+                  - name: jaeger
+                    jaeger:
+                      collectorUrl: http://jaeger-collector:9411/api/v2/spans
+                      library: /usr/local/lib/libjaegertracing_plugin.so`,
+				expected: `
+                violations:
+                - field: tracing.backends[0].jaeger.library
+                  message: can be used only with agentAddress`,
+			}),
+			Entry("tracing with jaeger with collector URL without port", testCase{
+				mesh: `
+                tracing:
+                  backends:
+                  - name: jaeger
+                    jaeger:
+                      collectorUrl: http://jaeger-collector/api/v2/spans`,
+				expected: `
+                violations:
+                - field: tracing.backends[0].jaeger.collectorUrl
+                  message: port has to be explicitly specified`,
+			}),
+			Entry("tracing with datadog with invalid address", testCase{
+				mesh: `
+                tracing:
+                  backends:
+                  - name: datadog-1
+                    datadog:
+                      address: datadog-agent
+                  - name: datadog-2
+                    datadog:
+                      address: datadog-agent:http`,
+				expected: `
+                violations:
+                - field: tracing.backends[0].datadog.address
+                  message: has to be in format of HOST:PORT
+                - field: tracing.backends[1].datadog.address
+                  message: has to be in format of HOST:PORT`,
 			}),
 			Entry("default backend has to be set to one of the backends", testCase{
 				mesh: `
@@ -289,6 +516,40 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: tracing.defaultBackend
                   message: has to be set to one of the tracing backend in mesh`,
+			}),
+			Entry("prometheus protected by mTLS without mTLS on the mesh", testCase{
+				mesh: `
+                metrics:
+                  prometheus:
+                    port: 5670
+                    mtls: true`,
+				expected: `
+                violations:
+                - field: metrics.prometheus.mtls
+                  message: can only be enabled when mTLS is enabled on the Mesh`,
+			}),
+			Entry("statsd without address", testCase{
+				mesh: `
+                metrics:
+                  statsd:
+                    prefix: kuma`,
+				expected: `
+                violations:
+                - field: metrics.statsd.address
+                  message: cannot be empty`,
+			}),
+			Entry("statsd with invalid address and tag format", testCase{
+				mesh: `
+                metrics:
+                  statsd:
+                    address: datadog-agent:8125
+                    tagFormat: influxdb`,
+				expected: `
+                violations:
+                - field: metrics.statsd.address
+                  message: has to be in format of IP:PORT
+                - field: metrics.statsd.tagFormat
+                  message: 'has invalid value. Allowed values: none, dogstatsd'`,
 			}),
 			Entry("multiple errors", testCase{
 				mesh: `
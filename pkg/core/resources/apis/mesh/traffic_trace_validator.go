@@ -1,6 +1,10 @@
 package mesh
 
 import (
+	"fmt"
+	"strings"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	"github.com/Kong/kuma/pkg/core/validators"
 )
 
@@ -8,6 +12,7 @@ func (d *TrafficTraceResource) Validate() error {
 	var err validators.ValidationError
 	err.Add(d.validateSelectors())
 	// d.Spec.Conf and d.Spec.Conf.DefaultBackend can be empty, then default backend of the mesh is chosen.
+	err.AddError("conf", validateTrafficTraceConf(d.Spec.GetConf()))
 	return err.OrNil()
 }
 
@@ -19,3 +24,43 @@ func (d *TrafficTraceResource) validateSelectors() validators.ValidationError {
 		},
 	})
 }
+
+func validateTrafficTraceConf(conf *mesh_proto.TrafficTrace_Conf) validators.ValidationError {
+	var verr validators.ValidationError
+	if conf == nil {
+		return verr
+	}
+	if conf.Sampling != nil && (conf.Sampling.GetValue() < 0.0 || conf.Sampling.GetValue() > 100.0) {
+		verr.AddViolation("sampling", "has to be in [0.0 - 100.0] range")
+	}
+	for i, tag := range conf.GetTags() {
+		verr.AddError(fmt.Sprintf("tags[%d]", i), validateTrafficTraceTag(tag))
+	}
+	for i, path := range conf.GetExcludedPaths() {
+		if !strings.HasPrefix(path, "/") {
+			verr.AddViolation(fmt.Sprintf("excludedPaths[%d]", i), "has to start with '/'")
+		}
+	}
+	return verr
+}
+
+func validateTrafficTraceTag(tag *mesh_proto.TrafficTrace_Conf_Tag) validators.ValidationError {
+	var verr validators.ValidationError
+	if tag.GetName() == "" {
+		verr.AddViolation("name", "cannot be empty")
+	}
+	switch value := tag.GetValue().(type) {
+	case *mesh_proto.TrafficTrace_Conf_Tag_Header_:
+		if value.Header.GetName() == "" {
+			verr.AddViolation("header.name", "cannot be empty")
+		}
+	case *mesh_proto.TrafficTrace_Conf_Tag_Literal:
+	case *mesh_proto.TrafficTrace_Conf_Tag_Env_:
+		if value.Env.GetName() == "" {
+			verr.AddViolation("env.name", "cannot be empty")
+		}
+	default:
+		verr.AddViolation("value", "has to be one of header, literal or env")
+	}
+	return verr
+}
@@ -41,6 +41,26 @@ var _ = Describe("TrafficTrace", func() {
                     region: eu
                 conf:
                   backend: # backend can be empty, default backend from mesh is chosen`,
+			),
+			Entry("per-traffic settings", `
+                selectors:
+                - match:
+                    region: eu
+                conf:
+                  backend: zipkin-eu
+                  sampling: 10.5
+                  tags:
+                  - name: user
+                    header:
+                      name: x-user
+                      default: anonymous
+                  - name: team
+                    literal: payments
+                  - name: pod
+                    env:
+                      name: POD_NAME
+                  excludedPaths:
+                  - /health`,
 			),
 			Entry("empty conf", `
                 selectors:
@@ -105,6 +125,36 @@ var _ = Describe("TrafficTrace", func() {
                   message: tag value must be non-empty
                 - field: selectors[0].match["service"]
                   message: tag value must be non-empty
+`,
+			}),
+			Entry("invalid per-traffic settings", testCase{
+				trafficTrace: `
+                selectors:
+                - match:
+                    region: eu
+                conf:
+                  sampling: 100.5
+                  tags:
+                  - header:
+                      name: x-user
+                  - name: team
+                  - name: pod
+                    env: {}
+                  excludedPaths:
+                  - health
+`,
+				expected: `
+                violations:
+                - field: conf.sampling
+                  message: has to be in [0.0 - 100.0] range
+                - field: conf.tags[0].name
+                  message: cannot be empty
+                - field: conf.tags[1].value
+                  message: has to be one of header, literal or env
+                - field: conf.tags[2].env.name
+                  message: cannot be empty
+                - field: conf.excludedPaths[0]
+                  message: has to start with '/'
 `,
 			}),
 			Entry("multiple selectors", testCase{
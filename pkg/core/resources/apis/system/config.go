@@ -0,0 +1,75 @@
+package system
+
+import (
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	"github.com/Kong/kuma/pkg/core/resources/model"
+)
+
+const (
+	ConfigType model.ResourceType = "Config"
+)
+
+var _ model.Resource = &ConfigResource{}
+
+// ConfigResource is a non-sensitive piece of state that the Control Plane persists for itself, e.g. VIPs of services.
+// Unlike SecretResource, it is stored as is, without encryption.
+type ConfigResource struct {
+	Meta model.ResourceMeta
+	Spec wrappers.StringValue
+}
+
+func (t *ConfigResource) GetType() model.ResourceType {
+	return ConfigType
+}
+func (t *ConfigResource) GetMeta() model.ResourceMeta {
+	return t.Meta
+}
+func (t *ConfigResource) SetMeta(m model.ResourceMeta) {
+	t.Meta = m
+}
+func (t *ConfigResource) GetSpec() model.ResourceSpec {
+	return &t.Spec
+}
+func (t *ConfigResource) SetSpec(spec model.ResourceSpec) error {
+	value, ok := spec.(*wrappers.StringValue)
+	if !ok {
+		return errors.New("invalid type of spec")
+	} else {
+		t.Spec = *value
+		return nil
+	}
+}
+func (t *ConfigResource) Validate() error {
+	return nil
+}
+
+var _ model.ResourceList = &ConfigResourceList{}
+
+type ConfigResourceList struct {
+	Items []*ConfigResource
+}
+
+func (l *ConfigResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+func (l *ConfigResourceList) GetItemType() model.ResourceType {
+	return ConfigType
+}
+func (l *ConfigResourceList) NewItem() model.Resource {
+	return &ConfigResource{}
+}
+func (l *ConfigResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*ConfigResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*ConfigResource)(nil), r)
+	}
+}
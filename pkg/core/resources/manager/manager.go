@@ -88,6 +88,39 @@ func DeleteAllResources(manager ResourceManager, ctx context.Context, list model
 	return nil
 }
 
+// maxUpsertAttempts limits the number of attempts to save a resource
+// when it is being modified concurrently, e.g. by another instance of the Control Plane.
+const maxUpsertAttempts = 5
+
+// Upsert creates or updates a resource of a given key with changes made by fn.
+// fn is applied to the latest version of the resource, so changes are retried if the resource is modified concurrently.
+func Upsert(manager ResourceManager, ctx context.Context, key model.ResourceKey, resource model.Resource, fn func(resource model.Resource)) error {
+	var err error
+	for attempt := 0; attempt < maxUpsertAttempts; attempt++ {
+		err = upsert(manager, ctx, key, resource, fn)
+		if !store.IsResourceConflict(err) && !store.IsResourceAlreadyExists(err) {
+			return err
+		}
+	}
+	return err
+}
+
+func upsert(manager ResourceManager, ctx context.Context, key model.ResourceKey, resource model.Resource, fn func(resource model.Resource)) error {
+	resource.GetSpec().Reset()
+	create := false
+	if err := manager.Get(ctx, resource, store.GetBy(key)); err != nil {
+		if !store.IsResourceNotFound(err) {
+			return err
+		}
+		create = true
+	}
+	fn(resource)
+	if create {
+		return manager.Create(ctx, resource, store.CreateBy(key))
+	}
+	return manager.Update(ctx, resource)
+}
+
 func (r *resourcesManager) Update(ctx context.Context, resource model.Resource, fs ...store.UpdateOptionsFunc) error {
 	if err := resource.Validate(); err != nil {
 		return err
@@ -123,4 +123,34 @@ var _ = Describe("Resource Manager", func() {
 
 		})
 	})
+
+	Describe("Upsert()", func() {
+		It("should create a resource and then update it", func() {
+			// setup
+			Expect(createSampleMesh("mesh-1")).To(Succeed())
+			key := model.ResourceKey{Mesh: "mesh-1", Name: "tr-1"}
+			setPath := func(path string) func(model.Resource) {
+				return func(resource model.Resource) {
+					resource.(*sample.TrafficRouteResource).Spec.Path = path
+				}
+			}
+
+			// when
+			err := manager.Upsert(resManager, context.Background(), key, &sample.TrafficRouteResource{}, setPath("/created"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			actual := &sample.TrafficRouteResource{}
+			Expect(resManager.Get(context.Background(), actual, store.GetBy(key))).To(Succeed())
+			Expect(actual.Spec.Path).To(Equal("/created"))
+
+			// when
+			err = manager.Upsert(resManager, context.Background(), key, &sample.TrafficRouteResource{}, setPath("/updated"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resManager.Get(context.Background(), actual, store.GetBy(key))).To(Succeed())
+			Expect(actual.Spec.Path).To(Equal("/updated"))
+		})
+	})
 })
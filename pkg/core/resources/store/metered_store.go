@@ -0,0 +1,71 @@
+package store
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/Kong/kuma/pkg/core/resources/model"
+	"github.com/Kong/kuma/pkg/metrics"
+)
+
+// NewMeteredStore returns a ResourceStore that measures latency of operations of a given ResourceStore.
+func NewMeteredStore(delegate ResourceStore, metrics metrics.Metrics) (ClosableResourceStore, error) {
+	latency := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "store_operation_duration_seconds",
+		Help: "Latency of operations on the resource store",
+	}, []string{"operation", "resource_type"})
+	if err := metrics.Register(latency); err != nil {
+		return nil, err
+	}
+	return &meteredStore{
+		delegate: delegate,
+		latency:  latency,
+	}, nil
+}
+
+var _ ResourceStore = &meteredStore{}
+
+type meteredStore struct {
+	delegate ResourceStore
+	latency  *prometheus.HistogramVec
+}
+
+func (m *meteredStore) Create(ctx context.Context, resource model.Resource, fs ...CreateOptionsFunc) error {
+	defer m.observe("create", resource.GetType(), time.Now())
+	return m.delegate.Create(ctx, resource, fs...)
+}
+
+func (m *meteredStore) Update(ctx context.Context, resource model.Resource, fs ...UpdateOptionsFunc) error {
+	defer m.observe("update", resource.GetType(), time.Now())
+	return m.delegate.Update(ctx, resource, fs...)
+}
+
+func (m *meteredStore) Delete(ctx context.Context, resource model.Resource, fs ...DeleteOptionsFunc) error {
+	defer m.observe("delete", resource.GetType(), time.Now())
+	return m.delegate.Delete(ctx, resource, fs...)
+}
+
+func (m *meteredStore) Get(ctx context.Context, resource model.Resource, fs ...GetOptionsFunc) error {
+	defer m.observe("get", resource.GetType(), time.Now())
+	return m.delegate.Get(ctx, resource, fs...)
+}
+
+func (m *meteredStore) List(ctx context.Context, list model.ResourceList, fs ...ListOptionsFunc) error {
+	defer m.observe("list", list.GetItemType(), time.Now())
+	return m.delegate.List(ctx, list, fs...)
+}
+
+func (m *meteredStore) Close() error {
+	closable, ok := m.delegate.(io.Closer)
+	if ok {
+		return closable.Close()
+	}
+	return nil
+}
+
+func (m *meteredStore) observe(operation string, resourceType model.ResourceType, start time.Time) {
+	m.latency.WithLabelValues(operation, string(resourceType)).Observe(time.Since(start).Seconds())
+}
@@ -13,6 +13,7 @@ import (
 	core_store "github.com/Kong/kuma/pkg/core/resources/store"
 	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/metrics"
 )
 
 // BuilderContext provides access to Builder's interim state.
@@ -22,6 +23,8 @@ type BuilderContext interface {
 	XdsContext() core_xds.XdsContext
 	Config() kuma_cp.Config
 	Extensions() context.Context
+	Metrics() metrics.Metrics
+	Health() Health
 }
 
 var _ BuilderContext = &Builder{}
@@ -38,10 +41,12 @@ type Builder struct {
 	pcm provided_ca.ProvidedCaManager
 	xds core_xds.XdsContext
 	ext context.Context
+	met metrics.Metrics
+	hlt Health
 }
 
 func BuilderFor(cfg kuma_cp.Config) *Builder {
-	return &Builder{cfg: cfg, ext: context.Background()}
+	return &Builder{cfg: cfg, ext: context.Background(), hlt: NewHealth()}
 }
 
 func (b *Builder) WithComponentManager(cm ComponentManager) *Builder {
@@ -89,6 +94,11 @@ func (b *Builder) WithExtensions(ext context.Context) *Builder {
 	return b
 }
 
+func (b *Builder) WithMetrics(met metrics.Metrics) *Builder {
+	b.met = met
+	return b
+}
+
 func (b *Builder) Build() (Runtime, error) {
 	if b.cm == nil {
 		return nil, errors.Errorf("ComponentManager has not been configured")
@@ -117,6 +127,9 @@ func (b *Builder) Build() (Runtime, error) {
 	if b.ext == nil {
 		return nil, errors.Errorf("Extensions have been misconfigured")
 	}
+	if b.met == nil {
+		return nil, errors.Errorf("Metrics have not been configured")
+	}
 	return &runtime{
 		RuntimeInfo: &runtimeInfo{
 			instanceId: core.NewUUID(),
@@ -130,8 +143,10 @@ func (b *Builder) Build() (Runtime, error) {
 			pcm: b.pcm,
 			xds: b.xds,
 			ext: b.ext,
+			met: b.met,
+			hlt: b.hlt,
 		},
-		ComponentManager: b.cm,
+		ComponentManager: NewHealthTrackingComponentManager(b.cm, b.hlt),
 	}, nil
 }
 
@@ -159,3 +174,9 @@ func (b *Builder) Config() kuma_cp.Config {
 func (b *Builder) Extensions() context.Context {
 	return b.ext
 }
+func (b *Builder) Metrics() metrics.Metrics {
+	return b.met
+}
+func (b *Builder) Health() Health {
+	return b.hlt
+}
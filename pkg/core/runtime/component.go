@@ -2,11 +2,31 @@ package runtime
 
 import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/Kong/kuma/pkg/core"
 )
 
+var log = core.Log.WithName("runtime")
+
 // Component of the Control Plane, i.e. gRPC Server, HTTP server, reconciliation loop.
 type Component = manager.Runnable
 
+// LeaderComponent is a Component that must run only on a single instance of the Control Plane at a time,
+// i.e. creation of default resources, usage reports.
+//
+// It mirrors LeaderElectionRunnable of controller-runtime.
+type LeaderComponent interface {
+	Component
+	NeedLeaderElection() bool
+}
+
+// LeaderObserver is a LeaderComponent that is notified when another instance of the Control Plane is a leader,
+// i.e. to stop waiting for a work that only a leader does.
+type LeaderObserver interface {
+	LeaderComponent
+	OnObservedOtherLeader()
+}
+
 var _ Component = ComponentFunc(nil)
 
 type ComponentFunc func(<-chan struct{}) error
@@ -15,6 +35,26 @@ func (f ComponentFunc) Start(stop <-chan struct{}) error {
 	return f(stop)
 }
 
+var _ LeaderComponent = LeaderComponentFunc(nil)
+
+// LeaderComponentFunc is a component that is started when the Control Plane instance becomes a leader
+// and is stopped when the instance loses leadership.
+type LeaderComponentFunc func(<-chan struct{}) error
+
+func (f LeaderComponentFunc) Start(stop <-chan struct{}) error {
+	return f(stop)
+}
+
+func (f LeaderComponentFunc) NeedLeaderElection() bool {
+	return true
+}
+
+// IsLeaderComponent returns true if a given component must run only on a leader instance of the Control Plane.
+func IsLeaderComponent(c Component) bool {
+	lc, ok := c.(LeaderComponent)
+	return ok && lc.NeedLeaderElection()
+}
+
 type ComponentManager interface {
 
 	// Add registers a component, i.e. gRPC Server, HTTP server, reconciliation loop.
@@ -0,0 +1,192 @@
+package runtime
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// HealthChecker is implemented by parts of the Control Plane that can report degraded health,
+// e.g. a resource store that has lost connection to DB.
+type HealthChecker interface {
+	// CheckHealth returns an error if a part of the Control Plane is unhealthy.
+	CheckHealth() error
+}
+
+var _ HealthChecker = HealthCheckerFunc(nil)
+
+type HealthCheckerFunc func() error
+
+func (f HealthCheckerFunc) CheckHealth() error {
+	return f()
+}
+
+// HealthCheckResult is an outcome of a single health check.
+type HealthCheckResult struct {
+	Name string
+	Err  error
+}
+
+// HealthReport is an outcome of all health checks of a given kind.
+type HealthReport []HealthCheckResult
+
+// Healthy returns true if all health checks have passed.
+func (r HealthReport) Healthy() bool {
+	for _, result := range r {
+		if result.Err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (r HealthReport) String() string {
+	var report string
+	for _, result := range r {
+		if result.Err != nil {
+			report += fmt.Sprintf("[-] %s: %s\n", result.Name, result.Err)
+		} else {
+			report += fmt.Sprintf("[+] %s: ok\n", result.Name)
+		}
+	}
+	return report
+}
+
+// Health aggregates health checks of the Control Plane.
+type Health interface {
+	// AddReadinessCheck registers a check that must pass before the Control Plane can receive traffic.
+	AddReadinessCheck(name string, checker HealthChecker)
+	// AddLivenessCheck registers a check that must pass for the Control Plane to be considered alive.
+	AddLivenessCheck(name string, checker HealthChecker)
+	CheckReadiness() HealthReport
+	CheckLiveness() HealthReport
+}
+
+var _ Health = &health{}
+
+func NewHealth() Health {
+	return &health{}
+}
+
+type namedHealthChecker struct {
+	name    string
+	checker HealthChecker
+}
+
+type health struct {
+	mu        sync.RWMutex // protects access to the fields below
+	readiness []namedHealthChecker
+	liveness  []namedHealthChecker
+}
+
+func (h *health) AddReadinessCheck(name string, checker HealthChecker) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.readiness = append(h.readiness, namedHealthChecker{name: name, checker: checker})
+}
+
+func (h *health) AddLivenessCheck(name string, checker HealthChecker) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.liveness = append(h.liveness, namedHealthChecker{name: name, checker: checker})
+}
+
+func (h *health) CheckReadiness() HealthReport {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return check(h.readiness)
+}
+
+func (h *health) CheckLiveness() HealthReport {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return check(h.liveness)
+}
+
+func check(checkers []namedHealthChecker) HealthReport {
+	report := make(HealthReport, 0, len(checkers))
+	for _, c := range checkers {
+		report = append(report, HealthCheckResult{Name: c.name, Err: c.checker.CheckHealth()})
+	}
+	return report
+}
+
+var _ ComponentManager = &healthTrackingComponentManager{}
+
+// NewHealthTrackingComponentManager returns a ComponentManager that reports the Control Plane as not ready
+// until all registered components have been started and while any of them reports degraded health.
+//
+// Leader components are not tracked since they run only on a single instance of the Control Plane.
+func NewHealthTrackingComponentManager(delegate ComponentManager, health Health) ComponentManager {
+	cm := &healthTrackingComponentManager{
+		delegate: delegate,
+	}
+	health.AddReadinessCheck("components", HealthCheckerFunc(cm.checkHealth))
+	return cm
+}
+
+type healthTrackingComponentManager struct {
+	delegate ComponentManager
+
+	mu         sync.RWMutex // protects access to the fields below
+	components []*trackedComponent
+}
+
+func (cm *healthTrackingComponentManager) Add(c Component) error {
+	if IsLeaderComponent(c) {
+		return cm.delegate.Add(c)
+	}
+	tracked := &trackedComponent{Component: c}
+	cm.mu.Lock()
+	cm.components = append(cm.components, tracked)
+	cm.mu.Unlock()
+	return cm.delegate.Add(tracked)
+}
+
+func (cm *healthTrackingComponentManager) Start(stop <-chan struct{}) error {
+	return cm.delegate.Start(stop)
+}
+
+func (cm *healthTrackingComponentManager) checkHealth() error {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	notStarted := 0
+	for _, c := range cm.components {
+		if !c.Started() {
+			notStarted++
+			continue
+		}
+		if checker, ok := c.Component.(HealthChecker); ok {
+			if err := checker.CheckHealth(); err != nil {
+				return errors.Wrapf(err, "component %T is unhealthy", c.Component)
+			}
+		}
+	}
+	if notStarted > 0 {
+		return errors.Errorf("%d out of %d components have not been started yet", notStarted, len(cm.components))
+	}
+	return nil
+}
+
+// trackedComponent remembers whether a component has been started.
+type trackedComponent struct {
+	Component
+	started int32
+}
+
+func (c *trackedComponent) Start(stop <-chan struct{}) error {
+	atomic.StoreInt32(&c.started, 1)
+	return c.Component.Start(stop)
+}
+
+func (c *trackedComponent) Started() bool {
+	return atomic.LoadInt32(&c.started) == 1
+}
+
+// NeedLeaderElection is implemented to let controller-runtime Manager start a component
+// regardless of whether the Manager has leader election enabled or not.
+func (c *trackedComponent) NeedLeaderElection() bool {
+	return false
+}
@@ -0,0 +1,121 @@
+package runtime_test
+
+import (
+	"github.com/pkg/errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+)
+
+var _ = Describe("Health", func() {
+
+	It("should aggregate readiness and liveness checks", func() {
+		// given
+		health := core_runtime.NewHealth()
+		health.AddReadinessCheck("store", core_runtime.HealthCheckerFunc(func() error {
+			return errors.New("could not connect to DB")
+		}))
+		health.AddReadinessCheck("defaults", core_runtime.HealthCheckerFunc(func() error {
+			return nil
+		}))
+		health.AddLivenessCheck("leader-election", core_runtime.HealthCheckerFunc(func() error {
+			return nil
+		}))
+
+		// when
+		readiness := health.CheckReadiness()
+		liveness := health.CheckLiveness()
+
+		// then
+		Expect(readiness.Healthy()).To(BeFalse())
+		Expect(readiness.String()).To(Equal("[-] store: could not connect to DB\n[+] defaults: ok\n"))
+		// and
+		Expect(liveness.Healthy()).To(BeTrue())
+		Expect(liveness.String()).To(Equal("[+] leader-election: ok\n"))
+	})
+})
+
+var _ = Describe("HealthTrackingComponentManager", func() {
+
+	var health core_runtime.Health
+	var delegate *fakeComponentManager
+	var cm core_runtime.ComponentManager
+
+	BeforeEach(func() {
+		health = core_runtime.NewHealth()
+		delegate = &fakeComponentManager{}
+		cm = core_runtime.NewHealthTrackingComponentManager(delegate, health)
+	})
+
+	It("should report not ready until all components are started", func() {
+		// given
+		noop := core_runtime.ComponentFunc(func(_ <-chan struct{}) error {
+			return nil
+		})
+		leaderComponent := core_runtime.LeaderComponentFunc(func(_ <-chan struct{}) error {
+			return nil
+		})
+		Expect(core_runtime.Add(cm, noop, noop, leaderComponent)).To(Succeed())
+
+		// when
+		report := health.CheckReadiness()
+
+		// then
+		Expect(report.Healthy()).To(BeFalse())
+		Expect(report.String()).To(Equal("[-] components: 2 out of 2 components have not been started yet\n"))
+
+		// when
+		Expect(delegate.components[0].Start(nil)).To(Succeed())
+		Expect(delegate.components[1].Start(nil)).To(Succeed())
+
+		// then leader components are not required to be started
+		Expect(health.CheckReadiness().Healthy()).To(BeTrue())
+	})
+
+	It("should report degraded health of a component", func() {
+		// given
+		component := &unhealthyComponent{err: errors.New("connection lost")}
+		Expect(cm.Add(component)).To(Succeed())
+
+		// when
+		Expect(delegate.components[0].Start(nil)).To(Succeed())
+		report := health.CheckReadiness()
+
+		// then
+		Expect(report.Healthy()).To(BeFalse())
+		Expect(report.String()).To(Equal("[-] components: component *runtime_test.unhealthyComponent is unhealthy: connection lost\n"))
+
+		// when
+		component.err = nil
+
+		// then
+		Expect(health.CheckReadiness().Healthy()).To(BeTrue())
+	})
+
+	It("should not make components leader-only", func() {
+		// given
+		component := core_runtime.ComponentFunc(func(_ <-chan struct{}) error {
+			return nil
+		})
+
+		// when
+		Expect(cm.Add(component)).To(Succeed())
+
+		// then
+		Expect(core_runtime.IsLeaderComponent(delegate.components[0])).To(BeFalse())
+	})
+})
+
+type unhealthyComponent struct {
+	err error
+}
+
+func (c *unhealthyComponent) Start(_ <-chan struct{}) error {
+	return nil
+}
+
+func (c *unhealthyComponent) CheckHealth() error {
+	return c.err
+}
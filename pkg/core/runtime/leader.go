@@ -0,0 +1,98 @@
+package runtime
+
+import (
+	"sync"
+)
+
+// LeaderCallbacks are notified when the Control Plane instance acquires or loses leadership.
+type LeaderCallbacks struct {
+	OnStartedLeading func()
+	OnStoppedLeading func()
+	// OnObservedOtherLeader is called whenever the instance sees that another instance is a leader. Optional.
+	OnObservedOtherLeader func()
+}
+
+// LeaderElector elects a single leader among all instances of the Control Plane
+// that share the same resource store.
+type LeaderElector interface {
+	// AddCallbacks registers callbacks. Must be called before Start.
+	AddCallbacks(LeaderCallbacks)
+	// IsLeader returns true if the Control Plane instance is a leader at the moment.
+	IsLeader() bool
+	// Start participates in leader election and blocks until the Stop channel is closed.
+	// OnStoppedLeading callbacks are guaranteed to be called if the instance was a leader by the time of stop.
+	Start(stop <-chan struct{})
+}
+
+var _ ComponentManager = &leaderAwareComponentManager{}
+
+// NewLeaderAwareComponentManager returns a ComponentManager that runs leader components
+// only while the Control Plane instance is a leader and delegates all other components
+// to a given ComponentManager.
+func NewLeaderAwareComponentManager(delegate ComponentManager, leaderElector LeaderElector) ComponentManager {
+	return &leaderAwareComponentManager{
+		delegate:      delegate,
+		leaderElector: leaderElector,
+	}
+}
+
+type leaderAwareComponentManager struct {
+	delegate         ComponentManager
+	leaderElector    LeaderElector
+	leaderComponents []Component
+}
+
+func (cm *leaderAwareComponentManager) Add(c Component) error {
+	if IsLeaderComponent(c) {
+		cm.leaderComponents = append(cm.leaderComponents, c)
+		return nil
+	}
+	return cm.delegate.Add(c)
+}
+
+func (cm *leaderAwareComponentManager) Start(stop <-chan struct{}) error {
+	errCh := make(chan error, len(cm.leaderComponents)+1)
+
+	var mu sync.Mutex // protects leaderStop
+	var leaderStop chan struct{}
+	cm.leaderElector.AddCallbacks(LeaderCallbacks{
+		OnStartedLeading: func() {
+			log.Info("leader acquired, starting leader components")
+			mu.Lock()
+			defer mu.Unlock()
+			leaderStop = make(chan struct{})
+			for _, component := range cm.leaderComponents {
+				go func(c Component, stop <-chan struct{}) {
+					if err := c.Start(stop); err != nil {
+						select {
+						case errCh <- err:
+						default:
+						}
+					}
+				}(component, leaderStop)
+			}
+		},
+		OnStoppedLeading: func() {
+			log.Info("leader lost, stopping leader components")
+			mu.Lock()
+			defer mu.Unlock()
+			if leaderStop != nil {
+				close(leaderStop)
+				leaderStop = nil
+			}
+		},
+		OnObservedOtherLeader: func() {
+			for _, component := range cm.leaderComponents {
+				if observer, ok := component.(LeaderObserver); ok {
+					observer.OnObservedOtherLeader()
+				}
+			}
+		},
+	})
+	go cm.leaderElector.Start(stop)
+
+	go func() {
+		errCh <- cm.delegate.Start(stop)
+	}()
+	return <-errCh
+}
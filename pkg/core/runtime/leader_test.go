@@ -0,0 +1,193 @@
+package runtime_test
+
+import (
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+)
+
+var _ = Describe("LeaderAwareComponentManager", func() {
+
+	var elector *fakeLeaderElector
+	var delegate *fakeComponentManager
+	var cm core_runtime.ComponentManager
+
+	BeforeEach(func() {
+		elector = &fakeLeaderElector{events: make(chan bool), others: make(chan struct{})}
+		delegate = &fakeComponentManager{}
+		cm = core_runtime.NewLeaderAwareComponentManager(delegate, elector)
+	})
+
+	It("should run leader components only while the instance is a leader", func() {
+		// given
+		var running int32
+		leaderComponent := core_runtime.LeaderComponentFunc(func(stop <-chan struct{}) error {
+			atomic.StoreInt32(&running, 1)
+			<-stop
+			atomic.StoreInt32(&running, 0)
+			return nil
+		})
+		regularComponent := core_runtime.ComponentFunc(func(stop <-chan struct{}) error {
+			<-stop
+			return nil
+		})
+
+		// when
+		err := core_runtime.Add(cm, leaderComponent, regularComponent)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(delegate.components).To(HaveLen(1))
+
+		// when
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			defer GinkgoRecover()
+			Expect(cm.Start(stop)).To(Succeed())
+		}()
+
+		// then leader component is not running until the instance becomes a leader
+		Consistently(func() int32 {
+			return atomic.LoadInt32(&running)
+		}, "100ms").Should(Equal(int32(0)))
+
+		// when
+		elector.events <- true
+
+		// then
+		Eventually(func() int32 {
+			return atomic.LoadInt32(&running)
+		}, "1s").Should(Equal(int32(1)))
+
+		// when
+		elector.events <- false
+
+		// then
+		Eventually(func() int32 {
+			return atomic.LoadInt32(&running)
+		}, "1s").Should(Equal(int32(0)))
+	})
+
+	It("should notify leader components when another instance is a leader", func() {
+		// given
+		observer := &fakeLeaderObserver{}
+		Expect(cm.Add(observer)).To(Succeed())
+
+		// when
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			defer GinkgoRecover()
+			Expect(cm.Start(stop)).To(Succeed())
+		}()
+		elector.others <- struct{}{}
+
+		// then
+		Eventually(func() int32 {
+			return atomic.LoadInt32(&observer.observed)
+		}, "1s").Should(Equal(int32(1)))
+	})
+
+	It("should return an error of a leader component", func() {
+		// given
+		leaderComponent := core_runtime.LeaderComponentFunc(func(stop <-chan struct{}) error {
+			return errTest
+		})
+		Expect(cm.Add(leaderComponent)).To(Succeed())
+
+		// when
+		stop := make(chan struct{})
+		defer close(stop)
+		errCh := make(chan error)
+		go func() {
+			errCh <- cm.Start(stop)
+		}()
+		elector.events <- true
+
+		// then
+		Eventually(errCh, "1s").Should(Receive(Equal(errTest)))
+	})
+})
+
+var errTest = errors.New("test error")
+
+var _ core_runtime.LeaderElector = &fakeLeaderElector{}
+
+// fakeLeaderElector changes leadership according to events it receives.
+type fakeLeaderElector struct {
+	callbacks []core_runtime.LeaderCallbacks
+	events    chan bool
+	others    chan struct{}
+	leader    bool
+}
+
+func (e *fakeLeaderElector) AddCallbacks(callbacks core_runtime.LeaderCallbacks) {
+	e.callbacks = append(e.callbacks, callbacks)
+}
+
+func (e *fakeLeaderElector) IsLeader() bool {
+	return e.leader
+}
+
+func (e *fakeLeaderElector) Start(stop <-chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		case <-e.others:
+			for _, callbacks := range e.callbacks {
+				callbacks.OnObservedOtherLeader()
+			}
+		case leader := <-e.events:
+			e.leader = leader
+			for _, callbacks := range e.callbacks {
+				if leader {
+					callbacks.OnStartedLeading()
+				} else {
+					callbacks.OnStoppedLeading()
+				}
+			}
+		}
+	}
+}
+
+var _ core_runtime.LeaderObserver = &fakeLeaderObserver{}
+
+type fakeLeaderObserver struct {
+	observed int32
+}
+
+func (o *fakeLeaderObserver) Start(stop <-chan struct{}) error {
+	<-stop
+	return nil
+}
+
+func (o *fakeLeaderObserver) NeedLeaderElection() bool {
+	return true
+}
+
+func (o *fakeLeaderObserver) OnObservedOtherLeader() {
+	atomic.StoreInt32(&o.observed, 1)
+}
+
+var _ core_runtime.ComponentManager = &fakeComponentManager{}
+
+type fakeComponentManager struct {
+	components []core_runtime.Component
+}
+
+func (cm *fakeComponentManager) Add(c core_runtime.Component) error {
+	cm.components = append(cm.components, c)
+	return nil
+}
+
+func (cm *fakeComponentManager) Start(stop <-chan struct{}) error {
+	<-stop
+	return nil
+}
@@ -145,19 +145,26 @@ func (b *reportsBuffer) initImmutable(rt core_runtime.Runtime) {
 	}
 }
 
-func startReportTicker(rt core_runtime.Runtime, buffer *reportsBuffer) {
+func startReportTicker(rt core_runtime.Runtime, buffer *reportsBuffer, stop <-chan struct{}) {
 	go func() {
-		for range time.Tick(time.Second * pingInterval) {
-			err := buffer.dispatch(rt, pingHost, pingPort)
-			if err != nil {
-				log.V(2).Info("Failed sending usage info", err)
+		ticker := time.NewTicker(time.Second * pingInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				err := buffer.dispatch(rt, pingHost, pingPort)
+				if err != nil {
+					log.V(2).Info("Failed sending usage info", err)
+				}
+			case <-stop:
+				return
 			}
 		}
 	}()
 }
 
-// Init core reports
-func Init(rt core_runtime.Runtime, cfg kuma_cp.Config) {
+// Init core reports. Reports are sent until the Stop channel is closed.
+func Init(rt core_runtime.Runtime, cfg kuma_cp.Config, stop <-chan struct{}) {
 	var buffer reportsBuffer
 	buffer.immutable = make(map[string]string)
 	buffer.mutable = make(map[string]string)
@@ -165,6 +172,6 @@ func Init(rt core_runtime.Runtime, cfg kuma_cp.Config) {
 	buffer.initImmutable(rt)
 
 	if cfg.Reports.Enabled {
-		startReportTicker(rt, &buffer)
+		startReportTicker(rt, &buffer, stop)
 	}
 }
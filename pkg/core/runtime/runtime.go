@@ -9,6 +9,7 @@ import (
 	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
 	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/metrics"
 )
 
 // Runtime represents initialized application state.
@@ -31,6 +32,8 @@ type RuntimeContext interface {
 	BuiltinCaManager() builtin_ca.BuiltinCaManager
 	ProvidedCaManager() provided_ca.ProvidedCaManager
 	Extensions() context.Context
+	Metrics() metrics.Metrics
+	Health() Health
 }
 
 var _ Runtime = &runtime{}
@@ -62,6 +65,8 @@ type runtimeContext struct {
 	pcm provided_ca.ProvidedCaManager
 	xds core_xds.XdsContext
 	ext context.Context
+	met metrics.Metrics
+	hlt Health
 }
 
 func (rc *runtimeContext) Config() kuma_cp.Config {
@@ -88,3 +93,9 @@ func (rc *runtimeContext) ProvidedCaManager() provided_ca.ProvidedCaManager {
 func (rc *runtimeContext) Extensions() context.Context {
 	return rc.ext
 }
+func (rc *runtimeContext) Metrics() metrics.Metrics {
+	return rc.met
+}
+func (rc *runtimeContext) Health() Health {
+	return rc.hlt
+}
@@ -0,0 +1,80 @@
+package xds
+
+import (
+	"sync"
+)
+
+// ApiVersion is a version of Envoy xDS API resources are served in.
+type ApiVersion string
+
+const (
+	ApiVersionV2 ApiVersion = "v2"
+	ApiVersionV3 ApiVersion = "v3"
+)
+
+// ApiVersionTracker keeps track of resources that are served to Dataplanes in xDS v3.
+//
+// Resources are generated and cached in xDS v2 and get upgraded only when they are sent to Envoy,
+// so the cache alone cannot tell which version of resources Envoy has actually received.
+// Envoy connected over xDS v3 transport chooses a version of every resource type on its own.
+type ApiVersionTracker interface {
+	// TrackV3 remembers that resources of a given xDS v2 type URL are served in xDS v3 to a Dataplane over a given stream.
+	TrackV3(stream interface{}, proxyId ProxyId, typeURL string)
+	// Forget forgets resources served over a stream that has been closed.
+	Forget(stream interface{})
+	// ApiVersion returns a version of Envoy xDS API resources of a given xDS v2 type URL are served in to a Dataplane.
+	ApiVersion(proxyId ProxyId, typeURL string) ApiVersion
+}
+
+func NewApiVersionTracker() ApiVersionTracker {
+	return &apiVersionTracker{
+		streams: map[ProxyId]*apiVersionStream{},
+	}
+}
+
+var _ ApiVersionTracker = &apiVersionTracker{}
+
+type apiVersionTracker struct {
+	mu sync.RWMutex // protects access to the fields below
+	// streams holds the latest stream of every Dataplane, since a reconnected Dataplane might open a new stream
+	// before the old one is closed
+	streams map[ProxyId]*apiVersionStream
+}
+
+type apiVersionStream struct {
+	stream     interface{}
+	v3TypeURLs map[string]bool
+}
+
+func (t *apiVersionTracker) TrackV3(stream interface{}, proxyId ProxyId, typeURL string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tracked, ok := t.streams[proxyId]
+	if !ok || tracked.stream != stream {
+		tracked = &apiVersionStream{
+			stream:     stream,
+			v3TypeURLs: map[string]bool{},
+		}
+		t.streams[proxyId] = tracked
+	}
+	tracked.v3TypeURLs[typeURL] = true
+}
+
+func (t *apiVersionTracker) Forget(stream interface{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for proxyId, tracked := range t.streams {
+		if tracked.stream == stream {
+			delete(t.streams, proxyId)
+		}
+	}
+}
+
+func (t *apiVersionTracker) ApiVersion(proxyId ProxyId, typeURL string) ApiVersion {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if tracked, ok := t.streams[proxyId]; ok && tracked.v3TypeURLs[typeURL] {
+		return ApiVersionV3
+	}
+	return ApiVersionV2
+}
@@ -0,0 +1,51 @@
+package xds_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v2"
+
+	"github.com/Kong/kuma/pkg/core/xds"
+)
+
+var _ = Describe("ApiVersionTracker", func() {
+
+	proxyId := xds.ProxyId{Mesh: "default", Name: "backend-01"}
+
+	It("should track resources served in xDS v3 per Dataplane", func() {
+		// given
+		tracker := xds.NewApiVersionTracker()
+		stream := &struct{ int }{}
+
+		// when
+		tracker.TrackV3(stream, proxyId, envoy_resource.ListenerType)
+
+		// then
+		Expect(tracker.ApiVersion(proxyId, envoy_resource.ListenerType)).To(Equal(xds.ApiVersionV3))
+		Expect(tracker.ApiVersion(proxyId, envoy_resource.ClusterType)).To(Equal(xds.ApiVersionV2))
+		Expect(tracker.ApiVersion(xds.ProxyId{Mesh: "default", Name: "web-01"}, envoy_resource.ListenerType)).To(Equal(xds.ApiVersionV2))
+
+		// when
+		tracker.Forget(stream)
+
+		// then
+		Expect(tracker.ApiVersion(proxyId, envoy_resource.ListenerType)).To(Equal(xds.ApiVersionV2))
+	})
+
+	It("should not forget a new stream of a reconnected Dataplane when the old one is closed", func() {
+		// given
+		tracker := xds.NewApiVersionTracker()
+		oldStream := &struct{ int }{1}
+		newStream := &struct{ int }{2}
+		tracker.TrackV3(oldStream, proxyId, envoy_resource.ListenerType)
+
+		// when
+		tracker.TrackV3(newStream, proxyId, envoy_resource.ClusterType)
+		tracker.Forget(oldStream)
+
+		// then
+		Expect(tracker.ApiVersion(proxyId, envoy_resource.ListenerType)).To(Equal(xds.ApiVersionV2))
+		Expect(tracker.ApiVersion(proxyId, envoy_resource.ClusterType)).To(Equal(xds.ApiVersionV3))
+	})
+})
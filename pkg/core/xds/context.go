@@ -2,7 +2,7 @@ package xds
 
 import (
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
-	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v2"
 	envoy_log "github.com/envoyproxy/go-control-plane/pkg/log"
 	"github.com/go-logr/logr"
 
@@ -13,6 +13,7 @@ import (
 type XdsContext interface {
 	Hasher() envoy_cache.NodeHash
 	Cache() envoy_cache.SnapshotCache
+	ApiVersions() ApiVersionTracker
 }
 
 func NewXdsContext() XdsContext {
@@ -25,9 +26,10 @@ func newXdsContext(name string, ads bool) XdsContext {
 	logger := util_xds.NewLogger(log)
 	cache := envoy_cache.NewSnapshotCache(ads, hasher, logger)
 	return &xdsContext{
-		NodeHash:      hasher,
-		Logger:        logger,
-		SnapshotCache: cache,
+		NodeHash:          hasher,
+		Logger:            logger,
+		SnapshotCache:     cache,
+		ApiVersionTracker: NewApiVersionTracker(),
 	}
 }
 
@@ -37,6 +39,7 @@ type xdsContext struct {
 	envoy_cache.NodeHash
 	envoy_log.Logger
 	envoy_cache.SnapshotCache
+	ApiVersionTracker
 }
 
 func (c *xdsContext) Hasher() envoy_cache.NodeHash {
@@ -47,6 +50,10 @@ func (c *xdsContext) Cache() envoy_cache.SnapshotCache {
 	return c.SnapshotCache
 }
 
+func (c *xdsContext) ApiVersions() ApiVersionTracker {
+	return c.ApiVersionTracker
+}
+
 var _ envoy_cache.NodeHash = &hasher{}
 
 type hasher struct {
@@ -13,8 +13,9 @@ var metadataLog = core.Log.WithName("xds-server").WithName("metadata-tracker")
 const (
 	// Supported Envoy node metadata fields.
 
-	fieldDataplaneTokenPath = "dataplaneTokenPath"
-	fieldDataplaneAdminPort = "dataplane.admin.port"
+	fieldDataplaneTokenPath   = "dataplaneTokenPath"
+	fieldDataplaneAdminPort   = "dataplane.admin.port"
+	fieldDataplaneMetricsPort = "dataplane.metrics.port"
 )
 
 // DataplaneMetadata represents environment-specific part of a dataplane configuration.
@@ -34,6 +35,7 @@ const (
 type DataplaneMetadata struct {
 	DataplaneTokenPath string
 	AdminPort          uint32
+	MetricsPort        uint32
 }
 
 func (m *DataplaneMetadata) GetDataplaneTokenPath() string {
@@ -50,6 +52,13 @@ func (m *DataplaneMetadata) GetAdminPort() uint32 {
 	return m.AdminPort
 }
 
+func (m *DataplaneMetadata) GetMetricsPort() uint32 {
+	if m == nil {
+		return 0
+	}
+	return m.MetricsPort
+}
+
 func DataplaneMetadataFromNode(node *envoy_core.Node) *DataplaneMetadata {
 	metadata := DataplaneMetadata{}
 	if node.Metadata == nil {
@@ -65,5 +74,12 @@ func DataplaneMetadataFromNode(node *envoy_core.Node) *DataplaneMetadata {
 			metadataLog.Error(err, "invalid value in dataplane metadata", "field", fieldDataplaneAdminPort, "value", value)
 		}
 	}
+	if value := node.Metadata.Fields[fieldDataplaneMetricsPort]; value != nil {
+		if port, err := strconv.Atoi(value.GetStringValue()); err == nil {
+			metadata.MetricsPort = uint32(port)
+		} else {
+			metadataLog.Error(err, "invalid value in dataplane metadata", "field", fieldDataplaneMetricsPort, "value", value)
+		}
+	}
 	return &metadata
 }
@@ -40,12 +40,18 @@ var _ = DescribeTable("DataplaneMetadataFromNode",
 							StringValue: "1234",
 						},
 					},
+					"dataplane.metrics.port": &pstruct.Value{
+						Kind: &pstruct.Value_StringValue{
+							StringValue: "9902",
+						},
+					},
 				},
 			},
 		},
 		expected: xds.DataplaneMetadata{
 			DataplaneTokenPath: "/tmp/token",
 			AdminPort:          1234,
+			MetricsPort:        9902,
 		},
 	}),
 )
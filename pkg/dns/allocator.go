@@ -0,0 +1,213 @@
+package dns
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core"
+	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/apis/system"
+	"github.com/Kong/kuma/pkg/core/resources/manager"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+)
+
+var allocatorLog = core.Log.WithName("dns").WithName("vip-allocator")
+
+var _ core_runtime.LeaderComponent = &VIPsAllocator{}
+
+// VIPsAllocator periodically allocates virtual IPs to services of every mesh.
+//
+// A service keeps its VIP for as long as there is a Dataplane that provides it.
+// VIPs of services that are gone are released and can be allocated to other services,
+// though only after all other IPs of the CIDR have been allocated.
+type VIPsAllocator struct {
+	rm       manager.ResourceManager
+	cidr     *net.IPNet
+	interval time.Duration
+}
+
+func NewVIPsAllocator(rm manager.ResourceManager, cidr string, interval time.Duration) (*VIPsAllocator, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse CIDR %q", cidr)
+	}
+	if network.IP.To4() == nil {
+		return nil, errors.Errorf("CIDR %q is not an IPv4 network", cidr)
+	}
+	return &VIPsAllocator{
+		rm:       rm,
+		cidr:     network,
+		interval: interval,
+	}, nil
+}
+
+func (a *VIPsAllocator) Start(stop <-chan struct{}) error {
+	allocatorLog.Info("starting", "cidr", a.cidr)
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := a.AllocateVIPs(); err != nil {
+				allocatorLog.Error(err, "could not allocate VIPs")
+			}
+		case <-stop:
+			allocatorLog.Info("stopping")
+			return nil
+		}
+	}
+}
+
+func (a *VIPsAllocator) NeedLeaderElection() bool {
+	return true
+}
+
+// AllocateVIPs allocates VIPs to services of all meshes and removes VIPs of meshes that are gone.
+func (a *VIPsAllocator) AllocateVIPs() error {
+	ctx := context.Background()
+	meshes := &mesh.MeshResourceList{}
+	if err := a.rm.List(ctx, meshes); err != nil {
+		return err
+	}
+	existing := map[string]bool{}
+	for _, m := range meshes.Items {
+		existing[m.GetMeta().GetName()] = true
+		if err := a.allocateVIPs(ctx, m.GetMeta().GetName()); err != nil {
+			allocatorLog.Error(err, "could not allocate VIPs", "mesh", m.GetMeta().GetName())
+		}
+	}
+	return a.removeVIPsOfDeletedMeshes(ctx, existing)
+}
+
+func (a *VIPsAllocator) allocateVIPs(ctx context.Context, meshName string) error {
+	dataplanes := &mesh.DataplaneResourceList{}
+	if err := a.rm.List(ctx, dataplanes, store.ListByMesh(meshName)); err != nil {
+		return err
+	}
+	services := map[string]bool{}
+	for _, dataplane := range dataplanes.Items {
+		for _, service := range dataplane.Spec.Tags().Values(mesh_proto.ServiceTag) {
+			services[service] = true
+		}
+	}
+
+	current, err := loadVIPsState(ctx, a.rm, meshName)
+	if err != nil {
+		return err
+	}
+	next, changed, err := allocate(a.cidr, current, services)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	allocatorLog.V(1).Info("storing VIPs", "mesh", meshName, "vips", next.VIPs)
+	return storeVIPsState(ctx, a.rm, meshName, next)
+}
+
+// removeVIPsOfDeletedMeshes removes VIPs of meshes that are gone, so a mesh created with the same name starts afresh.
+// Unlike secrets, configs are not deleted together with a mesh.
+func (a *VIPsAllocator) removeVIPsOfDeletedMeshes(ctx context.Context, existing map[string]bool) error {
+	configs := &system.ConfigResourceList{}
+	if err := a.rm.List(ctx, configs); err != nil {
+		return err
+	}
+	for _, config := range configs.Items {
+		key := model.MetaToResourceKey(config.GetMeta())
+		if existing[key.Mesh] || key != vipsResourceKey(key.Mesh) {
+			continue
+		}
+		allocatorLog.V(1).Info("removing VIPs of deleted mesh", "mesh", key.Mesh)
+		if err := a.rm.Delete(ctx, config, store.DeleteBy(key)); err != nil && !store.IsResourceNotFound(err) {
+			return errors.Wrapf(err, "could not remove VIPs of mesh %q", key.Mesh)
+		}
+	}
+	return nil
+}
+
+// allocate returns VIPs of given services. Existing VIPs are preserved and new services get free IPs of the network
+// that follow the one allocated last, wrapping around at the end of the network.
+// That way a VIP of a removed service is not handed over to another service while DNS caches and Envoys still
+// refer to it, unless all other IPs are taken.
+// Network and broadcast addresses are never allocated.
+func allocate(network *net.IPNet, current vipsState, services map[string]bool) (vipsState, bool, error) {
+	// network and broadcast addresses are skipped
+	ones, bits := network.Mask.Size()
+	first := uint64(binary.BigEndian.Uint32(network.IP.To4())) + 1
+	last := first - 1 // no IP can be allocated in /31 and /32 networks
+	if size := uint64(1) << uint(bits-ones); size >= 4 {
+		last = first + size - 3
+	}
+	contains := func(ip uint64) bool {
+		return ip >= first && ip <= last
+	}
+	parse := func(vip string) (uint64, bool) {
+		ip := net.ParseIP(vip).To4()
+		if ip == nil {
+			return 0, false
+		}
+		return uint64(binary.BigEndian.Uint32(ip)), true
+	}
+	format := func(ip uint64) string {
+		result := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(result, uint32(ip))
+		return result.String()
+	}
+
+	next := vipsState{VIPs: VIPList{}, Last: current.Last}
+	taken := map[uint64]bool{}
+	changed := false
+	for service, vip := range current.VIPs {
+		ip, ok := parse(vip)
+		if !services[service] || !ok || !contains(ip) {
+			changed = true
+			continue
+		}
+		next.VIPs[service] = vip
+		taken[ip] = true
+	}
+
+	var newServices []string
+	for service := range services {
+		if _, ok := next.VIPs[service]; !ok {
+			newServices = append(newServices, service)
+		}
+	}
+	sort.Strings(newServices)
+
+	cursor := first
+	if ip, ok := parse(current.Last); ok && contains(ip) {
+		cursor = ip + 1
+	}
+	for _, service := range newServices {
+		allocated := false
+		for i := first; i <= last; i++ {
+			if cursor > last {
+				cursor = first
+			}
+			candidate := cursor
+			cursor++
+			if !taken[candidate] {
+				next.VIPs[service] = format(candidate)
+				next.Last = format(candidate)
+				taken[candidate] = true
+				changed = true
+				allocated = true
+				break
+			}
+		}
+		if !allocated {
+			return vipsState{}, false, errors.Errorf("could not allocate VIP to service %q: no free IPs left in %s", service, network)
+		}
+	}
+	return next, changed, nil
+}
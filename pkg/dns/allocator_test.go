@@ -0,0 +1,158 @@
+package dns_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/apis/system"
+	"github.com/Kong/kuma/pkg/core/resources/manager"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	"github.com/Kong/kuma/pkg/dns"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("VIPsAllocator", func() {
+
+	var rm manager.ResourceManager
+	var allocator *dns.VIPsAllocator
+
+	newAllocator := func(cidr string) *dns.VIPsAllocator {
+		allocator, err := dns.NewVIPsAllocator(rm, cidr, time.Second)
+		Expect(err).ToNot(HaveOccurred())
+		return allocator
+	}
+
+	BeforeEach(func() {
+		rm = manager.NewResourceManager(memory.NewStore())
+		allocator = newAllocator("240.0.0.0/29")
+
+		err := rm.Create(context.Background(), &mesh.MeshResource{}, store.CreateByKey("default", "default"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	createDataplane := func(name string, service string) {
+		dataplane := &mesh.DataplaneResource{
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port:        8080,
+							ServicePort: 80,
+							Tags: map[string]string{
+								"service": service,
+							},
+						},
+					},
+				},
+			},
+		}
+		err := rm.Create(context.Background(), dataplane, store.CreateByKey(name, "default"))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	deleteDataplane := func(name string) {
+		err := rm.Delete(context.Background(), &mesh.DataplaneResource{}, store.DeleteByKey(name, "default"))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	loadVIPs := func() dns.VIPList {
+		vips, err := dns.LoadVIPs(context.Background(), rm, "default")
+		Expect(err).ToNot(HaveOccurred())
+		return vips
+	}
+
+	It("should return no VIPs when none has been allocated yet", func() {
+		// expect
+		Expect(loadVIPs()).To(BeEmpty())
+	})
+
+	It("should allocate VIPs to services", func() {
+		// given
+		createDataplane("web-01", "web")
+		createDataplane("backend-01", "backend")
+		createDataplane("backend-02", "backend")
+
+		// when
+		Expect(allocator.AllocateVIPs()).To(Succeed())
+
+		// then
+		Expect(loadVIPs()).To(Equal(dns.VIPList{
+			"backend": "240.0.0.1",
+			"web":     "240.0.0.2",
+		}))
+	})
+
+	It("should keep VIPs of existing services and not reuse VIPs of removed services right away", func() {
+		// given
+		createDataplane("web-01", "web")
+		createDataplane("backend-01", "backend")
+		Expect(allocator.AllocateVIPs()).To(Succeed())
+
+		// when
+		deleteDataplane("backend-01")
+		createDataplane("redis-01", "redis")
+		Expect(allocator.AllocateVIPs()).To(Succeed())
+
+		// then
+		Expect(loadVIPs()).To(Equal(dns.VIPList{
+			"redis": "240.0.0.3",
+			"web":   "240.0.0.2",
+		}))
+	})
+
+	It("should reuse VIPs of removed services once the end of CIDR is reached", func() {
+		// given
+		allocator = newAllocator("240.0.0.0/30")
+		createDataplane("web-01", "web")
+		createDataplane("backend-01", "backend")
+		Expect(allocator.AllocateVIPs()).To(Succeed())
+
+		// when
+		deleteDataplane("backend-01")
+		createDataplane("redis-01", "redis")
+		Expect(allocator.AllocateVIPs()).To(Succeed())
+
+		// then broadcast address is skipped
+		Expect(loadVIPs()).To(Equal(dns.VIPList{
+			"redis": "240.0.0.1",
+			"web":   "240.0.0.2",
+		}))
+	})
+
+	It("should not allocate VIPs once CIDR is exhausted", func() {
+		// given
+		allocator = newAllocator("240.0.0.0/30")
+		createDataplane("a-01", "a")
+		createDataplane("b-01", "b")
+		createDataplane("c-01", "c")
+
+		// when
+		Expect(allocator.AllocateVIPs()).To(Succeed())
+
+		// then
+		Expect(loadVIPs()).To(BeEmpty())
+	})
+
+	It("should remove VIPs of deleted meshes", func() {
+		// given
+		createDataplane("web-01", "web")
+		Expect(allocator.AllocateVIPs()).To(Succeed())
+		Expect(loadVIPs()).ToNot(BeEmpty())
+
+		// when
+		err := rm.Delete(context.Background(), &mesh.MeshResource{}, store.DeleteByKey("default", "default"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocator.AllocateVIPs()).To(Succeed())
+
+		// then
+		configs := &system.ConfigResourceList{}
+		Expect(rm.List(context.Background(), configs)).To(Succeed())
+		Expect(configs.Items).To(BeEmpty())
+	})
+})
@@ -0,0 +1,30 @@
+package dns
+
+import (
+	"context"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+)
+
+// LoadVIPsFunc returns VIPs allocated to services of a given mesh.
+type LoadVIPsFunc func(ctx context.Context, mesh string) (VIPList, error)
+
+// NewCachedVIPsLoader returns a function that keeps VIPs of every mesh for a given expiration time,
+// so VIPs are loaded and parsed once per mesh rather than once per Dataplane.
+//
+// Returned VIPList is shared between callers and must not be modified.
+func NewCachedVIPsLoader(loader LoadVIPsFunc, expirationTime time.Duration) LoadVIPsFunc {
+	vipsCache := cache.New(expirationTime, time.Duration(int64(float64(expirationTime)*0.9)))
+	return func(ctx context.Context, mesh string) (VIPList, error) {
+		if vips, found := vipsCache.Get(mesh); found {
+			return vips.(VIPList), nil
+		}
+		vips, err := loader(ctx, mesh)
+		if err != nil {
+			return nil, err
+		}
+		vipsCache.SetDefault(mesh, vips)
+		return vips, nil
+	}
+}
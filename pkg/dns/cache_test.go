@@ -0,0 +1,50 @@
+package dns_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/pkg/dns"
+)
+
+var _ = Describe("NewCachedVIPsLoader()", func() {
+
+	var calls map[string]int
+	var loader dns.LoadVIPsFunc
+
+	BeforeEach(func() {
+		calls = map[string]int{}
+		loader = func(_ context.Context, mesh string) (dns.VIPList, error) {
+			calls[mesh]++
+			return dns.VIPList{"backend": "240.0.0.1"}, nil
+		}
+	})
+
+	It("should load VIPs once per mesh until they expire", func() {
+		// given
+		cached := dns.NewCachedVIPsLoader(loader, 100*time.Millisecond)
+
+		// when
+		for i := 0; i < 3; i++ {
+			for _, mesh := range []string{"default", "demo"} {
+				vips, err := cached(context.Background(), mesh)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vips).To(Equal(dns.VIPList{"backend": "240.0.0.1"}))
+			}
+		}
+
+		// then
+		Expect(calls).To(Equal(map[string]int{"default": 1, "demo": 1}))
+
+		// when
+		time.Sleep(200 * time.Millisecond)
+		_, err := cached(context.Background(), "default")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(calls["default"]).To(Equal(2))
+	})
+})
@@ -0,0 +1,47 @@
+package dns
+
+import (
+	"context"
+
+	kuma_cp "github.com/Kong/kuma/pkg/config/app/kuma-cp"
+	config_core "github.com/Kong/kuma/pkg/config/core"
+	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+)
+
+// Enabled returns whether services are resolved to VIPs.
+// VIPs are not allocated on Kubernetes, where services have their own ClusterIPs.
+func Enabled(cfg kuma_cp.Config) bool {
+	return cfg.DNSServer.Enabled && cfg.Environment != config_core.KubernetesEnvironment
+}
+
+// VIPsLoader returns a function that loads VIPs of a given mesh or nil if VIPs are not allocated.
+func VIPsLoader(rt core_runtime.Runtime) LoadVIPsFunc {
+	if !Enabled(rt.Config()) {
+		return nil
+	}
+	return func(ctx context.Context, mesh string) (VIPList, error) {
+		return LoadVIPs(ctx, rt.ReadOnlyResourceManager(), mesh)
+	}
+}
+
+// CachedVIPsLoader returns a function that loads VIPs of a given mesh at most once per expiration time of the store cache
+// or nil if VIPs are not allocated. It is meant for generating configs of all Dataplanes of a mesh.
+func CachedVIPsLoader(rt core_runtime.Runtime) LoadVIPsFunc {
+	loader := VIPsLoader(rt)
+	if loader == nil || !rt.Config().Store.Cache.Enabled {
+		return loader
+	}
+	return NewCachedVIPsLoader(loader, rt.Config().Store.Cache.ExpirationTime)
+}
+
+func Setup(rt core_runtime.Runtime) error {
+	if !Enabled(rt.Config()) {
+		return nil
+	}
+	cfg := rt.Config().DNSServer
+	allocator, err := NewVIPsAllocator(rt.ResourceManager(), cfg.CIDR, cfg.VIPRefreshInterval)
+	if err != nil {
+		return err
+	}
+	return rt.Add(allocator)
+}
@@ -0,0 +1,47 @@
+package dns_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	kuma_cp "github.com/Kong/kuma/pkg/config/app/kuma-cp"
+	config_core "github.com/Kong/kuma/pkg/config/core"
+	"github.com/Kong/kuma/pkg/dns"
+)
+
+var _ = Describe("Enabled()", func() {
+
+	type testCase struct {
+		environment config_core.EnvironmentType
+		enabled     bool
+		expected    bool
+	}
+
+	DescribeTable("should allocate VIPs only if enabled in universal environment",
+		func(given testCase) {
+			// given
+			cfg := kuma_cp.DefaultConfig()
+			cfg.Environment = given.environment
+			cfg.DNSServer.Enabled = given.enabled
+
+			// expect
+			Expect(dns.Enabled(cfg)).To(Equal(given.expected))
+		},
+		Entry("disabled by default", testCase{
+			environment: config_core.UniversalEnvironment,
+			enabled:     false,
+			expected:    false,
+		}),
+		Entry("enabled in universal environment", testCase{
+			environment: config_core.UniversalEnvironment,
+			enabled:     true,
+			expected:    true,
+		}),
+		Entry("not supported in kubernetes environment", testCase{
+			environment: config_core.KubernetesEnvironment,
+			enabled:     true,
+			expected:    false,
+		}),
+	)
+})
@@ -0,0 +1,83 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/pkg/core/resources/apis/system"
+	"github.com/Kong/kuma/pkg/core/resources/manager"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+)
+
+// VIPList maps services of a mesh to virtual IPs that `<service>.<domain>` resolves to.
+type VIPList map[string]string
+
+// vipsState is what the allocator persists for a mesh.
+type vipsState struct {
+	VIPs VIPList `json:"vips"`
+	// Last is the VIP that has been allocated last. Allocation continues after it,
+	// so released VIPs are reused only once all other IPs of the CIDR have been allocated.
+	Last string `json:"last,omitempty"`
+}
+
+// vipsResourceKey returns a key of the config that persists VIPs of a given mesh.
+func vipsResourceKey(mesh string) model.ResourceKey {
+	return model.ResourceKey{
+		Mesh: mesh,
+		Name: fmt.Sprintf("kuma-%s-dns-vips", mesh),
+	}
+}
+
+// LoadVIPs returns VIPs allocated to services of a given mesh.
+// Empty list is returned if no VIP has been allocated yet.
+func LoadVIPs(ctx context.Context, manager manager.ReadOnlyResourceManager, mesh string) (VIPList, error) {
+	state, err := loadVIPsState(ctx, manager, mesh)
+	if err != nil {
+		return nil, err
+	}
+	return state.VIPs, nil
+}
+
+func loadVIPsState(ctx context.Context, manager manager.ReadOnlyResourceManager, mesh string) (vipsState, error) {
+	resource := &system.ConfigResource{}
+	if err := manager.Get(ctx, resource, store.GetBy(vipsResourceKey(mesh))); err != nil {
+		if store.IsResourceNotFound(err) {
+			return vipsState{VIPs: VIPList{}}, nil
+		}
+		return vipsState{}, errors.Wrapf(err, "could not retrieve VIPs of mesh %q", mesh)
+	}
+	state := vipsState{}
+	if err := json.Unmarshal([]byte(resource.Spec.Value), &state); err != nil {
+		return vipsState{}, errors.Wrapf(err, "could not parse VIPs of mesh %q", mesh)
+	}
+	if state.VIPs == nil {
+		state.VIPs = VIPList{}
+	}
+	return state, nil
+}
+
+// storeVIPsState persists VIPs allocated to services of a given mesh.
+func storeVIPsState(ctx context.Context, manager manager.ResourceManager, mesh string, state vipsState) error {
+	value, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	key := vipsResourceKey(mesh)
+	resource := &system.ConfigResource{}
+	err = manager.Get(ctx, resource, store.GetBy(key))
+	switch {
+	case store.IsResourceNotFound(err):
+		resource.Spec.Value = string(value)
+		err = manager.Create(ctx, resource, store.CreateBy(key))
+	case err != nil:
+		return errors.Wrapf(err, "could not retrieve VIPs of mesh %q", mesh)
+	default:
+		resource.Spec.Value = string(value)
+		err = manager.Update(ctx, resource)
+	}
+	return errors.Wrapf(err, "could not store VIPs of mesh %q", mesh)
+}
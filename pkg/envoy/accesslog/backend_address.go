@@ -0,0 +1,194 @@
+package accesslog
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Addresses of logging backends that kuma-dp forwards access logs to.
+//
+// An address of a TCP logging backend is `<host>:<port>`.
+//
+// An address of a syslog server is `syslog://<host>:<port>?transport=<transport>&facility=<facility>&appName=<app name>`,
+// where all query parameters are optional.
+//
+// An address of an HTTP endpoint is its URL with batching settings in the fragment,
+// e.g. `https://collector.internal/logs#batchSize=100&flushInterval=1s`, where all settings are optional.
+//
+// An address of a file is `file://<path>`. kuma-dp writes to a file itself only for structured access logs,
+// since Envoy 1.12 cannot render their values as JSON values other than strings.
+const (
+	SyslogAddressScheme = "syslog"
+	FileAddressScheme   = "file"
+)
+
+// Transports to a syslog server.
+const (
+	SyslogTransportUDP = "udp"
+	SyslogTransportTCP = "tcp"
+	SyslogTransportTLS = "tls"
+)
+
+// SyslogFacilities maps names of syslog facilities to their numerical codes.
+var SyslogFacilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"ntp":      12,
+	"security": 13,
+	"console":  14,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// SyslogAddress represents an address of a syslog server.
+type SyslogAddress struct {
+	Address   string
+	Transport string
+	Facility  string
+	AppName   string
+}
+
+func (a *SyslogAddress) String() string {
+	params := url.Values{}
+	if a.Transport != "" {
+		params.Set("transport", a.Transport)
+	}
+	if a.Facility != "" {
+		params.Set("facility", a.Facility)
+	}
+	if a.AppName != "" {
+		params.Set("appName", a.AppName)
+	}
+	u := url.URL{
+		Scheme:   SyslogAddressScheme,
+		Host:     a.Address,
+		RawQuery: params.Encode(),
+	}
+	return u.String()
+}
+
+// IsSyslogAddress returns true if a given address is an address of a syslog server.
+func IsSyslogAddress(address string) bool {
+	return strings.HasPrefix(address, SyslogAddressScheme+"://")
+}
+
+func ParseSyslogAddress(address string) (*SyslogAddress, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "address of a syslog server is not valid: %q", address)
+	}
+	if u.Scheme != SyslogAddressScheme {
+		return nil, errors.Errorf("address of a syslog server must have %q scheme, got: %q", SyslogAddressScheme, address)
+	}
+	if _, _, err := net.SplitHostPort(u.Host); err != nil {
+		return nil, errors.Wrapf(err, "address of a syslog server is not valid: %q", address)
+	}
+	params := u.Query()
+	return &SyslogAddress{
+		Address:   u.Host,
+		Transport: params.Get("transport"),
+		Facility:  params.Get("facility"),
+		AppName:   params.Get("appName"),
+	}, nil
+}
+
+// HttpAddress represents an address of an HTTP endpoint.
+type HttpAddress struct {
+	URL           string
+	BatchSize     uint32
+	FlushInterval time.Duration
+}
+
+func (a *HttpAddress) String() string {
+	params := url.Values{}
+	if a.BatchSize != 0 {
+		params.Set("batchSize", strconv.FormatUint(uint64(a.BatchSize), 10))
+	}
+	if a.FlushInterval != 0 {
+		params.Set("flushInterval", a.FlushInterval.String())
+	}
+	if len(params) == 0 {
+		return a.URL
+	}
+	return a.URL + "#" + params.Encode()
+}
+
+// IsHttpAddress returns true if a given address is an address of an HTTP endpoint.
+func IsHttpAddress(address string) bool {
+	return strings.HasPrefix(address, "http://") || strings.HasPrefix(address, "https://")
+}
+
+func ParseHttpAddress(address string) (*HttpAddress, error) {
+	parts := strings.SplitN(address, "#", 2)
+	if _, err := url.ParseRequestURI(parts[0]); err != nil {
+		return nil, errors.Wrapf(err, "address of an HTTP endpoint is not valid: %q", address)
+	}
+	result := &HttpAddress{
+		URL: parts[0],
+	}
+	if len(parts) == 1 {
+		return result, nil
+	}
+	params, err := url.ParseQuery(parts[1])
+	if err != nil {
+		return nil, errors.Wrapf(err, "batching settings of an HTTP endpoint are not valid: %q", address)
+	}
+	if value := params.Get("batchSize"); value != "" {
+		batchSize, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "batch size of an HTTP endpoint is not valid: %q", address)
+		}
+		result.BatchSize = uint32(batchSize)
+	}
+	if value := params.Get("flushInterval"); value != "" {
+		flushInterval, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "flush interval of an HTTP endpoint is not valid: %q", address)
+		}
+		result.FlushInterval = flushInterval
+	}
+	return result, nil
+}
+
+// FileAddress returns an address of a file at a given path.
+func FileAddress(path string) string {
+	return FileAddressScheme + "://" + path
+}
+
+// IsFileAddress returns true if a given address is an address of a file.
+func IsFileAddress(address string) bool {
+	return strings.HasPrefix(address, FileAddressScheme+"://")
+}
+
+// ParseFileAddress returns a path of a file from its address.
+func ParseFileAddress(address string) (string, error) {
+	if !IsFileAddress(address) {
+		return "", errors.Errorf("address of a file must have %q scheme, got: %q", FileAddressScheme, address)
+	}
+	path := strings.TrimPrefix(address, FileAddressScheme+"://")
+	if path == "" {
+		return "", errors.Errorf("address of a file must have a path, got: %q", address)
+	}
+	return path, nil
+}
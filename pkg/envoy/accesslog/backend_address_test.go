@@ -0,0 +1,119 @@
+package accesslog_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/envoy/accesslog"
+)
+
+var _ = Describe("SyslogAddress", func() {
+
+	It("should support round trip", func() {
+		// given
+		address := &SyslogAddress{
+			Address:   "rsyslog.internal:6514",
+			Transport: SyslogTransportTLS,
+			Facility:  "local3",
+			AppName:   "web app",
+		}
+
+		// when
+		actual := address.String()
+		// then
+		Expect(actual).To(Equal("syslog://rsyslog.internal:6514?appName=web+app&facility=local3&transport=tls"))
+		Expect(IsSyslogAddress(actual)).To(BeTrue())
+
+		// when
+		parsed, err := ParseSyslogAddress(actual)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsed).To(Equal(address))
+	})
+
+	It("should omit settings that are not set", func() {
+		// given
+		address := &SyslogAddress{Address: "127.0.0.1:514"}
+
+		// expect
+		Expect(address.String()).To(Equal("syslog://127.0.0.1:514"))
+	})
+
+	It("should reject an address without port", func() {
+		// when
+		_, err := ParseSyslogAddress("syslog://rsyslog.internal")
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("HttpAddress", func() {
+
+	It("should support round trip", func() {
+		// given
+		address := &HttpAddress{
+			URL:           "https://collector.internal/logs?token=abc",
+			BatchSize:     50,
+			FlushInterval: 500 * time.Millisecond,
+		}
+
+		// when
+		actual := address.String()
+		// then
+		Expect(actual).To(Equal("https://collector.internal/logs?token=abc#batchSize=50&flushInterval=500ms"))
+		Expect(IsHttpAddress(actual)).To(BeTrue())
+		Expect(IsSyslogAddress(actual)).To(BeFalse())
+
+		// when
+		parsed, err := ParseHttpAddress(actual)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsed).To(Equal(address))
+	})
+
+	It("should keep URL as is when batching settings are not set", func() {
+		// given
+		address := &HttpAddress{URL: "http://collector.internal/logs"}
+
+		// expect
+		Expect(address.String()).To(Equal("http://collector.internal/logs"))
+	})
+
+	It("should reject invalid batching settings", func() {
+		// when
+		_, err := ParseHttpAddress("http://collector.internal/logs#batchSize=many")
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not consider an address of a TCP logging backend to be an address of an HTTP endpoint", func() {
+		// expect
+		Expect(IsHttpAddress("127.0.0.1:1234")).To(BeFalse())
+	})
+})
+
+var _ = Describe("FileAddress", func() {
+
+	It("should support round trip", func() {
+		// when
+		address := FileAddress("/var/log/envoy/access.log")
+		// then
+		Expect(address).To(Equal("file:///var/log/envoy/access.log"))
+		Expect(IsFileAddress(address)).To(BeTrue())
+
+		// when
+		path, err := ParseFileAddress(address)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(path).To(Equal("/var/log/envoy/access.log"))
+	})
+
+	It("should reject an address without path", func() {
+		// when
+		_, err := ParseFileAddress("file://")
+		// then
+		Expect(err).To(MatchError(`address of a file must have a path, got: "file://"`))
+	})
+})
@@ -7,6 +7,9 @@ Envoy access log command syntax.
 
 Use ParseFormat() function to parse a format string.
 
+Use ParseJsonFormat() function to parse a structured format, i.e. a map of a field name
+in a JSON object to a format string.
+
 Use HttpLogEntryFormatter interface to format an HTTP log entry.
 
 Use TcpLogEntryFormatter interface to format a TCP log entry.
@@ -15,9 +18,9 @@ Use HttpLogConfigurer interface to configure `envoy.http_grpc_access_log` filter
 
 Use TcpLogConfigurer interface to configure `envoy.tcp_grpc_access_log` filter.
 
-The initial implementation is missing the following features:
-1. `%START_TIME%` commands ignore the user-defined format string
-2. `%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%` commands return a stub value
-3. `%FILTER_STATE(KEY):Z%` commands return a stub value
+Use SyslogAddress and HttpAddress types to pass settings of syslog and HTTP logging backends to kuma-dp.
+
+`%FILTER_STATE(KEY):Z%` commands can only format filter state objects of types
+that are known to kuma-dp, e.g. `google.protobuf.StringValue`. Other objects are formatted as `-`.
 */
 package accesslog
@@ -4,8 +4,13 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/golang/protobuf/proto"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+
 	accesslog_config "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v2"
 	accesslog_data "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v2"
+
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
 )
 
 // DynamicMetadataOperator represents a `%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%` command operator.
@@ -24,8 +29,40 @@ func (f *DynamicMetadataOperator) FormatTcpLogEntry(entry *accesslog_data.TCPAcc
 }
 
 func (f *DynamicMetadataOperator) format(entry *accesslog_data.AccessLogCommon) (string, error) {
-	// TODO(yskopets): implement
-	return "UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)", nil
+	metadata, exists := entry.GetMetadata().GetFilterMetadata()[f.FilterNamespace]
+	if !exists {
+		return "", nil
+	}
+	var value proto.Message = metadata
+	if len(f.Path) > 0 {
+		nested := metadataValue(metadata, f.Path)
+		if nested == nil {
+			return "", nil
+		}
+		value = nested
+	}
+	json, err := util_proto.ToJSON(value)
+	if err != nil {
+		return "", err
+	}
+	return truncate(string(json), f.MaxLength), nil
+}
+
+// metadataValue returns a value at a given path inside of a metadata struct or nil if there is no such value.
+func metadataValue(metadata *structpb.Struct, path []string) *structpb.Value {
+	var value *structpb.Value
+	for i, key := range path {
+		value = metadata.GetFields()[key]
+		if value == nil {
+			return nil
+		}
+		if i < len(path)-1 {
+			if metadata = value.GetStructValue(); metadata == nil {
+				return nil
+			}
+		}
+	}
+	return value
 }
 
 func (f *DynamicMetadataOperator) ConfigureHttpLog(config *accesslog_config.HttpGrpcAccessLogConfig) error {
@@ -6,10 +6,128 @@ import (
 	. "github.com/onsi/gomega"
 
 	. "github.com/Kong/kuma/pkg/envoy/accesslog"
+
+	structpb "github.com/golang/protobuf/ptypes/struct"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	accesslog_data "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v2"
 )
 
 var _ = Describe("DynamicMetadataOperator", func() {
 
+	Describe("FormatHttpLogEntry() and FormatTcpLogEntry()", func() {
+		commonProperties := &accesslog_data.AccessLogCommon{
+			Metadata: &envoy_core.Metadata{
+				FilterMetadata: map[string]*structpb.Struct{
+					"com.test.my_filter": {
+						Fields: map[string]*structpb.Value{
+							"test_key": {
+								Kind: &structpb.Value_StringValue{StringValue: "test_value"},
+							},
+							"test_number": {
+								Kind: &structpb.Value_NumberValue{NumberValue: 123},
+							},
+							"test_object": {
+								Kind: &structpb.Value_StructValue{StructValue: &structpb.Struct{
+									Fields: map[string]*structpb.Value{
+										"inner_key": {
+											Kind: &structpb.Value_BoolValue{BoolValue: true},
+										},
+									},
+								}},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		type testCase struct {
+			filterNamespace  string
+			path             []string
+			maxLength        int
+			commonProperties *accesslog_data.AccessLogCommon
+			expected         string
+		}
+
+		DescribeTable("should format properly",
+			func(given testCase) {
+				// setup
+				fragment := &DynamicMetadataOperator{FilterNamespace: given.filterNamespace, Path: given.path, MaxLength: given.maxLength}
+
+				// when
+				actual, err := fragment.FormatHttpLogEntry(&accesslog_data.HTTPAccessLogEntry{
+					CommonProperties: given.commonProperties,
+				})
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(Equal(given.expected))
+
+				// when
+				actual, err = fragment.FormatTcpLogEntry(&accesslog_data.TCPAccessLogEntry{
+					CommonProperties: given.commonProperties,
+				})
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(Equal(given.expected))
+			},
+			Entry("no metadata", testCase{
+				filterNamespace:  "com.test.my_filter",
+				commonProperties: nil,
+				expected:         ``,
+			}),
+			Entry("unknown filter namespace", testCase{
+				filterNamespace:  "com.test.other_filter",
+				commonProperties: commonProperties,
+				expected:         ``,
+			}),
+			Entry("whole filter namespace", testCase{
+				filterNamespace:  "com.test.my_filter",
+				commonProperties: commonProperties,
+				expected:         `{"test_key":"test_value","test_number":123,"test_object":{"inner_key":true}}`,
+			}),
+			Entry("string value", testCase{
+				filterNamespace:  "com.test.my_filter",
+				path:             []string{"test_key"},
+				commonProperties: commonProperties,
+				expected:         `"test_value"`,
+			}),
+			Entry("number value", testCase{
+				filterNamespace:  "com.test.my_filter",
+				path:             []string{"test_number"},
+				commonProperties: commonProperties,
+				expected:         `123`,
+			}),
+			Entry("nested value", testCase{
+				filterNamespace:  "com.test.my_filter",
+				path:             []string{"test_object", "inner_key"},
+				commonProperties: commonProperties,
+				expected:         `true`,
+			}),
+			Entry("unknown key", testCase{
+				filterNamespace:  "com.test.my_filter",
+				path:             []string{"unknown_key"},
+				commonProperties: commonProperties,
+				expected:         ``,
+			}),
+			Entry("path through a non-object value", testCase{
+				filterNamespace:  "com.test.my_filter",
+				path:             []string{"test_key", "inner_key"},
+				commonProperties: commonProperties,
+				expected:         ``,
+			}),
+			Entry("value truncated to max length", testCase{
+				filterNamespace:  "com.test.my_filter",
+				path:             []string{"test_object"},
+				maxLength:        5,
+				commonProperties: commonProperties,
+				expected:         `{"inn`,
+			}),
+		)
+	})
+
 	Describe("String()", func() {
 		type testCase struct {
 			filterNamespace string
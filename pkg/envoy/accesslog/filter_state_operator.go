@@ -4,8 +4,12 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/golang/protobuf/ptypes"
+
 	accesslog_config "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v2"
 	accesslog_data "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v2"
+
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
 )
 
 // FilterStateOperator represents a `%FILTER_STATE(KEY):Z%` command operator.
@@ -23,8 +27,20 @@ func (f *FilterStateOperator) FormatTcpLogEntry(entry *accesslog_data.TCPAccessL
 }
 
 func (f *FilterStateOperator) format(entry *accesslog_data.AccessLogCommon) (string, error) {
-	// TODO(yskopets): implement
-	return "UNSUPPORTED_COMMAND(%FILTER_STATE(KEY):Z%)", nil
+	object, exists := entry.GetFilterStateObjects()[f.Key]
+	if !exists {
+		return "", nil
+	}
+	value := &ptypes.DynamicAny{}
+	if err := ptypes.UnmarshalAny(object, value); err != nil {
+		// to replicate Envoy's behaviour on objects that cannot be serialized
+		return "", nil
+	}
+	json, err := util_proto.ToJSON(value.Message)
+	if err != nil {
+		return "", err
+	}
+	return truncate(string(json), f.MaxLength), nil
 }
 
 func (f *FilterStateOperator) ConfigureHttpLog(config *accesslog_config.HttpGrpcAccessLogConfig) error {
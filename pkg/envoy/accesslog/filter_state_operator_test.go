@@ -6,10 +6,93 @@ import (
 	. "github.com/onsi/gomega"
 
 	. "github.com/Kong/kuma/pkg/envoy/accesslog"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	accesslog_data "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v2"
+
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
 )
 
 var _ = Describe("FilterStateOperator", func() {
 
+	Describe("FormatHttpLogEntry() and FormatTcpLogEntry()", func() {
+		commonProperties := &accesslog_data.AccessLogCommon{
+			FilterStateObjects: map[string]*any.Any{
+				"filter.state.string": util_proto.MustMarshalAny(&wrappers.StringValue{Value: "value"}),
+				"filter.state.number": util_proto.MustMarshalAny(&wrappers.UInt32Value{Value: 123}),
+				"filter.state.unknown": {
+					TypeUrl: "type.googleapis.com/envoy.unknown.Object",
+					Value:   []byte("unknown"),
+				},
+			},
+		}
+
+		type testCase struct {
+			key              string
+			maxLength        int
+			commonProperties *accesslog_data.AccessLogCommon
+			expected         string
+		}
+
+		DescribeTable("should format properly",
+			func(given testCase) {
+				// setup
+				fragment := &FilterStateOperator{Key: given.key, MaxLength: given.maxLength}
+
+				// when
+				actual, err := fragment.FormatHttpLogEntry(&accesslog_data.HTTPAccessLogEntry{
+					CommonProperties: given.commonProperties,
+				})
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(Equal(given.expected))
+
+				// when
+				actual, err = fragment.FormatTcpLogEntry(&accesslog_data.TCPAccessLogEntry{
+					CommonProperties: given.commonProperties,
+				})
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(Equal(given.expected))
+			},
+			Entry("no filter state", testCase{
+				key:              "filter.state.string",
+				commonProperties: nil,
+				expected:         ``,
+			}),
+			Entry("unknown key", testCase{
+				key:              "filter.state.other",
+				commonProperties: commonProperties,
+				expected:         ``,
+			}),
+			Entry("string object", testCase{
+				key:              "filter.state.string",
+				commonProperties: commonProperties,
+				expected:         `"value"`,
+			}),
+			Entry("number object", testCase{
+				key:              "filter.state.number",
+				commonProperties: commonProperties,
+				expected:         `123`,
+			}),
+			Entry("object of unknown type", testCase{
+				key:              "filter.state.unknown",
+				commonProperties: commonProperties,
+				expected:         ``,
+			}),
+			Entry("object truncated to max length", testCase{
+				key:              "filter.state.string",
+				maxLength:        3,
+				commonProperties: commonProperties,
+				expected:         `"va`,
+			}),
+		)
+	})
+
 	Describe("String()", func() {
 		type testCase struct {
 			key       string
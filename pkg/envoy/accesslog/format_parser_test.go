@@ -10,8 +10,11 @@ import (
 	. "github.com/Kong/kuma/pkg/envoy/accesslog"
 
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/golang/protobuf/ptypes/wrappers"
 
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	accesslog_config "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v2"
 	accesslog_data "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v2"
 
@@ -39,6 +42,29 @@ var _ = Describe("ParseFormat()", func() {
 			UpstreamRemoteAddress:          EnvoySocketAddress("10.0.0.2", 443),
 			UpstreamTransportFailureReason: "mystery",
 			RouteName:                      "outbound:backend",
+			Metadata: &envoy_core.Metadata{
+				FilterMetadata: map[string]*structpb.Struct{
+					"com.test.my_filter": {
+						Fields: map[string]*structpb.Value{
+							"test_key": {
+								Kind: &structpb.Value_StringValue{StringValue: "test_value"},
+							},
+							"test_object": {
+								Kind: &structpb.Value_StructValue{StructValue: &structpb.Struct{
+									Fields: map[string]*structpb.Value{
+										"inner_key": {
+											Kind: &structpb.Value_StringValue{StringValue: "inner_value"},
+										},
+									},
+								}},
+							},
+						},
+					},
+				},
+			},
+			FilterStateObjects: map[string]*any.Any{
+				"key": util_proto.MustMarshalAny(&wrappers.StringValue{Value: "value"}),
+			},
 			TlsProperties: &accesslog_data.TLSProperties{
 				TlsSniHostname: "backend.internal",
 				PeerCertificateProperties: &accesslog_data.TLSProperties_CertificateProperties{
@@ -151,14 +177,14 @@ var _ = Describe("ParseFormat()", func() {
 				expectedTCP:  `2020-02-18T21:52:17.987Z`,
 			}),
 			Entry("%START_TIME(%Y/%m/%dT%H:%M:%S%z %s)%", testCase{
-				format:       `%START_TIME(%Y/%m/%dT%H:%M:%S%z %s)%`, // user-defined format is not supported yet
-				expectedHTTP: `2020-02-18T21:52:17.987Z`,
-				expectedTCP:  `2020-02-18T21:52:17.987Z`,
+				format:       `%START_TIME(%Y/%m/%dT%H:%M:%S%z %s)%`,
+				expectedHTTP: `2020/02/18T21:52:17+0000 1582062737`,
+				expectedTCP:  `2020/02/18T21:52:17+0000 1582062737`,
 			}),
 			Entry("%START_TIME(%s.%3f)%", testCase{
-				format:       `%START_TIME(%s.%3f)%`, // user-defined format is not supported yet
-				expectedHTTP: `2020-02-18T21:52:17.987Z`,
-				expectedTCP:  `2020-02-18T21:52:17.987Z`,
+				format:       `%START_TIME(%s.%3f)%`,
+				expectedHTTP: `1582062737.987`,
+				expectedTCP:  `1582062737.987`,
 			}),
 			Entry("%BYTES_RECEIVED%", testCase{
 				format:       `%BYTES_RECEIVED%`,
@@ -367,53 +393,53 @@ var _ = Describe("ParseFormat()", func() {
 			}),
 			Entry("%DYNAMIC_METADATA()%", testCase{ // apparently, Envoy allows both `FilterNamespace` and `Path` to be empty
 				format:       `%DYNAMIC_METADATA()%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
+				expectedHTTP: `-`,
+				expectedTCP:  `-`,
 			}),
 			Entry("%DYNAMIC_METADATA():10%", testCase{ // apparently, Envoy allows both `FilterNamespace` and `Path` to be empty
 				format:       `%DYNAMIC_METADATA():10%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
+				expectedHTTP: `-`,
+				expectedTCP:  `-`,
 			}),
 			Entry("%DYNAMIC_METADATA(com.test.my_filter)%", testCase{
 				format:       `%DYNAMIC_METADATA(com.test.my_filter)%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
+				expectedHTTP: `{"test_key":"test_value","test_object":{"inner_key":"inner_value"}}`,
+				expectedTCP:  `{"test_key":"test_value","test_object":{"inner_key":"inner_value"}}`,
 			}),
 			Entry("%DYNAMIC_METADATA(com.test.my_filter):10%", testCase{
 				format:       `%DYNAMIC_METADATA(com.test.my_filter):10%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
+				expectedHTTP: `{"test_key`,
+				expectedTCP:  `{"test_key`,
 			}),
 			Entry("%DYNAMIC_METADATA(com.test.my_filter:test_key)%", testCase{
 				format:       `%DYNAMIC_METADATA(com.test.my_filter:test_key)%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
+				expectedHTTP: `"test_value"`,
+				expectedTCP:  `"test_value"`,
 			}),
 			Entry("%DYNAMIC_METADATA(com.test.my_filter:test_key):10%", testCase{
 				format:       `%DYNAMIC_METADATA(com.test.my_filter:test_key):10%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
+				expectedHTTP: `"test_valu`,
+				expectedTCP:  `"test_valu`,
 			}),
 			Entry("%DYNAMIC_METADATA(com.test.my_filter:test_object:inner_key)%", testCase{
 				format:       `%DYNAMIC_METADATA(com.test.my_filter:test_object:inner_key)%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
+				expectedHTTP: `"inner_value"`,
+				expectedTCP:  `"inner_value"`,
 			}),
 			Entry("%DYNAMIC_METADATA(com.test.my_filter:test_object:inner_key):10%", testCase{
 				format:       `%DYNAMIC_METADATA(com.test.my_filter:test_object:inner_key):10%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%DYNAMIC_METADATA(NAMESPACE:KEY*):Z%)`, // not supported yet
+				expectedHTTP: `"inner_val`,
+				expectedTCP:  `"inner_val`,
 			}),
 			Entry("%FILTER_STATE(key)%", testCase{
 				format:       `%FILTER_STATE(key)%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%FILTER_STATE(KEY):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%FILTER_STATE(KEY):Z%)`, // not supported yet
+				expectedHTTP: `"value"`,
+				expectedTCP:  `"value"`,
 			}),
 			Entry("%FILTER_STATE(key):10%", testCase{
 				format:       `%FILTER_STATE(key):10%`,
-				expectedHTTP: `UNSUPPORTED_COMMAND(%FILTER_STATE(KEY):Z%)`, // not supported yet
-				expectedTCP:  `UNSUPPORTED_COMMAND(%FILTER_STATE(KEY):Z%)`, // not supported yet
+				expectedHTTP: `"value"`,
+				expectedTCP:  `"value"`,
 			}),
 			Entry("%UPSTREAM_HOST%", testCase{
 				format:       `%UPSTREAM_HOST%`,
@@ -30,6 +30,15 @@ type TcpLogEntryFormatter interface {
 	FormatTcpLogEntry(entry *accesslog_data.TCPAccessLogEntry) (string, error)
 }
 
+// LogEntryFormatter formats HTTP and TCP log entries,
+// e.g. according to a format string or a structured JSON format.
+type LogEntryFormatter interface {
+	HttpLogEntryFormatter
+	TcpLogEntryFormatter
+	// String returns the canonical representation of the format.
+	String() string
+}
+
 // HttpLogConfigurer adjusts configuration of `envoy.http_grpc_access_log`
 // according to the format string, e.g. to capture additional HTTP headers.
 type HttpLogConfigurer interface {
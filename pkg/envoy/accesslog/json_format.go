@@ -0,0 +1,254 @@
+package accesslog
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	accesslog_config "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v2"
+	accesslog_data "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v2"
+)
+
+// JsonLogNameMarker is the first component of a log name of `envoy.http_grpc_access_log`
+// that carries a structured format, i.e. `json;{"address":"<address>","format":{<structured format>}}`
+// as opposed to `<address>;<format string>`.
+// The rest of the log name is a JSON object, so neither an address nor a format can break it up.
+const JsonLogNameMarker = "json"
+
+type jsonLogName struct {
+	Address string            `json:"address"`
+	Format  map[string]string `json:"format"`
+}
+
+// JsonLogName returns a log name that carries an address of a logging backend and a structured format.
+func JsonLogName(address string, format *JsonAccessLogFormat) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(jsonLogName{Address: address, Format: format.FormatStrings()}) // encoding of strings never fails
+	return JsonLogNameMarker + ";" + strings.TrimSuffix(buf.String(), "\n")
+}
+
+// IsJsonLogName returns true if a given log name carries a structured format.
+func IsJsonLogName(logName string) bool {
+	return strings.HasPrefix(logName, JsonLogNameMarker+";")
+}
+
+// ParseJsonLogName extracts an address of a logging backend and a structured format from a log name.
+func ParseJsonLogName(logName string) (string, *JsonAccessLogFormat, error) {
+	if !IsJsonLogName(logName) {
+		return "", nil, errors.Errorf("log name %q has invalid format: expected %q prefix", logName, JsonLogNameMarker+";")
+	}
+	value := jsonLogName{}
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(logName, JsonLogNameMarker+";")), &value); err != nil {
+		return "", nil, errors.Wrapf(err, "log name %q has invalid format", logName)
+	}
+	if value.Address == "" {
+		return "", nil, errors.Errorf("log name %q has invalid format: address cannot be empty", logName)
+	}
+	format, err := ParseJsonFormat(value.Format)
+	if err != nil {
+		return "", nil, err
+	}
+	return value.Address, format, nil
+}
+
+// numericCommands are command operators that are rendered as JSON numbers
+// when used alone as a value of a field.
+var numericCommands = map[FieldOperator]bool{
+	CMD_BYTES_RECEIVED:       true,
+	CMD_BYTES_SENT:           true,
+	CMD_RESPONSE_CODE:        true,
+	CMD_DURATION:             true,
+	CMD_REQUEST_DURATION:     true,
+	CMD_RESPONSE_DURATION:    true,
+	CMD_RESPONSE_TX_DURATION: true,
+}
+
+// JsonAccessLogFormat represents a structured access log format,
+// i.e. a map of a field name in a JSON object to a format string.
+type JsonAccessLogFormat struct {
+	Fields map[string]*AccessLogFormat
+}
+
+// ValidateJsonFormat validates whether a given structured format is valid.
+func ValidateJsonFormat(format map[string]string) error {
+	_, err := ParseJsonFormat(format)
+	return err
+}
+
+// ParseJsonFormat parses format strings of all fields of a given structured format.
+func ParseJsonFormat(format map[string]string) (*JsonAccessLogFormat, error) {
+	if len(format) == 0 {
+		return nil, errors.New("JSON format must have at least one field")
+	}
+	fields := make(map[string]*AccessLogFormat, len(format))
+	for name, value := range format {
+		if name == "" {
+			return nil, errors.New("JSON format cannot have a field with an empty name")
+		}
+		field, err := ParseFormat(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "format string of a field %q is not valid", name)
+		}
+		fields[name] = field
+	}
+	return &JsonAccessLogFormat{Fields: fields}, nil
+}
+
+// FormatHttpLogEntry renders a given HTTP log entry as a JSON object.
+func (f *JsonAccessLogFormat) FormatHttpLogEntry(entry *accesslog_data.HTTPAccessLogEntry) (string, error) {
+	return f.format(func(fragment AccessLogFragment) (string, error) {
+		return fragment.FormatHttpLogEntry(entry)
+	}, func(field *AccessLogFormat) (string, error) {
+		return field.FormatHttpLogEntry(entry)
+	})
+}
+
+// FormatTcpLogEntry renders a given TCP log entry as a JSON object.
+func (f *JsonAccessLogFormat) FormatTcpLogEntry(entry *accesslog_data.TCPAccessLogEntry) (string, error) {
+	return f.format(func(fragment AccessLogFragment) (string, error) {
+		return fragment.FormatTcpLogEntry(entry)
+	}, func(field *AccessLogFormat) (string, error) {
+		return field.FormatTcpLogEntry(entry)
+	})
+}
+
+func (f *JsonAccessLogFormat) format(
+	formatFragment func(AccessLogFragment) (string, error),
+	formatField func(*AccessLogFormat) (string, error),
+) (string, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, name := range f.names() {
+		field := f.Fields[name]
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(jsonString(name))
+		buf.WriteByte(':')
+		if len(field.Fragments) == 1 && isTyped(field.Fragments[0]) {
+			value, err := formatFragment(field.Fragments[0])
+			if err != nil {
+				return "", err
+			}
+			buf.Write(typedValue(field.Fragments[0], value))
+			continue
+		}
+		value, err := formatField(field)
+		if err != nil {
+			return "", err
+		}
+		buf.Write(jsonString(value))
+	}
+	buf.WriteByte('}')
+	return buf.String(), nil
+}
+
+func (f *JsonAccessLogFormat) ConfigureHttpLog(config *accesslog_config.HttpGrpcAccessLogConfig) error {
+	for _, name := range f.names() {
+		if err := f.Fields[name].ConfigureHttpLog(config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *JsonAccessLogFormat) ConfigureTcpLog(config *accesslog_config.TcpGrpcAccessLogConfig) error {
+	for _, name := range f.names() {
+		if err := f.Fields[name].ConfigureTcpLog(config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Interpolate returns a structured format with `%KUMA_*%` placeholders bound in all fields.
+func (f *JsonAccessLogFormat) Interpolate(variables InterpolationVariables) (*JsonAccessLogFormat, error) {
+	fields := make(map[string]*AccessLogFormat, len(f.Fields))
+	for name, field := range f.Fields {
+		interpolated, err := field.Interpolate(variables)
+		if err != nil {
+			return nil, err
+		}
+		fields[name] = interpolated
+	}
+	return &JsonAccessLogFormat{Fields: fields}, nil
+}
+
+// FormatStrings returns the canonical representation of format strings of all fields.
+func (f *JsonAccessLogFormat) FormatStrings() map[string]string {
+	formats := make(map[string]string, len(f.Fields))
+	for name, field := range f.Fields {
+		formats[name] = field.String()
+	}
+	return formats
+}
+
+// String returns the canonical representation of this structured format as a JSON object.
+func (f *JsonAccessLogFormat) String() string {
+	// map keys are sorted by encoding/json
+	value, _ := json.Marshal(f.FormatStrings())
+	return string(value)
+}
+
+func (f *JsonAccessLogFormat) names() []string {
+	names := make([]string, 0, len(f.Fields))
+	for name := range f.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseJsonFormatString parses the canonical representation of a structured format.
+func ParseJsonFormatString(format string) (*JsonAccessLogFormat, error) {
+	var fields map[string]string
+	if err := json.Unmarshal([]byte(format), &fields); err != nil {
+		return nil, errors.Wrap(err, "JSON format is not a valid JSON object")
+	}
+	return ParseJsonFormat(fields)
+}
+
+// isTyped returns true if a value of a given command operator is rendered as a JSON value other than string.
+func isTyped(fragment AccessLogFragment) bool {
+	switch fragment := fragment.(type) {
+	case FieldOperator:
+		return numericCommands[fragment]
+	case *DynamicMetadataOperator:
+		return fragment.MaxLength == 0
+	case *FilterStateOperator:
+		return fragment.MaxLength == 0
+	default:
+		return false
+	}
+}
+
+func typedValue(fragment AccessLogFragment, value string) []byte {
+	if value == "" {
+		return []byte("null")
+	}
+	if _, ok := fragment.(FieldOperator); ok {
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return jsonString(value)
+		}
+		return []byte(value)
+	}
+	// DYNAMIC_METADATA and FILTER_STATE are already rendered as JSON
+	if !json.Valid([]byte(value)) {
+		return jsonString(value)
+	}
+	return []byte(value)
+}
+
+func jsonString(value string) []byte {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(value) // encoding of a string never fails
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+}
@@ -0,0 +1,283 @@
+package accesslog_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/envoy/accesslog"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	structpb "github.com/golang/protobuf/ptypes/struct"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	accesslog_config "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v2"
+	accesslog_data "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v2"
+
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("JsonAccessLogFormat", func() {
+
+	commonProperties := &accesslog_data.AccessLogCommon{
+		StartTime:             util_proto.MustTimestampProto(time.Unix(1582062737, 987654321)),
+		TimeToLastRxByte:      ptypes.DurationProto(57000 * time.Microsecond),
+		UpstreamRemoteAddress: EnvoySocketAddress("10.0.0.2", 443),
+		Metadata: &envoy_core.Metadata{
+			FilterMetadata: map[string]*structpb.Struct{
+				"com.test.my_filter": {
+					Fields: map[string]*structpb.Value{
+						"test_key": {
+							Kind: &structpb.Value_StringValue{StringValue: "test_value"},
+						},
+					},
+				},
+			},
+		},
+		FilterStateObjects: map[string]*any.Any{
+			"key": util_proto.MustMarshalAny(&wrappers.StringValue{Value: "value"}),
+		},
+	}
+
+	httpExample := &accesslog_data.HTTPAccessLogEntry{
+		CommonProperties: commonProperties,
+		Request: &accesslog_data.HTTPRequestProperties{
+			RequestMethod:    envoy_core.RequestMethod_GET,
+			Path:             "/api?query=\"quoted\"",
+			RequestBodyBytes: 234,
+			RequestHeaders: map[string]string{
+				"x-note": "curl/7.54.0 <\"test\">",
+			},
+		},
+		Response: &accesslog_data.HTTPResponseProperties{
+			ResponseCode: &wrappers.UInt32Value{
+				Value: 200,
+			},
+			ResponseBodyBytes: 567,
+		},
+	}
+
+	tcpExample := &accesslog_data.TCPAccessLogEntry{
+		CommonProperties: commonProperties,
+		ConnectionProperties: &accesslog_data.ConnectionProperties{
+			ReceivedBytes: 234,
+			SentBytes:     567,
+		},
+	}
+
+	type testCase struct {
+		format       map[string]string
+		expectedHTTP string
+		expectedTCP  string
+	}
+
+	DescribeTable("should format log entries as JSON objects",
+		func(given testCase) {
+			// when
+			format, err := ParseJsonFormat(given.format)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := format.FormatHttpLogEntry(httpExample)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(Equal(given.expectedHTTP))
+
+			// when
+			actual, err = format.FormatTcpLogEntry(tcpExample)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(Equal(given.expectedTCP))
+		},
+		Entry("string values", testCase{
+			format: map[string]string{
+				"start_time": "%START_TIME%",
+				"upstream":   "%UPSTREAM_HOST%",
+				"literal":    "plain text",
+			},
+			expectedHTTP: `{"literal":"plain text","start_time":"2020-02-18T21:52:17.987Z","upstream":"10.0.0.2:443"}`,
+			expectedTCP:  `{"literal":"plain text","start_time":"2020-02-18T21:52:17.987Z","upstream":"10.0.0.2:443"}`,
+		}),
+		Entry("values with characters that have to be escaped", testCase{
+			format: map[string]string{
+				"path": "%REQ(:PATH)%",
+				"note": "%REQ(X-NOTE)%",
+			},
+			expectedHTTP: `{"note":"curl/7.54.0 <\"test\">","path":"/api?query=\"quoted\""}`,
+			expectedTCP:  `{"note":"-","path":"-"}`,
+		}),
+		Entry("numeric values", testCase{
+			format: map[string]string{
+				"status":           "%RESPONSE_CODE%",
+				"bytes_received":   "%BYTES_RECEIVED%",
+				"bytes_sent":       "%BYTES_SENT%",
+				"request_duration": "%REQUEST_DURATION%",
+			},
+			expectedHTTP: `{"bytes_received":234,"bytes_sent":567,"request_duration":57,"status":200}`,
+			expectedTCP:  `{"bytes_received":234,"bytes_sent":567,"request_duration":null,"status":0}`,
+		}),
+		Entry("numeric command mixed with text", testCase{
+			format: map[string]string{
+				"status": "status=%RESPONSE_CODE%",
+			},
+			expectedHTTP: `{"status":"status=200"}`,
+			expectedTCP:  `{"status":"status=0"}`,
+		}),
+		Entry("structured values", testCase{
+			format: map[string]string{
+				"metadata":     "%DYNAMIC_METADATA(com.test.my_filter)%",
+				"filter_state": "%FILTER_STATE(key)%",
+				"unknown":      "%FILTER_STATE(unknown)%",
+			},
+			expectedHTTP: `{"filter_state":"value","metadata":{"test_key":"test_value"},"unknown":null}`,
+			expectedTCP:  `{"filter_state":"value","metadata":{"test_key":"test_value"},"unknown":null}`,
+		}),
+		Entry("truncated structured values", testCase{
+			format: map[string]string{
+				"metadata": "%DYNAMIC_METADATA(com.test.my_filter):5%",
+			},
+			expectedHTTP: `{"metadata":"{\"tes"}`,
+			expectedTCP:  `{"metadata":"{\"tes"}`,
+		}),
+	)
+
+	Describe("ParseJsonFormat()", func() {
+		type testCase struct {
+			format      map[string]string
+			expectedErr string
+		}
+
+		DescribeTable("should reject invalid formats",
+			func(given testCase) {
+				// when
+				_, err := ParseJsonFormat(given.format)
+				// then
+				Expect(err).To(MatchError(given.expectedErr))
+			},
+			Entry("no fields", testCase{
+				format:      nil,
+				expectedErr: `JSON format must have at least one field`,
+			}),
+			Entry("field with an empty name", testCase{
+				format:      map[string]string{"": "%START_TIME%"},
+				expectedErr: `JSON format cannot have a field with an empty name`,
+			}),
+			Entry("field with an invalid format string", testCase{
+				format:      map[string]string{"bytes": "%sent_bytes%"},
+				expectedErr: `format string of a field "bytes" is not valid: format string is not valid: expected a command operator to start at position 1, instead got: "%sent_bytes%"`,
+			}),
+		)
+	})
+
+	Describe("String() and ParseJsonFormatString()", func() {
+		It("should support round trip", func() {
+			// given
+			format, err := ParseJsonFormat(map[string]string{
+				"status": "%RESPONSE_CODE%",
+				"path":   "%REQ(:PATH)%",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual := format.String()
+			// then
+			Expect(actual).To(Equal(`{"path":"%REQ(:path)%","status":"%RESPONSE_CODE%"}`))
+
+			// when
+			parsed, err := ParseJsonFormatString(actual)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(parsed.String()).To(Equal(actual))
+		})
+
+		It("should reject a string that is not a JSON object", func() {
+			// when
+			_, err := ParseJsonFormatString(`"%RESPONSE_CODE%"`)
+			// then
+			Expect(err).To(HaveOccurred())
+			// and
+			Expect(err.Error()).To(HavePrefix("JSON format is not a valid JSON object"))
+		})
+	})
+
+	Describe("JsonLogName() and ParseJsonLogName()", func() {
+		It("should support round trip of an address with separators", func() {
+			// given
+			format, err := ParseJsonFormat(map[string]string{
+				"status": "%RESPONSE_CODE%",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			address := "https://collector.internal/logs;v=1#batchSize=50"
+
+			// when
+			logName := JsonLogName(address, format)
+			// then
+			Expect(logName).To(Equal(`json;{"address":"https://collector.internal/logs;v=1#batchSize=50","format":{"status":"%RESPONSE_CODE%"}}`))
+			Expect(IsJsonLogName(logName)).To(BeTrue())
+
+			// when
+			parsedAddress, parsedFormat, err := ParseJsonLogName(logName)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsedAddress).To(Equal(address))
+			Expect(parsedFormat.String()).To(Equal(format.String()))
+		})
+
+		It("should reject a log name without an address", func() {
+			// when
+			_, _, err := ParseJsonLogName(`json;{"format":{"status":"%RESPONSE_CODE%"}}`)
+			// then
+			Expect(err).To(MatchError(`log name "json;{\"format\":{\"status\":\"%RESPONSE_CODE%\"}}" has invalid format: address cannot be empty`))
+		})
+	})
+
+	Describe("Interpolate()", func() {
+		It("should bind Kuma-specific placeholders in all fields", func() {
+			// given
+			format, err := ParseJsonFormat(map[string]string{
+				"source":      "%KUMA_SOURCE_SERVICE%",
+				"destination": "to %KUMA_DESTINATION_SERVICE%",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			interpolated, err := format.Interpolate(InterpolationVariables{
+				CMD_KUMA_SOURCE_SERVICE:      "web",
+				CMD_KUMA_DESTINATION_SERVICE: "backend",
+			})
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(interpolated.String()).To(Equal(`{"destination":"to backend","source":"web"}`))
+		})
+	})
+
+	Describe("ConfigureHttpLog()", func() {
+		It("should capture headers of all fields", func() {
+			// given
+			format, err := ParseJsonFormat(map[string]string{
+				"origin": "%REQ(ORIGIN)%",
+				"server": "%RESP(SERVER)%",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			config := &accesslog_config.HttpGrpcAccessLogConfig{}
+
+			// when
+			err = format.ConfigureHttpLog(config)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(config.AdditionalRequestHeadersToLog).To(Equal([]string{"origin"}))
+			Expect(config.AdditionalResponseHeadersToLog).To(Equal([]string{"server"}))
+		})
+	})
+})
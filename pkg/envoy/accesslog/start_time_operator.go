@@ -33,8 +33,10 @@ func (f StartTimeOperator) format(entry *accesslog_data.AccessLogCommon) (string
 	if err != nil {
 		return "", err
 	}
-	// TODO(yskopets): take format string parameter into account
-	return startTime.Format(defaultStartTimeFormat), nil
+	if f == "" {
+		return startTime.Format(defaultStartTimeFormat), nil
+	}
+	return strftime(startTime, string(f)), nil
 }
 
 func (f StartTimeOperator) ConfigureHttpLog(config *accesslog_config.HttpGrpcAccessLogConfig) error {
@@ -61,11 +61,25 @@ var _ = Describe("StartTimeOperator", func() {
 				expected: `2020-02-18T21:52:17.987Z`,
 			}),
 			Entry("user-defined time format", testCase{
-				timeFormat: "%s.%3f", // not supported yet
+				timeFormat: "%s.%3f",
 				commonProperties: &accesslog_data.AccessLogCommon{
 					StartTime: util_proto.MustTimestampProto(time.Unix(1582062737, 987654321)),
 				},
-				expected: `2020-02-18T21:52:17.987Z`,
+				expected: `1582062737.987`,
+			}),
+			Entry("user-defined time format with Envoy and Abseil extensions", testCase{
+				timeFormat: "%F %T.%f %E3S %E*S %1f %9f %%",
+				commonProperties: &accesslog_data.AccessLogCommon{
+					StartTime: util_proto.MustTimestampProto(time.Unix(1582062737, 987654321)),
+				},
+				expected: `2020-02-18 21:52:17.987654321 17.987 17.987654321 9 987654321 %`,
+			}),
+			Entry("user-defined time format with names", testCase{
+				timeFormat: "%a, %d %b %Y %I:%M:%S %p %Z",
+				commonProperties: &accesslog_data.AccessLogCommon{
+					StartTime: util_proto.MustTimestampProto(time.Unix(1582062737, 987654321)),
+				},
+				expected: `Tue, 18 Feb 2020 09:52:17 PM UTC`,
 			}),
 		)
 
@@ -0,0 +1,143 @@
+package accesslog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// strftime formats time according to a format string of `%START_TIME(FORMAT)%` command.
+//
+// It replicates Envoy's behaviour, i.e. supports conversion specifications of C++ strftime(),
+// extensions of Abseil (`%E#S`, `%E*S`) and extensions of Envoy:
+//   - `%[1-9]f` - fractional seconds with a given number of digits, e.g. `%3f` for milliseconds
+//   - `%f` - fractional seconds with 9 digits (nanoseconds)
+//   - `%s` - number of seconds since the Epoch
+func strftime(t time.Time, format string) string {
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			b.WriteByte(format[i])
+			continue
+		}
+		i++
+		spec := format[i]
+
+		// Envoy: %[1-9]f
+		if '1' <= spec && spec <= '9' && i+1 < len(format) && format[i+1] == 'f' {
+			b.WriteString(fractionalSeconds(t, int(spec-'0')))
+			i++
+			continue
+		}
+		// Abseil: %E#S and %E*S
+		if spec == 'E' && i+2 < len(format) && format[i+2] == 'S' {
+			precision := format[i+1]
+			switch {
+			case precision == '*':
+				b.WriteString(fmt.Sprintf("%02d", t.Second()))
+				if fraction := strings.TrimRight(fractionalSeconds(t, 9), "0"); fraction != "" {
+					b.WriteString("." + fraction)
+				}
+				i += 2
+				continue
+			case '0' <= precision && precision <= '9':
+				b.WriteString(fmt.Sprintf("%02d", t.Second()))
+				if digits := int(precision - '0'); digits > 0 {
+					b.WriteString("." + fractionalSeconds(t, digits))
+				}
+				i += 2
+				continue
+			}
+		}
+
+		switch spec {
+		case 'f':
+			b.WriteString(fractionalSeconds(t, 9))
+		case 's':
+			b.WriteString(strconv.FormatInt(t.Unix(), 10))
+		case 'Y':
+			b.WriteString(strconv.Itoa(t.Year()))
+		case 'C':
+			b.WriteString(fmt.Sprintf("%02d", t.Year()/100))
+		case 'y':
+			b.WriteString(fmt.Sprintf("%02d", t.Year()%100))
+		case 'm':
+			b.WriteString(fmt.Sprintf("%02d", int(t.Month())))
+		case 'd':
+			b.WriteString(fmt.Sprintf("%02d", t.Day()))
+		case 'e':
+			b.WriteString(fmt.Sprintf("%2d", t.Day()))
+		case 'j':
+			b.WriteString(fmt.Sprintf("%03d", t.YearDay()))
+		case 'H':
+			b.WriteString(fmt.Sprintf("%02d", t.Hour()))
+		case 'k':
+			b.WriteString(fmt.Sprintf("%2d", t.Hour()))
+		case 'I':
+			b.WriteString(fmt.Sprintf("%02d", hour12(t)))
+		case 'l':
+			b.WriteString(fmt.Sprintf("%2d", hour12(t)))
+		case 'M':
+			b.WriteString(fmt.Sprintf("%02d", t.Minute()))
+		case 'S':
+			b.WriteString(fmt.Sprintf("%02d", t.Second()))
+		case 'p':
+			b.WriteString(t.Format("PM"))
+		case 'a':
+			b.WriteString(t.Format("Mon"))
+		case 'A':
+			b.WriteString(t.Format("Monday"))
+		case 'b', 'h':
+			b.WriteString(t.Format("Jan"))
+		case 'B':
+			b.WriteString(t.Format("January"))
+		case 'u':
+			weekday := int(t.Weekday())
+			if weekday == 0 {
+				weekday = 7
+			}
+			b.WriteString(strconv.Itoa(weekday))
+		case 'w':
+			b.WriteString(strconv.Itoa(int(t.Weekday())))
+		case 'z':
+			b.WriteString(t.Format("-0700"))
+		case 'Z':
+			b.WriteString(t.Format("MST"))
+		case 'F':
+			b.WriteString(t.Format("2006-01-02"))
+		case 'T':
+			b.WriteString(t.Format("15:04:05"))
+		case 'R':
+			b.WriteString(t.Format("15:04"))
+		case 'D':
+			b.WriteString(t.Format("01/02/06"))
+		case 'c':
+			b.WriteString(t.Format("Mon Jan _2 15:04:05 2006"))
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case '%':
+			b.WriteByte('%')
+		default:
+			// unknown conversion specifications are kept as is
+			b.WriteByte('%')
+			b.WriteByte(spec)
+		}
+	}
+	return b.String()
+}
+
+// fractionalSeconds returns a given number of the most significant digits of fractional seconds.
+func fractionalSeconds(t time.Time, digits int) string {
+	return fmt.Sprintf("%09d", t.Nanosecond())[:digits]
+}
+
+func hour12(t time.Time) int {
+	hour := t.Hour() % 12
+	if hour == 0 {
+		hour = 12
+	}
+	return hour
+}
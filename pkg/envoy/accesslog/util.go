@@ -29,3 +29,11 @@ func (s stringList) AppendToSet(dest []string) []string {
 	}
 	return dest
 }
+
+// truncate limits the length of a value the way Envoy does for commands with `:Z` suffix.
+func truncate(value string, maxLength int) string {
+	if maxLength > 0 && len(value) > maxLength {
+		return value[:maxLength]
+	}
+	return value
+}
@@ -0,0 +1,22 @@
+package gc
+
+import (
+	"time"
+
+	config_core "github.com/Kong/kuma/pkg/config/core"
+	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+)
+
+const dataplaneCleanupInterval = time.Minute
+
+func Setup(rt core_runtime.Runtime) error {
+	if rt.Config().Environment != config_core.UniversalEnvironment {
+		// Dataplanes on Kubernetes are deleted together with Pods
+		return nil
+	}
+	ttl := rt.Config().Runtime.Universal.DataplaneCleanupAge
+	if ttl == 0 {
+		return nil
+	}
+	return rt.Add(NewDataplaneCollector(rt.ResourceManager(), ttl, dataplaneCleanupInterval))
+}
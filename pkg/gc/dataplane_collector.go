@@ -0,0 +1,134 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/Kong/kuma/pkg/core"
+	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/manager"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+)
+
+var dataplaneCollectorLog = core.Log.WithName("gc").WithName("dataplane-collector")
+
+// staleInsightAge is the period of time after which subscriptions of a DataplaneInsight that has not been saved
+// are considered to belong to a Control Plane instance that died without closing them.
+// Control Plane saves DataplaneInsight of every connected Dataplane at least once a minute.
+const staleInsightAge = 10 * time.Minute
+
+var _ core_runtime.LeaderComponent = &dataplaneCollector{}
+
+// NewDataplaneCollector returns a component that periodically deletes Dataplanes (together with their insights)
+// that have not been connected to the Control Plane for longer than a given TTL.
+//
+// Dataplane is considered offline since its most recent ADS subscription was closed or, if it has never
+// connected, since it was last modified. Subscriptions that have not been closed are ignored once DataplaneInsight
+// has not been saved for a while, in which case Dataplane is considered offline since that moment.
+func NewDataplaneCollector(rm manager.ResourceManager, ttl time.Duration, interval time.Duration) core_runtime.Component {
+	return &dataplaneCollector{
+		rm:       rm,
+		ttl:      ttl,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+type dataplaneCollector struct {
+	rm       manager.ResourceManager
+	ttl      time.Duration
+	interval time.Duration
+	now      func() time.Time
+}
+
+func (d *dataplaneCollector) Start(stop <-chan struct{}) error {
+	dataplaneCollectorLog.Info("starting", "ttl", d.ttl)
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := d.cleanup(); err != nil {
+				dataplaneCollectorLog.Error(err, "could not clean up offline Dataplanes")
+			}
+		case <-stop:
+			dataplaneCollectorLog.Info("stopping")
+			return nil
+		}
+	}
+}
+
+func (d *dataplaneCollector) NeedLeaderElection() bool {
+	return true
+}
+
+func (d *dataplaneCollector) cleanup() error {
+	ctx := context.Background()
+	dataplanes := &mesh.DataplaneResourceList{}
+	if err := d.rm.List(ctx, dataplanes); err != nil {
+		return err
+	}
+	insights := &mesh.DataplaneInsightResourceList{}
+	if err := d.rm.List(ctx, insights); err != nil {
+		return err
+	}
+	insightsByKey := map[model.ResourceKey]*mesh.DataplaneInsightResource{}
+	for _, insight := range insights.Items {
+		insightsByKey[model.MetaToResourceKey(insight.GetMeta())] = insight
+	}
+
+	now := d.now()
+	for _, dataplane := range dataplanes.Items {
+		if dataplane.Spec.GetCleanup().GetDisabled() {
+			continue
+		}
+		key := model.MetaToResourceKey(dataplane.GetMeta())
+		offlineSince, online := offlineSince(dataplane, insightsByKey[key], now)
+		if online || offlineSince.IsZero() || now.Sub(offlineSince) < d.ttl {
+			continue
+		}
+		dataplaneCollectorLog.Info("deleting Dataplane that has been offline for too long", "name", key.Name, "mesh", key.Mesh, "offlineSince", offlineSince)
+		if err := d.rm.Delete(ctx, &mesh.DataplaneResource{}, store.DeleteBy(key)); err != nil && !store.IsResourceNotFound(err) {
+			dataplaneCollectorLog.Error(err, "could not delete Dataplane", "name", key.Name, "mesh", key.Mesh)
+			continue
+		}
+		if err := d.rm.Delete(ctx, &mesh.DataplaneInsightResource{}, store.DeleteBy(key)); err != nil && !store.IsResourceNotFound(err) {
+			dataplaneCollectorLog.Error(err, "could not delete DataplaneInsight", "name", key.Name, "mesh", key.Mesh)
+		}
+	}
+	return nil
+}
+
+// offlineSince returns time since when a Dataplane has been offline or true if it is online.
+// Zero time is returned if it cannot be determined.
+func offlineSince(dataplane *mesh.DataplaneResource, insight *mesh.DataplaneInsightResource, now time.Time) (time.Time, bool) {
+	if insight == nil {
+		return dataplane.GetMeta().GetModificationTime(), false
+	}
+	if insight.Spec.IsOnline() {
+		lastSeen := insight.GetMeta().GetModificationTime()
+		if lastSeen.IsZero() || now.Sub(lastSeen) < staleInsightAge {
+			return time.Time{}, true
+		}
+		// Control Plane instance that Dataplane has been connected to is gone without closing the subscription
+		return lastSeen, false
+	}
+	var since time.Time
+	for _, subscription := range insight.Spec.GetSubscriptions() {
+		disconnectTime, err := ptypes.Timestamp(subscription.GetDisconnectTime())
+		if err != nil {
+			continue
+		}
+		if disconnectTime.After(since) {
+			since = disconnectTime
+		}
+	}
+	if since.IsZero() {
+		return dataplane.GetMeta().GetModificationTime(), false
+	}
+	return since, false
+}
@@ -0,0 +1,161 @@
+package gc_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/manager"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	"github.com/Kong/kuma/pkg/gc"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Dataplane Collector", func() {
+
+	var s store.ResourceStore
+	var rm manager.ResourceManager
+
+	BeforeEach(func() {
+		s = memory.NewStore()
+		rm = manager.NewResourceManager(s)
+		err := rm.Create(context.Background(), &mesh.MeshResource{}, store.CreateByKey("default", "default"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	createDataplane := func(name string, tags map[string]string, cleanup ...*mesh_proto.Dataplane_Cleanup) {
+		dataplane := &mesh.DataplaneResource{
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port:        8080,
+							ServicePort: 80,
+							Tags:        tags,
+						},
+					},
+				},
+			},
+		}
+		if len(cleanup) > 0 {
+			dataplane.Spec.Cleanup = cleanup[0]
+		}
+		err := rm.Create(context.Background(), dataplane, store.CreateByKey(name, "default"))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	createInsight := func(name string, subscriptions ...*mesh_proto.DiscoverySubscription) {
+		insight := &mesh.DataplaneInsightResource{
+			Spec: mesh_proto.DataplaneInsight{
+				Subscriptions: subscriptions,
+			},
+		}
+		err := rm.Create(context.Background(), insight, store.CreateByKey(name, "default"))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	createStaleInsight := func(name string, lastSaved time.Duration, subscriptions ...*mesh_proto.DiscoverySubscription) {
+		insight := &mesh.DataplaneInsightResource{
+			Spec: mesh_proto.DataplaneInsight{
+				Subscriptions: subscriptions,
+			},
+		}
+		err := s.Create(context.Background(), insight, store.CreateByKey(name, "default"), store.CreatedAt(time.Now().Add(-lastSaved)))
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	subscription := func(connected time.Duration, disconnected *time.Duration) *mesh_proto.DiscoverySubscription {
+		connectTime, err := ptypes.TimestampProto(time.Now().Add(-connected))
+		Expect(err).ToNot(HaveOccurred())
+		s := &mesh_proto.DiscoverySubscription{
+			Id:                     "1",
+			ControlPlaneInstanceId: "kuma-cp",
+			ConnectTime:            connectTime,
+			Status:                 mesh_proto.NewSubscriptionStatus(),
+		}
+		if disconnected != nil {
+			disconnectTime, err := ptypes.TimestampProto(time.Now().Add(-*disconnected))
+			Expect(err).ToNot(HaveOccurred())
+			s.DisconnectTime = disconnectTime
+		}
+		return s
+	}
+
+	exists := func(resource interface{}, name string) bool {
+		var err error
+		switch r := resource.(type) {
+		case *mesh.DataplaneResource:
+			err = rm.Get(context.Background(), r, store.GetByKey(name, "default"))
+		case *mesh.DataplaneInsightResource:
+			err = rm.Get(context.Background(), r, store.GetByKey(name, "default"))
+		}
+		if store.IsResourceNotFound(err) {
+			return false
+		}
+		Expect(err).ToNot(HaveOccurred())
+		return true
+	}
+
+	It("should delete Dataplanes that have been offline for longer than TTL", func() {
+		// given
+		longAgo := 2 * time.Hour
+		recently := time.Minute
+
+		createDataplane("offline", map[string]string{"service": "backend"})
+		createInsight("offline", subscription(3*time.Hour, &longAgo))
+
+		createDataplane("recently-offline", map[string]string{"service": "backend"})
+		createInsight("recently-offline", subscription(3*time.Hour, &longAgo), subscription(time.Hour, &recently))
+
+		createDataplane("online", map[string]string{"service": "backend"})
+		createInsight("online", subscription(3*time.Hour, nil))
+
+		createDataplane("abandoned", map[string]string{"service": "backend"})
+		createStaleInsight("abandoned", 2*time.Hour, subscription(3*time.Hour, nil))
+
+		createDataplane("recently-abandoned", map[string]string{"service": "backend"})
+		createStaleInsight("recently-abandoned", 30*time.Minute, subscription(3*time.Hour, nil))
+
+		createDataplane("never-connected", map[string]string{"service": "backend"})
+
+		createDataplane("static", map[string]string{"service": "backend"}, &mesh_proto.Dataplane_Cleanup{Disabled: true})
+		createInsight("static", subscription(3*time.Hour, &longAgo))
+
+		// when
+		collector := gc.NewDataplaneCollector(rm, time.Hour, 10*time.Millisecond)
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			defer GinkgoRecover()
+			Expect(collector.Start(stop)).To(Succeed())
+		}()
+
+		// then
+		Eventually(func() bool {
+			return exists(&mesh.DataplaneResource{}, "offline")
+		}, "5s", "10ms").Should(BeFalse())
+		Expect(exists(&mesh.DataplaneInsightResource{}, "offline")).To(BeFalse())
+		Eventually(func() bool {
+			return exists(&mesh.DataplaneResource{}, "abandoned")
+		}, "5s", "10ms").Should(BeFalse())
+
+		// and
+		Consistently(func() []string {
+			dataplanes := &mesh.DataplaneResourceList{}
+			Expect(rm.List(context.Background(), dataplanes)).To(Succeed())
+			var names []string
+			for _, dataplane := range dataplanes.Items {
+				names = append(names, dataplane.GetMeta().GetName())
+			}
+			return names
+		}, "100ms", "10ms").Should(ConsistOf("recently-offline", "online", "recently-abandoned", "never-connected", "static"))
+		Expect(exists(&mesh.DataplaneInsightResource{}, "static")).To(BeTrue())
+	})
+})
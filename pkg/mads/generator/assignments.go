@@ -21,6 +21,14 @@ const (
 	meshLabel = "mesh"
 	// dataplaneLabel is the name of the label that holds the dataplane name.
 	dataplaneLabel = "dataplane"
+
+	// mtlsLabel is the name of the label that marks targets protected by mTLS.
+	// Such targets have to be scraped by a job with a client certificate issued by the CA of the mesh
+	// that verifies targets against that CA and `server_name: <mesh>.mesh.kuma.io`,
+	// e.g. `relabel_configs: [{source_labels: [__meta_kuma_mtls, mesh], regex: "true;default", action: keep}]`.
+	mtlsLabel = "__meta_kuma_mtls"
+	// spiffeIdLabel is the name of the label that holds SPIFFE ID of the certificate presented by a target protected by mTLS.
+	spiffeIdLabel = "__meta_kuma_spiffe_id"
 )
 
 // MonitoringAssignmentsGenerator knows how to generate MonitoringAssignment
@@ -87,6 +95,15 @@ const (
 //       service: backend
 //       services: ,backend,
 //
+//  If Prometheus endpoint of a dataplane is protected by mTLS, `__scheme__` is set to `https`
+//  and the following labels are added as hints for TLS configuration of a scrape job.
+//  Such a job verifies targets against the CA of the mesh and checks `server_name: <mesh>.mesh.kuma.io`,
+//  while `__meta_kuma_spiffe_id` tells which service a target is expected to present:
+//
+//     labels:
+//       __meta_kuma_mtls: "true"
+//       __meta_kuma_spiffe_id: spiffe://default/backend
+//
 type MonitoringAssignmentsGenerator struct {
 }
 
@@ -106,6 +123,10 @@ func (g MonitoringAssignmentsGenerator) Generate(args Args) ([]*core_xds.Resourc
 			// Prometheus metrics are not enabled on that Mesh
 			continue
 		}
+		if prometheusEndpoint.GetMtls().GetValue() && !mesh.Spec.GetMtls().GetEnabled() {
+			// Prometheus endpoint is not exposed by a dataplane unless mTLS is enabled on that Mesh
+			continue
+		}
 
 		assignment := &observability_proto.MonitoringAssignment{
 			Name: g.assignmentName(dataplane),
@@ -176,6 +197,11 @@ func (g MonitoringAssignmentsGenerator) dataplaneLabels(dataplane *mesh_core.Dat
 	labels[meshLabel] = dataplane.Meta.GetMesh()
 	labels[dataplaneLabel] = dataplane.Meta.GetName()
 	// notice that `service` tag is handled as part of user-defined tags
+	if endpoint.GetMtls().GetValue() {
+		labels[prom.SchemeLabel] = "https"
+		labels[mtlsLabel] = "true"
+		labels[spiffeIdLabel] = fmt.Sprintf("spiffe://%s/%s", dataplane.Meta.GetMesh(), dataplane.Spec.GetIdentifyingService())
+	}
 	return labels
 }
 
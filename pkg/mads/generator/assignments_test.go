@@ -1,6 +1,7 @@
 package generator_test
 
 import (
+	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -577,6 +578,118 @@ var _ = Describe("MonitoringAssignmentsGenerator", func() {
 					},
 				},
 			}),
+			Entry("Dataplane with Prometheus endpoint protected by mTLS", testCase{
+				meshes: []*mesh_core.MeshResource{
+					{
+						Meta: &test_model.ResourceMeta{
+							Name: "demo",
+							Mesh: "demo",
+						},
+						Spec: mesh_proto.Mesh{
+							Mtls: &mesh_proto.Mesh_Mtls{
+								Enabled: true,
+							},
+							Metrics: &mesh_proto.Metrics{
+								Prometheus: &mesh_proto.Metrics_Prometheus{
+									Port: 1234,
+									Path: "/non-standard-path",
+									Mtls: &wrappers.BoolValue{Value: true},
+								},
+							},
+						},
+					},
+				},
+				dataplanes: []*mesh_core.DataplaneResource{
+					{
+						Meta: &test_model.ResourceMeta{
+							Name: "backend-01",
+							Mesh: "demo",
+						},
+						Spec: mesh_proto.Dataplane{
+							Networking: &mesh_proto.Dataplane_Networking{
+								Address: "192.168.0.1",
+								Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+									Port:        80,
+									ServicePort: 8080,
+									Tags: map[string]string{
+										"service": "backend",
+									},
+								}},
+							},
+						},
+					},
+				},
+				expected: []*core_xds.Resource{
+					{
+						Name:    "/meshes/demo/dataplanes/backend-01",
+						Version: "",
+						Resource: &observability_proto.MonitoringAssignment{
+							Name: "/meshes/demo/dataplanes/backend-01",
+							Targets: []*observability_proto.MonitoringAssignment_Target{{
+								Labels: map[string]string{
+									"__address__": "192.168.0.1:1234",
+								},
+							}},
+							Labels: map[string]string{
+								"__scheme__":            "https",
+								"__metrics_path__":      "/non-standard-path",
+								"__meta_kuma_mtls":      "true",
+								"__meta_kuma_spiffe_id": "spiffe://demo/backend",
+								"job":                   "backend",
+								"instance":              "backend-01",
+								"mesh":                  "demo",
+								"dataplane":             "backend-01",
+								"service":               "backend",
+								"services":              ",backend,",
+							},
+						},
+					},
+				},
+			}),
+			Entry("Dataplane with Prometheus endpoint protected by mTLS inside a Mesh without mTLS", testCase{
+				meshes: []*mesh_core.MeshResource{
+					{
+						Meta: &test_model.ResourceMeta{
+							Name: "demo",
+							Mesh: "demo",
+						},
+						Spec: mesh_proto.Mesh{
+							Metrics: &mesh_proto.Metrics{
+								Prometheus: &mesh_proto.Metrics_Prometheus{
+									Port: 1234,
+									Path: "/non-standard-path",
+								},
+							},
+						},
+					},
+				},
+				dataplanes: []*mesh_core.DataplaneResource{
+					{
+						Meta: &test_model.ResourceMeta{
+							Name: "backend-01",
+							Mesh: "demo",
+						},
+						Spec: mesh_proto.Dataplane{
+							Networking: &mesh_proto.Dataplane_Networking{
+								Address: "192.168.0.1",
+								Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+									Port:        80,
+									ServicePort: 8080,
+									Tags: map[string]string{
+										"service": "backend",
+									},
+								}},
+							},
+							Metrics: &mesh_proto.Metrics{
+								Prometheus: &mesh_proto.Metrics_Prometheus{
+									Mtls: &wrappers.BoolValue{Value: true},
+								},
+							},
+						},
+					},
+				},
+				expected: []*core_xds.Resource{},
+			}),
 		)
 	})
 })
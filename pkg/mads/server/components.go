@@ -14,8 +14,8 @@ import (
 	util_xds "github.com/Kong/kuma/pkg/util/xds"
 
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
-	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache"
-	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v2"
+	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server/v2"
 )
 
 func NewSnapshotGenerator(rt core_runtime.Runtime) mads_reconcile.SnapshotGenerator {
@@ -1,8 +1,14 @@
 package k8s
 
 import (
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/pkg/core"
 	core_plugins "github.com/Kong/kuma/pkg/core/plugins"
 	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+	leader_k8s "github.com/Kong/kuma/pkg/plugins/leader/k8s"
 	k8s_runtime "github.com/Kong/kuma/pkg/runtime/k8s"
 
 	kube_runtime "k8s.io/apimachinery/pkg/runtime"
@@ -32,7 +38,30 @@ func (p *plugin) Bootstrap(b *core_runtime.Builder, _ core_plugins.PluginConfig)
 	if err != nil {
 		return err
 	}
-	b.WithComponentManager(mgr)
+	// Leader election of controller-runtime Manager is deliberately not used since it would make
+	// all components leader-only. Instead, only components that need it are run on a leader.
+	identity, err := leaderIdentity()
+	if err != nil {
+		return err
+	}
+	leaderElector, err := leader_k8s.NewLeaderElector(mgr.GetConfig(), b.Config().Store.Kubernetes.SystemNamespace, identity)
+	if err != nil {
+		return err
+	}
+	if checker, ok := leaderElector.(core_runtime.HealthChecker); ok {
+		// leader that fails to renew the lease should be restarted by kubelet
+		b.Health().AddLivenessCheck("leader-election", checker)
+	}
+	b.WithComponentManager(core_runtime.NewLeaderAwareComponentManager(mgr, leaderElector))
 	b.WithExtensions(k8s_runtime.NewManagerContext(b.Extensions(), mgr))
 	return nil
 }
+
+// leaderIdentity is unique for every instance of the Control Plane, even if they run in the same Pod.
+func leaderIdentity() (string, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", errors.Wrap(err, "could not determine identity for leader election")
+	}
+	return hostname + "_" + core.NewUUID(), nil
+}
@@ -1,8 +1,14 @@
 package universal
 
 import (
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/pkg/config/core/resources/store"
 	core_plugins "github.com/Kong/kuma/pkg/core/plugins"
 	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+	common_postgres "github.com/Kong/kuma/pkg/plugins/common/postgres"
+	leader_memory "github.com/Kong/kuma/pkg/plugins/leader/memory"
+	leader_postgres "github.com/Kong/kuma/pkg/plugins/leader/postgres"
 )
 
 var _ core_plugins.BootstrapPlugin = &plugin{}
@@ -14,6 +20,29 @@ func init() {
 }
 
 func (p *plugin) Bootstrap(b *core_runtime.Builder, _ core_plugins.PluginConfig) error {
-	b.WithComponentManager(NewComponentManager())
+	leaderElector, err := newLeaderElector(b)
+	if err != nil {
+		return err
+	}
+	if checker, ok := leaderElector.(core_runtime.HealthChecker); ok {
+		b.Health().AddReadinessCheck("leader-election", checker)
+	}
+	b.WithComponentManager(core_runtime.NewLeaderAwareComponentManager(NewComponentManager(), leaderElector))
 	return nil
 }
+
+func newLeaderElector(b *core_runtime.Builder) (core_runtime.LeaderElector, error) {
+	switch storeType := b.Config().Store.Type; storeType {
+	case store.MemoryStore:
+		// in-memory store cannot be shared, so there is only one instance of the Control Plane
+		return leader_memory.NewAlwaysLeaderElector(), nil
+	case store.PostgresStore:
+		db, err := common_postgres.ConnectToDb(*b.Config().Store.Postgres)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not connect to DB for leader election")
+		}
+		return leader_postgres.NewLeaderElector(db), nil
+	default:
+		return nil, errors.Errorf("unsupported store type %q for leader election in universal environment", storeType)
+	}
+}
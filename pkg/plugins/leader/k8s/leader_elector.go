@@ -0,0 +1,132 @@
+package k8s
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_client "k8s.io/client-go/kubernetes"
+	kube_rest "k8s.io/client-go/rest"
+	kube_leaderelection "k8s.io/client-go/tools/leaderelection"
+	kube_resourcelock "k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/Kong/kuma/pkg/core"
+	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+)
+
+var log = core.Log.WithName("leader").WithName("k8s")
+
+const (
+	leaseName = "kuma-cp-leader"
+
+	leaseDuration = 15 * time.Second
+	renewDeadline = 10 * time.Second
+	retryPeriod   = 2 * time.Second
+
+	// maxTolerableExpiredLease is how long a leader may fail to renew the lease before it is reported as unhealthy
+	maxTolerableExpiredLease = 20 * time.Second
+)
+
+var _ core_runtime.LeaderElector = &kubeLeaderElector{}
+var _ core_runtime.HealthChecker = &kubeLeaderElector{}
+
+// NewLeaderElector returns a LeaderElector based on a Lease object in a given namespace.
+func NewLeaderElector(config *kube_rest.Config, namespace string, identity string) (core_runtime.LeaderElector, error) {
+	client, err := kube_client.NewForConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create Kubernetes client")
+	}
+	return &kubeLeaderElector{
+		namespace: namespace,
+		lock: &kube_resourcelock.LeaseLock{
+			LeaseMeta: kube_meta.ObjectMeta{
+				Namespace: namespace,
+				Name:      leaseName,
+			},
+			Client: client.CoordinationV1(),
+			LockConfig: kube_resourcelock.ResourceLockConfig{
+				Identity: identity,
+			},
+		},
+		watchDog: kube_leaderelection.NewLeaderHealthzAdaptor(maxTolerableExpiredLease),
+	}, nil
+}
+
+type kubeLeaderElector struct {
+	namespace string
+	lock      kube_resourcelock.Interface
+	watchDog  *kube_leaderelection.HealthzAdaptor
+	callbacks []core_runtime.LeaderCallbacks
+	leader    int32
+}
+
+func (e *kubeLeaderElector) AddCallbacks(callbacks core_runtime.LeaderCallbacks) {
+	e.callbacks = append(e.callbacks, callbacks)
+}
+
+func (e *kubeLeaderElector) IsLeader() bool {
+	return atomic.LoadInt32(&e.leader) == 1
+}
+
+// CheckHealth returns an error if the instance of the Control Plane holds the lease but fails to renew it.
+func (e *kubeLeaderElector) CheckHealth() error {
+	return e.watchDog.Check(nil)
+}
+
+func (e *kubeLeaderElector) Start(stop <-chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-stop
+		cancel()
+	}()
+	log.Info("waiting for lease", "namespace", e.namespace, "name", leaseName)
+	for {
+		elector, err := kube_leaderelection.NewLeaderElector(kube_leaderelection.LeaderElectionConfig{
+			Lock:          e.lock,
+			LeaseDuration: leaseDuration,
+			RenewDeadline: renewDeadline,
+			RetryPeriod:   retryPeriod,
+			WatchDog:      e.watchDog,
+			Callbacks: kube_leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(context.Context) {
+					log.Info("lease acquired")
+					atomic.StoreInt32(&e.leader, 1)
+					for _, callbacks := range e.callbacks {
+						callbacks.OnStartedLeading()
+					}
+				},
+				OnStoppedLeading: func() {
+					if atomic.CompareAndSwapInt32(&e.leader, 1, 0) {
+						log.Info("lease lost")
+						for _, callbacks := range e.callbacks {
+							callbacks.OnStoppedLeading()
+						}
+					}
+				},
+				OnNewLeader: func(identity string) {
+					if identity == e.lock.Identity() {
+						return
+					}
+					for _, callbacks := range e.callbacks {
+						if callbacks.OnObservedOtherLeader != nil {
+							callbacks.OnObservedOtherLeader()
+						}
+					}
+				},
+			},
+		})
+		if err != nil {
+			log.Error(err, "could not create leader elector")
+			return
+		}
+		// Run returns once the lease is lost or the context is cancelled
+		elector.Run(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryPeriod):
+		}
+	}
+}
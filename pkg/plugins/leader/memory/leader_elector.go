@@ -0,0 +1,41 @@
+package memory
+
+import (
+	"sync/atomic"
+
+	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+)
+
+var _ core_runtime.LeaderElector = &alwaysLeaderElector{}
+
+// NewAlwaysLeaderElector returns a LeaderElector that makes the Control Plane instance a leader immediately.
+//
+// It is meant to be used with in-memory resource store, which cannot be shared between several instances.
+func NewAlwaysLeaderElector() core_runtime.LeaderElector {
+	return &alwaysLeaderElector{}
+}
+
+type alwaysLeaderElector struct {
+	callbacks []core_runtime.LeaderCallbacks
+	leader    int32
+}
+
+func (e *alwaysLeaderElector) AddCallbacks(callbacks core_runtime.LeaderCallbacks) {
+	e.callbacks = append(e.callbacks, callbacks)
+}
+
+func (e *alwaysLeaderElector) IsLeader() bool {
+	return atomic.LoadInt32(&e.leader) == 1
+}
+
+func (e *alwaysLeaderElector) Start(stop <-chan struct{}) {
+	atomic.StoreInt32(&e.leader, 1)
+	for _, callbacks := range e.callbacks {
+		callbacks.OnStartedLeading()
+	}
+	<-stop
+	atomic.StoreInt32(&e.leader, 0)
+	for _, callbacks := range e.callbacks {
+		callbacks.OnStoppedLeading()
+	}
+}
@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/pkg/core"
+	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+)
+
+var log = core.Log.WithName("leader").WithName("postgres")
+
+// kumaCpLeaderLockId is an arbitrary key of the advisory lock that is held by the leader.
+// It has to be the same across all instances of the Control Plane that share the same DB.
+const kumaCpLeaderLockId int64 = 0x6b756d61 // "kuma"
+
+const (
+	retryPeriod  = 2 * time.Second
+	queryTimeout = 5 * time.Second
+)
+
+var _ core_runtime.LeaderElector = &postgresLeaderElector{}
+var _ core_runtime.HealthChecker = &postgresLeaderElector{}
+
+// NewLeaderElector returns a LeaderElector based on Postgres session-level advisory lock.
+//
+// The lock is held by a dedicated DB connection. Once that connection is broken, Postgres releases the lock
+// and another instance of the Control Plane takes over.
+func NewLeaderElector(db *sql.DB) core_runtime.LeaderElector {
+	return &postgresLeaderElector{
+		db:          db,
+		lockId:      kumaCpLeaderLockId,
+		retryPeriod: retryPeriod,
+	}
+}
+
+type postgresLeaderElector struct {
+	db          *sql.DB
+	lockId      int64
+	retryPeriod time.Duration
+	callbacks   []core_runtime.LeaderCallbacks
+	leader      int32
+
+	mu      sync.RWMutex // protects access to lastErr
+	lastErr error
+}
+
+func (e *postgresLeaderElector) AddCallbacks(callbacks core_runtime.LeaderCallbacks) {
+	e.callbacks = append(e.callbacks, callbacks)
+}
+
+func (e *postgresLeaderElector) IsLeader() bool {
+	return atomic.LoadInt32(&e.leader) == 1
+}
+
+// CheckHealth returns an error if the instance of the Control Plane cannot participate in leader election.
+func (e *postgresLeaderElector) CheckHealth() error {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.lastErr != nil {
+		return errors.Wrap(e.lastErr, "could not participate in leader election")
+	}
+	return nil
+}
+
+func (e *postgresLeaderElector) setLastErr(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.lastErr = err
+}
+
+func (e *postgresLeaderElector) Start(stop <-chan struct{}) {
+	log.Info("waiting for lock")
+	for {
+		conn, err := e.tryAcquireLock()
+		e.setLastErr(err)
+		if err != nil {
+			log.Error(err, "could not acquire lock")
+		}
+		if conn == nil && err == nil {
+			e.observedOtherLeader()
+		}
+		if conn != nil {
+			log.Info("lock acquired")
+			e.setLeader(true)
+			if err := e.holdLock(conn, stop); err != nil {
+				log.Error(err, "lock lost")
+				e.setLastErr(err)
+			}
+			e.setLeader(false)
+			e.releaseLock(conn)
+		}
+		select {
+		case <-stop:
+			log.Info("stopping leader election")
+			return
+		case <-time.After(e.retryPeriod):
+		}
+	}
+}
+
+// tryAcquireLock returns a connection that holds the lock or nil if the lock is held by another instance.
+func (e *postgresLeaderElector) tryAcquireLock() (*sql.Conn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+	// advisory lock belongs to a session, that is why we have to pin a connection
+	conn, err := e.db.Conn(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not obtain DB connection")
+	}
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", e.lockId).Scan(&acquired); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	if !acquired {
+		_ = conn.Close()
+		return nil, nil
+	}
+	return conn, nil
+}
+
+// holdLock blocks until the Stop channel is closed or the connection that holds the lock is broken.
+func (e *postgresLeaderElector) holdLock(conn *sql.Conn, stop <-chan struct{}) error {
+	ticker := time.NewTicker(e.retryPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+			_, err := conn.ExecContext(ctx, "SELECT 1")
+			cancel()
+			if err != nil {
+				return errors.Wrap(err, "connection that holds the lock is broken")
+			}
+		}
+	}
+}
+
+func (e *postgresLeaderElector) releaseLock(conn *sql.Conn) {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", e.lockId); err != nil {
+		log.V(1).Info("could not release lock explicitly, it will be released by Postgres once connection is closed", "err", err)
+	}
+	if err := conn.Close(); err != nil {
+		log.Error(err, "could not close DB connection")
+	}
+}
+
+// observedOtherLeader notifies callbacks that the lock is held by another instance.
+func (e *postgresLeaderElector) observedOtherLeader() {
+	for _, callbacks := range e.callbacks {
+		if callbacks.OnObservedOtherLeader != nil {
+			callbacks.OnObservedOtherLeader()
+		}
+	}
+}
+
+func (e *postgresLeaderElector) setLeader(leader bool) {
+	if leader {
+		atomic.StoreInt32(&e.leader, 1)
+		for _, callbacks := range e.callbacks {
+			callbacks.OnStartedLeading()
+		}
+	} else {
+		atomic.StoreInt32(&e.leader, 0)
+		for _, callbacks := range e.callbacks {
+			callbacks.OnStoppedLeading()
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// +build integration
+
+package postgres
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/pkg/config"
+	"github.com/Kong/kuma/pkg/config/plugins/resources/postgres"
+	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+	common_postgres "github.com/Kong/kuma/pkg/plugins/common/postgres"
+)
+
+var _ = Describe("postgresLeaderElector", func() {
+
+	newElector := func() core_runtime.LeaderElector {
+		cfg := postgres.PostgresStoreConfig{}
+		err := config.Load("", &cfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		db, err := common_postgres.ConnectToDb(cfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		elector := NewLeaderElector(db).(*postgresLeaderElector)
+		elector.retryPeriod = 100 * time.Millisecond
+		return elector
+	}
+
+	It("should elect only one leader", func() {
+		// given
+		elector1 := newElector()
+		elector2 := newElector()
+		stop1 := make(chan struct{})
+		stop2 := make(chan struct{})
+		defer close(stop2)
+
+		// when
+		go elector1.Start(stop1)
+
+		// then
+		Eventually(elector1.IsLeader, "5s").Should(BeTrue())
+
+		// when
+		go elector2.Start(stop2)
+
+		// then
+		Consistently(elector2.IsLeader, "1s").Should(BeFalse())
+
+		// when the leader is stopped
+		close(stop1)
+
+		// then another instance takes over
+		Eventually(elector1.IsLeader, "5s").Should(BeFalse())
+		Eventually(elector2.IsLeader, "5s").Should(BeTrue())
+	})
+
+	It("should notify callbacks", func() {
+		// given
+		elector := newElector()
+		started := make(chan struct{})
+		stopped := make(chan struct{})
+		elector.AddCallbacks(core_runtime.LeaderCallbacks{
+			OnStartedLeading: func() {
+				close(started)
+			},
+			OnStoppedLeading: func() {
+				close(stopped)
+			},
+		})
+		stop := make(chan struct{})
+
+		// when
+		go elector.Start(stop)
+
+		// then
+		Eventually(started, "5s").Should(BeClosed())
+
+		// when
+		close(stop)
+
+		// then
+		Eventually(stopped, "5s").Should(BeClosed())
+	})
+})
@@ -0,0 +1,13 @@
+package postgres
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestPostgresLeaderElector(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Postgres Leader Elector Suite")
+}
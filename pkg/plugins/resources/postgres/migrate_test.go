@@ -6,6 +6,7 @@ import (
 	"github.com/Kong/kuma/pkg/config"
 	"github.com/Kong/kuma/pkg/config/plugins/resources/postgres"
 	"github.com/Kong/kuma/pkg/core/plugins"
+	common_postgres "github.com/Kong/kuma/pkg/plugins/common/postgres"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -46,7 +47,7 @@ var _ = Describe("Migrate", func() {
 		_, err := migrateDb(cfg)
 		Expect(err).ToNot(HaveOccurred())
 
-		sql, err := connectToDb(cfg)
+		sql, err := common_postgres.ConnectToDb(cfg)
 		Expect(err).ToNot(HaveOccurred())
 		res, err := sql.Exec("UPDATE schema_migrations SET version = 9999999999")
 		Expect(err).ToNot(HaveOccurred())
@@ -8,17 +8,20 @@ import (
 	"strings"
 	"time"
 
-	_ "github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	config "github.com/Kong/kuma/pkg/config/plugins/resources/postgres"
+	core_plugins "github.com/Kong/kuma/pkg/core/plugins"
 	"github.com/Kong/kuma/pkg/core/resources/model"
 	"github.com/Kong/kuma/pkg/core/resources/store"
+	common_postgres "github.com/Kong/kuma/pkg/plugins/common/postgres"
 	"github.com/Kong/kuma/pkg/util/proto"
 )
 
 const duplicateKeyErrorMsg = "duplicate key value violates unique constraint"
 
+const healthCheckTimeout = 5 * time.Second
+
 type postgresResourceStore struct {
 	db *sql.DB
 }
@@ -26,7 +29,7 @@ type postgresResourceStore struct {
 var _ store.ResourceStore = &postgresResourceStore{}
 
 func NewStore(config config.PostgresStoreConfig) (store.ResourceStore, error) {
-	db, err := connectToDb(config)
+	db, err := common_postgres.ConnectToDb(config)
 	if err != nil {
 		return nil, err
 	}
@@ -36,41 +39,29 @@ func NewStore(config config.PostgresStoreConfig) (store.ResourceStore, error) {
 	}, nil
 }
 
-func connectToDb(cfg config.PostgresStoreConfig) (*sql.DB, error) {
-	mode, err := postgresMode(cfg.TLS.Mode)
-	if err != nil {
-		return nil, err
+// CheckHealth returns an error if DB is not reachable or is not migrated to the version expected by Kuma.
+func (r *postgresResourceStore) CheckHealth() error {
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+	if err := r.db.PingContext(ctx); err != nil {
+		return errors.Wrap(err, "could not connect to DB")
 	}
-	connStr := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s connect_timeout=%d sslmode=%s sslcert=%s sslkey=%s sslrootcert=%s",
-		cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.DbName, cfg.ConnectionTimeout, mode, cfg.TLS.CertPath, cfg.TLS.KeyPath, cfg.TLS.CAPath)
-	db, err := sql.Open("postgres", connStr)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot create connection to DB")
+	var version int64
+	var dirty bool
+	if err := r.db.QueryRowContext(ctx, `SELECT version, dirty FROM schema_migrations LIMIT 1`).Scan(&version, &dirty); err != nil {
+		return errors.Wrap(err, "could not retrieve DB migration version")
 	}
-
-	db.SetMaxOpenConns(cfg.MaxOpenConnections)
-
-	// check connection to DB, Open() does not check it.
-	if err := db.Ping(); err != nil {
-		return nil, errors.Wrap(err, "cannot connect to DB")
+	if dirty {
+		return errors.Errorf("DB migration to version %d has failed. Fix DB manually and run \"kuma-cp migrate up\"", version)
 	}
-
-	return db, nil
-}
-
-func postgresMode(mode config.TLSMode) (string, error) {
-	switch mode {
-	case config.Disable:
-		return "disable", nil
-	case config.VerifyNone:
-		return "require", nil
-	case config.VerifyCa:
-		return "verify-ca", nil
-	case config.VerifyFull:
-		return "verify-full", nil
-	default:
-		return "", errors.Errorf("could not translate mode %q to postgres mode", mode)
+	expected, err := newestMigration()
+	if err != nil {
+		return err
+	}
+	if core_plugins.DbVersion(version) != expected {
+		return errors.Errorf("DB is migrated to version %d, but Kuma expects version %d", version, expected)
 	}
+	return nil
 }
 
 func (r *postgresResourceStore) Create(_ context.Context, resource model.Resource, fs ...store.CreateOptionsFunc) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/Kong/kuma/pkg/config"
 	"github.com/Kong/kuma/pkg/config/plugins/resources/postgres"
 	"github.com/Kong/kuma/pkg/core/resources/store"
+	common_postgres "github.com/Kong/kuma/pkg/plugins/common/postgres"
 	test_store "github.com/Kong/kuma/pkg/test/store"
 )
 
@@ -39,7 +40,7 @@ var _ = Describe("postgresResourceStore", func() {
 })
 
 func createRandomDb(cfg postgres.PostgresStoreConfig) (string, error) {
-	db, err := connectToDb(cfg)
+	db, err := common_postgres.ConnectToDb(cfg)
 	if err != nil {
 		return "", err
 	}
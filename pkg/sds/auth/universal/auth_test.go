@@ -2,6 +2,7 @@ package universal_test
 
 import (
 	"context"
+	"fmt"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -10,6 +11,9 @@ import (
 	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	"github.com/Kong/kuma/pkg/core/resources/manager"
 	"github.com/Kong/kuma/pkg/core/resources/store"
+	"github.com/Kong/kuma/pkg/core/secrets/cipher"
+	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
+	secret_store "github.com/Kong/kuma/pkg/core/secrets/store"
 	"github.com/Kong/kuma/pkg/core/xds"
 	"github.com/Kong/kuma/pkg/plugins/resources/memory"
 	"github.com/Kong/kuma/pkg/sds/auth"
@@ -24,11 +28,14 @@ var _ = Describe("Authentication flow", func() {
 	issuer := builtin_issuer.NewDataplaneTokenIssuer(privateKey)
 	var authenticator auth.Authenticator
 	var resStore store.ResourceStore
+	var revocations builtin_issuer.RevocationList
 
 	BeforeEach(func() {
 		resStore = memory.NewStore()
+		revocations = builtin_issuer.NewRevocationList(secret_manager.NewSecretManager(secret_store.NewSecretStore(resStore), cipher.None()))
 		authenticator = universal.NewAuthenticator(
 			issuer,
+			revocations,
 			server.DefaultDataplaneResolver(manager.NewResourceManager(resStore)),
 		)
 	})
@@ -60,7 +67,7 @@ var _ = Describe("Authentication flow", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		// when
-		credential, err := issuer.Generate(id)
+		credential, err := issuer.Generate(id, 0)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
@@ -92,7 +99,7 @@ var _ = Describe("Authentication flow", func() {
 			Mesh: "default",
 			Name: "different-name-than-dp1",
 		}
-		token, err := issuer.Generate(generateId)
+		token, err := issuer.Generate(generateId, 0)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
@@ -114,7 +121,7 @@ var _ = Describe("Authentication flow", func() {
 			Mesh: "different-mesh-than-default",
 			Name: "dp1",
 		}
-		token, err := issuer.Generate(generateId)
+		token, err := issuer.Generate(generateId, 0)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
@@ -138,7 +145,7 @@ var _ = Describe("Authentication flow", func() {
 		}
 
 		// when
-		token, err := issuer.Generate(id)
+		token, err := issuer.Generate(id, 0)
 
 		// then
 		Expect(err).ToNot(HaveOccurred())
@@ -149,4 +156,28 @@ var _ = Describe("Authentication flow", func() {
 		// then
 		Expect(err).To(MatchError(`unable to find Dataplane for proxy {"default" "non-existent-dp"}: Resource not found: type="Dataplane" name="non-existent-dp" mesh="default"`))
 	})
+
+	It("should throw an error on revoked token", func() {
+		// given
+		id := xds.ProxyId{
+			Mesh: "default",
+			Name: "dp1",
+		}
+		credential, err := issuer.Generate(id, 0)
+		Expect(err).ToNot(HaveOccurred())
+		token, err := issuer.Validate(credential)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = revocations.Revoke(token.ProxyId.Mesh, token.Id, token.ExpiresAt)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = authenticator.Authenticate(context.Background(), id, credential)
+
+		// then
+		Expect(err).To(MatchError(fmt.Sprintf("token %s has been revoked", token.Id)))
+	})
 })
@@ -11,15 +11,17 @@ import (
 	builtin_issuer "github.com/Kong/kuma/pkg/tokens/builtin/issuer"
 )
 
-func NewAuthenticator(issuer builtin_issuer.DataplaneTokenIssuer, dataplaneResolver common_auth.DataplaneResolver) sds_auth.Authenticator {
+func NewAuthenticator(issuer builtin_issuer.DataplaneTokenIssuer, revocations builtin_issuer.RevocationList, dataplaneResolver common_auth.DataplaneResolver) sds_auth.Authenticator {
 	return &universalAuthenticator{
 		issuer:            issuer,
+		revocations:       revocations,
 		dataplaneResolver: dataplaneResolver,
 	}
 }
 
 type universalAuthenticator struct {
 	issuer            builtin_issuer.DataplaneTokenIssuer
+	revocations       builtin_issuer.RevocationList
 	dataplaneResolver common_auth.DataplaneResolver
 }
 
@@ -36,10 +38,16 @@ func (u *universalAuthenticator) Authenticate(ctx context.Context, proxyId core_
 }
 
 func (u *universalAuthenticator) reviewToken(expectedId core_xds.ProxyId, credential sds_auth.Credential) error {
-	proxyId, err := u.issuer.Validate(credential)
+	return ReviewToken(u.issuer, u.revocations, expectedId, credential)
+}
+
+// ReviewToken checks that a Dataplane token is valid, has been issued for a given Dataplane and has not been revoked.
+func ReviewToken(issuer builtin_issuer.DataplaneTokenIssuer, revocations builtin_issuer.RevocationList, expectedId core_xds.ProxyId, credential sds_auth.Credential) error {
+	token, err := issuer.Validate(credential)
 	if err != nil {
 		return err
 	}
+	proxyId := token.ProxyId
 
 	if expectedId.Name != proxyId.Name {
 		return errors.Errorf("proxy name from requestor: %s is different than in token: %s", expectedId.Name, proxyId.Name)
@@ -47,5 +55,15 @@ func (u *universalAuthenticator) reviewToken(expectedId core_xds.ProxyId, creden
 	if expectedId.Mesh != proxyId.Mesh {
 		return errors.Errorf("proxy mesh from requestor: %s is different than in token: %s", expectedId.Mesh, proxyId.Mesh)
 	}
+	// tokens issued by older versions of the Control Plane have no id and therefore cannot be revoked
+	if token.Id != "" {
+		revoked, err := revocations.IsRevoked(token)
+		if err != nil {
+			return err
+		}
+		if revoked {
+			return errors.Errorf("token %s has been revoked", token.Id)
+		}
+	}
 	return nil
 }
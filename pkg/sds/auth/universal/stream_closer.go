@@ -0,0 +1,170 @@
+package universal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Kong/kuma/pkg/core"
+	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+	sds_auth "github.com/Kong/kuma/pkg/sds/auth"
+	builtin_issuer "github.com/Kong/kuma/pkg/tokens/builtin/issuer"
+)
+
+var streamCloserLog = core.Log.WithName("sds").WithName("revoked-stream-closer")
+
+// RevokedStreamCloser closes gRPC streams (xDS and SDS) that have been opened with a Dataplane Token
+// which has been revoked or has expired since.
+//
+// Tokens are checked when a stream is opened only, so without it a Dataplane would keep receiving
+// configuration and certificates over streams that are already open.
+// A single closer is meant to be shared by all gRPC servers, so the revocation list is loaded once per check.
+type RevokedStreamCloser struct {
+	issuer      builtin_issuer.DataplaneTokenIssuer
+	revocations builtin_issuer.RevocationList
+	interval    time.Duration
+	now         func() time.Time
+
+	mu      sync.Mutex // protects access to streams
+	streams map[*trackedStream]struct{}
+}
+
+type trackedStream struct {
+	token  builtin_issuer.Token
+	cancel context.CancelFunc
+	// reason is set once the stream is closed
+	reason string
+}
+
+var _ core_runtime.Component = &RevokedStreamCloser{}
+
+func NewRevokedStreamCloser(issuer builtin_issuer.DataplaneTokenIssuer, revocations builtin_issuer.RevocationList, interval time.Duration) *RevokedStreamCloser {
+	return &RevokedStreamCloser{
+		issuer:      issuer,
+		revocations: revocations,
+		interval:    interval,
+		now:         time.Now,
+		streams:     map[*trackedStream]struct{}{},
+	}
+}
+
+// StreamInterceptor tracks streams that have been opened with a valid Dataplane Token and terminates them
+// once the token is revoked or expires. Streams without a valid token are left to authentication.
+func (c *RevokedStreamCloser) StreamInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	credential, err := sds_auth.ExtractCredential(ss.Context())
+	if err != nil || credential == "" {
+		return handler(srv, ss)
+	}
+	token, err := c.issuer.Validate(credential)
+	if err != nil || (token.Id == "" && token.ExpiresAt.IsZero()) {
+		// tokens issued by older versions of the Control Plane neither have an id nor expire
+		return handler(srv, ss)
+	}
+	ctx, cancel := context.WithCancel(ss.Context())
+	defer cancel()
+	stream := &trackedStream{token: token, cancel: cancel}
+	c.mu.Lock()
+	c.streams[stream] = struct{}{}
+	c.mu.Unlock()
+
+	err = handler(srv, &closableServerStream{ServerStream: ss, ctx: ctx})
+
+	c.mu.Lock()
+	delete(c.streams, stream)
+	reason := stream.reason
+	c.mu.Unlock()
+	if reason != "" {
+		return status.Error(codes.Unauthenticated, reason)
+	}
+	return err
+}
+
+func (c *RevokedStreamCloser) Start(stop <-chan struct{}) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.closeRevokedStreams()
+		case <-stop:
+			return nil
+		}
+	}
+}
+
+func (c *RevokedStreamCloser) closeRevokedStreams() {
+	now := c.now()
+	byMesh := map[string][]*trackedStream{}
+	c.mu.Lock()
+	for stream := range c.streams {
+		if !stream.token.ExpiresAt.IsZero() && !now.Before(stream.token.ExpiresAt) {
+			c.close(stream, "token "+stream.token.Id+" has expired")
+			continue
+		}
+		if stream.token.Id != "" {
+			byMesh[stream.token.ProxyId.Mesh] = append(byMesh[stream.token.ProxyId.Mesh], stream)
+		}
+	}
+	c.mu.Unlock()
+
+	for mesh, streams := range byMesh {
+		revoked, err := c.revocations.Revoked(mesh)
+		if err != nil {
+			streamCloserLog.Error(err, "could not check whether tokens have been revoked", "mesh", mesh)
+			continue
+		}
+		c.mu.Lock()
+		for _, stream := range streams {
+			if revoked[stream.token.Id] {
+				c.close(stream, "token "+stream.token.Id+" has been revoked")
+			}
+		}
+		c.mu.Unlock()
+	}
+}
+
+// close cancels a stream that is still open. It must be called with mu held.
+func (c *RevokedStreamCloser) close(stream *trackedStream, reason string) {
+	if _, open := c.streams[stream]; !open || stream.reason != "" {
+		return
+	}
+	streamCloserLog.Info("closing stream", "mesh", stream.token.ProxyId.Mesh, "name", stream.token.ProxyId.Name, "reason", reason)
+	stream.reason = reason
+	stream.cancel()
+}
+
+// closableServerStream is a stream that fails to receive and send messages once its context is cancelled,
+// so handlers that do not watch the context of a stream (e.g. of go-control-plane) return as well.
+type closableServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *closableServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *closableServerStream) SendMsg(m interface{}) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+func (s *closableServerStream) RecvMsg(m interface{}) error {
+	received := make(chan error, 1)
+	go func() {
+		// the underlying stream is finished once the interceptor returns, so the goroutine does not leak
+		received <- s.ServerStream.RecvMsg(m)
+	}()
+	select {
+	case err := <-received:
+		return err
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
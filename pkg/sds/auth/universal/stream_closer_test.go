@@ -0,0 +1,183 @@
+package universal_test
+
+import (
+	"context"
+	"io"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Kong/kuma/pkg/core/secrets/cipher"
+	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
+	secret_store "github.com/Kong/kuma/pkg/core/secrets/store"
+	"github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+	sds_auth "github.com/Kong/kuma/pkg/sds/auth"
+	"github.com/Kong/kuma/pkg/sds/auth/universal"
+	builtin_issuer "github.com/Kong/kuma/pkg/tokens/builtin/issuer"
+)
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	finish chan struct{}
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// RecvMsg blocks like a stream without incoming messages until the client finishes the stream.
+func (s *fakeServerStream) RecvMsg(interface{}) error {
+	<-s.finish
+	return io.EOF
+}
+
+var _ = Describe("RevokedStreamCloser", func() {
+
+	issuer := builtin_issuer.NewDataplaneTokenIssuer([]byte("testPrivateKey"))
+	var revocations builtin_issuer.RevocationList
+	var closer *universal.RevokedStreamCloser
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		revocations = builtin_issuer.NewRevocationList(secret_manager.NewSecretManager(secret_store.NewSecretStore(memory.NewStore()), cipher.None()))
+		closer = universal.NewRevokedStreamCloser(issuer, revocations, 10*time.Millisecond)
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			Expect(closer.Start(stop)).To(Succeed())
+		}()
+	})
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	// openStream starts a long running stream that is finished either by the interceptor or by closing finish channel.
+	// Like handlers of go-control-plane, the handler of the stream does not watch the context of the stream.
+	openStream := func(credential sds_auth.Credential, finish chan struct{}) chan error {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", string(credential)))
+		result := make(chan error, 1)
+		go func() {
+			defer GinkgoRecover()
+			handlerDone := false
+			err := closer.StreamInterceptor(nil, &fakeServerStream{ctx: ctx, finish: finish}, &grpc.StreamServerInfo{}, func(_ interface{}, ss grpc.ServerStream) error {
+				defer func() { handlerDone = true }()
+				if err := ss.RecvMsg(nil); err != io.EOF {
+					return err
+				}
+				return nil
+			})
+			// interceptor must not return before the handler
+			Expect(handlerDone).To(BeTrue())
+			result <- err
+		}()
+		return result
+	}
+
+	It("should close streams opened with a revoked token", func() {
+		// given
+		credential, err := issuer.Generate(xds.ProxyId{Mesh: "default", Name: "dp-1"}, 0)
+		Expect(err).ToNot(HaveOccurred())
+		token, err := issuer.Validate(credential)
+		Expect(err).ToNot(HaveOccurred())
+		finish := make(chan struct{})
+		defer close(finish)
+		result := openStream(credential, finish)
+
+		// when
+		Consistently(result, "50ms").ShouldNot(Receive())
+		err = revocations.Revoke(token.ProxyId.Mesh, token.Id, token.ExpiresAt)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Eventually(result).Should(Receive(&err))
+		Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		Expect(err.Error()).To(ContainSubstring("token " + token.Id + " has been revoked"))
+	})
+
+	It("should close streams opened with a token that has expired", func() {
+		// given
+		credential, err := issuer.Generate(xds.ProxyId{Mesh: "default", Name: "dp-1"}, 2*time.Second)
+		Expect(err).ToNot(HaveOccurred())
+		token, err := issuer.Validate(credential)
+		Expect(err).ToNot(HaveOccurred())
+		finish := make(chan struct{})
+		defer close(finish)
+
+		// when
+		result := openStream(credential, finish)
+
+		// then
+		Eventually(result, "5s").Should(Receive(&err))
+		Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		Expect(err.Error()).To(ContainSubstring("token " + token.Id + " has expired"))
+	})
+
+	It("should not close streams of other meshes with a token of the same id", func() {
+		// given
+		credential, err := issuer.Generate(xds.ProxyId{Mesh: "default", Name: "dp-1"}, 0)
+		Expect(err).ToNot(HaveOccurred())
+		token, err := issuer.Validate(credential)
+		Expect(err).ToNot(HaveOccurred())
+		finish := make(chan struct{})
+		result := openStream(credential, finish)
+
+		// when
+		err = revocations.Revoke("demo", token.Id, token.ExpiresAt)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Consistently(result, "100ms").ShouldNot(Receive())
+
+		// when
+		close(finish)
+
+		// then
+		Eventually(result).Should(Receive(BeNil()))
+	})
+
+	It("should not close streams opened with other tokens", func() {
+		// given
+		revokedCredential, err := issuer.Generate(xds.ProxyId{Mesh: "default", Name: "dp-1"}, 0)
+		Expect(err).ToNot(HaveOccurred())
+		revokedToken, err := issuer.Validate(revokedCredential)
+		Expect(err).ToNot(HaveOccurred())
+		credential, err := issuer.Generate(xds.ProxyId{Mesh: "default", Name: "dp-2"}, 0)
+		Expect(err).ToNot(HaveOccurred())
+		finish := make(chan struct{})
+		result := openStream(credential, finish)
+
+		// when
+		err = revocations.Revoke(revokedToken.ProxyId.Mesh, revokedToken.Id, revokedToken.ExpiresAt)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Consistently(result, "100ms").ShouldNot(Receive())
+
+		// when
+		close(finish)
+
+		// then
+		Eventually(result).Should(Receive(BeNil()))
+	})
+
+	It("should pass through streams without a valid token", func() {
+		// given
+		finish := make(chan struct{})
+		close(finish)
+
+		// when
+		result := openStream("this-is-not-valid-jwt-token", finish)
+
+		// then
+		Eventually(result).Should(Receive(BeNil()))
+	})
+})
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"time"
 
 	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
@@ -29,6 +30,10 @@ const (
 	IdentityCertResource = "identity_cert"
 )
 
+// revokedTokensCheckInterval defines how often streams opened with Dataplane Tokens are checked against the revocation list
+// and expiration time of the tokens.
+const revokedTokensCheckInterval = 5 * time.Second
+
 func NewKubeAuthenticator(rt core_runtime.Runtime) (sds_auth.Authenticator, error) {
 	mgr, ok := k8s_runtime.FromManagerContext(rt.Extensions())
 	if !ok {
@@ -49,7 +54,20 @@ func NewUniversalAuthenticator(rt core_runtime.Runtime) (sds_auth.Authenticator,
 	if err != nil {
 		return nil, err
 	}
-	return universal_sds_auth.NewAuthenticator(issuer, dpResolver), nil
+	return universal_sds_auth.NewAuthenticator(issuer, builtin.NewRevocationList(rt), dpResolver), nil
+}
+
+// DefaultRevokedStreamCloser returns a closer of streams opened with revoked or expired Dataplane Tokens or nil if tokens are not used.
+// Dataplanes on Kubernetes authenticate with Service Account Tokens instead.
+func DefaultRevokedStreamCloser(rt core_runtime.Runtime) (*universal_sds_auth.RevokedStreamCloser, error) {
+	if rt.Config().Environment != config_core.UniversalEnvironment || !rt.Config().DataplaneTokenServer.Enabled {
+		return nil, nil
+	}
+	issuer, err := builtin.NewDataplaneTokenIssuer(rt)
+	if err != nil {
+		return nil, err
+	}
+	return universal_sds_auth.NewRevokedStreamCloser(issuer, builtin.NewRevocationList(rt), revokedTokensCheckInterval), nil
 }
 
 func DefaultAuthenticator(rt core_runtime.Runtime) (sds_auth.Authenticator, error) {
@@ -81,9 +99,13 @@ func DefaultIdentityCertProvider(rt core_runtime.Runtime) sds_provider.SecretPro
 	return identity_sds_provider.New(rt.ResourceManager(), rt.BuiltinCaManager(), rt.ProvidedCaManager())
 }
 
-func DefaultSecretProviderSelector(rt core_runtime.Runtime) func(string) (sds_provider.SecretProvider, error) {
-	meshCaProvider := DefaultMeshCaProvider(rt)
-	identityCertProvider := DefaultIdentityCertProvider(rt)
+func DefaultSecretProviderSelector(rt core_runtime.Runtime) (func(string) (sds_provider.SecretProvider, error), error) {
+	metrics, err := newSecretProviderMetrics(rt.Metrics())
+	if err != nil {
+		return nil, err
+	}
+	meshCaProvider := &meteredSecretProvider{DefaultMeshCaProvider(rt), metrics}
+	identityCertProvider := &meteredSecretProvider{DefaultIdentityCertProvider(rt), metrics}
 	return func(resource string) (sds_provider.SecretProvider, error) {
 		switch resource {
 		case MeshCaResource:
@@ -93,7 +115,7 @@ func DefaultSecretProviderSelector(rt core_runtime.Runtime) func(string) (sds_pr
 		default:
 			return nil, errors.Errorf("SDS request for %q resource is not supported", resource)
 		}
-	}
+	}, nil
 }
 
 func DefaultSecretDiscoveryHandler(rt core_runtime.Runtime) (SecretDiscoveryHandler, error) {
@@ -101,7 +123,10 @@ func DefaultSecretDiscoveryHandler(rt core_runtime.Runtime) (SecretDiscoveryHand
 	if err != nil {
 		return nil, err
 	}
-	secretProviderSelector := DefaultSecretProviderSelector(rt)
+	secretProviderSelector, err := DefaultSecretProviderSelector(rt)
+	if err != nil {
+		return nil, err
+	}
 	return SecretDiscoveryHandlerFunc(func(ctx context.Context, req envoy.DiscoveryRequest) (*envoy_auth.Secret, error) {
 		resource := req.ResourceNames[0]
 		provider, err := secretProviderSelector(resource)
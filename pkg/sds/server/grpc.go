@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/credentials"
 
 	envoy_discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v2"
+	envoy_secret_v3 "github.com/envoyproxy/go-control-plane/envoy/service/secret/v3"
 
 	sds_config "github.com/Kong/kuma/pkg/config/sds"
 	"github.com/Kong/kuma/pkg/core"
@@ -24,6 +25,8 @@ var (
 type grpcServer struct {
 	server Server
 	config sds_config.SdsServerConfig
+	// streamInterceptor is optional
+	streamInterceptor grpc.StreamServerInterceptor
 }
 
 // Make sure that grpcServer implements all relevant interfaces
@@ -42,6 +45,9 @@ func (s *grpcServer) Start(stop <-chan struct{}) error {
 		}
 		grpcOptions = append(grpcOptions, grpc.Creds(creds))
 	}
+	if s.streamInterceptor != nil {
+		grpcOptions = append(grpcOptions, grpc.StreamInterceptor(s.streamInterceptor))
+	}
 	grpcServer := grpc.NewServer(grpcOptions...)
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.config.GrpcPort))
@@ -51,6 +57,7 @@ func (s *grpcServer) Start(stop <-chan struct{}) error {
 
 	// register services
 	envoy_discovery.RegisterSecretDiscoveryServiceServer(grpcServer, s.server)
+	envoy_secret_v3.RegisterSecretDiscoveryServiceServer(grpcServer, NewServerV3(s.server))
 
 	errChan := make(chan error)
 	go func() {
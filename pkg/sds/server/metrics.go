@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/Kong/kuma/pkg/metrics"
+	sds_auth "github.com/Kong/kuma/pkg/sds/auth"
+	sds_provider "github.com/Kong/kuma/pkg/sds/provider"
+)
+
+type secretProviderMetrics struct {
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+}
+
+func newSecretProviderMetrics(metrics metrics.Metrics) (*secretProviderMetrics, error) {
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "sds_cert_generation_duration_seconds",
+		Help: "Duration of issuing certificates served over SDS",
+	}, []string{"resource"})
+	if err := metrics.Register(duration); err != nil {
+		return nil, err
+	}
+	errs := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "sds_cert_generation_errors_total",
+		Help: "Number of failures to issue certificates served over SDS",
+	}, []string{"resource"})
+	if err := metrics.Register(errs); err != nil {
+		return nil, err
+	}
+	return &secretProviderMetrics{
+		duration: duration,
+		errors:   errs,
+	}, nil
+}
+
+// meteredSecretProvider measures the number and latency of issued certificates.
+// The number of issued certificates is exposed as a count of the duration histogram.
+type meteredSecretProvider struct {
+	delegate sds_provider.SecretProvider
+	metrics  *secretProviderMetrics
+}
+
+var _ sds_provider.SecretProvider = &meteredSecretProvider{}
+
+func (p *meteredSecretProvider) RequiresIdentity() bool {
+	return p.delegate.RequiresIdentity()
+}
+
+func (p *meteredSecretProvider) Get(ctx context.Context, name string, requestor sds_auth.Identity) (sds_provider.Secret, error) {
+	start := time.Now()
+	secret, err := p.delegate.Get(ctx, name, requestor)
+	if err != nil {
+		p.metrics.errors.WithLabelValues(name).Inc()
+		return nil, err
+	}
+	p.metrics.duration.WithLabelValues(name).Observe(time.Since(start).Seconds())
+	return secret, nil
+}
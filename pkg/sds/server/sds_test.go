@@ -9,6 +9,9 @@ import (
 
 	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	envoy_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/golang/protobuf/ptypes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -117,6 +120,50 @@ var _ = Describe("Sds", func() {
 		// finally
 		close(done)
 	})
+
+	It("should support SDS fetch requests", func() {
+		// given
+		handler := SecretDiscoveryHandlerFunc(func(ctx context.Context, req envoy.DiscoveryRequest) (*envoy_auth.Secret, error) {
+			return &envoy_auth.Secret{Name: req.ResourceNames[0]}, nil
+		})
+		sds := NewServer(handler, nil, test_logr.NewTestLogger(GinkgoT()))
+
+		// when
+		resp, err := sds.FetchSecrets(context.Background(), &envoy.DiscoveryRequest{
+			ResourceNames: []string{"mesh_ca"},
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.TypeUrl).To(Equal("type.googleapis.com/envoy.api.v2.auth.Secret"))
+		Expect(resp.Resources).To(HaveLen(1))
+		secret := &envoy_auth.Secret{}
+		Expect(ptypes.UnmarshalAny(resp.Resources[0], secret)).To(Succeed())
+		Expect(secret.Name).To(Equal("mesh_ca"))
+	})
+
+	It("should support SDS fetch requests of xDS v3", func() {
+		// given
+		handler := SecretDiscoveryHandlerFunc(func(ctx context.Context, req envoy.DiscoveryRequest) (*envoy_auth.Secret, error) {
+			return &envoy_auth.Secret{Name: req.ResourceNames[0]}, nil
+		})
+		sds := NewServerV3(NewServer(handler, nil, test_logr.NewTestLogger(GinkgoT())))
+
+		// when
+		resp, err := sds.FetchSecrets(context.Background(), &envoy_discovery_v3.DiscoveryRequest{
+			TypeUrl:       "type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.Secret",
+			ResourceNames: []string{"identity_cert"},
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.TypeUrl).To(Equal("type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.Secret"))
+		Expect(resp.Resources).To(HaveLen(1))
+		Expect(resp.Resources[0].TypeUrl).To(Equal("type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.Secret"))
+		secret := &envoy_tls_v3.Secret{}
+		Expect(ptypes.UnmarshalAny(resp.Resources[0], secret)).To(Succeed())
+		Expect(secret.Name).To(Equal("identity_cert"))
+	})
 })
 
 func newMockStream() *mockStream {
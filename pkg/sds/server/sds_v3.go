@@ -0,0 +1,42 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	envoy_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	envoy_secret_v3 "github.com/envoyproxy/go-control-plane/envoy/service/secret/v3"
+	envoy_resource_v3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+
+	util_xds_v3 "github.com/Kong/kuma/pkg/util/xds/v3"
+)
+
+// NewServerV3 exposes a given SDS server over Envoy xDS v3 transport.
+func NewServerV3(server Server) envoy_secret_v3.SecretDiscoveryServiceServer {
+	return &serverV3{server: server}
+}
+
+type serverV3 struct {
+	server Server
+}
+
+func (s *serverV3) StreamSecrets(stream envoy_secret_v3.SecretDiscoveryService_StreamSecretsServer) error {
+	return s.server.StreamSecrets(util_xds_v3.AdaptStream(stream, envoy_resource_v3.SecretType))
+}
+
+func (s *serverV3) FetchSecrets(ctx context.Context, reqV3 *envoy_discovery_v3.DiscoveryRequest) (*envoy_discovery_v3.DiscoveryResponse, error) {
+	req, isV3, err := util_xds_v3.DowngradeRequest(reqV3, envoy_resource_v3.SecretType)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.server.FetchSecrets(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return util_xds_v3.UpgradeResponse(resp, isV3)
+}
+
+func (s *serverV3) DeltaSecrets(_ envoy_secret_v3.SecretDiscoveryService_DeltaSecretsServer) error {
+	return errors.New("not implemented")
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"github.com/Kong/kuma/pkg/core"
 	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+	universal_sds_auth "github.com/Kong/kuma/pkg/sds/auth/universal"
 	util_xds "github.com/Kong/kuma/pkg/util/xds"
 )
 
@@ -10,7 +11,8 @@ var (
 	sdsServerLog = core.Log.WithName("sds-server")
 )
 
-func SetupServer(rt core_runtime.Runtime) error {
+// SetupServer sets up SDS server. Stream closer is optional and is shared with xDS server.
+func SetupServer(rt core_runtime.Runtime, streamCloser *universal_sds_auth.RevokedStreamCloser) error {
 	handler, err := DefaultSecretDiscoveryHandler(rt)
 	if err != nil {
 		return err
@@ -19,8 +21,22 @@ func SetupServer(rt core_runtime.Runtime) error {
 		util_xds.LoggingCallbacks{Log: sdsServerLog},
 	}
 	srv := NewServer(handler, callbacks, sdsServerLog)
-	return core_runtime.Add(
-		rt,
-		&grpcServer{srv, *rt.Config().SdsServer},
-	)
+	server := &grpcServer{server: srv, config: *rt.Config().SdsServer}
+	if streamCloser != nil {
+		server.streamInterceptor = streamCloser.StreamInterceptor
+	}
+	return core_runtime.Add(rt, server)
+}
+
+// SetupRevokedStreamCloser sets up a closer of streams opened with revoked or expired Dataplane Tokens
+// that is shared by SDS and xDS servers. It returns nil if tokens are not used.
+func SetupRevokedStreamCloser(rt core_runtime.Runtime) (*universal_sds_auth.RevokedStreamCloser, error) {
+	streamCloser, err := DefaultRevokedStreamCloser(rt)
+	if err != nil || streamCloser == nil {
+		return nil, err
+	}
+	if err := rt.Add(streamCloser); err != nil {
+		return nil, err
+	}
+	return streamCloser, nil
 }
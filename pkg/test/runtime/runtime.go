@@ -15,6 +15,7 @@ import (
 
 	kuma_cp "github.com/Kong/kuma/pkg/config/app/kuma-cp"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/metrics"
 	bootstrap_universal "github.com/Kong/kuma/pkg/plugins/bootstrap/universal"
 	resources_memory "github.com/Kong/kuma/pkg/plugins/resources/memory"
 )
@@ -29,8 +30,13 @@ func (i TestRuntimeInfo) GetInstanceId() string {
 	return i.InstanceId
 }
 
-func BuilderFor(cfg kuma_cp.Config) *core_runtime.Builder {
+func BuilderFor(cfg kuma_cp.Config) (*core_runtime.Builder, error) {
+	metrics, err := metrics.NewMetrics()
+	if err != nil {
+		return nil, err
+	}
 	builder := core_runtime.BuilderFor(cfg).
+		WithMetrics(metrics).
 		WithComponentManager(bootstrap_universal.NewComponentManager()).
 		WithResourceStore(resources_memory.NewStore()).
 		WithXdsContext(core_xds.NewXdsContext())
@@ -43,7 +49,7 @@ func BuilderFor(cfg kuma_cp.Config) *core_runtime.Builder {
 	builder.WithResourceManager(rm).
 		WithReadOnlyResourceManager(rm)
 
-	return builder
+	return builder, nil
 }
 
 func newSecretManager(builder *core_runtime.Builder) secret_manager.SecretManager {
@@ -6,9 +6,12 @@ import (
 )
 
 func NewDataplaneTokenIssuer(rt runtime.Runtime) (issuer.DataplaneTokenIssuer, error) {
-	key, err := issuer.GetSigningKey(rt.SecretManager())
-	if err != nil {
-		return nil, err
-	}
-	return issuer.NewDataplaneTokenIssuer(key), nil
+	// signing key is created by a leader once the Control Plane is started
+	return issuer.NewLazyDataplaneTokenIssuer(func() ([]byte, error) {
+		return issuer.GetSigningKey(rt.SecretManager())
+	}), nil
+}
+
+func NewRevocationList(rt runtime.Runtime) issuer.RevocationList {
+	return issuer.NewRevocationList(rt.SecretManager())
 }
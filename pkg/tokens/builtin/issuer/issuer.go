@@ -1,6 +1,10 @@
 package issuer
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
 	"github.com/dgrijalva/jwt-go"
 	"github.com/pkg/errors"
 
@@ -9,8 +13,19 @@ import (
 )
 
 type DataplaneTokenIssuer interface {
-	Generate(proxyId xds.ProxyId) (auth.Credential, error)
-	Validate(credential auth.Credential) (xds.ProxyId, error)
+	// Generate issues a token for a given Dataplane. The token never expires if validFor is 0.
+	Generate(proxyId xds.ProxyId, validFor time.Duration) (auth.Credential, error)
+	Validate(credential auth.Credential) (Token, error)
+}
+
+// Token is a Dataplane Token that has been successfully validated.
+type Token struct {
+	ProxyId xds.ProxyId
+	// Id uniquely identifies a token so it can be revoked.
+	// It is empty for tokens issued by older versions of the Control Plane.
+	Id string
+	// ExpiresAt is the expiration time of a token. It is zero if a token never expires.
+	ExpiresAt time.Time
 }
 
 type claims struct {
@@ -29,11 +44,25 @@ type jwtTokenIssuer struct {
 	privateKey []byte
 }
 
-func (i *jwtTokenIssuer) Generate(proxyId xds.ProxyId) (auth.Credential, error) {
+func (i *jwtTokenIssuer) Generate(proxyId xds.ProxyId, validFor time.Duration) (auth.Credential, error) {
+	if validFor < 0 {
+		return "", errors.New("validity period of a token cannot be negative")
+	}
+	id, err := newTokenId()
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
 	c := claims{
-		Name:           proxyId.Name,
-		Mesh:           proxyId.Mesh,
-		StandardClaims: jwt.StandardClaims{},
+		Name: proxyId.Name,
+		Mesh: proxyId.Mesh,
+		StandardClaims: jwt.StandardClaims{
+			Id:       id,
+			IssuedAt: now.Unix(),
+		},
+	}
+	if validFor > 0 {
+		c.ExpiresAt = now.Add(validFor).Unix()
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, c)
@@ -44,22 +73,37 @@ func (i *jwtTokenIssuer) Generate(proxyId xds.ProxyId) (auth.Credential, error)
 	return auth.Credential(tokenString), nil
 }
 
-func (i *jwtTokenIssuer) Validate(credential auth.Credential) (xds.ProxyId, error) {
+func (i *jwtTokenIssuer) Validate(credential auth.Credential) (Token, error) {
 	c := &claims{}
 
+	// expiration time is verified as part of parsing
 	token, err := jwt.ParseWithClaims(string(credential), c, func(*jwt.Token) (interface{}, error) {
 		return i.privateKey, nil
 	})
 	if err != nil {
-		return xds.ProxyId{}, errors.Wrap(err, "could not parse token")
+		return Token{}, errors.Wrap(err, "could not parse token")
 	}
 	if !token.Valid {
-		return xds.ProxyId{}, errors.New("token is not valid")
+		return Token{}, errors.New("token is not valid")
+	}
+
+	result := Token{
+		ProxyId: xds.ProxyId{
+			Mesh: c.Mesh,
+			Name: c.Name,
+		},
+		Id: c.Id,
 	}
+	if c.ExpiresAt != 0 {
+		result.ExpiresAt = time.Unix(c.ExpiresAt, 0)
+	}
+	return result, nil
+}
 
-	id := xds.ProxyId{
-		Mesh: c.Mesh,
-		Name: c.Name,
+func newTokenId() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", errors.Wrap(err, "could not generate token id")
 	}
-	return id, nil
+	return hex.EncodeToString(id), nil
 }
@@ -0,0 +1,104 @@
+package issuer_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/tokens/builtin/issuer"
+)
+
+var _ = Describe("DataplaneTokenIssuer", func() {
+
+	tokenIssuer := issuer.NewDataplaneTokenIssuer([]byte("signing-key"))
+	id := xds.ProxyId{
+		Mesh: "default",
+		Name: "dp-1",
+	}
+
+	It("should issue a token that never expires", func() {
+		// when
+		credential, err := tokenIssuer.Generate(id, 0)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		token, err := tokenIssuer.Validate(credential)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token.ProxyId).To(Equal(id))
+		Expect(token.Id).ToNot(BeEmpty())
+		Expect(token.ExpiresAt.IsZero()).To(BeTrue())
+	})
+
+	It("should issue tokens with unique ids", func() {
+		// when
+		credential1, err := tokenIssuer.Generate(id, 0)
+		Expect(err).ToNot(HaveOccurred())
+		credential2, err := tokenIssuer.Generate(id, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		token1, err := tokenIssuer.Validate(credential1)
+		Expect(err).ToNot(HaveOccurred())
+		token2, err := tokenIssuer.Validate(credential2)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(token1.Id).ToNot(Equal(token2.Id))
+	})
+
+	It("should accept a token that has not expired yet", func() {
+		// given
+		credential, err := tokenIssuer.Generate(id, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		token, err := tokenIssuer.Validate(credential)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(token.ProxyId).To(Equal(id))
+		Expect(token.ExpiresAt).To(BeTemporally("~", time.Now().Add(time.Hour), 5*time.Second))
+	})
+
+	It("should reject an expired token", func() {
+		// given
+		credential, err := tokenIssuer.Generate(id, time.Second)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		Eventually(func() error {
+			_, err := tokenIssuer.Validate(credential)
+			return err
+		}, "5s", "100ms").Should(HaveOccurred())
+
+		// then
+		_, err = tokenIssuer.Validate(credential)
+		Expect(err.Error()).To(HavePrefix("could not parse token: token is expired"))
+	})
+
+	It("should reject a token signed with a different key", func() {
+		// given
+		credential, err := issuer.NewDataplaneTokenIssuer([]byte("different-key")).Generate(id, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = tokenIssuer.Validate(credential)
+
+		// then
+		Expect(err).To(MatchError("could not parse token: signature is invalid"))
+	})
+
+	It("should refuse to issue a token with negative validity period", func() {
+		// when
+		_, err := tokenIssuer.Generate(id, -time.Hour)
+
+		// then
+		Expect(err).To(MatchError("validity period of a token cannot be negative"))
+	})
+})
@@ -0,0 +1,55 @@
+package issuer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/sds/auth"
+)
+
+// NewLazyDataplaneTokenIssuer returns a DataplaneTokenIssuer that retrieves the signing key on first use.
+// It is needed since the default signing key is created only once the Control Plane is started.
+func NewLazyDataplaneTokenIssuer(signingKey func() ([]byte, error)) DataplaneTokenIssuer {
+	return &lazyDataplaneTokenIssuer{
+		signingKey: signingKey,
+	}
+}
+
+var _ DataplaneTokenIssuer = &lazyDataplaneTokenIssuer{}
+
+type lazyDataplaneTokenIssuer struct {
+	signingKey func() ([]byte, error)
+
+	mu       sync.Mutex // protects access to delegate
+	delegate DataplaneTokenIssuer
+}
+
+func (i *lazyDataplaneTokenIssuer) Generate(proxyId xds.ProxyId, validFor time.Duration) (auth.Credential, error) {
+	delegate, err := i.getDelegate()
+	if err != nil {
+		return "", err
+	}
+	return delegate.Generate(proxyId, validFor)
+}
+
+func (i *lazyDataplaneTokenIssuer) Validate(credential auth.Credential) (Token, error) {
+	delegate, err := i.getDelegate()
+	if err != nil {
+		return Token{}, err
+	}
+	return delegate.Validate(credential)
+}
+
+func (i *lazyDataplaneTokenIssuer) getDelegate() (DataplaneTokenIssuer, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.delegate == nil {
+		key, err := i.signingKey()
+		if err != nil {
+			return nil, err
+		}
+		i.delegate = NewDataplaneTokenIssuer(key)
+	}
+	return i.delegate, nil
+}
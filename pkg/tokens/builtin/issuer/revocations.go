@@ -0,0 +1,173 @@
+package issuer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/pkg/core/resources/apis/system"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	core_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
+)
+
+// revocationsSecretName is the name of a secret that keeps revoked tokens of Dataplanes in the mesh of the secret.
+const revocationsSecretName = "dataplane-token-revocations"
+
+// maxRevokeAttempts is how many times revocation is retried when the list is modified concurrently.
+const maxRevokeAttempts = 5
+
+// RevocationList keeps track of Dataplane Tokens that must no longer be accepted even though they are not expired.
+type RevocationList interface {
+	// IsRevoked returns true if a given token has been revoked.
+	IsRevoked(token Token) (bool, error)
+	// Revoked returns ids of revoked tokens that have been issued for Dataplanes of a given mesh.
+	Revoked(mesh string) (map[string]bool, error)
+	// Revoke makes a token with a given id that has been issued for a Dataplane of a given mesh rejected from now on.
+	// Revocation is forgotten once the token expires. Zero expiration time means that the token never expires.
+	Revoke(mesh string, tokenId string, expiresAt time.Time) error
+}
+
+// NewRevocationList returns a RevocationList stored as a secret in every mesh with one revoked token per line.
+func NewRevocationList(manager core_manager.SecretManager) RevocationList {
+	return &secretRevocationList{
+		manager: manager,
+		now:     time.Now,
+	}
+}
+
+var _ RevocationList = &secretRevocationList{}
+
+type secretRevocationList struct {
+	manager core_manager.SecretManager
+	now     func() time.Time
+}
+
+// revocation is a revoked token that is kept until it expires.
+type revocation struct {
+	tokenId string
+	// expiresAt is zero for tokens that never expire
+	expiresAt time.Time
+}
+
+func (r *secretRevocationList) IsRevoked(token Token) (bool, error) {
+	revoked, err := r.Revoked(token.ProxyId.Mesh)
+	if err != nil {
+		return false, err
+	}
+	return revoked[token.Id], nil
+}
+
+func (r *secretRevocationList) Revoked(mesh string) (map[string]bool, error) {
+	resource := system.SecretResource{}
+	if err := r.manager.Get(context.Background(), &resource, store.GetBy(revocationsResourceKey(mesh))); err != nil {
+		if store.IsResourceNotFound(err) {
+			return map[string]bool{}, nil
+		}
+		return nil, errors.Wrapf(err, "could not retrieve revoked dataplane tokens of mesh %q", mesh)
+	}
+	revoked := map[string]bool{}
+	for _, rev := range parseRevocations(resource.Spec.Value) {
+		revoked[rev.tokenId] = true
+	}
+	return revoked, nil
+}
+
+func (r *secretRevocationList) Revoke(mesh string, tokenId string, expiresAt time.Time) error {
+	if mesh == "" {
+		return errors.New("mesh cannot be empty")
+	}
+	if tokenId == "" {
+		return errors.New("token id cannot be empty")
+	}
+	key := revocationsResourceKey(mesh)
+	ctx := context.Background()
+	var err error
+	for i := 0; i < maxRevokeAttempts; i++ {
+		resource := system.SecretResource{}
+		err = r.manager.Get(ctx, &resource, store.GetBy(key))
+		switch {
+		case store.IsResourceNotFound(err):
+			resource.Spec = wrappers.BytesValue{Value: formatRevocations([]revocation{{tokenId: tokenId, expiresAt: expiresAt}})}
+			err = r.manager.Create(ctx, &resource, store.CreateBy(key))
+		case err != nil:
+			return errors.Wrapf(err, "could not retrieve revoked dataplane tokens of mesh %q", mesh)
+		default:
+			revoked := r.withoutExpired(parseRevocations(resource.Spec.Value))
+			alreadyRevoked := false
+			for _, rev := range revoked {
+				if rev.tokenId == tokenId {
+					alreadyRevoked = true
+					break
+				}
+			}
+			if !alreadyRevoked {
+				revoked = append(revoked, revocation{tokenId: tokenId, expiresAt: expiresAt})
+			}
+			resource.Spec = wrappers.BytesValue{Value: formatRevocations(revoked)}
+			err = r.manager.Update(ctx, &resource)
+		}
+		if err == nil {
+			return nil
+		}
+		if !store.IsResourceConflict(err) && !store.IsResourceAlreadyExists(err) {
+			break
+		}
+	}
+	return errors.Wrap(err, "could not store revoked dataplane token")
+}
+
+// withoutExpired drops revocations of tokens that have expired since, because they are rejected anyway.
+func (r *secretRevocationList) withoutExpired(revoked []revocation) []revocation {
+	now := r.now()
+	var valid []revocation
+	for _, rev := range revoked {
+		if rev.expiresAt.IsZero() || now.Before(rev.expiresAt) {
+			valid = append(valid, rev)
+		}
+	}
+	return valid
+}
+
+func revocationsResourceKey(mesh string) model.ResourceKey {
+	return model.ResourceKey{
+		Mesh: mesh,
+		Name: revocationsSecretName,
+	}
+}
+
+// parseRevocations parses lines of "<token id> [<expiration time in Unix seconds>]".
+func parseRevocations(value []byte) []revocation {
+	var revoked []revocation
+	for _, line := range strings.Split(string(value), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		rev := revocation{tokenId: fields[0]}
+		if len(fields) > 1 {
+			if exp, err := strconv.ParseInt(fields[1], 10, 64); err == nil {
+				rev.expiresAt = time.Unix(exp, 0)
+			}
+		}
+		revoked = append(revoked, rev)
+	}
+	return revoked
+}
+
+func formatRevocations(revoked []revocation) []byte {
+	var sb strings.Builder
+	for _, rev := range revoked {
+		sb.WriteString(rev.tokenId)
+		if !rev.expiresAt.IsZero() {
+			sb.WriteString(fmt.Sprintf(" %d", rev.expiresAt.Unix()))
+		}
+		sb.WriteString("\n")
+	}
+	return []byte(sb.String())
+}
@@ -0,0 +1,111 @@
+package issuer_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/pkg/core/resources/apis/system"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	"github.com/Kong/kuma/pkg/core/secrets/cipher"
+	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
+	secret_store "github.com/Kong/kuma/pkg/core/secrets/store"
+	"github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+	"github.com/Kong/kuma/pkg/tokens/builtin/issuer"
+)
+
+var _ = Describe("RevocationList", func() {
+
+	var manager secret_manager.SecretManager
+	var revocations issuer.RevocationList
+
+	BeforeEach(func() {
+		manager = secret_manager.NewSecretManager(secret_store.NewSecretStore(memory.NewStore()), cipher.None())
+		revocations = issuer.NewRevocationList(manager)
+	})
+
+	token := func(mesh string, id string) issuer.Token {
+		return issuer.Token{
+			ProxyId: xds.ProxyId{Mesh: mesh, Name: "dp-1"},
+			Id:      id,
+		}
+	}
+
+	It("should not consider any token revoked when nothing has been revoked yet", func() {
+		// when
+		revoked, err := revocations.IsRevoked(token("default", "token-1"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeFalse())
+	})
+
+	It("should revoke tokens", func() {
+		// given
+		expiresAt := time.Unix(time.Now().Add(time.Hour).Unix(), 0)
+
+		// when
+		Expect(revocations.Revoke("default", "token-1", time.Time{})).To(Succeed())
+		Expect(revocations.Revoke("default", "token-2", expiresAt)).To(Succeed())
+		// and revoking the same token twice is not an error
+		Expect(revocations.Revoke("default", "token-1", time.Time{})).To(Succeed())
+
+		// then
+		for _, id := range []string{"token-1", "token-2"} {
+			revoked, err := revocations.IsRevoked(token("default", id))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeTrue())
+		}
+		// and
+		revoked, err := revocations.IsRevoked(token("default", "token-3"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeFalse())
+
+		// and revocation list is stored as a secret
+		secret := system.SecretResource{}
+		err = manager.Get(context.Background(), &secret, store.GetBy(model.ResourceKey{Mesh: "default", Name: "dataplane-token-revocations"}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(secret.Spec.Value)).To(Equal(fmt.Sprintf("token-1\ntoken-2 %d\n", expiresAt.Unix())))
+	})
+
+	It("should keep revoked tokens of every mesh separately", func() {
+		// when
+		Expect(revocations.Revoke("demo", "token-1", time.Time{})).To(Succeed())
+
+		// then
+		revoked, err := revocations.IsRevoked(token("demo", "token-1"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeTrue())
+		// and
+		revoked, err = revocations.IsRevoked(token("default", "token-1"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeFalse())
+		// and
+		Expect(revocations.Revoked("demo")).To(Equal(map[string]bool{"token-1": true}))
+		Expect(revocations.Revoked("default")).To(BeEmpty())
+	})
+
+	It("should forget revoked tokens that have expired", func() {
+		// given
+		Expect(revocations.Revoke("default", "token-1", time.Now().Add(-time.Minute))).To(Succeed())
+
+		// when
+		Expect(revocations.Revoke("default", "token-2", time.Time{})).To(Succeed())
+
+		// then
+		Expect(revocations.Revoked("default")).To(Equal(map[string]bool{"token-2": true}))
+	})
+
+	It("should not revoke a token without id", func() {
+		// when
+		err := revocations.Revoke("default", "", time.Time{})
+
+		// then
+		Expect(err).To(MatchError("token id cannot be empty"))
+	})
+})
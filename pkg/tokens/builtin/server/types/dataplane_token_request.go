@@ -5,6 +5,9 @@ import "github.com/Kong/kuma/pkg/core/xds"
 type DataplaneTokenRequest struct {
 	Name string `json:"name"`
 	Mesh string `json:"mesh"`
+	// ValidFor is a validity period of a token in the format of Go duration, e.g. "24h".
+	// Token never expires if it is empty.
+	ValidFor string `json:"validFor,omitempty"`
 }
 
 func (i DataplaneTokenRequest) ToProxyId() xds.ProxyId {
@@ -13,3 +16,13 @@ func (i DataplaneTokenRequest) ToProxyId() xds.ProxyId {
 		Name: i.Name,
 	}
 }
+
+// DataplaneTokenRevocationRequest identifies a token to revoke either by the token itself
+// or by its id (jti claim) and the mesh of a Dataplane it has been issued for.
+type DataplaneTokenRevocationRequest struct {
+	Token string `json:"token,omitempty"`
+	// Id can be used when the token is not available, e.g. because it has been lost.
+	// Since expiration time of the token is not known then, its revocation is kept forever.
+	Id   string `json:"id,omitempty"`
+	Mesh string `json:"mesh,omitempty"`
+}
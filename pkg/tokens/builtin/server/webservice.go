@@ -2,12 +2,15 @@ package server
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/emicklei/go-restful"
 
 	"github.com/Kong/kuma/pkg/core"
 	"github.com/Kong/kuma/pkg/core/rest/errors"
 	"github.com/Kong/kuma/pkg/core/validators"
+	"github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/sds/auth"
 	"github.com/Kong/kuma/pkg/tokens/builtin/issuer"
 	"github.com/Kong/kuma/pkg/tokens/builtin/server/types"
 )
@@ -15,12 +18,14 @@ import (
 var log = core.Log.WithName("dataplane-token-ws")
 
 type dataplaneTokenWebService struct {
-	issuer issuer.DataplaneTokenIssuer
+	issuer      issuer.DataplaneTokenIssuer
+	revocations issuer.RevocationList
 }
 
-func NewWebservice(issuer issuer.DataplaneTokenIssuer) *restful.WebService {
+func NewWebservice(issuer issuer.DataplaneTokenIssuer, revocations issuer.RevocationList) *restful.WebService {
 	ws := dataplaneTokenWebService{
-		issuer: issuer,
+		issuer:      issuer,
+		revocations: revocations,
 	}
 	return ws.createWs()
 }
@@ -30,7 +35,8 @@ func (d *dataplaneTokenWebService) createWs() *restful.WebService {
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON)
 	ws.Path("/tokens").
-		Route(ws.POST("").To(d.handleIdentityRequest))
+		Route(ws.POST("").To(d.handleIdentityRequest)).
+		Route(ws.POST("/revocations").To(d.handleRevocationRequest))
 	return ws
 }
 
@@ -48,12 +54,22 @@ func (d *dataplaneTokenWebService) handleIdentityRequest(request *restful.Reques
 	if idReq.Mesh == "" {
 		verr.AddViolation("mesh", "cannot be empty")
 	}
+	var validFor time.Duration
+	if idReq.ValidFor != "" {
+		var err error
+		validFor, err = time.ParseDuration(idReq.ValidFor)
+		if err != nil {
+			verr.AddViolation("validFor", "must be a valid duration, e.g. 24h")
+		} else if validFor <= 0 {
+			verr.AddViolation("validFor", "must be positive")
+		}
+	}
 	if verr.HasViolations() {
 		errors.HandleError(response, verr.OrNil(), "Invalid request")
 		return
 	}
 
-	token, err := d.issuer.Generate(idReq.ToProxyId())
+	token, err := d.issuer.Generate(idReq.ToProxyId(), validFor)
 	if err != nil {
 		errors.HandleError(response, err, "Could not issue a token")
 		return
@@ -64,3 +80,47 @@ func (d *dataplaneTokenWebService) handleIdentityRequest(request *restful.Reques
 		log.Error(err, "Could write a response")
 	}
 }
+
+func (d *dataplaneTokenWebService) handleRevocationRequest(request *restful.Request, response *restful.Response) {
+	revReq := types.DataplaneTokenRevocationRequest{}
+	if err := request.ReadEntity(&revReq); err != nil {
+		log.Error(err, "Could not read a request")
+		response.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	verr := validators.ValidationError{}
+	var revoked issuer.Token
+	switch {
+	case revReq.Token != "" && (revReq.Id != "" || revReq.Mesh != ""):
+		verr.AddViolation("token", "cannot be used together with id and mesh")
+	case revReq.Token != "":
+		token, err := d.issuer.Validate(auth.Credential(revReq.Token))
+		switch {
+		case err != nil:
+			verr.AddViolation("token", err.Error())
+		case token.Id == "":
+			verr.AddViolation("token", "token has been issued without an id and cannot be revoked")
+		default:
+			revoked = token
+		}
+	case revReq.Id != "" || revReq.Mesh != "":
+		if revReq.Id == "" {
+			verr.AddViolation("id", "cannot be empty")
+		}
+		if revReq.Mesh == "" {
+			verr.AddViolation("mesh", "cannot be empty")
+		}
+		revoked = issuer.Token{ProxyId: xds.ProxyId{Mesh: revReq.Mesh}, Id: revReq.Id}
+	default:
+		verr.AddViolation("token", "either token or id and mesh must be provided")
+	}
+	if verr.HasViolations() {
+		errors.HandleError(response, verr.OrNil(), "Invalid request")
+		return
+	}
+	if err := d.revocations.Revoke(revoked.ProxyId.Mesh, revoked.Id, revoked.ExpiresAt); err != nil {
+		errors.HandleError(response, err, "Could not revoke a token")
+		return
+	}
+	response.WriteHeader(http.StatusOK)
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"time"
 
 	"github.com/emicklei/go-restful"
 	. "github.com/onsi/ginkgo"
@@ -23,26 +24,67 @@ import (
 )
 
 type staticTokenIssuer struct {
-	resp string
+	resp     string
+	validFor time.Duration
 }
 
 var _ issuer.DataplaneTokenIssuer = &staticTokenIssuer{}
 
-func (s *staticTokenIssuer) Generate(proxyId xds.ProxyId) (auth.Credential, error) {
+func (s *staticTokenIssuer) Generate(proxyId xds.ProxyId, validFor time.Duration) (auth.Credential, error) {
+	s.validFor = validFor
 	return auth.Credential(s.resp), nil
 }
 
-func (s *staticTokenIssuer) Validate(credential auth.Credential) (xds.ProxyId, error) {
-	return xds.ProxyId{}, errors.New("not implemented")
+func (s *staticTokenIssuer) Validate(credential auth.Credential) (issuer.Token, error) {
+	switch credential {
+	case "token-with-id":
+		return issuer.Token{ProxyId: xds.ProxyId{Mesh: "demo", Name: "dp-1"}, Id: "token-id"}, nil
+	case "token-without-id":
+		return issuer.Token{}, nil
+	default:
+		return issuer.Token{}, errors.New("could not parse token")
+	}
+}
+
+type staticRevocationList struct {
+	revoked []string
+}
+
+var _ issuer.RevocationList = &staticRevocationList{}
+
+func (s *staticRevocationList) IsRevoked(issuer.Token) (bool, error) {
+	return false, errors.New("not implemented")
+}
+
+func (s *staticRevocationList) Revoked(string) (map[string]bool, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (s *staticRevocationList) Revoke(mesh string, tokenId string, _ time.Time) error {
+	s.revoked = append(s.revoked, mesh+"/"+tokenId)
+	return nil
 }
 
 var _ = Describe("Dataplane Token Webservice", func() {
 
 	const credentials = "test"
 	var url string
+	var tokenIssuer *staticTokenIssuer
+	var revocations *staticRevocationList
+
+	post := func(path string, json string) *http.Response {
+		req, err := http.NewRequest("POST", fmt.Sprintf("%s%s", url, path), strings.NewReader(json))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Add("content-type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		return resp
+	}
 
 	BeforeEach(func() {
-		ws := server.NewWebservice(&staticTokenIssuer{credentials})
+		tokenIssuer = &staticTokenIssuer{resp: credentials}
+		revocations = &staticRevocationList{}
+		ws := server.NewWebservice(tokenIssuer, revocations)
 
 		container := restful.NewContainer()
 		container.Add(ws)
@@ -99,6 +141,53 @@ var _ = Describe("Dataplane Token Webservice", func() {
 		},
 		Entry("json does not contain name", `{"mesh": "default"}`),
 		Entry("json does not contain mesh", `{"name": "default"}`),
+		Entry("validFor is not a valid duration", `{"name": "dp-1", "mesh": "default", "validFor": "1 day"}`),
+		Entry("validFor is not positive", `{"name": "dp-1", "mesh": "default", "validFor": "-1h"}`),
+		Entry("not valid json", `not-valid-json`),
+	)
+
+	It("should generate token with expiration time", func() {
+		// when
+		resp := post("/tokens", `{"name": "dp-1", "mesh": "default", "validFor": "24h"}`)
+
+		// then
+		Expect(resp.StatusCode).To(Equal(200))
+		Expect(tokenIssuer.validFor).To(Equal(24 * time.Hour))
+	})
+
+	It("should revoke token", func() {
+		// when
+		resp := post("/tokens/revocations", `{"token": "token-with-id"}`)
+
+		// then
+		Expect(resp.StatusCode).To(Equal(200))
+		Expect(revocations.revoked).To(Equal([]string{"demo/token-id"}))
+	})
+
+	It("should revoke token by id", func() {
+		// when
+		resp := post("/tokens/revocations", `{"id": "token-id", "mesh": "demo"}`)
+
+		// then
+		Expect(resp.StatusCode).To(Equal(200))
+		Expect(revocations.revoked).To(Equal([]string{"demo/token-id"}))
+	})
+
+	DescribeTable("should not revoke invalid token",
+		func(json string) {
+			// when
+			resp := post("/tokens/revocations", json)
+
+			// then
+			Expect(resp.StatusCode).To(Equal(400))
+			Expect(revocations.revoked).To(BeEmpty())
+		},
+		Entry("json does not contain token", `{}`),
+		Entry("token is not valid", `{"token": "not-valid-token"}`),
+		Entry("token has no id", `{"token": "token-without-id"}`),
+		Entry("id without mesh", `{"id": "token-id"}`),
+		Entry("mesh without id", `{"mesh": "demo"}`),
+		Entry("token together with id", `{"token": "token-with-id", "id": "token-id", "mesh": "demo"}`),
 		Entry("not valid json", `not-valid-json`),
 	)
 })
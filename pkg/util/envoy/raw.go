@@ -4,7 +4,7 @@ import (
 	"bytes"
 	"errors"
 
-	"github.com/envoyproxy/go-control-plane/pkg/cache"
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	"github.com/ghodss/yaml"
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
@@ -26,7 +26,7 @@ func ResourceFromYaml(resYaml string) (proto.Message, error) {
 	if err := ptypes.UnmarshalAny(&anything, &dyn); err != nil {
 		return nil, err
 	}
-	p, ok := dyn.Message.(cache.Resource)
+	p, ok := dyn.Message.(envoy_types.Resource)
 	if !ok {
 		return nil, errors.New("xDS resource doesn't implement all required interfaces")
 	}
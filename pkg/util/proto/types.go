@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
 	tspb "github.com/golang/protobuf/ptypes/timestamp"
 )
 
@@ -27,6 +29,14 @@ func MustTimestampFromProto(ts *tspb.Timestamp) *time.Time {
 	return &t
 }
 
+func MustMarshalAny(pb proto.Message) *any.Any {
+	msg, err := ptypes.MarshalAny(pb)
+	if err != nil {
+		panic(err.Error())
+	}
+	return msg
+}
+
 func TimestampString(ts *tspb.Timestamp, layout string) string {
 	t, err := ptypes.Timestamp(ts)
 	if err != nil {
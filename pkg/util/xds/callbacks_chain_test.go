@@ -10,7 +10,7 @@ import (
 	. "github.com/Kong/kuma/pkg/util/xds"
 
 	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
-	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server"
+	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server/v2"
 )
 
 var _ = Describe("CallbacksChain", func() {
@@ -61,12 +61,16 @@ var _ = Describe("CallbacksChain", func() {
 	})
 
 	Describe("OnStreamOpen", func() {
-		It("should be called sequentially and aggregate errors", func() {
+		It("should be called sequentially", func() {
 			// given
 			ctx := context.Background()
 			streamID := int64(1)
 			typ := "xDS"
 			// setup
+			first.OnStreamOpenFunc = func(ctx context.Context, streamID int64, typ string) error {
+				calls = append(calls, methodCall{"1st", "OnStreamOpen()", []interface{}{ctx, streamID, typ}})
+				return nil
+			}
 			chain := CallbacksChain{first, second}
 
 			// when
@@ -78,7 +82,26 @@ var _ = Describe("CallbacksChain", func() {
 				methodCall{"2nd", "OnStreamOpen()", []interface{}{ctx, streamID, typ}},
 			}))
 			// and
-			Expect(err).To(MatchError("1st: OnStreamOpen(); 2nd: OnStreamOpen()"))
+			Expect(err).To(MatchError("2nd: OnStreamOpen()"))
+		})
+
+		It("should stop at the first error", func() {
+			// given
+			ctx := context.Background()
+			streamID := int64(1)
+			typ := "xDS"
+			// setup
+			chain := CallbacksChain{first, second}
+
+			// when
+			err := chain.OnStreamOpen(ctx, streamID, typ)
+
+			// then
+			Expect(calls).To(Equal([]methodCall{
+				methodCall{"1st", "OnStreamOpen()", []interface{}{ctx, streamID, typ}},
+			}))
+			// and
+			Expect(err).To(MatchError("1st: OnStreamOpen()"))
 		})
 	})
 	Describe("OnStreamClose", func() {
@@ -99,12 +122,16 @@ var _ = Describe("CallbacksChain", func() {
 		})
 	})
 	Describe("OnStreamRequest", func() {
-		It("should be called sequentially and aggregate errors", func() {
+		It("should be called sequentially", func() {
 			// given
 			streamID := int64(1)
 			req := &envoy.DiscoveryRequest{}
 
 			// setup
+			first.OnStreamRequestFunc = func(streamID int64, req *envoy.DiscoveryRequest) error {
+				calls = append(calls, methodCall{"1st", "OnStreamRequest()", []interface{}{streamID, req}})
+				return nil
+			}
 			chain := CallbacksChain{first, second}
 
 			// when
@@ -116,7 +143,26 @@ var _ = Describe("CallbacksChain", func() {
 				{"2nd", "OnStreamRequest()", []interface{}{streamID, req}},
 			}))
 			// and
-			Expect(err).To(MatchError("1st: OnStreamRequest(); 2nd: OnStreamRequest()"))
+			Expect(err).To(MatchError("2nd: OnStreamRequest()"))
+		})
+
+		It("should stop at the first error", func() {
+			// given
+			streamID := int64(1)
+			req := &envoy.DiscoveryRequest{}
+
+			// setup
+			chain := CallbacksChain{first, second}
+
+			// when
+			err := chain.OnStreamRequest(streamID, req)
+
+			// then
+			Expect(calls).To(Equal([]methodCall{
+				{"1st", "OnStreamRequest()", []interface{}{streamID, req}},
+			}))
+			// and
+			Expect(err).To(MatchError("1st: OnStreamRequest()"))
 		})
 	})
 	Describe("OnStreamResponse", func() {
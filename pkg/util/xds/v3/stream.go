@@ -0,0 +1,129 @@
+package v3
+
+import (
+	"sync"
+
+	envoy_api_v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+)
+
+// StreamV2 is a bi-directional stream of Envoy xDS v2 transport, e.g. ADS or SDS stream.
+type StreamV2 interface {
+	grpc.ServerStream
+
+	Send(*envoy_api_v2.DiscoveryResponse) error
+	Recv() (*envoy_api_v2.DiscoveryRequest, error)
+}
+
+// StreamV3 is a bi-directional stream of Envoy xDS v3 transport, e.g. ADS or SDS stream.
+type StreamV3 interface {
+	grpc.ServerStream
+
+	Send(*envoy_discovery_v3.DiscoveryResponse) error
+	Recv() (*envoy_discovery_v3.DiscoveryRequest, error)
+}
+
+// AdaptStream lets a server built on top of Envoy xDS v2 transport serve a stream of xDS v3 transport.
+//
+// API version of resources is negotiated per resource type according to type URLs requested by Envoy:
+// if Envoy requests a type URL of xDS v3 resource, it will be served resources upgraded to v3,
+// otherwise it will be served v2 resources as is.
+// This way, Envoy is free to choose API version of resources independently of API version of transport.
+//
+// defaultTypeURL is assumed when a request has no type URL, which is only allowed for non-aggregated streams, e.g. SDS.
+func AdaptStream(stream StreamV3, defaultTypeURL string) StreamV2 {
+	return &adaptedStream{
+		ServerStream:   stream,
+		stream:         stream,
+		defaultTypeURL: defaultTypeURL,
+		v3TypeURLs:     map[string]bool{},
+	}
+}
+
+type adaptedStream struct {
+	grpc.ServerStream
+	stream         StreamV3
+	defaultTypeURL string
+
+	mu sync.RWMutex // protects access to the fields below
+	// v2 type URLs that Envoy has requested in v3 version
+	v3TypeURLs map[string]bool
+}
+
+func (s *adaptedStream) Recv() (*envoy_api_v2.DiscoveryRequest, error) {
+	reqV3, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	req, isV3, err := DowngradeRequest(reqV3, s.defaultTypeURL)
+	if err != nil {
+		return nil, err
+	}
+	if isV3 {
+		s.mu.Lock()
+		s.v3TypeURLs[req.TypeUrl] = true
+		s.mu.Unlock()
+	}
+	return req, nil
+}
+
+func (s *adaptedStream) Send(resp *envoy_api_v2.DiscoveryResponse) error {
+	s.mu.RLock()
+	isV3 := s.v3TypeURLs[resp.TypeUrl]
+	s.mu.RUnlock()
+	respV3, err := UpgradeResponse(resp, isV3)
+	if err != nil {
+		return err
+	}
+	return s.stream.Send(respV3)
+}
+
+// DowngradeRequest converts a request of xDS v3 transport into a request of xDS v2 transport for resources of v2 type URL.
+// The second return value indicates whether the request is for xDS v3 resources.
+//
+// defaultTypeURL is assumed when a request has no type URL, which is only allowed for non-aggregated streams, e.g. SDS.
+func DowngradeRequest(reqV3 *envoy_discovery_v3.DiscoveryRequest, defaultTypeURL string) (*envoy_api_v2.DiscoveryRequest, bool, error) {
+	req := &envoy_api_v2.DiscoveryRequest{}
+	if err := convert(reqV3, req); err != nil {
+		return nil, false, err
+	}
+	if req.TypeUrl == "" {
+		req.TypeUrl = defaultTypeURL
+	}
+	typeURL, isV3 := ToV2TypeURL(req.TypeUrl)
+	req.TypeUrl = typeURL
+	return req, isV3, nil
+}
+
+// UpgradeResponse converts a response of xDS v2 transport into a response of xDS v3 transport.
+// Resources are upgraded to xDS v3 if upgradeResources is true, otherwise they are left as is.
+func UpgradeResponse(resp *envoy_api_v2.DiscoveryResponse, upgradeResources bool) (*envoy_discovery_v3.DiscoveryResponse, error) {
+	respV3 := &envoy_discovery_v3.DiscoveryResponse{}
+	if err := convert(resp, respV3); err != nil {
+		return nil, err
+	}
+	if !upgradeResources {
+		return respV3, nil
+	}
+	respV3.TypeUrl, _ = ToV3TypeURL(resp.TypeUrl)
+	for i, resource := range respV3.Resources {
+		upgraded, err := UpgradeResource(resource)
+		if err != nil {
+			return nil, err
+		}
+		respV3.Resources[i] = upgraded
+	}
+	return respV3, nil
+}
+
+// convert copies a message into a message of another type with the same wire format,
+// e.g. DiscoveryRequest of xDS v2 into DiscoveryRequest of xDS v3.
+func convert(from proto.Message, to proto.Message) error {
+	b, err := proto.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(b, to)
+}
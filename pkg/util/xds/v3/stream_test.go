@@ -0,0 +1,127 @@
+package v3_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	envoy_api_v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	envoy_resource_v2 "github.com/envoyproxy/go-control-plane/pkg/resource/v2"
+	envoy_resource_v3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/grpc"
+
+	. "github.com/Kong/kuma/pkg/util/xds/v3"
+)
+
+type fakeStream struct {
+	grpc.ServerStream
+	requests  []*envoy_discovery_v3.DiscoveryRequest
+	responses []*envoy_discovery_v3.DiscoveryResponse
+}
+
+func (s *fakeStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeStream) Send(resp *envoy_discovery_v3.DiscoveryResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func (s *fakeStream) Recv() (*envoy_discovery_v3.DiscoveryRequest, error) {
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+var _ = Describe("AdaptStream()", func() {
+
+	var stream *fakeStream
+
+	BeforeEach(func() {
+		stream = &fakeStream{}
+	})
+
+	clusters := func() *envoy_api_v2.DiscoveryResponse {
+		cluster, err := ptypes.MarshalAny(&envoy_api_v2.Cluster{Name: "backend"})
+		Expect(err).ToNot(HaveOccurred())
+		return &envoy_api_v2.DiscoveryResponse{
+			VersionInfo: "1",
+			TypeUrl:     envoy_resource_v2.ClusterType,
+			Resources:   []*any.Any{cluster},
+			Nonce:       "2",
+		}
+	}
+
+	It("should serve v3 resources when Envoy requests v3 type URL", func() {
+		// given
+		stream.requests = []*envoy_discovery_v3.DiscoveryRequest{{
+			Node:    &envoy_core_v3.Node{Id: "demo.backend-01"},
+			TypeUrl: envoy_resource_v3.ClusterType,
+		}}
+		adapted := AdaptStream(stream, envoy_resource_v3.AnyType)
+
+		// when
+		req, err := adapted.Recv()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(req.Node).To(Equal(&envoy_core.Node{Id: "demo.backend-01"}))
+		Expect(req.TypeUrl).To(Equal(envoy_resource_v2.ClusterType))
+
+		// when
+		err = adapted.Send(clusters())
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stream.responses).To(HaveLen(1))
+		Expect(stream.responses[0].VersionInfo).To(Equal("1"))
+		Expect(stream.responses[0].Nonce).To(Equal("2"))
+		Expect(stream.responses[0].TypeUrl).To(Equal(envoy_resource_v3.ClusterType))
+		Expect(stream.responses[0].Resources).To(HaveLen(1))
+		Expect(stream.responses[0].Resources[0].TypeUrl).To(Equal(envoy_resource_v3.ClusterType))
+	})
+
+	It("should serve v2 resources when Envoy requests v2 type URL", func() {
+		// given
+		stream.requests = []*envoy_discovery_v3.DiscoveryRequest{{
+			Node:    &envoy_core_v3.Node{Id: "demo.backend-01"},
+			TypeUrl: envoy_resource_v2.ClusterType,
+		}}
+		adapted := AdaptStream(stream, envoy_resource_v3.AnyType)
+
+		// when
+		req, err := adapted.Recv()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(req.TypeUrl).To(Equal(envoy_resource_v2.ClusterType))
+
+		// when
+		err = adapted.Send(clusters())
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stream.responses).To(HaveLen(1))
+		Expect(stream.responses[0].TypeUrl).To(Equal(envoy_resource_v2.ClusterType))
+		Expect(stream.responses[0].Resources[0].TypeUrl).To(Equal(envoy_resource_v2.ClusterType))
+	})
+
+	It("should assume default type URL when request has none", func() {
+		// given
+		stream.requests = []*envoy_discovery_v3.DiscoveryRequest{{
+			ResourceNames: []string{"identity_cert"},
+		}}
+		adapted := AdaptStream(stream, envoy_resource_v3.SecretType)
+
+		// when
+		req, err := adapted.Recv()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(req.TypeUrl).To(Equal(envoy_resource_v2.SecretType))
+		Expect(req.ResourceNames).To(Equal([]string{"identity_cert"}))
+	})
+})
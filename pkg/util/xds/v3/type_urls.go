@@ -0,0 +1,43 @@
+package v3
+
+import (
+	envoy_resource_v2 "github.com/envoyproxy/go-control-plane/pkg/resource/v2"
+	envoy_resource_v3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+)
+
+// v2ToV3TypeURLs maps type URLs of Envoy xDS v2 resources to type URLs of their v3 equivalents.
+var v2ToV3TypeURLs = map[string]string{
+	envoy_resource_v2.EndpointType: envoy_resource_v3.EndpointType,
+	envoy_resource_v2.ClusterType:  envoy_resource_v3.ClusterType,
+	envoy_resource_v2.RouteType:    envoy_resource_v3.RouteType,
+	envoy_resource_v2.ListenerType: envoy_resource_v3.ListenerType,
+	envoy_resource_v2.SecretType:   envoy_resource_v3.SecretType,
+	envoy_resource_v2.RuntimeType:  envoy_resource_v3.RuntimeType,
+}
+
+var v3ToV2TypeURLs = func() map[string]string {
+	typeURLs := map[string]string{}
+	for v2, v3 := range v2ToV3TypeURLs {
+		typeURLs[v3] = v2
+	}
+	return typeURLs
+}()
+
+// ToV2TypeURL returns a type URL of Envoy xDS v2 resource that is equivalent to a given type URL of xDS v3 resource.
+// The second return value indicates whether a given type URL is a type URL of xDS v3 resource.
+func ToV2TypeURL(typeURL string) (string, bool) {
+	v2, isV3 := v3ToV2TypeURLs[typeURL]
+	if !isV3 {
+		return typeURL, false
+	}
+	return v2, true
+}
+
+// ToV3TypeURL returns a type URL of Envoy xDS v3 resource that is equivalent to a given type URL of xDS v2 resource.
+func ToV3TypeURL(typeURL string) (string, bool) {
+	v3, isV2 := v2ToV3TypeURLs[typeURL]
+	if !isV2 {
+		return typeURL, false
+	}
+	return v3, true
+}
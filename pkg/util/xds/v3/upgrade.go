@@ -0,0 +1,308 @@
+package v3
+
+import (
+	"reflect"
+	"strings"
+
+	envoy_api_v2_auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	envoy_accesslog_v2 "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v2"
+	envoy_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_endpoint_v3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoy_router_v2 "github.com/envoyproxy/go-control-plane/envoy/config/filter/http/router/v2"
+	envoy_original_dst_v2 "github.com/envoyproxy/go-control-plane/envoy/config/filter/listener/original_dst/v2"
+	envoy_hcm_v2 "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/http_connection_manager/v2"
+	envoy_rbac_v2 "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/rbac/v2"
+	envoy_tcp_v2 "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/tcp_proxy/v2"
+	envoy_grpc_credential_v2 "github.com/envoyproxy/go-control-plane/envoy/config/grpc_credential/v2alpha"
+	envoy_grpc_credential_v3 "github.com/envoyproxy/go-control-plane/envoy/config/grpc_credential/v3"
+	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_file_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
+	envoy_grpc_accesslog_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	envoy_router_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
+	envoy_original_dst_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/original_dst/v3"
+	envoy_hcm_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_rbac_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	envoy_tcp_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	envoy_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_runtime_v3 "github.com/envoyproxy/go-control-plane/envoy/service/runtime/v3"
+	envoy_resource_v3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+)
+
+const (
+	typeURLPrefix             = "type.googleapis.com/"
+	tlsTransportSocketName    = "envoy.transport_sockets.tls"
+	originalDstListenerFilter = "envoy.filters.listener.original_dst"
+)
+
+// UpgradeResource converts Envoy xDS v2 resource into its v3 equivalent.
+//
+// Envoy xDS v3 API preserves field numbers of xDS v2 API, which is why a v2 resource
+// can be re-read as a v3 resource. The only exception is fields that got deprecated in v2
+// and therefore have been hidden in v3. Those fields are replaced with their v3 alternatives.
+//
+// Typed configs of extensions (e.g. network filters, access loggers and transport sockets) are upgraded as well,
+// since Envoy that supports only xDS v3 rejects configs of xDS v2 extensions.
+func UpgradeResource(resource *any.Any) (*any.Any, error) {
+	typeURL, ok := ToV3TypeURL(resource.GetTypeUrl())
+	if !ok {
+		return nil, errors.Errorf("resource of type %q cannot be upgraded to xDS v3", resource.GetTypeUrl())
+	}
+	msg, err := newResource(typeURL)
+	if err != nil {
+		return nil, err
+	}
+	if err := proto.Unmarshal(resource.GetValue(), msg); err != nil {
+		return nil, errors.Wrapf(err, "failed to read resource of type %q as %q", resource.GetTypeUrl(), typeURL)
+	}
+	// typed configs must be upgraded before deprecated fields get replaced with typed configs of their v3 alternatives
+	if err := upgradeTypedConfigs(reflect.ValueOf(msg)); err != nil {
+		return nil, errors.Wrapf(err, "failed to upgrade resource of type %q", resource.GetTypeUrl())
+	}
+	if err := upgradeDeprecatedFields(msg); err != nil {
+		return nil, errors.Wrapf(err, "failed to upgrade resource of type %q", resource.GetTypeUrl())
+	}
+	value, err := marshalDeterministic(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &any.Any{
+		TypeUrl: typeURL,
+		Value:   value,
+	}, nil
+}
+
+// Envoy relies on serialized protobuf bytes for detecting changes to the resources.
+// This requires deterministic serialization.
+func marshalDeterministic(msg proto.Message) ([]byte, error) {
+	b := proto.NewBuffer(nil)
+	b.SetDeterministic(true)
+	if err := b.Marshal(msg); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// v3TypedConfigs maps names of typed configs of xDS v2 extensions generated by the Control Plane
+// to their v3 equivalents.
+var v3TypedConfigs = map[string]func() proto.Message{
+	proto.MessageName(&envoy_hcm_v2.HttpConnectionManager{}):               func() proto.Message { return &envoy_hcm_v3.HttpConnectionManager{} },
+	proto.MessageName(&envoy_tcp_v2.TcpProxy{}):                            func() proto.Message { return &envoy_tcp_v3.TcpProxy{} },
+	proto.MessageName(&envoy_rbac_v2.RBAC{}):                               func() proto.Message { return &envoy_rbac_v3.RBAC{} },
+	proto.MessageName(&envoy_router_v2.Router{}):                           func() proto.Message { return &envoy_router_v3.Router{} },
+	proto.MessageName(&envoy_original_dst_v2.OriginalDst{}):                func() proto.Message { return &envoy_original_dst_v3.OriginalDst{} },
+	proto.MessageName(&envoy_accesslog_v2.FileAccessLog{}):                 func() proto.Message { return &envoy_file_accesslog_v3.FileAccessLog{} },
+	proto.MessageName(&envoy_accesslog_v2.HttpGrpcAccessLogConfig{}):       func() proto.Message { return &envoy_grpc_accesslog_v3.HttpGrpcAccessLogConfig{} },
+	proto.MessageName(&envoy_accesslog_v2.TcpGrpcAccessLogConfig{}):        func() proto.Message { return &envoy_grpc_accesslog_v3.TcpGrpcAccessLogConfig{} },
+	proto.MessageName(&envoy_api_v2_auth.UpstreamTlsContext{}):             func() proto.Message { return &envoy_tls_v3.UpstreamTlsContext{} },
+	proto.MessageName(&envoy_api_v2_auth.DownstreamTlsContext{}):           func() proto.Message { return &envoy_tls_v3.DownstreamTlsContext{} },
+	proto.MessageName(&envoy_grpc_credential_v2.FileBasedMetadataConfig{}): func() proto.Message { return &envoy_grpc_credential_v3.FileBasedMetadataConfig{} },
+}
+
+// upgradeTypedConfigs walks through a given message and upgrades every typed config of xDS v2 extension it finds,
+// including typed configs nested in other typed configs, e.g. access loggers of HTTP connection manager.
+func upgradeTypedConfigs(value reflect.Value) error {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return nil
+		}
+		if typedConfig, ok := value.Interface().(*any.Any); ok {
+			return upgradeTypedConfig(typedConfig)
+		}
+		return upgradeTypedConfigs(value.Elem())
+	case reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return upgradeTypedConfigs(value.Elem())
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if strings.HasPrefix(value.Type().Field(i).Name, "XXX_") {
+				continue
+			}
+			if err := upgradeTypedConfigs(value.Field(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			if err := upgradeTypedConfigs(value.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, key := range value.MapKeys() {
+			if err := upgradeTypedConfigs(value.MapIndex(key)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func upgradeTypedConfig(typedConfig *any.Any) error {
+	name, err := ptypes.AnyMessageName(typedConfig)
+	if err != nil {
+		return err
+	}
+	newConfig, ok := v3TypedConfigs[name]
+	if !ok {
+		// either a config of xDS v3 extension or a config that is not versioned, e.g. google.protobuf.Struct
+		return nil
+	}
+	msg := newConfig()
+	if err := proto.Unmarshal(typedConfig.GetValue(), msg); err != nil {
+		return errors.Wrapf(err, "failed to read typed config of type %q as %q", name, proto.MessageName(msg))
+	}
+	if err := upgradeTypedConfigs(reflect.ValueOf(msg)); err != nil {
+		return err
+	}
+	upgradeDeprecatedTypedConfigFields(msg)
+	value, err := marshalDeterministic(msg)
+	if err != nil {
+		return err
+	}
+	typedConfig.TypeUrl = typeURLPrefix + proto.MessageName(msg)
+	typedConfig.Value = value
+	return nil
+}
+
+func upgradeDeprecatedTypedConfigFields(msg proto.Message) {
+	switch config := msg.(type) {
+	case *envoy_tls_v3.UpstreamTlsContext:
+		upgradeCommonTlsContext(config.GetCommonTlsContext())
+	case *envoy_tls_v3.DownstreamTlsContext:
+		upgradeCommonTlsContext(config.GetCommonTlsContext())
+	case *envoy_hcm_v3.HttpConnectionManager:
+		upgradeConfigSource(config.GetRds().GetConfigSource())
+	}
+}
+
+func newResource(typeURL string) (proto.Message, error) {
+	switch typeURL {
+	case envoy_resource_v3.EndpointType:
+		return &envoy_endpoint_v3.ClusterLoadAssignment{}, nil
+	case envoy_resource_v3.ClusterType:
+		return &envoy_cluster_v3.Cluster{}, nil
+	case envoy_resource_v3.RouteType:
+		return &envoy_route_v3.RouteConfiguration{}, nil
+	case envoy_resource_v3.ListenerType:
+		return &envoy_listener_v3.Listener{}, nil
+	case envoy_resource_v3.SecretType:
+		return &envoy_tls_v3.Secret{}, nil
+	case envoy_resource_v3.RuntimeType:
+		return &envoy_runtime_v3.Runtime{}, nil
+	default:
+		return nil, errors.Errorf("unsupported resource type %q", typeURL)
+	}
+}
+
+func upgradeDeprecatedFields(msg proto.Message) error {
+	switch resource := msg.(type) {
+	case *envoy_cluster_v3.Cluster:
+		return upgradeCluster(resource)
+	case *envoy_listener_v3.Listener:
+		return upgradeListener(resource)
+	default:
+		return nil
+	}
+}
+
+func upgradeCluster(cluster *envoy_cluster_v3.Cluster) error {
+	upgradeConfigSource(cluster.GetEdsClusterConfig().GetEdsConfig())
+	tlsContext := cluster.HiddenEnvoyDeprecatedTlsContext
+	if tlsContext == nil {
+		return nil
+	}
+	upgradeCommonTlsContext(tlsContext.GetCommonTlsContext())
+	transportSocket, err := tlsTransportSocket(tlsContext)
+	if err != nil {
+		return err
+	}
+	cluster.TransportSocket = transportSocket
+	cluster.HiddenEnvoyDeprecatedTlsContext = nil
+	return nil
+}
+
+func upgradeListener(listener *envoy_listener_v3.Listener) error {
+	if err := upgradeUseOriginalDst(listener); err != nil {
+		return err
+	}
+	for _, filterChain := range listener.GetFilterChains() {
+		tlsContext := filterChain.HiddenEnvoyDeprecatedTlsContext
+		if tlsContext == nil {
+			continue
+		}
+		upgradeCommonTlsContext(tlsContext.GetCommonTlsContext())
+		transportSocket, err := tlsTransportSocket(tlsContext)
+		if err != nil {
+			return err
+		}
+		filterChain.TransportSocket = transportSocket
+		filterChain.HiddenEnvoyDeprecatedTlsContext = nil
+	}
+	return nil
+}
+
+// upgradeUseOriginalDst replaces `use_original_dst` of a Listener with `original_dst` listener filter.
+func upgradeUseOriginalDst(listener *envoy_listener_v3.Listener) error {
+	useOriginalDst := listener.HiddenEnvoyDeprecatedUseOriginalDst
+	listener.HiddenEnvoyDeprecatedUseOriginalDst = nil
+	if !useOriginalDst.GetValue() {
+		return nil
+	}
+	for _, filter := range listener.GetListenerFilters() {
+		if filter.GetName() == originalDstListenerFilter {
+			return nil
+		}
+	}
+	typedConfig, err := ptypes.MarshalAny(&envoy_original_dst_v3.OriginalDst{})
+	if err != nil {
+		return err
+	}
+	listener.ListenerFilters = append(listener.ListenerFilters, &envoy_listener_v3.ListenerFilter{
+		Name: originalDstListenerFilter,
+		ConfigType: &envoy_listener_v3.ListenerFilter_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	})
+	return nil
+}
+
+// upgradeCommonTlsContext makes Envoy fetch secrets using xDS v3 API.
+func upgradeCommonTlsContext(tlsContext *envoy_tls_v3.CommonTlsContext) {
+	for _, sdsConfig := range tlsContext.GetTlsCertificateSdsSecretConfigs() {
+		upgradeConfigSource(sdsConfig.GetSdsConfig())
+	}
+	upgradeConfigSource(tlsContext.GetValidationContextSdsSecretConfig().GetSdsConfig())
+	upgradeConfigSource(tlsContext.GetCombinedValidationContext().GetValidationContextSdsSecretConfig().GetSdsConfig())
+}
+
+func upgradeConfigSource(source *envoy_core_v3.ConfigSource) {
+	if source == nil {
+		return
+	}
+	source.ResourceApiVersion = envoy_core_v3.ApiVersion_V3
+	if apiConfigSource := source.GetApiConfigSource(); apiConfigSource != nil {
+		apiConfigSource.TransportApiVersion = envoy_core_v3.ApiVersion_V3
+	}
+}
+
+func tlsTransportSocket(tlsContext proto.Message) (*envoy_core_v3.TransportSocket, error) {
+	typedConfig, err := ptypes.MarshalAny(tlsContext)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_core_v3.TransportSocket{
+		Name: tlsTransportSocketName,
+		ConfigType: &envoy_core_v3.TransportSocket_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}, nil
+}
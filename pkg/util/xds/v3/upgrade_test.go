@@ -0,0 +1,250 @@
+package v3_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	envoy_api_v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoy_cluster_v3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	. "github.com/Kong/kuma/pkg/util/xds/v3"
+)
+
+var _ = Describe("UpgradeResource()", func() {
+
+	sdsConfig := func(name string) *envoy_auth.SdsSecretConfig {
+		return &envoy_auth.SdsSecretConfig{
+			Name: name,
+			SdsConfig: &envoy_core.ConfigSource{
+				ConfigSourceSpecifier: &envoy_core.ConfigSource_ApiConfigSource{
+					ApiConfigSource: &envoy_core.ApiConfigSource{
+						ApiType: envoy_core.ApiConfigSource_GRPC,
+						GrpcServices: []*envoy_core.GrpcService{{
+							TargetSpecifier: &envoy_core.GrpcService_EnvoyGrpc_{
+								EnvoyGrpc: &envoy_core.GrpcService_EnvoyGrpc{
+									ClusterName: "sds",
+								},
+							},
+						}},
+					},
+				},
+			},
+		}
+	}
+	commonTlsContext := &envoy_auth.CommonTlsContext{
+		ValidationContextType: &envoy_auth.CommonTlsContext_ValidationContextSdsSecretConfig{
+			ValidationContextSdsSecretConfig: sdsConfig("mesh_ca"),
+		},
+		TlsCertificateSdsSecretConfigs: []*envoy_auth.SdsSecretConfig{
+			sdsConfig("identity_cert"),
+		},
+	}
+
+	It("should replace `tls_context` of a Cluster with `transport_socket`", func() {
+		// given
+		cluster := &envoy_api_v2.Cluster{
+			Name:                 "backend",
+			ConnectTimeout:       ptypes.DurationProto(5 * time.Second),
+			ClusterDiscoveryType: &envoy_api_v2.Cluster_Type{Type: envoy_api_v2.Cluster_EDS},
+			EdsClusterConfig: &envoy_api_v2.Cluster_EdsClusterConfig{
+				EdsConfig: &envoy_core.ConfigSource{
+					ConfigSourceSpecifier: &envoy_core.ConfigSource_Ads{
+						Ads: &envoy_core.AggregatedConfigSource{},
+					},
+				},
+			},
+			TlsContext: &envoy_auth.UpstreamTlsContext{
+				CommonTlsContext: commonTlsContext,
+			},
+		}
+		resource, err := ptypes.MarshalAny(cluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		upgraded, err := UpgradeResource(resource)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(upgraded.TypeUrl).To(Equal("type.googleapis.com/envoy.config.cluster.v3.Cluster"))
+
+		// when
+		actual := &envoy_cluster_v3.Cluster{}
+		Expect(proto.Unmarshal(upgraded.Value, actual)).To(Succeed())
+		actualYAML, err := util_proto.ToYAML(actual)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actualYAML).To(MatchYAML(`
+        name: backend
+        connectTimeout: 5s
+        type: EDS
+        edsClusterConfig:
+          edsConfig:
+            ads: {}
+            resourceApiVersion: V3
+        transportSocket:
+          name: envoy.transport_sockets.tls
+          typedConfig:
+            '@type': type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.UpstreamTlsContext
+            commonTlsContext:
+              tlsCertificateSdsSecretConfigs:
+              - name: identity_cert
+                sdsConfig:
+                  apiConfigSource:
+                    apiType: GRPC
+                    transportApiVersion: V3
+                    grpcServices:
+                    - envoyGrpc:
+                        clusterName: sds
+                  resourceApiVersion: V3
+              validationContextSdsSecretConfig:
+                name: mesh_ca
+                sdsConfig:
+                  apiConfigSource:
+                    apiType: GRPC
+                    transportApiVersion: V3
+                    grpcServices:
+                    - envoyGrpc:
+                        clusterName: sds
+                  resourceApiVersion: V3
+`))
+	})
+
+	It("should replace `tls_context` of a FilterChain with `transport_socket`", func() {
+		// given
+		listener := &envoy_api_v2.Listener{
+			Name: "inbound:192.168.0.1:8080",
+			Address: &envoy_core.Address{
+				Address: &envoy_core.Address_SocketAddress{
+					SocketAddress: &envoy_core.SocketAddress{
+						Address: "192.168.0.1",
+						PortSpecifier: &envoy_core.SocketAddress_PortValue{
+							PortValue: 8080,
+						},
+					},
+				},
+			},
+			FilterChains: []*envoy_listener.FilterChain{{
+				TlsContext: &envoy_auth.DownstreamTlsContext{
+					CommonTlsContext:         commonTlsContext,
+					RequireClientCertificate: &wrappers.BoolValue{Value: true},
+				},
+			}},
+		}
+		resource, err := ptypes.MarshalAny(listener)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		upgraded, err := UpgradeResource(resource)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(upgraded.TypeUrl).To(Equal("type.googleapis.com/envoy.config.listener.v3.Listener"))
+
+		// when
+		actual := &envoy_listener_v3.Listener{}
+		Expect(proto.Unmarshal(upgraded.Value, actual)).To(Succeed())
+		// then
+		Expect(actual.Name).To(Equal("inbound:192.168.0.1:8080"))
+		Expect(actual.GetAddress().GetSocketAddress().GetPortValue()).To(Equal(uint32(8080)))
+		Expect(actual.FilterChains).To(HaveLen(1))
+		Expect(actual.FilterChains[0].HiddenEnvoyDeprecatedTlsContext).To(BeNil())
+		Expect(actual.FilterChains[0].TransportSocket.Name).To(Equal("envoy.transport_sockets.tls"))
+		Expect(actual.FilterChains[0].TransportSocket.GetTypedConfig().TypeUrl).To(Equal("type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.DownstreamTlsContext"))
+	})
+
+	It("should replace `use_original_dst` of a Listener with `original_dst` listener filter", func() {
+		// given
+		listener := &envoy_api_v2.Listener{
+			Name: "catch_all",
+			Address: &envoy_core.Address{
+				Address: &envoy_core.Address_SocketAddress{
+					SocketAddress: &envoy_core.SocketAddress{
+						Address: "0.0.0.0",
+						PortSpecifier: &envoy_core.SocketAddress_PortValue{
+							PortValue: 15001,
+						},
+					},
+				},
+			},
+			UseOriginalDst: &wrappers.BoolValue{Value: true},
+		}
+		resource, err := ptypes.MarshalAny(listener)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		upgraded, err := UpgradeResource(resource)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual := &envoy_listener_v3.Listener{}
+		Expect(proto.Unmarshal(upgraded.Value, actual)).To(Succeed())
+		actualYAML, err := util_proto.ToYAML(actual)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actualYAML).To(MatchYAML(`
+        name: catch_all
+        address:
+          socketAddress:
+            address: 0.0.0.0
+            portValue: 15001
+        listenerFilters:
+        - name: envoy.filters.listener.original_dst
+          typedConfig:
+            '@type': type.googleapis.com/envoy.extensions.filters.listener.original_dst.v3.OriginalDst
+`))
+	})
+
+	It("should upgrade typed configs of extensions", func() {
+		// given
+		input, err := ioutil.ReadFile(filepath.Join("testdata", "resources.input.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		resources := &envoy_api_v2.DiscoveryResponse{}
+		Expect(util_proto.FromYAML(input, resources)).To(Succeed())
+
+		// when
+		upgraded := &envoy_discovery_v3.DiscoveryResponse{}
+		for _, resource := range resources.Resources {
+			upgradedResource, err := UpgradeResource(resource)
+			Expect(err).ToNot(HaveOccurred())
+			upgraded.Resources = append(upgraded.Resources, upgradedResource)
+		}
+		actual, err := util_proto.ToYAML(upgraded)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "resources.golden.yaml"))
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+		// and
+		Expect(string(actual)).ToNot(ContainSubstring("envoy.api.v2"))
+		Expect(string(actual)).ToNot(ContainSubstring("envoy.config.filter"))
+		Expect(string(actual)).ToNot(ContainSubstring("envoy.config.accesslog"))
+		Expect(string(actual)).ToNot(ContainSubstring("v2alpha"))
+	})
+
+	It("should fail to upgrade an unknown resource", func() {
+		// given
+		resource, err := ptypes.MarshalAny(&envoy_core.Node{})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = UpgradeResource(resource)
+
+		// then
+		Expect(err).To(MatchError(`resource of type "type.googleapis.com/envoy.api.v2.core.Node" cannot be upgraded to xDS v3`))
+	})
+})
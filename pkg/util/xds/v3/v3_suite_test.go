@@ -0,0 +1,13 @@
+package v3_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestXdsV3(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Xds v3 Suite")
+}
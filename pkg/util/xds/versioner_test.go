@@ -8,7 +8,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
-	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache"
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v2"
 
 	. "github.com/Kong/kuma/pkg/util/xds"
 )
@@ -56,348 +57,390 @@ var _ = Describe("SnapshotAutoVersioner", func() {
 			Entry("when 'old' = `nil` and 'new' has empty version", testCase{
 				old: nil,
 				new: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
-					}),
-					Clusters: envoy_cache.NewResources("", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster"},
-					}),
-					Routes: envoy_cache.NewResources("", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration"},
-					}),
-					Listeners: envoy_cache.NewResources("", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener"},
-					}),
-					Secrets:  envoy_cache.Resources{},
-					Runtimes: envoy_cache.NewResources("", []envoy_cache.Resource{}),
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration"},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener"},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{},
+						envoy_types.Runtime: envoy_cache.NewResources("", []envoy_types.Resource{}),
+					},
 				}},
 				expected: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("101", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
-					}),
-					Clusters: envoy_cache.NewResources("102", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster"},
-					}),
-					Routes: envoy_cache.NewResources("103", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration"},
-					}),
-					Listeners: envoy_cache.NewResources("104", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener"},
-					}),
-					Secrets:  envoy_cache.Resources{Version: "105"},
-					Runtimes: envoy_cache.NewResources("106", []envoy_cache.Resource{}),
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("101", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("102", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("103", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration"},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("104", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener"},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{Version: "105"},
+						envoy_types.Runtime: envoy_cache.NewResources("106", []envoy_types.Resource{}),
+					},
 				}},
 			}),
 			Entry("when 'old' = `nil` and each resource type in 'new' has the same version", testCase{
 				old: nil,
 				new: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("v1", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
-					}),
-					Clusters: envoy_cache.NewResources("v1", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster"},
-					}),
-					Routes: envoy_cache.NewResources("v1", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration"},
-					}),
-					Listeners: envoy_cache.NewResources("v1", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener"},
-					}),
-					Secrets:  envoy_cache.Resources{}, // empty version must be replaced
-					Runtimes: envoy_cache.NewResources("v1", []envoy_cache.Resource{}),
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("v1", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("v1", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("v1", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration"},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("v1", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener"},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{}, // empty version must be replaced
+						envoy_types.Runtime: envoy_cache.NewResources("v1", []envoy_types.Resource{}),
+					},
 				}},
 				expected: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("v1", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
-					}),
-					Clusters: envoy_cache.NewResources("v1", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster"},
-					}),
-					Routes: envoy_cache.NewResources("v1", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration"},
-					}),
-					Listeners: envoy_cache.NewResources("v1", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener"},
-					}),
-					Secrets:  envoy_cache.Resources{Version: "101"},
-					Runtimes: envoy_cache.NewResources("v1", []envoy_cache.Resource{}),
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("v1", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("v1", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("v1", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration"},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("v1", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener"},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{Version: "101"},
+						envoy_types.Runtime: envoy_cache.NewResources("v1", []envoy_types.Resource{}),
+					},
 				}},
 			}),
 			Entry("when 'old' = `nil` and each resource type in 'new' has different version", testCase{
 				old: nil,
 				new: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("v1", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
-					}),
-					Clusters: envoy_cache.NewResources("v2", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster"},
-					}),
-					Routes: envoy_cache.NewResources("v3", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration"},
-					}),
-					Listeners: envoy_cache.NewResources("v4", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener"},
-					}),
-					Secrets:  envoy_cache.Resources{}, // empty version must be replaced
-					Runtimes: envoy_cache.NewResources("v6", []envoy_cache.Resource{}),
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("v1", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("v2", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("v3", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration"},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("v4", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener"},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{}, // empty version must be replaced
+						envoy_types.Runtime: envoy_cache.NewResources("v6", []envoy_types.Resource{}),
+					},
 				}},
 				expected: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("v1", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
-					}),
-					Clusters: envoy_cache.NewResources("v2", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster"},
-					}),
-					Routes: envoy_cache.NewResources("v3", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration"},
-					}),
-					Listeners: envoy_cache.NewResources("v4", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener"},
-					}),
-					Secrets:  envoy_cache.Resources{Version: "101"},
-					Runtimes: envoy_cache.NewResources("v6", []envoy_cache.Resource{}),
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("v1", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("v2", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("v3", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration"},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("v4", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener"},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{Version: "101"},
+						envoy_types.Runtime: envoy_cache.NewResources("v6", []envoy_types.Resource{}),
+					},
 				}},
 			}),
 			Entry("when 'old' != `nil`, resources hasn't changed, versions are empty", testCase{
 				old: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("v1", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
-					}),
-					Clusters: envoy_cache.NewResources("v2", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster"},
-					}),
-					Routes: envoy_cache.NewResources("v3", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration"},
-					}),
-					Listeners: envoy_cache.NewResources("v4", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener"},
-					}),
-					Secrets:  envoy_cache.Resources{Version: "v5"},
-					Runtimes: envoy_cache.NewResources("v6", []envoy_cache.Resource{}),
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("v1", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("v2", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("v3", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration"},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("v4", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener"},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{Version: "v5"},
+						envoy_types.Runtime: envoy_cache.NewResources("v6", []envoy_types.Resource{}),
+					},
 				}},
 				new: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
-					}),
-					Clusters: envoy_cache.NewResources("", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster"},
-					}),
-					Routes: envoy_cache.NewResources("", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration"},
-					}),
-					Listeners: envoy_cache.NewResources("", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener"},
-					}),
-					Secrets:  envoy_cache.Resources{Version: ""},
-					Runtimes: envoy_cache.NewResources("", []envoy_cache.Resource{}),
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration"},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener"},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{Version: ""},
+						envoy_types.Runtime: envoy_cache.NewResources("", []envoy_types.Resource{}),
+					},
 				}},
 				expected: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("v1", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
-					}),
-					Clusters: envoy_cache.NewResources("v2", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster"},
-					}),
-					Routes: envoy_cache.NewResources("v3", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration"},
-					}),
-					Listeners: envoy_cache.NewResources("v4", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener"},
-					}),
-					Secrets:  envoy_cache.Resources{Version: "v5"},
-					Runtimes: envoy_cache.NewResources("v6", []envoy_cache.Resource{}),
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("v1", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("v2", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("v3", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration"},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("v4", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener"},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{Version: "v5"},
+						envoy_types.Runtime: envoy_cache.NewResources("v6", []envoy_types.Resource{}),
+					},
 				}},
 			}),
 			Entry("when 'old' != `nil`, resources hasn't changed, versions are not empty", testCase{
 				old: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("v1", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
-					}),
-					Clusters: envoy_cache.NewResources("v2", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster"},
-					}),
-					Routes: envoy_cache.NewResources("v3", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration"},
-					}),
-					Listeners: envoy_cache.NewResources("v4", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener"},
-					}),
-					Secrets:  envoy_cache.Resources{Version: "v5"},
-					Runtimes: envoy_cache.NewResources("v6", []envoy_cache.Resource{}),
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("v1", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("v2", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("v3", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration"},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("v4", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener"},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{Version: "v5"},
+						envoy_types.Runtime: envoy_cache.NewResources("v6", []envoy_types.Resource{}),
+					},
 				}},
 				new: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("v11", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
-					}),
-					Clusters: envoy_cache.NewResources("v22", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster"},
-					}),
-					Routes: envoy_cache.NewResources("v33", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration"},
-					}),
-					Listeners: envoy_cache.NewResources("v44", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener"},
-					}),
-					Secrets:  envoy_cache.Resources{Version: "v55"},
-					Runtimes: envoy_cache.NewResources("v66", []envoy_cache.Resource{}),
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("v11", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("v22", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("v33", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration"},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("v44", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener"},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{Version: "v55"},
+						envoy_types.Runtime: envoy_cache.NewResources("v66", []envoy_types.Resource{}),
+					},
 				}},
 				expected: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("v11", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
-					}),
-					Clusters: envoy_cache.NewResources("v22", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster"},
-					}),
-					Routes: envoy_cache.NewResources("v33", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration"},
-					}),
-					Listeners: envoy_cache.NewResources("v44", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener"},
-					}),
-					Secrets:  envoy_cache.Resources{Version: "v55"},
-					Runtimes: envoy_cache.NewResources("v66", []envoy_cache.Resource{}),
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("v11", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("v22", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("v33", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration"},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("v44", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener"},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{Version: "v55"},
+						envoy_types.Runtime: envoy_cache.NewResources("v66", []envoy_types.Resource{}),
+					},
 				}},
 			}),
 			Entry("when 'old' != `nil`, resources deleted, versions are empty", testCase{
 				old: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("v1", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
-					}),
-					Clusters: envoy_cache.NewResources("v2", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster"},
-					}),
-					Routes: envoy_cache.NewResources("v3", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration"},
-					}),
-					Listeners: envoy_cache.NewResources("v4", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener"},
-					}),
-					Secrets:  envoy_cache.Resources{Version: "v5"},                     // version should stay the same
-					Runtimes: envoy_cache.NewResources("v6", []envoy_cache.Resource{}), // version should stay the same
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("v1", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("v2", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("v3", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration"},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("v4", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener"},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{Version: "v5"},                     // version should stay the same
+						envoy_types.Runtime: envoy_cache.NewResources("v6", []envoy_types.Resource{}), // version should stay the same
+					},
 				}},
 				new: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("", []envoy_cache.Resource{}),
-					Clusters:  envoy_cache.NewResources("", []envoy_cache.Resource{}),
-					Routes:    envoy_cache.NewResources("", []envoy_cache.Resource{}),
-					Listeners: envoy_cache.Resources{Version: ""},
-					Secrets:   envoy_cache.Resources{Version: ""},
-					Runtimes:  envoy_cache.Resources{Version: ""},
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("", []envoy_types.Resource{}),
+						envoy_types.Cluster:  envoy_cache.NewResources("", []envoy_types.Resource{}),
+						envoy_types.Route:    envoy_cache.NewResources("", []envoy_types.Resource{}),
+						envoy_types.Listener: envoy_cache.Resources{Version: ""},
+						envoy_types.Secret:   envoy_cache.Resources{Version: ""},
+						envoy_types.Runtime:  envoy_cache.Resources{Version: ""},
+					},
 				}},
 				expected: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("101", []envoy_cache.Resource{}),
-					Clusters:  envoy_cache.NewResources("102", []envoy_cache.Resource{}),
-					Routes:    envoy_cache.NewResources("103", []envoy_cache.Resource{}),
-					Listeners: envoy_cache.Resources{Version: "104"},
-					Secrets:   envoy_cache.Resources{Version: "v5"},
-					Runtimes:  envoy_cache.Resources{Version: "v6"},
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("101", []envoy_types.Resource{}),
+						envoy_types.Cluster:  envoy_cache.NewResources("102", []envoy_types.Resource{}),
+						envoy_types.Route:    envoy_cache.NewResources("103", []envoy_types.Resource{}),
+						envoy_types.Listener: envoy_cache.Resources{Version: "104"},
+						envoy_types.Secret:   envoy_cache.Resources{Version: "v5"},
+						envoy_types.Runtime:  envoy_cache.Resources{Version: "v6"},
+					},
 				}},
 			}),
 			Entry("when 'old' != `nil`, resources added, versions are empty", testCase{
 				old: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("v1", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
-					}),
-					Clusters: envoy_cache.NewResources("v2", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster"},
-					}),
-					Routes: envoy_cache.NewResources("v3", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration"},
-					}),
-					Listeners: envoy_cache.NewResources("v4", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener"},
-					}),
-					Secrets:  envoy_cache.Resources{Version: "v5"},                     // version should stay the same
-					Runtimes: envoy_cache.NewResources("v6", []envoy_cache.Resource{}), // version should stay the same
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("v1", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("v2", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("v3", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration"},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("v4", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener"},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{Version: "v5"},                     // version should stay the same
+						envoy_types.Runtime: envoy_cache.NewResources("v6", []envoy_types.Resource{}), // version should stay the same
+					},
 				}},
 				new: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment2"},
-					}),
-					Clusters: envoy_cache.NewResources("", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster"},
-						&envoy.Cluster{Name: "Cluster2"},
-					}),
-					Routes: envoy_cache.NewResources("", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration"},
-						&envoy.RouteConfiguration{Name: "RouteConfiguration2"},
-					}),
-					Listeners: envoy_cache.NewResources("", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener"},
-						&envoy.Listener{Name: "Listener2"},
-					}),
-					Secrets:  envoy_cache.Resources{Version: ""}, // version should stay the same
-					Runtimes: envoy_cache.Resources{Version: ""}, // version should stay the same
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment2"},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster"},
+							&envoy.Cluster{Name: "Cluster2"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration"},
+							&envoy.RouteConfiguration{Name: "RouteConfiguration2"},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener"},
+							&envoy.Listener{Name: "Listener2"},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{Version: ""}, // version should stay the same
+						envoy_types.Runtime: envoy_cache.Resources{Version: ""}, // version should stay the same
+					},
 				}},
 				expected: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("101", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment2"},
-					}),
-					Clusters: envoy_cache.NewResources("102", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster"},
-						&envoy.Cluster{Name: "Cluster2"},
-					}),
-					Routes: envoy_cache.NewResources("103", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration"},
-						&envoy.RouteConfiguration{Name: "RouteConfiguration2"},
-					}),
-					Listeners: envoy_cache.NewResources("104", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener"},
-						&envoy.Listener{Name: "Listener2"},
-					}),
-					Secrets:  envoy_cache.Resources{Version: "v5"},
-					Runtimes: envoy_cache.Resources{Version: "v6"},
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("101", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment2"},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("102", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster"},
+							&envoy.Cluster{Name: "Cluster2"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("103", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration"},
+							&envoy.RouteConfiguration{Name: "RouteConfiguration2"},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("104", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener"},
+							&envoy.Listener{Name: "Listener2"},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{Version: "v5"},
+						envoy_types.Runtime: envoy_cache.Resources{Version: "v6"},
+					},
 				}},
 			}),
 			Entry("when 'old' != `nil`, resources modified, versions are empty", testCase{
 				old: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("v1", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
-					}),
-					Clusters: envoy_cache.NewResources("v2", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster"},
-					}),
-					Routes: envoy_cache.NewResources("v3", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration"},
-					}),
-					Listeners: envoy_cache.NewResources("v4", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener"},
-					}),
-					Secrets:  envoy_cache.Resources{Version: "v5"},                     // version should stay the same
-					Runtimes: envoy_cache.NewResources("v6", []envoy_cache.Resource{}), // version should stay the same
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("v1", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment"},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("v2", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("v3", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration"},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("v4", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener"},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{Version: "v5"},                     // version should stay the same
+						envoy_types.Runtime: envoy_cache.NewResources("v6", []envoy_types.Resource{}), // version should stay the same
+					},
 				}},
 				new: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment", Policy: &envoy.ClusterLoadAssignment_Policy{DisableOverprovisioning: true}},
-					}),
-					Clusters: envoy_cache.NewResources("", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster", AltStatName: "AltStatName"},
-					}),
-					Routes: envoy_cache.NewResources("", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration", MostSpecificHeaderMutationsWins: true},
-					}),
-					Listeners: envoy_cache.NewResources("", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener", ContinueOnListenerFiltersTimeout: true},
-					}),
-					Secrets:  envoy_cache.Resources{Version: ""}, // version should stay the same
-					Runtimes: envoy_cache.Resources{Version: ""}, // version should stay the same
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment", Policy: &envoy.ClusterLoadAssignment_Policy{DisableOverprovisioning: true}},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster", AltStatName: "AltStatName"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration", MostSpecificHeaderMutationsWins: true},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener", ContinueOnListenerFiltersTimeout: true},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{Version: ""}, // version should stay the same
+						envoy_types.Runtime: envoy_cache.Resources{Version: ""}, // version should stay the same
+					},
 				}},
 				expected: &SampleSnapshot{envoy_cache.Snapshot{
-					Endpoints: envoy_cache.NewResources("101", []envoy_cache.Resource{
-						&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment", Policy: &envoy.ClusterLoadAssignment_Policy{DisableOverprovisioning: true}},
-					}),
-					Clusters: envoy_cache.NewResources("102", []envoy_cache.Resource{
-						&envoy.Cluster{Name: "Cluster", AltStatName: "AltStatName"},
-					}),
-					Routes: envoy_cache.NewResources("103", []envoy_cache.Resource{
-						&envoy.RouteConfiguration{Name: "RouteConfiguration", MostSpecificHeaderMutationsWins: true},
-					}),
-					Listeners: envoy_cache.NewResources("104", []envoy_cache.Resource{
-						&envoy.Listener{Name: "Listener", ContinueOnListenerFiltersTimeout: true},
-					}),
-					Secrets:  envoy_cache.Resources{Version: "v5"},
-					Runtimes: envoy_cache.Resources{Version: "v6"},
+					Resources: [envoy_types.UnknownType]envoy_cache.Resources{
+						envoy_types.Endpoint: envoy_cache.NewResources("101", []envoy_types.Resource{
+							&envoy.ClusterLoadAssignment{ClusterName: "ClusterLoadAssignment", Policy: &envoy.ClusterLoadAssignment_Policy{DisableOverprovisioning: true}},
+						}),
+						envoy_types.Cluster: envoy_cache.NewResources("102", []envoy_types.Resource{
+							&envoy.Cluster{Name: "Cluster", AltStatName: "AltStatName"},
+						}),
+						envoy_types.Route: envoy_cache.NewResources("103", []envoy_types.Resource{
+							&envoy.RouteConfiguration{Name: "RouteConfiguration", MostSpecificHeaderMutationsWins: true},
+						}),
+						envoy_types.Listener: envoy_cache.NewResources("104", []envoy_types.Resource{
+							&envoy.Listener{Name: "Listener", ContinueOnListenerFiltersTimeout: true},
+						}),
+						envoy_types.Secret:  envoy_cache.Resources{Version: "v5"},
+						envoy_types.Runtime: envoy_cache.Resources{Version: "v6"},
+					},
 				}},
 			}),
 		)
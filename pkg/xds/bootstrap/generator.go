@@ -3,10 +3,13 @@ package bootstrap
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"text/template"
 
 	envoy_bootstrap "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v2"
+	// register FileBasedMetadataConfig that is used to read a dataplane token from a file
+	_ "github.com/envoyproxy/go-control-plane/envoy/config/grpc_credential/v2alpha"
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 
@@ -27,16 +30,20 @@ type BootstrapGenerator interface {
 
 func NewDefaultBootstrapGenerator(
 	resManager core_manager.ResourceManager,
-	config *bootstrap_config.BootstrapParamsConfig) BootstrapGenerator {
+	config *bootstrap_config.BootstrapParamsConfig,
+	xdsCert []byte) BootstrapGenerator {
 	return &bootstrapGenerator{
 		resManager: resManager,
 		config:     config,
+		xdsCert:    xdsCert,
 	}
 }
 
 type bootstrapGenerator struct {
 	resManager core_manager.ResourceManager
 	config     *bootstrap_config.BootstrapParamsConfig
+	// PEM-encoded TLS certificate of XDS server. If empty, Envoy will connect to XDS server without TLS
+	xdsCert []byte
 }
 
 func (b *bootstrapGenerator) Generate(ctx context.Context, request types.BootstrapRequest) (proto.Message, error) {
@@ -52,13 +59,20 @@ func (b *bootstrapGenerator) Generate(ctx context.Context, request types.Bootstr
 	if err != nil {
 		return nil, err
 	}
-	tracingBackend, err := b.fetchTracingBackend(ctx, dataplane)
+	mesh, err := b.fetchMesh(ctx, dataplane)
+	if err != nil {
+		return nil, err
+	}
+	tracingBackend, err := b.fetchTracingBackend(ctx, mesh, dataplane)
 	if err != nil {
 		return nil, err
 	}
 	if err := AddTracingConfig(bootstrapCfg, tracingBackend); err != nil {
 		return nil, err
 	}
+	if err := AddStatsConfig(bootstrapCfg, dataplane.GetStatsdSink(mesh)); err != nil {
+		return nil, err
+	}
 	return bootstrapCfg, nil
 }
 
@@ -66,6 +80,15 @@ func (b *bootstrapGenerator) generateFor(proxyId core_xds.ProxyId, dataplane *co
 	// if dataplane has no service - fill this with placeholder. Otherwise take the first service
 	service := dataplane.Spec.GetIdentifyingService()
 
+	var xdsApiV3 bool
+	switch request.XdsApiVersion {
+	case "", types.XdsApiVersionV2:
+	case types.XdsApiVersionV3:
+		xdsApiV3 = true
+	default:
+		return nil, errors.Errorf("unsupported xDS API version %q: must be one of %q or %q", request.XdsApiVersion, types.XdsApiVersionV2, types.XdsApiVersionV3)
+	}
+
 	adminPort := b.config.AdminPort
 	if request.AdminPort != 0 {
 		adminPort = request.AdminPort
@@ -76,12 +99,17 @@ func (b *bootstrapGenerator) generateFor(proxyId core_xds.ProxyId, dataplane *co
 		Service:            service,
 		AdminAddress:       b.config.AdminAddress,
 		AdminPort:          adminPort,
+		MetricsPort:        request.MetricsPort,
 		AdminAccessLogPath: b.config.AdminAccessLogPath,
 		XdsHost:            b.config.XdsHost,
 		XdsPort:            b.config.XdsPort,
 		XdsConnectTimeout:  b.config.XdsConnectTimeout,
 		AccessLogPipe:      accessLogPipe,
 		DataplaneTokenPath: request.DataplaneTokenPath,
+		XdsApiV3:           xdsApiV3,
+	}
+	if len(b.xdsCert) != 0 {
+		params.XdsCertBytes = base64.StdEncoding.EncodeToString(b.xdsCert)
 	}
 	log.WithValues("params", params).Info("Generating bootstrap config")
 	return b.configForParameters(params)
@@ -95,12 +123,15 @@ func (b *bootstrapGenerator) fetchDataplane(ctx context.Context, proxyId *core_x
 	return &res, nil
 }
 
-func (b *bootstrapGenerator) fetchTracingBackend(ctx context.Context, dataplane *core_mesh.DataplaneResource) (*mesh_proto.TracingBackend, error) {
+func (b *bootstrapGenerator) fetchMesh(ctx context.Context, dataplane *core_mesh.DataplaneResource) (*core_mesh.MeshResource, error) {
 	mesh := core_mesh.MeshResource{}
-	if err := b.resManager.Get(context.Background(), &mesh, core_store.GetByKey(dataplane.GetMeta().GetMesh(), dataplane.GetMeta().GetMesh())); err != nil {
+	if err := b.resManager.Get(ctx, &mesh, core_store.GetByKey(dataplane.GetMeta().GetMesh(), dataplane.GetMeta().GetMesh())); err != nil {
 		return nil, err
 	}
+	return &mesh, nil
+}
 
+func (b *bootstrapGenerator) fetchTracingBackend(ctx context.Context, mesh *core_mesh.MeshResource, dataplane *core_mesh.DataplaneResource) (*mesh_proto.TracingBackend, error) {
 	trafficTrace, err := topology.GetTrafficTrace(ctx, dataplane, b.resManager)
 	if err != nil {
 		return nil, err
@@ -78,13 +78,14 @@ var _ = Describe("bootstrapGenerator", func() {
 
 	type testCase struct {
 		config             func() *bootstrap_config.BootstrapParamsConfig
+		xdsCert            []byte
 		request            types.BootstrapRequest
 		expectedConfigFile string
 	}
 	DescribeTable("should generate bootstrap configuration",
 		func(given testCase) {
 			// setup
-			generator := NewDefaultBootstrapGenerator(resManager, given.config())
+			generator := NewDefaultBootstrapGenerator(resManager, given.config(), given.xdsCert)
 
 			// when
 			bootstrapConfig, err := generator.Generate(context.Background(), given.request)
@@ -128,6 +129,7 @@ var _ = Describe("bootstrapGenerator", func() {
 				Mesh:               "mesh",
 				Name:               "name.namespace",
 				AdminPort:          1234,
+				MetricsPort:        9902,
 				DataplaneTokenPath: "/tmp/token",
 			},
 			expectedConfigFile: "generator.default-config.golden.yaml",
@@ -168,8 +170,54 @@ var _ = Describe("bootstrapGenerator", func() {
 			},
 			expectedConfigFile: "generator.custom-config.golden.yaml",
 		}),
+		Entry("default config with dataplane token and XDS certificate", testCase{
+			config: func() *bootstrap_config.BootstrapParamsConfig {
+				cfg := bootstrap_config.DefaultBootstrapParamsConfig()
+				cfg.XdsHost = "127.0.0.1"
+				cfg.XdsPort = 5678
+				return cfg
+			},
+			xdsCert: []byte("-----BEGIN CERTIFICATE-----"),
+			request: types.BootstrapRequest{
+				Mesh:               "mesh",
+				Name:               "name.namespace",
+				DataplaneTokenPath: "/tmp/token",
+				DataplaneToken:     "sample-token",
+			},
+			expectedConfigFile: "generator.default-config-with-auth.golden.yaml",
+		}),
+		Entry("default config with xDS v3", testCase{
+			config: func() *bootstrap_config.BootstrapParamsConfig {
+				cfg := bootstrap_config.DefaultBootstrapParamsConfig()
+				cfg.XdsHost = "127.0.0.1"
+				cfg.XdsPort = 5678
+				return cfg
+			},
+			request: types.BootstrapRequest{
+				Mesh:          "mesh",
+				Name:          "name.namespace",
+				XdsApiVersion: types.XdsApiVersionV3,
+			},
+			expectedConfigFile: "generator.xds-api-v3.golden.yaml",
+		}),
 	)
 
+	It("should reject unsupported xDS API version", func() {
+		// setup
+		cfg := bootstrap_config.DefaultBootstrapParamsConfig()
+		generator := NewDefaultBootstrapGenerator(resManager, cfg, nil)
+
+		// when
+		_, err := generator.Generate(context.Background(), types.BootstrapRequest{
+			Mesh:          "mesh",
+			Name:          "name.namespace",
+			XdsApiVersion: "v4",
+		})
+
+		// then
+		Expect(err).To(MatchError(`unsupported xDS API version "v4": must be one of "v2" or "v3"`))
+	})
+
 	It("should generate bootstrap configuration with zipkin tracing", func() {
 		// setup
 		trafficTrace := mesh.TrafficTraceResource{
@@ -195,7 +243,7 @@ var _ = Describe("bootstrapGenerator", func() {
 		params.XdsHost = "127.0.0.1"
 		params.XdsPort = 5678
 
-		generator := NewDefaultBootstrapGenerator(resManager, params)
+		generator := NewDefaultBootstrapGenerator(resManager, params, nil)
 		request := types.BootstrapRequest{
 			Mesh: "mesh",
 			Name: "name.namespace",
@@ -219,4 +267,124 @@ var _ = Describe("bootstrapGenerator", func() {
 		// expect
 		Expect(actual).To(MatchYAML(expected))
 	})
+
+	It("should generate bootstrap configuration with jaeger tracing", func() {
+		// setup
+		meshRes := mesh.MeshResource{}
+		err := resManager.Get(context.Background(), &meshRes, store.GetByKey("mesh", "mesh"))
+		Expect(err).ToNot(HaveOccurred())
+		meshRes.Spec.Tracing.Backends = append(meshRes.Spec.Tracing.Backends, &mesh_proto.TracingBackend{
+			Name: "jaeger",
+			Type: &mesh_proto.TracingBackend_Jaeger_{
+				Jaeger: &mesh_proto.TracingBackend_Jaeger{
+					CollectorUrl: "http://jaeger-collector.us:9411/api/v2/spans",
+				},
+			},
+		})
+		err = resManager.Update(context.Background(), &meshRes)
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		trafficTrace := mesh.TrafficTraceResource{
+			Spec: mesh_proto.TrafficTrace{
+				Selectors: []*mesh_proto.Selector{
+					{
+						Match: map[string]string{
+							"service": "backend",
+						},
+					},
+				},
+				Conf: &mesh_proto.TrafficTrace_Conf{
+					Backend: "jaeger",
+				},
+			},
+		}
+		err = resManager.Create(context.Background(), &trafficTrace, store.CreateByKey("tt", "mesh"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// given
+		params := bootstrap_config.DefaultBootstrapParamsConfig()
+		params.XdsHost = "127.0.0.1"
+		params.XdsPort = 5678
+
+		generator := NewDefaultBootstrapGenerator(resManager, params, nil)
+		request := types.BootstrapRequest{
+			Mesh: "mesh",
+			Name: "name.namespace",
+		}
+
+		// when
+		bootstrapConfig, err := generator.Generate(context.Background(), request)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(bootstrapConfig)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "bootstrap.tracing-jaeger.yaml"))
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// expect
+		Expect(actual).To(MatchYAML(expected))
+	})
+
+	It("should generate bootstrap configuration with StatsD overridden by dataplane", func() {
+		// setup
+		meshRes := mesh.MeshResource{}
+		err := resManager.Get(context.Background(), &meshRes, store.GetByKey("mesh", "mesh"))
+		Expect(err).ToNot(HaveOccurred())
+		meshRes.Spec.Metrics = &mesh_proto.Metrics{
+			Statsd: &mesh_proto.Metrics_StatsD{
+				Address: "127.0.0.1:8125",
+				Prefix:  "kuma",
+			},
+		}
+		err = resManager.Update(context.Background(), &meshRes)
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		dataplane := mesh.DataplaneResource{}
+		err = resManager.Get(context.Background(), &dataplane, store.GetByKey("name.namespace", "mesh"))
+		Expect(err).ToNot(HaveOccurred())
+		dataplane.Spec.Metrics = &mesh_proto.Metrics{
+			Statsd: &mesh_proto.Metrics_StatsD{
+				TagFormat: "dogstatsd",
+			},
+		}
+		err = resManager.Update(context.Background(), &dataplane)
+		Expect(err).ToNot(HaveOccurred())
+
+		// given
+		params := bootstrap_config.DefaultBootstrapParamsConfig()
+		params.XdsHost = "127.0.0.1"
+		params.XdsPort = 5678
+
+		generator := NewDefaultBootstrapGenerator(resManager, params, nil)
+		request := types.BootstrapRequest{
+			Mesh: "mesh",
+			Name: "name.namespace",
+		}
+
+		// when
+		bootstrapConfig, err := generator.Generate(context.Background(), request)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(bootstrapConfig)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "bootstrap.statsd.yaml"))
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// expect
+		Expect(actual).To(MatchYAML(expected))
+	})
 })
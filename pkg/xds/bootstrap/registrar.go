@@ -0,0 +1,180 @@
+package bootstrap
+
+import (
+	"context"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+	"github.com/Kong/kuma/pkg/core/resources/model/rest"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/sds/auth"
+	"github.com/Kong/kuma/pkg/sds/auth/universal"
+	builtin_issuer "github.com/Kong/kuma/pkg/tokens/builtin/issuer"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+// DataplaneRegistrar creates, updates and deletes Dataplanes on behalf of kuma-dp,
+// so Dataplanes don't have to be applied before kuma-dp is started.
+type DataplaneRegistrar interface {
+	Register(ctx context.Context, proxyId core_xds.ProxyId, token string, dataplane *core_mesh.DataplaneResource) error
+	Unregister(ctx context.Context, proxyId core_xds.ProxyId, token string) error
+	// Drain marks all ready inbound interfaces of a Dataplane as drained and not ready, so other Dataplanes stop sending traffic to it.
+	Drain(ctx context.Context, proxyId core_xds.ProxyId, token string) error
+	// Undrain marks inbound interfaces of a Dataplane that have been drained by Drain as ready again once kuma-dp is restarted.
+	// Inbound interfaces marked as not ready by a user are left intact.
+	Undrain(ctx context.Context, proxyId core_xds.ProxyId, token string) error
+	// UpdateEnvoyStatus saves the status of the Envoy process of a Dataplane in DataplaneInsight.
+	UpdateEnvoyStatus(ctx context.Context, proxyId core_xds.ProxyId, token string, status *mesh_proto.EnvoyStatus) error
+}
+
+// UnauthorizedError means that a Dataplane token does not allow to manage a given Dataplane.
+type UnauthorizedError struct {
+	Reason string
+}
+
+func (e *UnauthorizedError) Error() string {
+	return "dataplane token does not allow to manage the Dataplane: " + e.Reason
+}
+
+func IsUnauthorized(err error) bool {
+	_, ok := errors.Cause(err).(*UnauthorizedError)
+	return ok
+}
+
+// NewDataplaneRegistrar returns a DataplaneRegistrar that authorizes requests with Dataplane tokens
+// that have not been revoked.
+// If issuer is nil, Dataplane tokens are disabled. Then Dataplanes cannot register and unregister themselves,
+// since there is no way to tell whether a request comes from the Dataplane it concerns, while drain and status
+// of existing Dataplanes are accepted the same way as bootstrap requests are.
+func NewDataplaneRegistrar(resManager core_manager.ResourceManager, issuer builtin_issuer.DataplaneTokenIssuer, revocations builtin_issuer.RevocationList) DataplaneRegistrar {
+	return &dataplaneRegistrar{
+		resManager:  resManager,
+		issuer:      issuer,
+		revocations: revocations,
+	}
+}
+
+type dataplaneRegistrar struct {
+	resManager  core_manager.ResourceManager
+	issuer      builtin_issuer.DataplaneTokenIssuer
+	revocations builtin_issuer.RevocationList
+}
+
+func (r *dataplaneRegistrar) Register(ctx context.Context, proxyId core_xds.ProxyId, token string, dataplane *core_mesh.DataplaneResource) error {
+	if err := r.authorizeWithToken(proxyId, token); err != nil {
+		return err
+	}
+	existing := &core_mesh.DataplaneResource{}
+	if err := r.resManager.Get(ctx, existing, core_store.GetBy(proxyId.ToResourceKey())); err != nil {
+		if !core_store.IsResourceNotFound(err) {
+			return err
+		}
+		return r.resManager.Create(ctx, dataplane, core_store.CreateBy(proxyId.ToResourceKey()))
+	}
+	if err := existing.SetSpec(&dataplane.Spec); err != nil {
+		return err
+	}
+	return r.resManager.Update(ctx, existing)
+}
+
+func (r *dataplaneRegistrar) Unregister(ctx context.Context, proxyId core_xds.ProxyId, token string) error {
+	if err := r.authorizeWithToken(proxyId, token); err != nil {
+		return err
+	}
+	return r.resManager.Delete(ctx, &core_mesh.DataplaneResource{}, core_store.DeleteBy(proxyId.ToResourceKey()))
+}
+
+func (r *dataplaneRegistrar) Drain(ctx context.Context, proxyId core_xds.ProxyId, token string) error {
+	if err := r.authorize(proxyId, token); err != nil {
+		return err
+	}
+	dataplane := &core_mesh.DataplaneResource{}
+	if err := r.resManager.Get(ctx, dataplane, core_store.GetBy(proxyId.ToResourceKey())); err != nil {
+		return err
+	}
+	for _, inbound := range dataplane.Spec.Networking.GetInbound() {
+		// inbound interfaces that are already not ready must stay that way once kuma-dp is restarted
+		if inbound.IsReady() {
+			inbound.Health = &mesh_proto.Dataplane_Networking_Inbound_Health{Ready: false, Drained: true}
+		}
+	}
+	return r.resManager.Update(ctx, dataplane)
+}
+
+func (r *dataplaneRegistrar) Undrain(ctx context.Context, proxyId core_xds.ProxyId, token string) error {
+	if err := r.authorize(proxyId, token); err != nil {
+		return err
+	}
+	dataplane := &core_mesh.DataplaneResource{}
+	if err := r.resManager.Get(ctx, dataplane, core_store.GetBy(proxyId.ToResourceKey())); err != nil {
+		return err
+	}
+	drained := false
+	for _, inbound := range dataplane.Spec.Networking.GetInbound() {
+		if inbound.GetHealth().GetDrained() {
+			inbound.Health = nil
+			drained = true
+		}
+	}
+	if !drained {
+		return nil
+	}
+	return r.resManager.Update(ctx, dataplane)
+}
+
+func (r *dataplaneRegistrar) UpdateEnvoyStatus(ctx context.Context, proxyId core_xds.ProxyId, token string, status *mesh_proto.EnvoyStatus) error {
+	if err := r.authorize(proxyId, token); err != nil {
+		return err
+	}
+	return core_manager.Upsert(r.resManager, ctx, proxyId.ToResourceKey(), &core_mesh.DataplaneInsightResource{}, func(resource model.Resource) {
+		insight := resource.(*core_mesh.DataplaneInsightResource)
+		insight.Spec.Envoy = status
+	})
+}
+
+// authorizeWithToken authorizes a request that is accepted only with a valid Dataplane token.
+func (r *dataplaneRegistrar) authorizeWithToken(proxyId core_xds.ProxyId, token string) error {
+	if r.issuer == nil {
+		return &UnauthorizedError{Reason: "Dataplane Token Server is disabled"}
+	}
+	return r.authorize(proxyId, token)
+}
+
+// authorize authorizes a request with a Dataplane token unless Dataplane tokens are disabled.
+func (r *dataplaneRegistrar) authorize(proxyId core_xds.ProxyId, token string) error {
+	if r.issuer == nil {
+		return nil
+	}
+	if token == "" {
+		return &UnauthorizedError{Reason: "token is missing"}
+	}
+	if err := universal.ReviewToken(r.issuer, r.revocations, proxyId, auth.Credential(token)); err != nil {
+		return &UnauthorizedError{Reason: err.Error()}
+	}
+	return nil
+}
+
+// parseDataplane parses a Dataplane resource in YAML format that is expected to have a given name and mesh.
+func parseDataplane(bytes []byte, proxyId core_xds.ProxyId) (*core_mesh.DataplaneResource, error) {
+	meta := rest.ResourceMeta{}
+	if err := yaml.Unmarshal(bytes, &meta); err != nil {
+		return nil, errors.Wrap(err, "could not parse Dataplane resource")
+	}
+	if meta.Type != string(core_mesh.DataplaneType) {
+		return nil, errors.Errorf("resource must be of type %q, got %q", core_mesh.DataplaneType, meta.Type)
+	}
+	if meta.Mesh != proxyId.Mesh || meta.Name != proxyId.Name {
+		return nil, errors.Errorf("Dataplane resource %q in mesh %q does not match Dataplane %q in mesh %q that is being started", meta.Name, meta.Mesh, proxyId.Name, proxyId.Mesh)
+	}
+	dataplane := &core_mesh.DataplaneResource{}
+	if err := util_proto.FromYAML(bytes, &dataplane.Spec); err != nil {
+		return nil, errors.Wrap(err, "could not parse Dataplane resource")
+	}
+	return dataplane, nil
+}
@@ -7,9 +7,18 @@ import (
 	"io/ioutil"
 	"net/http"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	"github.com/Kong/kuma/pkg/core"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
 	"github.com/Kong/kuma/pkg/core/resources/store"
 	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+	"github.com/Kong/kuma/pkg/core/validators"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/dns"
+	sds_auth "github.com/Kong/kuma/pkg/sds/auth"
 	"github.com/Kong/kuma/pkg/util/proto"
 	"github.com/Kong/kuma/pkg/xds/bootstrap/types"
 )
@@ -17,8 +26,19 @@ import (
 var log = core.Log.WithName("bootstrap-server")
 
 type BootstrapServer struct {
-	Port      uint32
-	Generator BootstrapGenerator
+	Port uint32
+	// TlsCertFile and TlsKeyFile define a TLS certificate of the server. If empty, the server is served in plaintext.
+	TlsCertFile string
+	TlsKeyFile  string
+	Generator   BootstrapGenerator
+	// Registrar is used to register Dataplanes on behalf of kuma-dp. If nil, self-registration is not supported.
+	Registrar DataplaneRegistrar
+	// VIPs returns virtual IPs allocated to services of a given mesh. If nil, services cannot be resolved by DNS in kuma-dp.
+	VIPs dns.LoadVIPsFunc
+	// Authenticator verifies that VIPs are requested by a Dataplane of the mesh, the same way as xDS requests are.
+	Authenticator sds_auth.Authenticator
+	// DNSDomain is a domain that services are resolved in by DNS server embedded in kuma-dp.
+	DNSDomain string
 }
 
 var _ core_runtime.Component = &BootstrapServer{}
@@ -26,6 +46,10 @@ var _ core_runtime.Component = &BootstrapServer{}
 func (b *BootstrapServer) Start(stop <-chan struct{}) error {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/bootstrap", b.handleBootstrapRequest)
+	mux.HandleFunc("/unregister", b.handleUnregisterRequest)
+	mux.HandleFunc("/drain", b.handleDrainRequest)
+	mux.HandleFunc("/envoy-status", b.handleEnvoyStatusRequest)
+	mux.HandleFunc("/vips", b.handleVIPsRequest)
 
 	bootstrapServer := &http.Server{
 		Addr:    fmt.Sprintf(":%d", b.Port),
@@ -36,7 +60,13 @@ func (b *BootstrapServer) Start(stop <-chan struct{}) error {
 
 	go func() {
 		defer close(errChan)
-		if err := bootstrapServer.ListenAndServe(); err != nil {
+		var err error
+		if b.TlsCertFile != "" {
+			err = bootstrapServer.ListenAndServeTLS(b.TlsCertFile, b.TlsKeyFile)
+		} else {
+			err = bootstrapServer.ListenAndServe()
+		}
+		if err != nil {
 			if err != http.ErrServerClosed {
 				log.Error(err, "terminated with an error")
 				errChan <- err
@@ -45,7 +75,7 @@ func (b *BootstrapServer) Start(stop <-chan struct{}) error {
 		}
 		log.Info("terminated normally")
 	}()
-	log.Info("starting", "interface", "0.0.0.0", "port", b.Port)
+	log.Info("starting", "interface", "0.0.0.0", "port", b.Port, "tls", b.TlsCertFile != "")
 
 	select {
 	case <-stop:
@@ -70,6 +100,18 @@ func (b *BootstrapServer) handleBootstrapRequest(resp http.ResponseWriter, req *
 		return
 	}
 
+	if reqParams.DataplaneResource != "" {
+		if err := b.register(req.Context(), reqParams); err != nil {
+			log.WithValues("mesh", reqParams.Mesh, "name", reqParams.Name).Error(err, "Could not register a Dataplane")
+			writeRegistrationError(resp, err)
+			return
+		}
+	} else if err := b.undrain(req.Context(), reqParams); err != nil && !store.IsResourceNotFound(err) {
+		log.WithValues("mesh", reqParams.Mesh, "name", reqParams.Name).Error(err, "Could not undrain a Dataplane")
+		writeRegistrationError(resp, err)
+		return
+	}
+
 	config, err := b.Generator.Generate(req.Context(), reqParams)
 	if err != nil {
 		if store.IsResourceNotFound(err) {
@@ -96,3 +138,252 @@ func (b *BootstrapServer) handleBootstrapRequest(resp http.ResponseWriter, req *
 		return
 	}
 }
+
+func (b *BootstrapServer) register(ctx context.Context, reqParams types.BootstrapRequest) error {
+	if b.Registrar == nil {
+		return errSelfRegistrationNotSupported
+	}
+	proxyId, err := core_xds.BuildProxyId(reqParams.Mesh, reqParams.Name)
+	if err != nil {
+		return &badRequestError{err}
+	}
+	dataplane, err := parseDataplane([]byte(reqParams.DataplaneResource), *proxyId)
+	if err != nil {
+		return &badRequestError{err}
+	}
+	return b.Registrar.Register(ctx, *proxyId, reqParams.DataplaneToken, dataplane)
+}
+
+// undrain reverts drain of a Dataplane that has been drained before kuma-dp was restarted.
+// It is requested only once per kuma-dp process, so later bootstrap requests do not undo a drain that is in progress.
+// Dataplane registered from a resource template does not need it since its inbound interfaces are overwritten anyway.
+func (b *BootstrapServer) undrain(ctx context.Context, reqParams types.BootstrapRequest) error {
+	if !reqParams.Undrain || b.Registrar == nil {
+		return nil
+	}
+	proxyId, err := core_xds.BuildProxyId(reqParams.Mesh, reqParams.Name)
+	if err != nil {
+		return &badRequestError{err}
+	}
+	return b.Registrar.Undrain(ctx, *proxyId, reqParams.DataplaneToken)
+}
+
+func (b *BootstrapServer) handleUnregisterRequest(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		resp.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	bytes, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		log.Error(err, "Could not read a request")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	reqParams := types.UnregisterRequest{}
+	if err := json.Unmarshal(bytes, &reqParams); err != nil {
+		log.Error(err, "Could not parse a request")
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := b.unregister(req.Context(), reqParams); err != nil {
+		log.WithValues("mesh", reqParams.Mesh, "name", reqParams.Name).Error(err, "Could not unregister a Dataplane")
+		writeRegistrationError(resp, err)
+		return
+	}
+	resp.WriteHeader(http.StatusOK)
+}
+
+func (b *BootstrapServer) unregister(ctx context.Context, reqParams types.UnregisterRequest) error {
+	if b.Registrar == nil {
+		return errSelfRegistrationNotSupported
+	}
+	proxyId, err := core_xds.BuildProxyId(reqParams.Mesh, reqParams.Name)
+	if err != nil {
+		return &badRequestError{err}
+	}
+	return b.Registrar.Unregister(ctx, *proxyId, reqParams.DataplaneToken)
+}
+
+func (b *BootstrapServer) handleDrainRequest(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		resp.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	bytes, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		log.Error(err, "Could not read a request")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	reqParams := types.DrainRequest{}
+	if err := json.Unmarshal(bytes, &reqParams); err != nil {
+		log.Error(err, "Could not parse a request")
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := b.drain(req.Context(), reqParams); err != nil {
+		log.WithValues("mesh", reqParams.Mesh, "name", reqParams.Name).Error(err, "Could not drain a Dataplane")
+		writeRegistrationError(resp, err)
+		return
+	}
+	log.Info("Dataplane is draining", "mesh", reqParams.Mesh, "name", reqParams.Name)
+	resp.WriteHeader(http.StatusOK)
+}
+
+func (b *BootstrapServer) drain(ctx context.Context, reqParams types.DrainRequest) error {
+	if b.Registrar == nil {
+		return errDrainNotSupported
+	}
+	proxyId, err := core_xds.BuildProxyId(reqParams.Mesh, reqParams.Name)
+	if err != nil {
+		return &badRequestError{err}
+	}
+	return b.Registrar.Drain(ctx, *proxyId, reqParams.DataplaneToken)
+}
+
+func (b *BootstrapServer) handleEnvoyStatusRequest(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		resp.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	bytes, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		log.Error(err, "Could not read a request")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	reqParams := types.EnvoyStatusRequest{}
+	if err := json.Unmarshal(bytes, &reqParams); err != nil {
+		log.Error(err, "Could not parse a request")
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := b.updateEnvoyStatus(req.Context(), reqParams); err != nil {
+		log.WithValues("mesh", reqParams.Mesh, "name", reqParams.Name).Error(err, "Could not update status of Envoy")
+		writeRegistrationError(resp, err)
+		return
+	}
+	if reqParams.CrashLooping {
+		log.Info("Envoy is crash looping", "mesh", reqParams.Mesh, "name", reqParams.Name, "restarts", reqParams.Restarts, "reason", reqParams.LastRestartReason)
+	}
+	resp.WriteHeader(http.StatusOK)
+}
+
+func (b *BootstrapServer) updateEnvoyStatus(ctx context.Context, reqParams types.EnvoyStatusRequest) error {
+	if b.Registrar == nil {
+		return errEnvoyStatusNotSupported
+	}
+	proxyId, err := core_xds.BuildProxyId(reqParams.Mesh, reqParams.Name)
+	if err != nil {
+		return &badRequestError{err}
+	}
+	lastRestartTime, err := ptypes.TimestampProto(reqParams.LastRestartTime)
+	if err != nil {
+		return &badRequestError{err}
+	}
+	return b.Registrar.UpdateEnvoyStatus(ctx, *proxyId, reqParams.DataplaneToken, &mesh_proto.EnvoyStatus{
+		Restarts:          reqParams.Restarts,
+		LastRestartTime:   lastRestartTime,
+		LastRestartReason: reqParams.LastRestartReason,
+		CrashLooping:      reqParams.CrashLooping,
+	})
+}
+
+func (b *BootstrapServer) handleVIPsRequest(resp http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		resp.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	bytes, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		log.Error(err, "Could not read a request")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	reqParams := types.VIPsRequest{}
+	if err := json.Unmarshal(bytes, &reqParams); err != nil {
+		log.Error(err, "Could not parse a request")
+		resp.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	vips, err := b.vips(req.Context(), reqParams)
+	if err != nil {
+		log.WithValues("mesh", reqParams.Mesh).Error(err, "Could not retrieve VIPs")
+		writeRegistrationError(resp, err)
+		return
+	}
+	bytes, err = json.Marshal(types.VIPsResponse{
+		Domain: b.DNSDomain,
+		VIPs:   vips,
+	})
+	if err != nil {
+		log.WithValues("mesh", reqParams.Mesh).Error(err, "Could not convert to json")
+		resp.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	resp.Header().Set("content-type", "application/json")
+	resp.WriteHeader(http.StatusOK)
+	if _, err := resp.Write(bytes); err != nil {
+		log.WithValues("mesh", reqParams.Mesh).Error(err, "Error while writing the response")
+	}
+}
+
+func (b *BootstrapServer) vips(ctx context.Context, reqParams types.VIPsRequest) (dns.VIPList, error) {
+	if b.VIPs == nil || b.Authenticator == nil {
+		return nil, errVIPsNotSupported
+	}
+	proxyId, err := core_xds.BuildProxyId(reqParams.Mesh, reqParams.Name)
+	if err != nil {
+		return nil, &badRequestError{err}
+	}
+	if _, err := b.Authenticator.Authenticate(ctx, *proxyId, sds_auth.Credential(reqParams.DataplaneToken)); err != nil {
+		return nil, &UnauthorizedError{Reason: err.Error()}
+	}
+	return b.VIPs(ctx, proxyId.Mesh)
+}
+
+var errVIPsNotSupported = &notSupportedError{errors.New("DNS resolution of services is not supported by the Control Plane in this environment")}
+
+var errEnvoyStatusNotSupported = &notSupportedError{errors.New("status of Envoy cannot be reported to the Control Plane in this environment")}
+
+var errDrainNotSupported = &notSupportedError{errors.New("draining of Dataplanes is not supported by the Control Plane in this environment")}
+
+var errSelfRegistrationNotSupported = &notSupportedError{errors.New("self-registration of Dataplanes is not supported by the Control Plane in this environment")}
+
+type badRequestError struct {
+	err error
+}
+
+func (e *badRequestError) Error() string {
+	return e.err.Error()
+}
+
+// notSupportedError means that a request cannot be handled by the Control Plane in its environment,
+// e.g. Dataplanes on Kubernetes are managed by the Control Plane itself.
+type notSupportedError struct {
+	err error
+}
+
+func (e *notSupportedError) Error() string {
+	return e.err.Error()
+}
+
+func writeRegistrationError(resp http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch cause := errors.Cause(err).(type) {
+	case *badRequestError, *validators.ValidationError, *core_manager.MeshNotFoundError:
+		status = http.StatusBadRequest
+	case *notSupportedError:
+		status = http.StatusNotImplemented
+	case *UnauthorizedError:
+		status = http.StatusForbidden
+	default:
+		if store.IsResourceNotFound(cause) {
+			status = http.StatusNotFound
+		}
+	}
+	resp.WriteHeader(status)
+	if _, err := resp.Write([]byte(err.Error())); err != nil {
+		log.Error(err, "Error while writing the response")
+	}
+}
@@ -2,11 +2,14 @@ package bootstrap
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -17,8 +20,18 @@ import (
 	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	"github.com/Kong/kuma/pkg/core/resources/manager"
 	"github.com/Kong/kuma/pkg/core/resources/store"
+	"github.com/Kong/kuma/pkg/core/secrets/cipher"
+	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
+	secret_store "github.com/Kong/kuma/pkg/core/secrets/store"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/dns"
 	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+	"github.com/Kong/kuma/pkg/sds/auth"
+	"github.com/Kong/kuma/pkg/sds/auth/universal"
 	"github.com/Kong/kuma/pkg/test"
+	builtin_issuer "github.com/Kong/kuma/pkg/tokens/builtin/issuer"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	"github.com/Kong/kuma/pkg/xds/bootstrap/types"
 )
 
 var _ = Describe("Bootstrap Server", func() {
@@ -27,9 +40,13 @@ var _ = Describe("Bootstrap Server", func() {
 	var resManager manager.ResourceManager
 	var config *bootstrap_config.BootstrapParamsConfig
 	var baseUrl string
+	var tokenIssuer builtin_issuer.DataplaneTokenIssuer
+	var revocations builtin_issuer.RevocationList
 
 	BeforeEach(func() {
-		resManager = manager.NewResourceManager(memory.NewStore())
+		resStore := memory.NewStore()
+		resManager = manager.NewResourceManager(resStore)
+		revocations = builtin_issuer.NewRevocationList(secret_manager.NewSecretManager(secret_store.NewSecretStore(resStore), cipher.None()))
 		config = bootstrap_config.DefaultBootstrapParamsConfig()
 		config.XdsHost = "127.0.0.1"
 		config.XdsPort = 5678
@@ -37,9 +54,25 @@ var _ = Describe("Bootstrap Server", func() {
 		port, err := test.GetFreePort()
 		baseUrl = "http://localhost:" + strconv.Itoa(port)
 		Expect(err).ToNot(HaveOccurred())
+		tokenIssuer = builtin_issuer.NewDataplaneTokenIssuer([]byte("signing-key"))
 		server := BootstrapServer{
 			Port:      uint32(port),
-			Generator: NewDefaultBootstrapGenerator(resManager, config),
+			Generator: NewDefaultBootstrapGenerator(resManager, config, nil),
+			Registrar: NewDataplaneRegistrar(resManager, tokenIssuer, revocations),
+			VIPs: func(_ context.Context, mesh string) (dns.VIPList, error) {
+				if mesh != "default" {
+					return dns.VIPList{}, nil
+				}
+				return dns.VIPList{
+					"backend": "240.0.0.1",
+				}, nil
+			},
+			Authenticator: universal.NewAuthenticator(tokenIssuer, revocations, func(ctx context.Context, proxyId core_xds.ProxyId) (*mesh.DataplaneResource, error) {
+				dataplane := &mesh.DataplaneResource{}
+				err := resManager.Get(ctx, dataplane, store.GetBy(proxyId.ToResourceKey()))
+				return dataplane, err
+			}),
+			DNSDomain: "mesh",
 		}
 		stop = make(chan struct{})
 		go func() {
@@ -141,4 +174,376 @@ var _ = Describe("Bootstrap Server", func() {
 		Expect(resp.Body.Close()).To(Succeed())
 		Expect(resp.StatusCode).To(Equal(404))
 	})
+
+	Describe("self-registration", func() {
+
+		dataplaneResource := "type: Dataplane\nmesh: default\nname: dp-1\nnetworking:\n  address: 192.168.0.1\n  inbound:\n  - port: 8080\n    servicePort: 80\n    tags:\n      service: backend\n"
+
+		tokenFor := func(mesh, name string) string {
+			token, err := tokenIssuer.Generate(core_xds.ProxyId{Mesh: mesh, Name: name}, 0)
+			Expect(err).ToNot(HaveOccurred())
+			return string(token)
+		}
+
+		bootstrapRequest := func(name, token, resource string) string {
+			body, err := json.Marshal(types.BootstrapRequest{
+				Mesh:              "default",
+				Name:              name,
+				DataplaneToken:    token,
+				DataplaneResource: resource,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			return string(body)
+		}
+
+		post := func(path string, body string) (int, string) {
+			resp, err := http.Post(baseUrl+path, "application/json", strings.NewReader(body))
+			Expect(err).ToNot(HaveOccurred())
+			received, err := ioutil.ReadAll(resp.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Body.Close()).To(Succeed())
+			return resp.StatusCode, string(received)
+		}
+
+		It("should create and then update a Dataplane", func() {
+			// when
+			status, _ := post("/bootstrap", bootstrapRequest("dp-1", tokenFor("default", "dp-1"), dataplaneResource))
+
+			// then
+			Expect(status).To(Equal(http.StatusOK))
+			dataplane := &mesh.DataplaneResource{}
+			Expect(resManager.Get(context.Background(), dataplane, store.GetByKey("dp-1", "default"))).To(Succeed())
+			Expect(dataplane.Spec.Networking.Address).To(Equal("192.168.0.1"))
+
+			// when
+			updated := strings.Replace(dataplaneResource, "192.168.0.1", "192.168.0.2", 1)
+			status, _ = post("/bootstrap", bootstrapRequest("dp-1", tokenFor("default", "dp-1"), updated))
+
+			// then
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(resManager.Get(context.Background(), dataplane, store.GetByKey("dp-1", "default"))).To(Succeed())
+			Expect(dataplane.Spec.Networking.Address).To(Equal("192.168.0.2"))
+		})
+
+		It("should reject a token issued for a different Dataplane", func() {
+			// when
+			status, body := post("/bootstrap", bootstrapRequest("dp-1", tokenFor("default", "dp-2"), dataplaneResource))
+
+			// then
+			Expect(status).To(Equal(http.StatusForbidden))
+			Expect(body).To(Equal("dataplane token does not allow to manage the Dataplane: proxy name from requestor: dp-1 is different than in token: dp-2"))
+			// and
+			err := resManager.Get(context.Background(), &mesh.DataplaneResource{}, store.GetByKey("dp-1", "default"))
+			Expect(store.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("should reject a revoked token", func() {
+			// given
+			token := tokenFor("default", "dp-1")
+			validToken, err := tokenIssuer.Validate(auth.Credential(token))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revocations.Revoke(validToken.ProxyId.Mesh, validToken.Id, validToken.ExpiresAt)).To(Succeed())
+
+			// when
+			status, body := post("/bootstrap", bootstrapRequest("dp-1", token, dataplaneResource))
+
+			// then
+			Expect(status).To(Equal(http.StatusForbidden))
+			Expect(body).To(Equal(fmt.Sprintf("dataplane token does not allow to manage the Dataplane: token %s has been revoked", validToken.Id)))
+			// and
+			err = resManager.Get(context.Background(), &mesh.DataplaneResource{}, store.GetByKey("dp-1", "default"))
+			Expect(store.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("should reject a Dataplane resource with a different name", func() {
+			// when
+			status, body := post("/bootstrap", bootstrapRequest("dp-2", tokenFor("default", "dp-2"), dataplaneResource))
+
+			// then
+			Expect(status).To(Equal(http.StatusBadRequest))
+			Expect(body).To(Equal(`Dataplane resource "dp-1" in mesh "default" does not match Dataplane "dp-2" in mesh "default" that is being started`))
+		})
+
+		It("should reject an invalid Dataplane resource", func() {
+			// when
+			invalid := strings.Replace(dataplaneResource, "      service: backend\n", "      version: v1\n", 1)
+			status, body := post("/bootstrap", bootstrapRequest("dp-1", tokenFor("default", "dp-1"), invalid))
+
+			// then
+			Expect(status).To(Equal(http.StatusBadRequest))
+			Expect(body).To(ContainSubstring(`tag has to exist`))
+		})
+
+		It("should delete a Dataplane on unregister", func() {
+			// given
+			status, _ := post("/bootstrap", bootstrapRequest("dp-1", tokenFor("default", "dp-1"), dataplaneResource))
+			Expect(status).To(Equal(http.StatusOK))
+
+			// when
+			body, err := json.Marshal(types.UnregisterRequest{
+				Mesh:           "default",
+				Name:           "dp-1",
+				DataplaneToken: tokenFor("default", "dp-1"),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			status, _ = post("/unregister", string(body))
+
+			// then
+			Expect(status).To(Equal(http.StatusOK))
+			err = resManager.Get(context.Background(), &mesh.DataplaneResource{}, store.GetByKey("dp-1", "default"))
+			Expect(store.IsResourceNotFound(err)).To(BeTrue())
+		})
+
+		It("should mark inbound interfaces of a Dataplane as not ready on drain", func() {
+			// given
+			status, _ := post("/bootstrap", bootstrapRequest("dp-1", tokenFor("default", "dp-1"), dataplaneResource))
+			Expect(status).To(Equal(http.StatusOK))
+
+			// when
+			body, err := json.Marshal(types.DrainRequest{
+				Mesh:           "default",
+				Name:           "dp-1",
+				DataplaneToken: tokenFor("default", "dp-1"),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			status, _ = post("/drain", string(body))
+
+			// then
+			Expect(status).To(Equal(http.StatusOK))
+			dataplane := &mesh.DataplaneResource{}
+			Expect(resManager.Get(context.Background(), dataplane, store.GetByKey("dp-1", "default"))).To(Succeed())
+			Expect(dataplane.Spec.Networking.Inbound).To(HaveLen(1))
+			Expect(dataplane.Spec.Networking.Inbound[0].IsReady()).To(BeFalse())
+		})
+
+		It("should return 404 on drain of a Dataplane that does not exist", func() {
+			// when
+			body, err := json.Marshal(types.DrainRequest{
+				Mesh:           "default",
+				Name:           "dp-1",
+				DataplaneToken: tokenFor("default", "dp-1"),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			status, _ := post("/drain", string(body))
+
+			// then
+			Expect(status).To(Equal(http.StatusNotFound))
+		})
+
+		Describe("undrain", func() {
+
+			twoInbounds := "type: Dataplane\nmesh: default\nname: dp-1\nnetworking:\n  address: 192.168.0.1\n  inbound:\n  - port: 8080\n    servicePort: 80\n    tags:\n      service: backend\n  - port: 8081\n    servicePort: 81\n    health:\n      ready: false\n    tags:\n      service: backend-admin\n"
+
+			startRequest := func(undrain bool) string {
+				body, err := json.Marshal(types.BootstrapRequest{
+					Mesh:           "default",
+					Name:           "dp-1",
+					DataplaneToken: tokenFor("default", "dp-1"),
+					Undrain:        undrain,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				return string(body)
+			}
+
+			var dataplane *mesh.DataplaneResource
+
+			BeforeEach(func() {
+				// given Dataplane that is applied rather than registered from a template
+				// with an inbound interface that has been marked as not ready by a user
+				var err error
+				dataplane, err = parseDataplane([]byte(twoInbounds), core_xds.ProxyId{Mesh: "default", Name: "dp-1"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resManager.Create(context.Background(), dataplane, store.CreateByKey("dp-1", "default"))).To(Succeed())
+				// and kuma-dp that has been drained on shutdown
+				body, err := json.Marshal(types.DrainRequest{
+					Mesh:           "default",
+					Name:           "dp-1",
+					DataplaneToken: tokenFor("default", "dp-1"),
+				})
+				Expect(err).ToNot(HaveOccurred())
+				status, _ := post("/drain", string(body))
+				Expect(status).To(Equal(http.StatusOK))
+				Expect(resManager.Get(context.Background(), dataplane, store.GetByKey("dp-1", "default"))).To(Succeed())
+				Expect(dataplane.Spec.Networking.Inbound[0].IsReady()).To(BeFalse())
+				Expect(dataplane.Spec.Networking.Inbound[0].Health.Drained).To(BeTrue())
+				Expect(dataplane.Spec.Networking.Inbound[1].IsReady()).To(BeFalse())
+				Expect(dataplane.Spec.Networking.Inbound[1].Health.Drained).To(BeFalse())
+			})
+
+			It("should mark drained inbound interfaces as ready once kuma-dp is restarted", func() {
+				// when kuma-dp is started again
+				status, _ := post("/bootstrap", startRequest(true))
+
+				// then
+				Expect(status).To(Equal(http.StatusOK))
+				Expect(resManager.Get(context.Background(), dataplane, store.GetByKey("dp-1", "default"))).To(Succeed())
+				Expect(dataplane.Spec.Networking.Inbound[0].Health).To(BeNil())
+				// and inbound interface marked as not ready by a user is left intact
+				Expect(dataplane.Spec.Networking.Inbound[1].IsReady()).To(BeFalse())
+			})
+
+			It("should not undrain inbound interfaces on subsequent bootstrap requests of kuma-dp", func() {
+				// when Envoy is hot restarted by kuma-dp that is being drained
+				status, _ := post("/bootstrap", startRequest(false))
+
+				// then
+				Expect(status).To(Equal(http.StatusOK))
+				Expect(resManager.Get(context.Background(), dataplane, store.GetByKey("dp-1", "default"))).To(Succeed())
+				Expect(dataplane.Spec.Networking.Inbound[0].IsReady()).To(BeFalse())
+				Expect(dataplane.Spec.Networking.Inbound[1].IsReady()).To(BeFalse())
+			})
+		})
+
+		It("should save status of Envoy in DataplaneInsight", func() {
+			// given
+			status, _ := post("/bootstrap", bootstrapRequest("dp-1", tokenFor("default", "dp-1"), dataplaneResource))
+			Expect(status).To(Equal(http.StatusOK))
+
+			// when
+			body, err := json.Marshal(types.EnvoyStatusRequest{
+				Mesh:              "default",
+				Name:              "dp-1",
+				DataplaneToken:    tokenFor("default", "dp-1"),
+				Restarts:          3,
+				LastRestartTime:   time.Date(2020, 3, 20, 10, 0, 0, 0, time.UTC),
+				LastRestartReason: "exit status 1",
+				CrashLooping:      true,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			status, _ = post("/envoy-status", string(body))
+
+			// then
+			Expect(status).To(Equal(http.StatusOK))
+			insight := &mesh.DataplaneInsightResource{}
+			Expect(resManager.Get(context.Background(), insight, store.GetByKey("dp-1", "default"))).To(Succeed())
+			actual, err := util_proto.ToYAML(insight.Spec.Envoy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(`
+            restarts: 3
+            lastRestartTime: "2020-03-20T10:00:00Z"
+            lastRestartReason: exit status 1
+            crashLooping: true
+`))
+		})
+
+		It("should reject status of Envoy with a token issued for a different Dataplane", func() {
+			// when
+			body, err := json.Marshal(types.EnvoyStatusRequest{
+				Mesh:           "default",
+				Name:           "dp-1",
+				DataplaneToken: tokenFor("default", "dp-2"),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			status, _ := post("/envoy-status", string(body))
+
+			// then
+			Expect(status).To(Equal(http.StatusForbidden))
+		})
+
+		It("should reject self-registration but accept drain and status when Dataplane tokens are disabled", func() {
+			// given
+			registrar := NewDataplaneRegistrar(resManager, nil, nil)
+			proxyId := core_xds.ProxyId{Mesh: "default", Name: "dp-1"}
+			dataplane, err := parseDataplane([]byte(dataplaneResource), proxyId)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = registrar.Register(context.Background(), proxyId, "", dataplane)
+			// then
+			Expect(IsUnauthorized(err)).To(BeTrue())
+			err = resManager.Get(context.Background(), &mesh.DataplaneResource{}, store.GetByKey("dp-1", "default"))
+			Expect(store.IsResourceNotFound(err)).To(BeTrue())
+
+			// when
+			err = registrar.Unregister(context.Background(), proxyId, "")
+			// then
+			Expect(IsUnauthorized(err)).To(BeTrue())
+
+			// given Dataplane applied by a user
+			Expect(resManager.Create(context.Background(), dataplane, store.CreateBy(proxyId.ToResourceKey()))).To(Succeed())
+
+			// when
+			err = registrar.Drain(context.Background(), proxyId, "")
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = registrar.Undrain(context.Background(), proxyId, "")
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = registrar.UpdateEnvoyStatus(context.Background(), proxyId, "", &mesh_proto.EnvoyStatus{})
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("VIPs", func() {
+
+		post := func(body string) (int, string) {
+			resp, err := http.Post(baseUrl+"/vips", "application/json", strings.NewReader(body))
+			Expect(err).ToNot(HaveOccurred())
+			received, err := ioutil.ReadAll(resp.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Body.Close()).To(Succeed())
+			return resp.StatusCode, string(received)
+		}
+
+		vipsRequest := func(mesh, name, token string) string {
+			body, err := json.Marshal(types.VIPsRequest{
+				Mesh:           mesh,
+				Name:           name,
+				DataplaneToken: token,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			return string(body)
+		}
+
+		tokenFor := func(mesh, name string) string {
+			token, err := tokenIssuer.Generate(core_xds.ProxyId{Mesh: mesh, Name: name}, 0)
+			Expect(err).ToNot(HaveOccurred())
+			return string(token)
+		}
+
+		BeforeEach(func() {
+			dataplane, err := parseDataplane([]byte("type: Dataplane\nmesh: default\nname: dp-1\nnetworking:\n  address: 192.168.0.1\n  inbound:\n  - port: 8080\n    servicePort: 80\n    tags:\n      service: web\n"), core_xds.ProxyId{Mesh: "default", Name: "dp-1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resManager.Create(context.Background(), dataplane, store.CreateByKey("dp-1", "default"))).To(Succeed())
+		})
+
+		It("should return VIPs of services of the mesh of a Dataplane", func() {
+			// when
+			status, body := post(vipsRequest("default", "dp-1", tokenFor("default", "dp-1")))
+
+			// then
+			Expect(status).To(Equal(http.StatusOK))
+			Expect(body).To(MatchJSON(`{"domain": "mesh", "vips": {"backend": "240.0.0.1"}}`))
+		})
+
+		It("should reject a request without a valid token", func() {
+			// when
+			status, _ := post(vipsRequest("default", "dp-1", ""))
+
+			// then
+			Expect(status).To(Equal(http.StatusForbidden))
+		})
+
+		It("should reject a request for VIPs of another mesh", func() {
+			// when
+			status, body := post(vipsRequest("other", "dp-1", tokenFor("default", "dp-1")))
+
+			// then
+			Expect(status).To(Equal(http.StatusForbidden))
+			Expect(body).To(Equal("dataplane token does not allow to manage the Dataplane: proxy mesh from requestor: other is different than in token: default"))
+		})
+
+		It("should reject a request without a name", func() {
+			// when
+			status, _ := post(vipsRequest("default", "", ""))
+
+			// then
+			Expect(status).To(Equal(http.StatusBadRequest))
+		})
+	})
 })
@@ -0,0 +1,91 @@
+package bootstrap
+
+import (
+	"net"
+	"strconv"
+
+	envoy_api_v2_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_bootstrap "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v2"
+	envoy_config_metrics_v2 "github.com/envoyproxy/go-control-plane/envoy/config/metrics/v2"
+	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+)
+
+const (
+	statsdTagFormatNone      = "none"
+	statsdTagFormatDogStatsD = "dogstatsd"
+)
+
+func AddStatsConfig(bootstrap *envoy_bootstrap.Bootstrap, statsd *mesh_proto.Metrics_StatsD) error {
+	if statsd == nil {
+		return nil
+	}
+	address, err := statsdAddress(statsd.Address)
+	if err != nil {
+		return err
+	}
+
+	var name string
+	var sink proto.Message
+	switch statsd.TagFormat {
+	case "", statsdTagFormatNone:
+		name = envoy_wellknown.Statsd
+		sink = &envoy_config_metrics_v2.StatsdSink{
+			StatsdSpecifier: &envoy_config_metrics_v2.StatsdSink_Address{
+				Address: address,
+			},
+			Prefix: statsd.Prefix,
+		}
+	case statsdTagFormatDogStatsD:
+		name = envoy_wellknown.DogStatsd
+		sink = &envoy_config_metrics_v2.DogStatsdSink{
+			DogStatsdSpecifier: &envoy_config_metrics_v2.DogStatsdSink_Address{
+				Address: address,
+			},
+			Prefix: statsd.Prefix,
+		}
+	default:
+		return errors.Errorf("unsupported StatsD tag format %q: must be one of %q or %q", statsd.TagFormat, statsdTagFormatNone, statsdTagFormatDogStatsD)
+	}
+	sinkAny, err := ptypes.MarshalAny(sink)
+	if err != nil {
+		return err
+	}
+	bootstrap.StatsSinks = append(bootstrap.StatsSinks, &envoy_config_metrics_v2.StatsSink{
+		Name: name,
+		ConfigType: &envoy_config_metrics_v2.StatsSink_TypedConfig{
+			TypedConfig: sinkAny,
+		},
+	})
+	return nil
+}
+
+// statsdAddress converts IP:PORT into a UDP address. Envoy does not resolve hostnames of StatsD sinks.
+func statsdAddress(address string) (*envoy_api_v2_core.Address, error) {
+	host, portValue, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid address of StatsD")
+	}
+	if net.ParseIP(host) == nil {
+		return nil, errors.Errorf("invalid address of StatsD: %q is not an IP address", host)
+	}
+	port, err := strconv.ParseUint(portValue, 10, 32)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid address of StatsD")
+	}
+	return &envoy_api_v2_core.Address{
+		Address: &envoy_api_v2_core.Address_SocketAddress{
+			SocketAddress: &envoy_api_v2_core.SocketAddress{
+				Protocol: envoy_api_v2_core.SocketAddress_UDP,
+				Address:  host,
+				PortSpecifier: &envoy_api_v2_core.SocketAddress_PortValue{
+					PortValue: uint32(port),
+				},
+			},
+		},
+	}, nil
+}
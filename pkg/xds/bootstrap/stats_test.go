@@ -0,0 +1,118 @@
+package bootstrap
+
+import (
+	envoy_config_bootstrap_v2 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Bootstrap Stats", func() {
+
+	type testCase struct {
+		statsd       *mesh_proto.Metrics_StatsD
+		expectedYAML string
+	}
+
+	DescribeTable("should enrich bootstrap config with stats sinks",
+		func(given testCase) {
+			// given
+			bootstrap := &envoy_config_bootstrap_v2.Bootstrap{}
+
+			// when
+			err := AddStatsConfig(bootstrap, given.statsd)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// and
+			actual, err := util_proto.ToYAML(bootstrap)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// and
+			Expect(actual).To(MatchYAML(given.expectedYAML))
+		},
+		Entry("statsd disabled", testCase{
+			statsd:       nil,
+			expectedYAML: `{}`,
+		}),
+		Entry("statsd with default tag format", testCase{
+			statsd: &mesh_proto.Metrics_StatsD{
+				Address: "127.0.0.1:8125",
+			},
+			expectedYAML: `
+                statsSinks:
+                - name: envoy.statsd
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.config.metrics.v2.StatsdSink
+                    address:
+                      socketAddress:
+                        address: 127.0.0.1
+                        portValue: 8125
+                        protocol: UDP
+`,
+		}),
+		Entry("statsd with prefix and no tags", testCase{
+			statsd: &mesh_proto.Metrics_StatsD{
+				Address:   "10.0.0.1:9125",
+				Prefix:    "kuma",
+				TagFormat: "none",
+			},
+			expectedYAML: `
+                statsSinks:
+                - name: envoy.statsd
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.config.metrics.v2.StatsdSink
+                    address:
+                      socketAddress:
+                        address: 10.0.0.1
+                        portValue: 9125
+                        protocol: UDP
+                    prefix: kuma
+`,
+		}),
+		Entry("dogstatsd", testCase{
+			statsd: &mesh_proto.Metrics_StatsD{
+				Address:   "127.0.0.1:8125",
+				TagFormat: "dogstatsd",
+			},
+			expectedYAML: `
+                statsSinks:
+                - name: envoy.dog_statsd
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.config.metrics.v2.DogStatsdSink
+                    address:
+                      socketAddress:
+                        address: 127.0.0.1
+                        portValue: 8125
+                        protocol: UDP
+`,
+		}),
+	)
+
+	DescribeTable("should reject invalid StatsD config",
+		func(statsd *mesh_proto.Metrics_StatsD, expectedErr string) {
+			// given
+			bootstrap := &envoy_config_bootstrap_v2.Bootstrap{}
+
+			// when
+			err := AddStatsConfig(bootstrap, statsd)
+
+			// then
+			Expect(err).To(MatchError(expectedErr))
+		},
+		Entry("hostname instead of IP",
+			&mesh_proto.Metrics_StatsD{Address: "datadog-agent:8125"},
+			`invalid address of StatsD: "datadog-agent" is not an IP address`,
+		),
+		Entry("unknown tag format",
+			&mesh_proto.Metrics_StatsD{Address: "127.0.0.1:8125", TagFormat: "influxdb"},
+			`unsupported StatsD tag format "influxdb": must be one of "none" or "dogstatsd"`,
+		),
+	)
+})
@@ -7,12 +7,15 @@ type configParameters struct {
 	Service            string
 	AdminAddress       string
 	AdminPort          uint32
+	MetricsPort        uint32
 	AdminAccessLogPath string
 	XdsHost            string
 	XdsPort            uint32
 	XdsConnectTimeout  time.Duration
 	AccessLogPipe      string
 	DataplaneTokenPath string
+	XdsCertBytes       string
+	XdsApiV3           bool
 }
 
 const configTemplate string = `
@@ -26,6 +29,9 @@ node:
 {{if .AdminPort }}
     dataplane.admin.port: "{{ .AdminPort }}"
 {{ end }}
+{{if .MetricsPort }}
+    dataplane.metrics.port: "{{ .MetricsPort }}"
+{{ end }}
 
 {{if .AdminPort }}
 admin:
@@ -49,21 +55,60 @@ stats_config:
     regex: '((.+?)\.)rbac\.'
 
 dynamic_resources:
+{{if .XdsApiV3}}
+  lds_config:
+    ads: {}
+    resource_api_version: V3
+  cds_config:
+    ads: {}
+    resource_api_version: V3
+  ads_config:
+    api_type: GRPC
+    transport_api_version: V3
+{{else}}
   lds_config: {ads: {}}
   cds_config: {ads: {}}
   ads_config:
     api_type: GRPC
+{{end}}
     grpc_services:
+{{if and .DataplaneTokenPath .XdsCertBytes}}
+    # dataplane token is read from a file, so it never ends up in the config and can be rotated
+    - google_grpc:
+        target_uri: {{ .XdsHost }}:{{ .XdsPort }}
+        stat_prefix: ads
+        channel_credentials:
+          ssl_credentials:
+            root_certs:
+              inline_bytes: {{ .XdsCertBytes }}
+        call_credentials:
+        - from_plugin:
+            name: envoy.grpc_credentials.file_based_metadata
+            typed_config:
+              '@type': type.googleapis.com/envoy.config.grpc_credential.v2alpha.FileBasedMetadataConfig
+              secret_data:
+                filename: {{ .DataplaneTokenPath }}
+        credentials_factory_name: envoy.grpc_credentials.file_based_metadata
+{{else}}
     - envoy_grpc:
         cluster_name: ads_cluster
+{{end}}
 
 static_resources:
   clusters:
+{{if not (and .DataplaneTokenPath .XdsCertBytes)}}
   - name: ads_cluster
     connect_timeout: {{ .XdsConnectTimeout }}
     type: STRICT_DNS
     lb_policy: ROUND_ROBIN
     http2_protocol_options: {}
+{{if .XdsCertBytes}}
+    tls_context:
+      common_tls_context:
+        validation_context:
+          trusted_ca:
+            inline_bytes: {{ .XdsCertBytes }}
+{{end}}
     upstream_connection_options:
       # configure a TCP keep-alive to detect and reconnect to the admin
       # server in the event of a TCP socket half open connection
@@ -77,6 +122,7 @@ static_resources:
               socket_address:
                 address: {{ .XdsHost }}
                 port_value: {{ .XdsPort }}
+{{end}}
   - name: access_log_sink
     connect_timeout: {{ .XdsConnectTimeout }}
     type: STATIC
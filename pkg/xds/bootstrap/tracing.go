@@ -1,6 +1,7 @@
 package bootstrap
 
 import (
+	"net"
 	net_url "net/url"
 	"strconv"
 	"time"
@@ -10,58 +11,128 @@ import (
 	envoy_api_v2_endpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
 	envoy_bootstrap "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v2"
 	envoy_config_trace_v2 "github.com/envoyproxy/go-control-plane/envoy/config/trace/v2"
+	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
 	"github.com/golang/protobuf/ptypes/duration"
+	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/pkg/errors"
 
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
 )
 
 func AddTracingConfig(bootstrap *envoy_bootstrap.Bootstrap, backend *mesh_proto.TracingBackend) error {
-	if zipkin, ok := backend.GetType().(*mesh_proto.TracingBackend_Zipkin_); ok {
-		cluster, tracingCfg, err := zipkinConfig(bootstrap, zipkin.Zipkin, backend.Name)
-		if err != nil {
-			return err
-		}
+	var cluster *envoy_api.Cluster
+	var tracingCfg *envoy_config_trace_v2.Tracing
+	var err error
+	switch backendType := backend.GetType().(type) {
+	case *mesh_proto.TracingBackend_Zipkin_:
+		cluster, tracingCfg, err = zipkinConfig(backendType.Zipkin, backend.Name)
+	case *mesh_proto.TracingBackend_Jaeger_:
+		cluster, tracingCfg, err = jaegerConfig(bootstrap, backendType.Jaeger, backend.Name)
+	case *mesh_proto.TracingBackend_Datadog_:
+		cluster, tracingCfg, err = datadogConfig(bootstrap, backendType.Datadog, backend.Name)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if cluster != nil {
 		if bootstrap.StaticResources == nil {
 			bootstrap.StaticResources = &envoy_bootstrap.Bootstrap_StaticResources{}
 		}
 		bootstrap.StaticResources.Clusters = append(bootstrap.StaticResources.Clusters, cluster)
-		bootstrap.Tracing = tracingCfg
 	}
+	bootstrap.Tracing = tracingCfg
 	return nil
 }
 
-func zipkinConfig(bootstrap *envoy_bootstrap.Bootstrap, zipkin *mesh_proto.TracingBackend_Zipkin, backendName string) (*envoy_api.Cluster, *envoy_config_trace_v2.Tracing, error) {
+func zipkinConfig(zipkin *mesh_proto.TracingBackend_Zipkin, backendName string) (*envoy_api.Cluster, *envoy_config_trace_v2.Tracing, error) {
 	url, err := net_url.ParseRequestURI(zipkin.Url)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "invalid URL of Zipkin")
 	}
+	return zipkinTracer(url, zipkin.TraceId128Bit, apiVersion(zipkin, url), backendName)
+}
 
-	cluster, err := zipkinCluster(backendName, url)
+// jaegerConfig reports spans either to Jaeger agent by Jaeger client library loaded into Envoy
+// or with Zipkin tracer of Envoy to Jaeger collector, which accepts spans in Zipkin format.
+func jaegerConfig(bootstrap *envoy_bootstrap.Bootstrap, jaeger *mesh_proto.TracingBackend_Jaeger, backendName string) (*envoy_api.Cluster, *envoy_config_trace_v2.Tracing, error) {
+	if jaeger.AgentAddress != "" {
+		tracingCfg, err := jaegerAgentConfig(bootstrap, jaeger)
+		return nil, tracingCfg, err
+	}
+	url, err := net_url.ParseRequestURI(jaeger.CollectorUrl)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, errors.Wrap(err, "invalid URL of Jaeger collector")
+	}
+	// Zipkin tracer reports spans for the cluster of the node, i.e. the service of a dataplane
+	if jaeger.ServiceName != "" && bootstrap.GetNode() != nil {
+		bootstrap.Node.Cluster = jaeger.ServiceName
+	}
+	return zipkinTracer(url, jaeger.TraceId128Bit, envoy_config_trace_v2.ZipkinConfig_HTTP_JSON, backendName)
+}
+
+const (
+	// defaultJaegerLibrary is a path to Jaeger client library plugin inside the official Envoy image with Jaeger support.
+	defaultJaegerLibrary = "/usr/local/lib/libjaegertracing_plugin.so"
+	// dynamicOtTracerName is a name of a tracer in Envoy that loads an OpenTracing plugin.
+	dynamicOtTracerName = "envoy.dynamic.ot"
+)
+
+func jaegerAgentConfig(bootstrap *envoy_bootstrap.Bootstrap, jaeger *mesh_proto.TracingBackend_Jaeger) (*envoy_config_trace_v2.Tracing, error) {
+	library := jaeger.Library
+	if library == "" {
+		library = defaultJaegerLibrary
+	}
+	// sampling is done by Envoy, so Jaeger client library has to report all spans it gets
+	values := map[string]interface{}{
+		"service_name": serviceName(bootstrap, jaeger.ServiceName),
+		"sampler": map[string]interface{}{
+			"type":  "const",
+			"param": 1,
+		},
+		"reporter": map[string]interface{}{
+			"localAgentHostPort": jaeger.AgentAddress,
+		},
+	}
+	if jaeger.TraceId128Bit {
+		values["traceid_128bit"] = true
+	}
+	config := &structpb.Struct{}
+	if err := util_proto.FromMap(values, config); err != nil {
+		return nil, err
+	}
+	dynamicOtConfigAny, err := ptypes.MarshalAny(&envoy_config_trace_v2.DynamicOtConfig{
+		Library: library,
+		Config:  config,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tracer(dynamicOtTracerName, dynamicOtConfigAny), nil
+}
+
+func zipkinTracer(url *net_url.URL, traceId128Bit bool, version envoy_config_trace_v2.ZipkinConfig_CollectorEndpointVersion, backendName string) (*envoy_api.Cluster, *envoy_config_trace_v2.Tracing, error) {
+	port, err := strconv.Atoi(url.Port())
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "invalid port of collector URL %q", url)
 	}
+	cluster := tracingCluster(backendName, url.Hostname(), uint32(port))
 
 	zipkinConfig := envoy_config_trace_v2.ZipkinConfig{
 		CollectorCluster:         cluster.Name,
 		CollectorEndpoint:        url.Path,
-		TraceId_128Bit:           zipkin.TraceId128Bit,
-		CollectorEndpointVersion: apiVersion(zipkin, url),
+		TraceId_128Bit:           traceId128Bit,
+		CollectorEndpointVersion: version,
 	}
 	zipkinConfigAny, err := ptypes.MarshalAny(&zipkinConfig)
 	if err != nil {
 		return nil, nil, err
 	}
-	tracingConfig := &envoy_config_trace_v2.Tracing{
-		Http: &envoy_config_trace_v2.Tracing_Http{
-			Name: "envoy.zipkin",
-			ConfigType: &envoy_config_trace_v2.Tracing_Http_TypedConfig{
-				TypedConfig: zipkinConfigAny,
-			},
-		},
-	}
-	return cluster, tracingConfig, nil
+	return cluster, tracer(envoy_wellknown.Zipkin, zipkinConfigAny), nil
 }
 
 func apiVersion(zipkin *mesh_proto.TracingBackend_Zipkin, url *net_url.URL) envoy_config_trace_v2.ZipkinConfig_CollectorEndpointVersion {
@@ -84,17 +155,55 @@ func apiVersion(zipkin *mesh_proto.TracingBackend_Zipkin, url *net_url.URL) envo
 	return envoy_config_trace_v2.ZipkinConfig_HTTP_JSON
 }
 
-const zipkinClusterTimeout = 10 * time.Second
+// datadogTracerName is a name of Datadog tracer in Envoy.
+const datadogTracerName = "envoy.tracers.datadog"
 
-func zipkinCluster(backendName string, url *net_url.URL) (*envoy_api.Cluster, error) {
-	port, err := strconv.Atoi(url.Port())
+func datadogConfig(bootstrap *envoy_bootstrap.Bootstrap, datadog *mesh_proto.TracingBackend_Datadog, backendName string) (*envoy_api.Cluster, *envoy_config_trace_v2.Tracing, error) {
+	host, portValue, err := net.SplitHostPort(datadog.Address)
 	if err != nil {
-		return nil, err
+		return nil, nil, errors.Wrap(err, "invalid address of Datadog agent")
+	}
+	port, err := strconv.ParseUint(portValue, 10, 32)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid address of Datadog agent")
+	}
+	cluster := tracingCluster(backendName, host, uint32(port))
+
+	datadogConfigAny, err := ptypes.MarshalAny(&envoy_config_trace_v2.DatadogConfig{
+		CollectorCluster: cluster.Name,
+		ServiceName:      serviceName(bootstrap, datadog.ServiceName),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return cluster, tracer(datadogTracerName, datadogConfigAny), nil
+}
+
+// serviceName returns a name of the service that spans are reported for, which is a service of a dataplane unless overridden.
+func serviceName(bootstrap *envoy_bootstrap.Bootstrap, override string) string {
+	if override != "" {
+		return override
 	}
+	return bootstrap.GetNode().GetCluster()
+}
+
+func tracer(name string, config *any.Any) *envoy_config_trace_v2.Tracing {
+	return &envoy_config_trace_v2.Tracing{
+		Http: &envoy_config_trace_v2.Tracing_Http{
+			Name: name,
+			ConfigType: &envoy_config_trace_v2.Tracing_Http_TypedConfig{
+				TypedConfig: config,
+			},
+		},
+	}
+}
+
+const tracingClusterTimeout = 10 * time.Second
 
-	cluster := &envoy_api.Cluster{
+func tracingCluster(backendName string, host string, port uint32) *envoy_api.Cluster {
+	return &envoy_api.Cluster{
 		Name:                 backendName,
-		ConnectTimeout:       &duration.Duration{Seconds: int64(zipkinClusterTimeout.Seconds())},
+		ConnectTimeout:       &duration.Duration{Seconds: int64(tracingClusterTimeout.Seconds())},
 		ClusterDiscoveryType: &envoy_api.Cluster_Type{Type: envoy_api.Cluster_STRICT_DNS},
 		LbPolicy:             envoy_api.Cluster_ROUND_ROBIN,
 		LoadAssignment: &envoy_api.ClusterLoadAssignment{
@@ -108,9 +217,9 @@ func zipkinCluster(backendName string, url *net_url.URL) (*envoy_api.Cluster, er
 									Address: &envoy_api_v2_core.Address{
 										Address: &envoy_api_v2_core.Address_SocketAddress{
 											SocketAddress: &envoy_api_v2_core.SocketAddress{
-												Address: url.Hostname(),
+												Address: host,
 												PortSpecifier: &envoy_api_v2_core.SocketAddress_PortValue{
-													PortValue: uint32(port),
+													PortValue: port,
 												},
 											},
 										},
@@ -123,5 +232,4 @@ func zipkinCluster(backendName string, url *net_url.URL) (*envoy_api.Cluster, er
 			},
 		},
 	}
-	return cluster, nil
 }
@@ -1,7 +1,10 @@
 package bootstrap
 
 import (
+	envoy_api_v2_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	envoy_config_bootstrap_v2 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v2"
+	envoy_config_trace_v2 "github.com/envoyproxy/go-control-plane/envoy/config/trace/v2"
+	"github.com/golang/protobuf/ptypes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -276,7 +279,195 @@ var _ = Describe("Bootstrap Tracing", func() {
                       collectorEndpoint: /api/v2/spans
                       collectorEndpointVersion: HTTP_JSON
                       traceId128bit: true
+`,
+		}),
+		Entry("jaeger", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name: "jaeger",
+				Type: &mesh_proto.TracingBackend_Jaeger_{
+					Jaeger: &mesh_proto.TracingBackend_Jaeger{
+						CollectorUrl:  "http://jaeger-collector:9411/api/v2/spans",
+						TraceId128Bit: true,
+					},
+				},
+			},
+			expectedYAML: `
+                staticResources:
+                  clusters:
+                  - connectTimeout: 10s
+                    loadAssignment:
+                      clusterName: jaeger
+                      endpoints:
+                      - lbEndpoints:
+                        - endpoint:
+                            address:
+                              socketAddress:
+                                address: jaeger-collector
+                                portValue: 9411
+                    name: jaeger
+                    type: STRICT_DNS
+                tracing:
+                  http:
+                    name: envoy.zipkin
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.trace.v2.ZipkinConfig
+                      collectorCluster: jaeger
+                      collectorEndpoint: /api/v2/spans
+                      collectorEndpointVersion: HTTP_JSON
+                      traceId128bit: true
+`,
+		}),
+		Entry("jaeger with agent address", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name: "jaeger",
+				Type: &mesh_proto.TracingBackend_Jaeger_{
+					Jaeger: &mesh_proto.TracingBackend_Jaeger{
+						AgentAddress:  "jaeger-agent:6831",
+						ServiceName:   "web",
+						Library:       "/opt/jaeger/libjaegertracing_plugin.so",
+						TraceId128Bit: true,
+					},
+				},
+			},
+			expectedYAML: `
+                tracing:
+                  http:
+                    name: envoy.dynamic.ot
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.trace.v2.DynamicOtConfig
+                      library: /opt/jaeger/libjaegertracing_plugin.so
+                      config:
+                        service_name: web
+                        sampler:
+                          type: const
+                          param: 1
+                        reporter:
+                          localAgentHostPort: jaeger-agent:6831
+                        traceid_128bit: true
+`,
+		}),
+		Entry("datadog", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name: "datadog",
+				Type: &mesh_proto.TracingBackend_Datadog_{
+					Datadog: &mesh_proto.TracingBackend_Datadog{
+						Address:     "datadog-agent:8126",
+						ServiceName: "web",
+					},
+				},
+			},
+			expectedYAML: `
+                staticResources:
+                  clusters:
+                  - connectTimeout: 10s
+                    loadAssignment:
+                      clusterName: datadog
+                      endpoints:
+                      - lbEndpoints:
+                        - endpoint:
+                            address:
+                              socketAddress:
+                                address: datadog-agent
+                                portValue: 8126
+                    name: datadog
+                    type: STRICT_DNS
+                tracing:
+                  http:
+                    name: envoy.tracers.datadog
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.trace.v2.DatadogConfig
+                      collectorCluster: datadog
+                      serviceName: web
 `,
 		}),
 	)
+
+	It("should report spans for the service of a dataplane unless overridden", func() {
+		// given
+		bootstrap := &envoy_config_bootstrap_v2.Bootstrap{
+			Node: &envoy_api_v2_core.Node{
+				Cluster: "backend",
+			},
+		}
+		// and
+		backend := &mesh_proto.TracingBackend{
+			Name: "datadog",
+			Type: &mesh_proto.TracingBackend_Datadog_{
+				Datadog: &mesh_proto.TracingBackend_Datadog{
+					Address: "datadog-agent:8126",
+				},
+			},
+		}
+
+		// when
+		err := AddTracingConfig(bootstrap, backend)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		config := &envoy_config_trace_v2.DatadogConfig{}
+		err = ptypes.UnmarshalAny(bootstrap.Tracing.Http.GetTypedConfig(), config)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.ServiceName).To(Equal("backend"))
+	})
+
+	It("should report spans to Jaeger collector for the overridden service", func() {
+		// given
+		bootstrap := &envoy_config_bootstrap_v2.Bootstrap{
+			Node: &envoy_api_v2_core.Node{
+				Cluster: "backend",
+			},
+		}
+		// and
+		backend := &mesh_proto.TracingBackend{
+			Name: "jaeger",
+			Type: &mesh_proto.TracingBackend_Jaeger_{
+				Jaeger: &mesh_proto.TracingBackend_Jaeger{
+					CollectorUrl: "http://jaeger-collector:9411/api/v2/spans",
+					ServiceName:  "web",
+				},
+			},
+		}
+
+		// when
+		err := AddTracingConfig(bootstrap, backend)
+
+		// then Zipkin tracer reports spans for the cluster of the node
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bootstrap.Node.Cluster).To(Equal("web"))
+		Expect(bootstrap.Tracing.Http.Name).To(Equal("envoy.zipkin"))
+	})
+
+	It("should report spans to Jaeger agent with the default library for the service of a dataplane", func() {
+		// given
+		bootstrap := &envoy_config_bootstrap_v2.Bootstrap{
+			Node: &envoy_api_v2_core.Node{
+				Cluster: "backend",
+			},
+		}
+		// and
+		backend := &mesh_proto.TracingBackend{
+			Name: "jaeger",
+			Type: &mesh_proto.TracingBackend_Jaeger_{
+				Jaeger: &mesh_proto.TracingBackend_Jaeger{
+					AgentAddress: "jaeger-agent:6831",
+				},
+			},
+		}
+
+		// when
+		err := AddTracingConfig(bootstrap, backend)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		config := &envoy_config_trace_v2.DynamicOtConfig{}
+		err = ptypes.UnmarshalAny(bootstrap.Tracing.Http.GetTypedConfig(), config)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.Library).To(Equal("/usr/local/lib/libjaegertracing_plugin.so"))
+		Expect(config.Config.Fields["service_name"].GetStringValue()).To(Equal("backend"))
+		Expect(bootstrap.Node.Cluster).To(Equal("backend"))
+	})
 })
@@ -1,8 +1,70 @@
 package types
 
+import (
+	"time"
+)
+
+const (
+	XdsApiVersionV2 = "v2"
+	XdsApiVersionV3 = "v3"
+)
+
 type BootstrapRequest struct {
-	Mesh               string `json:"mesh"`
-	Name               string `json:"name"`
-	AdminPort          uint32 `json:"adminPort,omitempty"`
+	Mesh      string `json:"mesh"`
+	Name      string `json:"name"`
+	AdminPort uint32 `json:"adminPort,omitempty"`
+	// Port of the kuma-dp endpoint that exposes metrics in Prometheus format. Zero value means the endpoint is disabled.
+	MetricsPort uint32 `json:"metricsPort,omitempty"`
+	// Path to a file with a dataplane token that Envoy sends to XDS and SDS servers.
 	DataplaneTokenPath string `json:"dataplaneTokenPath,omitempty"`
+	// Dataplane token that authorizes registration of DataplaneResource. It never ends up in a bootstrap config.
+	DataplaneToken string `json:"dataplaneToken,omitempty"`
+	// Version of Envoy xDS API to use. Empty value means xDS v2.
+	XdsApiVersion string `json:"xdsApiVersion,omitempty"`
+	// Dataplane resource in YAML format. If present, Dataplane is created or updated before generating a bootstrap config.
+	DataplaneResource string `json:"dataplaneResource,omitempty"`
+	// Undrain is set by kuma-dp on the first bootstrap request after it has been started,
+	// so that inbound interfaces drained on a previous shutdown are marked as ready again.
+	Undrain bool `json:"undrain,omitempty"`
+}
+
+// UnregisterRequest is sent by a Dataplane that registered itself to delete its Dataplane resource on exit.
+type UnregisterRequest struct {
+	Mesh           string `json:"mesh"`
+	Name           string `json:"name"`
+	DataplaneToken string `json:"dataplaneToken,omitempty"`
+}
+
+// DrainRequest is sent by a Dataplane on shutdown to stop receiving traffic from other Dataplanes.
+type DrainRequest struct {
+	Mesh           string `json:"mesh"`
+	Name           string `json:"name"`
+	DataplaneToken string `json:"dataplaneToken,omitempty"`
+}
+
+// EnvoyStatusRequest is sent by kuma-dp to report the status of the Envoy process it supervises.
+type EnvoyStatusRequest struct {
+	Mesh              string    `json:"mesh"`
+	Name              string    `json:"name"`
+	DataplaneToken    string    `json:"dataplaneToken,omitempty"`
+	Restarts          uint32    `json:"restarts"`
+	LastRestartTime   time.Time `json:"lastRestartTime"`
+	LastRestartReason string    `json:"lastRestartReason,omitempty"`
+	CrashLooping      bool      `json:"crashLooping"`
+}
+
+// VIPsRequest is sent by kuma-dp to find out virtual IPs that services of a mesh are resolved to by DNS.
+type VIPsRequest struct {
+	Mesh string `json:"mesh"`
+	Name string `json:"name"`
+	// DataplaneToken proves that the request comes from a Dataplane of the mesh.
+	DataplaneToken string `json:"dataplaneToken,omitempty"`
+}
+
+// VIPsResponse holds virtual IPs of services of a mesh.
+type VIPsResponse struct {
+	// Domain that services are resolved in, e.g. `backend.mesh`.
+	Domain string `json:"domain"`
+	// VIPs maps services to their virtual IPs.
+	VIPs map[string]string `json:"vips"`
 }
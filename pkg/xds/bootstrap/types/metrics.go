@@ -0,0 +1,7 @@
+package types
+
+// Contract between the Prometheus endpoint of Envoy and the kuma-dp endpoint it forwards scrapes to.
+const (
+	// AggregatedMetricsPath is a path on which kuma-dp merges metrics of Envoy, of the application and of kuma-dp itself.
+	AggregatedMetricsPath = "/metrics/aggregate"
+)
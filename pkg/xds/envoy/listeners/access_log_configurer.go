@@ -32,14 +32,6 @@ func convertLoggingBackend(mesh string, sourceService string, destinationService
 	if backend == nil {
 		return nil, nil
 	}
-	formatString := defaultFormat
-	if backend.Format != "" {
-		formatString = backend.Format
-	}
-	format, err := accesslog.ParseFormat(formatString)
-	if err != nil {
-		return nil, errors.Wrapf(err, "invalid access log format string: %s", formatString)
-	}
 
 	variables := accesslog.InterpolationVariables{
 		accesslog.CMD_KUMA_SOURCE_ADDRESS:              net.JoinHostPort(proxy.Dataplane.GetIP(), "0"), // deprecated variable
@@ -49,6 +41,19 @@ func convertLoggingBackend(mesh string, sourceService string, destinationService
 		accesslog.CMD_KUMA_MESH:                        mesh,
 	}
 
+	if len(backend.JsonFormat) > 0 {
+		return convertJsonLoggingBackend(backend, variables)
+	}
+
+	formatString := defaultFormat
+	if backend.Format != "" {
+		formatString = backend.Format
+	}
+	format, err := accesslog.ParseFormat(formatString)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid access log format string: %s", formatString)
+	}
+
 	format, err = format.Interpolate(variables)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to interpolate access log format string with Kuma-specific variables: %s", formatString)
@@ -56,17 +61,74 @@ func convertLoggingBackend(mesh string, sourceService string, destinationService
 
 	if file, ok := backend.GetType().(*mesh_proto.LoggingBackend_File_); ok {
 		return fileAccessLog(format, file)
-	} else if tcp, ok := backend.GetType().(*mesh_proto.LoggingBackend_Tcp_); ok {
-		return tcpAccessLog(format, tcp)
-	} else {
-		return nil, errors.Errorf("could not convert LoggingBackend of type %T to AccessLog", backend.GetType())
 	}
+	if _, ok := backend.GetType().(*mesh_proto.LoggingBackend_Http_); ok {
+		return nil, errors.New("LoggingBackend of type http requires jsonFormat")
+	}
+	address, err := forwardedLogAddress(backend)
+	if err != nil {
+		return nil, err
+	}
+	return tcpAccessLog(fmt.Sprintf("%s;%s", address, format.String()), format)
 }
 
-func tcpAccessLog(format *accesslog.AccessLogFormat, tcp *mesh_proto.LoggingBackend_Tcp_) (*filter_accesslog.AccessLog, error) {
+func convertJsonLoggingBackend(backend *mesh_proto.LoggingBackend, variables accesslog.InterpolationVariables) (*filter_accesslog.AccessLog, error) {
+	format, err := accesslog.ParseJsonFormat(backend.JsonFormat)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid access log JSON format")
+	}
+
+	format, err = format.Interpolate(variables)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to interpolate access log JSON format with Kuma-specific variables: %s", format)
+	}
+
+	// structured access logs are always rendered by kuma-dp, even to a file,
+	// since Envoy 1.12 renders values of all fields as JSON strings
+	address, err := forwardedLogAddress(backend)
+	if err != nil {
+		return nil, err
+	}
+	return tcpAccessLog(accesslog.JsonLogName(address, format), format)
+}
+
+// forwardedLogAddress returns an address of a logging backend that kuma-dp forwards access logs to.
+func forwardedLogAddress(backend *mesh_proto.LoggingBackend) (string, error) {
+	switch backendType := backend.GetType().(type) {
+	case *mesh_proto.LoggingBackend_Tcp_:
+		return backendType.Tcp.Address, nil
+	case *mesh_proto.LoggingBackend_Syslog_:
+		address := &accesslog.SyslogAddress{
+			Address:   backendType.Syslog.Address,
+			Transport: backendType.Syslog.Transport,
+			Facility:  backendType.Syslog.Facility,
+			AppName:   backendType.Syslog.AppName,
+		}
+		return address.String(), nil
+	case *mesh_proto.LoggingBackend_File_:
+		return accesslog.FileAddress(backendType.File.Path), nil
+	case *mesh_proto.LoggingBackend_Http_:
+		address := &accesslog.HttpAddress{
+			URL:       backendType.Http.Url,
+			BatchSize: backendType.Http.BatchSize,
+		}
+		if backendType.Http.FlushInterval != nil {
+			flushInterval, err := ptypes.Duration(backendType.Http.FlushInterval)
+			if err != nil {
+				return "", errors.Wrap(err, "invalid flush interval of an http logging backend")
+			}
+			address.FlushInterval = flushInterval
+		}
+		return address.String(), nil
+	default:
+		return "", errors.Errorf("could not convert LoggingBackend of type %T to AccessLog", backend.GetType())
+	}
+}
+
+func tcpAccessLog(logName string, format accesslog.HttpLogConfigurer) (*filter_accesslog.AccessLog, error) {
 	httpGrpcAccessLog := &envoy_accesslog.HttpGrpcAccessLogConfig{
 		CommonConfig: &envoy_accesslog.CommonGrpcAccessLogConfig{
-			LogName: fmt.Sprintf("%s;%s", tcp.Tcp.Address, format.String()),
+			LogName: logName,
 			GrpcService: &envoy_core.GrpcService{
 				TargetSpecifier: &envoy_core.GrpcService_EnvoyGrpc_{
 					EnvoyGrpc: &envoy_core.GrpcService_EnvoyGrpc{
@@ -77,7 +139,7 @@ func tcpAccessLog(format *accesslog.AccessLogFormat, tcp *mesh_proto.LoggingBack
 		},
 	}
 	if err := format.ConfigureHttpLog(httpGrpcAccessLog); err != nil {
-		return nil, errors.Wrapf(err, "failed to configure %T according to the format string: %s", httpGrpcAccessLog, format)
+		return nil, errors.Wrapf(err, "failed to configure %T according to the format: %s", httpGrpcAccessLog, format)
 	}
 	marshalled, err := ptypes.MarshalAny(httpGrpcAccessLog)
 	if err != nil {
@@ -92,12 +154,15 @@ func tcpAccessLog(format *accesslog.AccessLogFormat, tcp *mesh_proto.LoggingBack
 }
 
 func fileAccessLog(format *accesslog.AccessLogFormat, file *mesh_proto.LoggingBackend_File_) (*filter_accesslog.AccessLog, error) {
-	fileAccessLog := &envoy_accesslog.FileAccessLog{
+	return fileAccessLogWithFormat(&envoy_accesslog.FileAccessLog{
 		AccessLogFormat: &envoy_accesslog.FileAccessLog_Format{
 			Format: format.String(),
 		},
 		Path: file.File.Path,
-	}
+	})
+}
+
+func fileAccessLogWithFormat(fileAccessLog *envoy_accesslog.FileAccessLog) (*filter_accesslog.AccessLog, error) {
 	marshalled, err := ptypes.MarshalAny(fileAccessLog)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not marshall %T", fileAccessLog)
@@ -1,6 +1,10 @@
 package listeners_test
 
 import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -140,6 +144,108 @@ var _ = Describe("HttpAccessLogConfigurer", func() {
                     routeConfigName: outbound:backend
                   statPrefix: backend
             trafficDirection: OUTBOUND
+`,
+		}),
+		Entry("basic http_connection_manager with file access log in JSON format", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name: "file",
+				JsonFormat: map[string]string{
+					"status":      "%RESPONSE_CODE%",
+					"path":        "%REQ(:PATH)%",
+					"source":      "%KUMA_SOURCE_SERVICE%",
+					"destination": "%KUMA_DESTINATION_SERVICE%",
+				},
+				Type: &mesh_proto.LoggingBackend_File_{
+					File: &mesh_proto.LoggingBackend_File{
+						Path: "/tmp/log",
+					},
+				},
+			},
+			expected: `
+            name: outbound:127.0.0.1:27070
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.http_grpc_access_log
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.accesslog.v2.HttpGrpcAccessLogConfig
+                      commonConfig:
+                        grpcService:
+                          envoyGrpc:
+                            clusterName: access_log_sink
+                        logName: 'json;{"address":"file:///tmp/log","format":{"destination":"backend","path":"%REQ(:path)%","source":"web","status":"%RESPONSE_CODE%"}}'
+                  httpFilters:
+                  - name: envoy.router
+                  rds:
+                    configSource:
+                      ads: {}
+                    routeConfigName: outbound:backend
+                  statPrefix: backend
+            trafficDirection: OUTBOUND
+`,
+		}),
+		Entry("basic http_connection_manager with tcp access log in JSON format", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name: "tcp",
+				JsonFormat: map[string]string{
+					"status":      "%RESPONSE_CODE%",
+					"origin":      "%REQ(ORIGIN)%",
+					"source":      "%KUMA_SOURCE_SERVICE%",
+					"destination": "%KUMA_DESTINATION_SERVICE%",
+				},
+				Type: &mesh_proto.LoggingBackend_Tcp_{
+					Tcp: &mesh_proto.LoggingBackend_Tcp{
+						Address: "127.0.0.1:1234",
+					},
+				},
+			},
+			expected: `
+            name: outbound:127.0.0.1:27070
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.http_grpc_access_log
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.accesslog.v2.HttpGrpcAccessLogConfig
+                      additionalRequestHeadersToLog:
+                      - origin
+                      commonConfig:
+                        grpcService:
+                          envoyGrpc:
+                            clusterName: access_log_sink
+                        logName: 'json;{"address":"127.0.0.1:1234","format":{"destination":"backend","origin":"%REQ(origin)%","source":"web","status":"%RESPONSE_CODE%"}}'
+                  httpFilters:
+                  - name: envoy.router
+                  rds:
+                    configSource:
+                      ads: {}
+                    routeConfigName: outbound:backend
+                  statPrefix: backend
+            trafficDirection: OUTBOUND
 `,
 		}),
 		Entry("basic http_connection_manager with tcp access log", testCase{
@@ -198,6 +304,55 @@ var _ = Describe("HttpAccessLogConfigurer", func() {
                     routeConfigName: outbound:backend
                   statPrefix: backend
             trafficDirection: OUTBOUND
+`,
+		}),
+		Entry("basic http_connection_manager with http access log", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name: "http",
+				JsonFormat: map[string]string{
+					"status": "%RESPONSE_CODE%",
+				},
+				Type: &mesh_proto.LoggingBackend_Http_{
+					Http: &mesh_proto.LoggingBackend_Http{
+						Url:           "https://collector.internal/logs",
+						BatchSize:     50,
+						FlushInterval: ptypes.DurationProto(500 * time.Millisecond),
+					},
+				},
+			},
+			expected: `
+            name: outbound:127.0.0.1:27070
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.http_grpc_access_log
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.accesslog.v2.HttpGrpcAccessLogConfig
+                      commonConfig:
+                        grpcService:
+                          envoyGrpc:
+                            clusterName: access_log_sink
+                        logName: 'json;{"address":"https://collector.internal/logs#batchSize=50&flushInterval=500ms","format":{"status":"%RESPONSE_CODE%"}}'
+                  httpFilters:
+                  - name: envoy.router
+                  rds:
+                    configSource:
+                      ads: {}
+                    routeConfigName: outbound:backend
+                  statPrefix: backend
+            trafficDirection: OUTBOUND
 `,
 		}),
 	)
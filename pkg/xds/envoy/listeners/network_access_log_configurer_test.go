@@ -185,6 +185,48 @@ var _ = Describe("NetworkAccessLogConfigurer", func() {
                           "%RESP(server):5%" "%TRAILER(grpc-message):7%" "DYNAMIC_METADATA(namespace:object:key):9" "FILTER_STATE(filter.state.key):12"
                   cluster: db
                   statPrefix: db
+`,
+		}),
+		Entry("basic tcp_proxy with syslog access log", testCase{
+			listenerName:    "outbound:127.0.0.1:5432",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    5432,
+			statsName:       "db",
+			clusters:        []envoy_common.ClusterInfo{{Name: "db", Weight: 200}},
+			backend: &mesh_proto.LoggingBackend{
+				Name: "syslog",
+				Type: &mesh_proto.LoggingBackend_Syslog_{
+					Syslog: &mesh_proto.LoggingBackend_Syslog{
+						Address:   "127.0.0.1:514",
+						Transport: "tls",
+						Facility:  "local3",
+					},
+				},
+			},
+			expected: `
+            name: outbound:127.0.0.1:5432
+            trafficDirection: OUTBOUND
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 5432
+            filterChains:
+            - filters:
+              - name: envoy.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                  accessLog:
+                  - name: envoy.http_grpc_access_log
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.accesslog.v2.HttpGrpcAccessLogConfig
+                      commonConfig:
+                        grpcService:
+                          envoyGrpc:
+                            clusterName: access_log_sink
+                        logName: |
+                          syslog://127.0.0.1:514?facility=local3&transport=tls;[%START_TIME%] demo 192.168.0.1(backend)->%UPSTREAM_HOST%(db) took %DURATION%ms, sent %BYTES_SENT% bytes, received: %BYTES_RECEIVED% bytes
+                  cluster: db
+                  statPrefix: db
 `,
 		}),
 	)
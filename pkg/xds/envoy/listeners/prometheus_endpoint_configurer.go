@@ -10,25 +10,60 @@ import (
 	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
 
 	util_xds "github.com/Kong/kuma/pkg/util/xds"
+	bootstrap_types "github.com/Kong/kuma/pkg/xds/bootstrap/types"
 )
 
+// PrometheusEndpoint forwards scrapes to the `/stats/prometheus` endpoint of the Envoy Admin API.
 func PrometheusEndpoint(statsName string, path string, clusterName string) FilterChainBuilderOpt {
 	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
 		config.Add(&PrometheusEndpointConfigurer{
-			statsName:   statsName,
-			path:        path,
-			clusterName: clusterName,
+			statsName:     statsName,
+			path:          path,
+			clusterName:   clusterName,
+			virtualHost:   "envoy_admin",
+			prefixRewrite: "/stats/prometheus", // well-known Admin API endpoint
+		})
+	})
+}
+
+// AggregatedPrometheusEndpoint forwards scrapes to the endpoint of kuma-dp that merges metrics of Envoy,
+// of the application and of kuma-dp itself.
+func AggregatedPrometheusEndpoint(statsName string, path string, clusterName string) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		config.Add(&PrometheusEndpointConfigurer{
+			statsName:     statsName,
+			path:          path,
+			clusterName:   clusterName,
+			virtualHost:   "kuma_dp",
+			prefixRewrite: bootstrap_types.AggregatedMetricsPath,
 		})
 	})
 }
 
 type PrometheusEndpointConfigurer struct {
-	statsName   string
-	path        string
-	clusterName string
+	statsName     string
+	path          string
+	clusterName   string
+	virtualHost   string
+	prefixRewrite string
 }
 
 func (c *PrometheusEndpointConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	route := &envoy_route.Route{
+		Match: &envoy_route.RouteMatch{
+			PathSpecifier: &envoy_route.RouteMatch_Prefix{
+				Prefix: c.path,
+			},
+		},
+		Action: &envoy_route.Route_Route{
+			Route: &envoy_route.RouteAction{
+				ClusterSpecifier: &envoy_route.RouteAction_Cluster{
+					Cluster: c.clusterName,
+				},
+				PrefixRewrite: c.prefixRewrite,
+			},
+		},
+	}
 	config := &envoy_hcm.HttpConnectionManager{
 		StatPrefix: util_xds.SanitizeMetric(c.statsName),
 		CodecType:  envoy_hcm.HttpConnectionManager_AUTO,
@@ -38,23 +73,9 @@ func (c *PrometheusEndpointConfigurer) Configure(filterChain *envoy_listener.Fil
 		RouteSpecifier: &envoy_hcm.HttpConnectionManager_RouteConfig{
 			RouteConfig: &v2.RouteConfiguration{
 				VirtualHosts: []*envoy_route.VirtualHost{{
-					Name:    "envoy_admin",
+					Name:    c.virtualHost,
 					Domains: []string{"*"},
-					Routes: []*envoy_route.Route{{
-						Match: &envoy_route.RouteMatch{
-							PathSpecifier: &envoy_route.RouteMatch_Prefix{
-								Prefix: c.path,
-							},
-						},
-						Action: &envoy_route.Route_Route{
-							Route: &envoy_route.RouteAction{
-								ClusterSpecifier: &envoy_route.RouteAction_Cluster{
-									Cluster: c.clusterName,
-								},
-								PrefixRewrite: "/stats/prometheus", // well-known Admin API endpoint
-							},
-						},
-					}},
+					Routes:  []*envoy_route.Route{route},
 				}},
 			},
 		},
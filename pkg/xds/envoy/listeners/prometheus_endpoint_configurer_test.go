@@ -74,4 +74,47 @@ var _ = Describe("PrometheusEndpointConfigurer", func() {
 		}),
 	)
 
+	It("should forward scrapes to kuma-dp", func() {
+		// when
+		listener, err := NewListenerBuilder().
+			Configure(InboundListener("kuma:metrics:prometheus", "192.168.0.1", 8080)).
+			Configure(FilterChain(NewFilterChainBuilder().
+				Configure(AggregatedPrometheusEndpoint("kuma:metrics:prometheus", "/metrics", "kuma:dataplane:metrics")))).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(listener)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		expected := `
+        name: kuma:metrics:prometheus
+        trafficDirection: INBOUND
+        address:
+          socketAddress:
+            address: 192.168.0.1
+            portValue: 8080
+        filterChains:
+        - filters:
+          - name: envoy.http_connection_manager
+            typedConfig:
+              '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+              httpFilters:
+              - name: envoy.router
+              routeConfig:
+                virtualHosts:
+                - domains:
+                  - '*'
+                  name: kuma_dp
+                  routes:
+                  - match:
+                      prefix: /metrics
+                    route:
+                      cluster: kuma:dataplane:metrics
+                      prefixRewrite: /metrics/aggregate
+              statPrefix: kuma_metrics_prometheus
+`
+		Expect(actual).To(MatchYAML(expected))
+	})
 })
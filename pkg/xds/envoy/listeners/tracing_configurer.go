@@ -1,23 +1,34 @@
 package listeners
 
 import (
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/http_connection_manager/v2"
 	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type"
+	envoy_tracing "github.com/envoyproxy/go-control-plane/envoy/type/tracing/v2"
 )
 
-func Tracing(backend *mesh_proto.TracingBackend) FilterChainBuilderOpt {
+func Tracing(backend *mesh_proto.TracingBackend, conf *mesh_proto.TrafficTrace_Conf) FilterChainBuilderOpt {
 	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
 		config.Add(&TracingConfigurer{
 			backend: backend,
+			conf:    conf,
 		})
 	})
 }
 
+// TracingConfigurer enables tracing in HTTP Connection Manager.
+//
+// Paths excluded from tracing can only be applied to a route configuration embedded into HTTP Connection Manager,
+// therefore TracingConfigurer has to be applied after the route configuration.
 type TracingConfigurer struct {
 	backend *mesh_proto.TracingBackend
+	conf    *mesh_proto.TrafficTrace_Conf
 }
 
 func (c *TracingConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
@@ -27,11 +38,87 @@ func (c *TracingConfigurer) Configure(filterChain *envoy_listener.FilterChain) e
 
 	return UpdateHTTPConnectionManager(filterChain, func(hcm *envoy_hcm.HttpConnectionManager) error {
 		hcm.Tracing = &envoy_hcm.HttpConnectionManager_Tracing{}
-		if c.backend.Sampling != nil {
+		if sampling := c.sampling(); sampling != nil {
 			hcm.Tracing.OverallSampling = &envoy_type.Percent{
-				Value: c.backend.Sampling.Value,
+				Value: sampling.Value,
+			}
+		}
+		for _, tag := range c.conf.GetTags() {
+			hcm.Tracing.CustomTags = append(hcm.Tracing.CustomTags, customTag(tag))
+		}
+		if routeConfig := hcm.GetRouteConfig(); routeConfig != nil {
+			for _, virtualHost := range routeConfig.VirtualHosts {
+				virtualHost.Routes = excludeFromTracing(virtualHost.Routes, c.conf.GetExcludedPaths())
 			}
 		}
 		return nil
 	})
 }
+
+// sampling returns sampling of TrafficTrace if it is set, otherwise sampling of the backend.
+func (c *TracingConfigurer) sampling() *wrappers.DoubleValue {
+	if c.conf.GetSampling() != nil {
+		return c.conf.GetSampling()
+	}
+	return c.backend.Sampling
+}
+
+func customTag(tag *mesh_proto.TrafficTrace_Conf_Tag) *envoy_tracing.CustomTag {
+	customTag := &envoy_tracing.CustomTag{
+		Tag: tag.Name,
+	}
+	switch value := tag.GetValue().(type) {
+	case *mesh_proto.TrafficTrace_Conf_Tag_Header_:
+		customTag.Type = &envoy_tracing.CustomTag_RequestHeader{
+			RequestHeader: &envoy_tracing.CustomTag_Header{
+				Name:         value.Header.Name,
+				DefaultValue: value.Header.Default,
+			},
+		}
+	case *mesh_proto.TrafficTrace_Conf_Tag_Literal:
+		customTag.Type = &envoy_tracing.CustomTag_Literal_{
+			Literal: &envoy_tracing.CustomTag_Literal{
+				Value: value.Literal,
+			},
+		}
+	case *mesh_proto.TrafficTrace_Conf_Tag_Env_:
+		customTag.Type = &envoy_tracing.CustomTag_Environment_{
+			Environment: &envoy_tracing.CustomTag_Environment{
+				Name:         value.Env.Name,
+				DefaultValue: value.Env.Default,
+			},
+		}
+	}
+	return customTag
+}
+
+// excludeFromTracing adds routes that match given paths exactly and are never traced.
+// They forward requests the same way as the catch-all route.
+func excludeFromTracing(routes []*envoy_route.Route, paths []string) []*envoy_route.Route {
+	var catchAll *envoy_route.Route
+	for _, route := range routes {
+		if route.GetMatch().GetPrefix() == "/" {
+			catchAll = route
+			break
+		}
+	}
+	if catchAll == nil || len(paths) == 0 {
+		return routes
+	}
+	var excluded []*envoy_route.Route
+	for _, path := range paths {
+		route := proto.Clone(catchAll).(*envoy_route.Route)
+		route.Match.PathSpecifier = &envoy_route.RouteMatch_Path{
+			Path: path,
+		}
+		// overall sampling takes precedence over the other kinds of sampling, e.g. over forced tracing
+		route.Tracing = &envoy_route.Tracing{
+			OverallSampling: &envoy_type.FractionalPercent{
+				Numerator:   0,
+				Denominator: envoy_type.FractionalPercent_HUNDRED,
+			},
+		}
+		excluded = append(excluded, route)
+	}
+	return append(excluded, routes...)
+}
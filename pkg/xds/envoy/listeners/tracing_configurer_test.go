@@ -5,6 +5,7 @@ import (
 
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	envoy_common "github.com/Kong/kuma/pkg/xds/envoy"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -14,18 +15,26 @@ import (
 var _ = Describe("TracingConfigurer", func() {
 
 	type testCase struct {
-		backend  *mesh_proto.TracingBackend
-		expected string
+		backend      *mesh_proto.TracingBackend
+		conf         *mesh_proto.TrafficTrace_Conf
+		inboundRoute bool
+		expected     string
 	}
 
 	DescribeTable("should generate proper Envoy config",
 		func(given testCase) {
+			// given
+			filterChainBuilder := NewFilterChainBuilder().
+				Configure(HttpConnectionManager("localhost:8080"))
+			if given.inboundRoute {
+				filterChainBuilder.Configure(HttpInboundRoute("backend", envoy_common.ClusterInfo{Name: "localhost:8080"}))
+			}
+
 			// when
 			listener, err := NewListenerBuilder().
 				Configure(InboundListener("inbound:192.168.0.1:8080", "192.168.0.1", 8080)).
-				Configure(FilterChain(NewFilterChainBuilder().
-					Configure(HttpConnectionManager("localhost:8080")).
-					Configure(Tracing(given.backend)))).
+				Configure(FilterChain(filterChainBuilder.
+					Configure(Tracing(given.backend, given.conf)))).
 				Build()
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -91,6 +100,159 @@ var _ = Describe("TracingConfigurer", func() {
                   tracing: {}
                   httpFilters:
                   - name: envoy.router
+`,
+		}),
+		Entry("sampling of backend overridden by TrafficTrace", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name:     "zipkin",
+				Sampling: &wrappers.DoubleValue{Value: 30.5},
+				Type: &mesh_proto.TracingBackend_Zipkin_{
+					Zipkin: &mesh_proto.TracingBackend_Zipkin{
+						Url: "http://zipkin.us:9090/v2/spans",
+					},
+				},
+			},
+			conf: &mesh_proto.TrafficTrace_Conf{
+				Backend:  "zipkin",
+				Sampling: &wrappers.DoubleValue{Value: 0},
+			},
+			expected: `
+            name: inbound:192.168.0.1:8080
+            trafficDirection: INBOUND
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            filterChains:
+            - filters:
+              - name: envoy.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                  statPrefix: localhost_8080
+                  tracing:
+                    overallSampling: {}
+                  httpFilters:
+                  - name: envoy.router
+`,
+		}),
+		Entry("custom tags", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name: "zipkin",
+				Type: &mesh_proto.TracingBackend_Zipkin_{
+					Zipkin: &mesh_proto.TracingBackend_Zipkin{
+						Url: "http://zipkin.us:9090/v2/spans",
+					},
+				},
+			},
+			conf: &mesh_proto.TrafficTrace_Conf{
+				Tags: []*mesh_proto.TrafficTrace_Conf_Tag{
+					{
+						Name: "user",
+						Value: &mesh_proto.TrafficTrace_Conf_Tag_Header_{
+							Header: &mesh_proto.TrafficTrace_Conf_Tag_Header{
+								Name:    "x-user",
+								Default: "anonymous",
+							},
+						},
+					},
+					{
+						Name: "team",
+						Value: &mesh_proto.TrafficTrace_Conf_Tag_Literal{
+							Literal: "payments",
+						},
+					},
+					{
+						Name: "pod",
+						Value: &mesh_proto.TrafficTrace_Conf_Tag_Env_{
+							Env: &mesh_proto.TrafficTrace_Conf_Tag_Env{
+								Name: "POD_NAME",
+							},
+						},
+					},
+				},
+			},
+			expected: `
+            name: inbound:192.168.0.1:8080
+            trafficDirection: INBOUND
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            filterChains:
+            - filters:
+              - name: envoy.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                  statPrefix: localhost_8080
+                  tracing:
+                    customTags:
+                    - tag: user
+                      requestHeader:
+                        name: x-user
+                        defaultValue: anonymous
+                    - tag: team
+                      literal:
+                        value: payments
+                    - tag: pod
+                      environment:
+                        name: POD_NAME
+                  httpFilters:
+                  - name: envoy.router
+`,
+		}),
+		Entry("paths excluded from tracing", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name: "zipkin",
+				Type: &mesh_proto.TracingBackend_Zipkin_{
+					Zipkin: &mesh_proto.TracingBackend_Zipkin{
+						Url: "http://zipkin.us:9090/v2/spans",
+					},
+				},
+			},
+			conf: &mesh_proto.TrafficTrace_Conf{
+				ExcludedPaths: []string{"/health", "/ready"},
+			},
+			inboundRoute: true,
+			expected: `
+            name: inbound:192.168.0.1:8080
+            trafficDirection: INBOUND
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            filterChains:
+            - filters:
+              - name: envoy.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                  statPrefix: localhost_8080
+                  tracing: {}
+                  httpFilters:
+                  - name: envoy.router
+                  routeConfig:
+                    name: inbound:backend
+                    validateClusters: true
+                    virtualHosts:
+                    - domains:
+                      - '*'
+                      name: backend
+                      routes:
+                      - match:
+                          path: /health
+                        route:
+                          cluster: localhost:8080
+                        tracing:
+                          overallSampling: {}
+                      - match:
+                          path: /ready
+                        route:
+                          cluster: localhost:8080
+                        tracing:
+                          overallSampling: {}
+                      - match:
+                          prefix: /
+                        route:
+                          cluster: localhost:8080
 `,
 		}),
 		Entry("no backend specified", testCase{
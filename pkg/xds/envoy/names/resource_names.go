@@ -32,6 +32,10 @@ func GetEnvoyAdminClusterName() string {
 	return "kuma:envoy:admin"
 }
 
+func GetDataplaneMetricsClusterName() string {
+	return "kuma:dataplane:metrics"
+}
+
 func GetPrometheusListenerName() string {
 	return "kuma:metrics:prometheus"
 }
@@ -15,10 +15,17 @@ import (
 // that forwards HTTP requests into the `/stats/prometheus`
 // endpoint of the Envoy Admin API.
 //
+// If kuma-dp exposes its own metrics endpoint, HTTP requests are forwarded
+// to kuma-dp instead, which merges metrics of Envoy with metrics of the application
+// and of kuma-dp itself. This way, a single scrape covers the entire dataplane.
+//
 // When generating such a listener, it's important not to overshadow
 // a port that is already in use by the application or other Envoy listeners.
 // In the latter case we prefer not generate Prometheus endpoint at all
 // rather than introduce undeterministic behaviour.
+//
+// If Prometheus endpoint is protected by mTLS, scrapes have to present
+// a client certificate issued by the CA of the Mesh.
 type PrometheusEndpointGenerator struct {
 }
 
@@ -34,6 +41,10 @@ func (g PrometheusEndpointGenerator) Generate(ctx xds_context.Context, proxy *co
 		// TODO(yskopets): find a way to communicate this to users
 		return nil, nil
 	}
+	if prometheusEndpoint.GetMtls().GetValue() && !ctx.Mesh.Resource.Spec.GetMtls().GetEnabled() {
+		// Prometheus endpoint that is meant to be protected by mTLS must never be exposed in plaintext.
+		return nil, nil
+	}
 
 	// It should be always possible to scrape metrics out of a Dataplane,
 	// even when it doesn't have any inbound interfaces (e.g., gateway scenario).
@@ -58,13 +69,26 @@ func (g PrometheusEndpointGenerator) Generate(ctx xds_context.Context, proxy *co
 	// since it would allow a malicious user to manipulate that value and use Prometheus endpoint
 	// as a gateway to another host.
 	adminAddress := "127.0.0.1"
-	envoyAdminClusterName := envoy_names.GetEnvoyAdminClusterName()
 	prometheusListenerName := envoy_names.GetPrometheusListenerName()
+	clusterName := envoy_names.GetEnvoyAdminClusterName()
+	clusterPort := adminPort
+	endpoint := envoy_listeners.PrometheusEndpoint(prometheusListenerName, prometheusEndpoint.Path, clusterName)
+	if metricsPort := proxy.Metadata.GetMetricsPort(); metricsPort != 0 {
+		// For the same reason, metrics endpoint of kuma-dp is assumed to be available on a loopback interface.
+		clusterName = envoy_names.GetDataplaneMetricsClusterName()
+		clusterPort = metricsPort
+		endpoint = envoy_listeners.AggregatedPrometheusEndpoint(prometheusListenerName, prometheusEndpoint.Path, clusterName)
+	}
+
+	filterChainBuilder := envoy_listeners.NewFilterChainBuilder()
+	if prometheusEndpoint.GetMtls().GetValue() {
+		filterChainBuilder.Configure(envoy_listeners.ServerSideMTLS(ctx, proxy.Metadata))
+	}
+	filterChainBuilder.Configure(endpoint)
 
 	listener, err := envoy_listeners.NewListenerBuilder().
 		Configure(envoy_listeners.InboundListener(prometheusListenerName, prometheusEndpointAddress, prometheusEndpoint.Port)).
-		Configure(envoy_listeners.FilterChain(envoy_listeners.NewFilterChainBuilder().
-			Configure(envoy_listeners.PrometheusEndpoint(prometheusListenerName, prometheusEndpoint.Path, envoyAdminClusterName)))).
+		Configure(envoy_listeners.FilterChain(filterChainBuilder)).
 		Configure(envoy_listeners.TransparentProxying(proxy.Dataplane.Spec.Networking.GetTransparentProxying())).
 		Build()
 	if err != nil {
@@ -73,9 +97,9 @@ func (g PrometheusEndpointGenerator) Generate(ctx xds_context.Context, proxy *co
 	return []*core_xds.Resource{
 		// CDS resource
 		&core_xds.Resource{
-			Name:     envoyAdminClusterName,
+			Name:     clusterName,
 			Version:  "",
-			Resource: envoy_clusters.CreateLocalCluster(envoyAdminClusterName, adminAddress, adminPort),
+			Resource: envoy_clusters.CreateLocalCluster(clusterName, adminAddress, clusterPort),
 		},
 		// LDS resource
 		&core_xds.Resource{
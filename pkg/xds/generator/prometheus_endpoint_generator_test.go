@@ -1,6 +1,7 @@
 package generator_test
 
 import (
+	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -160,6 +161,44 @@ var _ = Describe("PrometheusEndpointGenerator", func() {
 				Metadata: &core_xds.DataplaneMetadata{}, // dataplane was started without AdminPort
 			},
 		}),
+		Entry("Prometheus endpoint has to be protected by mTLS but mTLS is not enabled on that Mesh", testCase{
+			ctx: xds_context.Context{
+				Mesh: xds_context.MeshContext{
+					Resource: &mesh_core.MeshResource{
+						Meta: &test_model.ResourceMeta{
+							Name: "demo",
+						},
+						Spec: mesh_proto.Mesh{
+							Metrics: &mesh_proto.Metrics{
+								Prometheus: &mesh_proto.Metrics_Prometheus{
+									Port: 1234,
+									Path: "/non-standard-path",
+								},
+							},
+						},
+					},
+				},
+			},
+			proxy: &model.Proxy{
+				Id: model.ProxyId{Name: "demo.backend-01"},
+				Dataplane: &mesh_core.DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "backend-01",
+						Mesh: "demo",
+					},
+					Spec: mesh_proto.Dataplane{
+						Metrics: &mesh_proto.Metrics{
+							Prometheus: &mesh_proto.Metrics_Prometheus{
+								Mtls: &wrappers.BoolValue{Value: true},
+							},
+						},
+					},
+				},
+				Metadata: &core_xds.DataplaneMetadata{
+					AdminPort: 9902,
+				},
+			},
+		}),
 	)
 
 	DescribeTable("should generate Envoy xDS resources if Prometheus metrics have been enabled Mesh-wide",
@@ -345,6 +384,201 @@ var _ = Describe("PrometheusEndpointGenerator", func() {
                               prefixRewrite: /stats/prometheus
                       statPrefix: kuma_metrics_prometheus
                 name: kuma:metrics:prometheus
+`,
+		}),
+		Entry("should protect Prometheus endpoint by mTLS", testCase{
+			ctx: xds_context.Context{
+				ControlPlane: &xds_context.ControlPlaneContext{
+					SdsLocation: "kuma-system:5677",
+					SdsTlsCert:  []byte("12345"),
+				},
+				Mesh: xds_context.MeshContext{
+					Resource: &mesh_core.MeshResource{
+						Meta: &test_model.ResourceMeta{
+							Name: "demo",
+						},
+						Spec: mesh_proto.Mesh{
+							Mtls: &mesh_proto.Mesh_Mtls{
+								Enabled: true,
+							},
+							Metrics: &mesh_proto.Metrics{
+								Prometheus: &mesh_proto.Metrics_Prometheus{
+									Port: 1234,
+									Path: "/non-standard-path",
+									Mtls: &wrappers.BoolValue{Value: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			proxy: &model.Proxy{
+				Id: model.ProxyId{Name: "demo.backend-01"},
+				Dataplane: &mesh_core.DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "backend-01",
+						Mesh: "demo",
+					},
+				},
+				Metadata: &core_xds.DataplaneMetadata{
+					AdminPort: 9902,
+				},
+			},
+			expected: `
+            resources:
+            - name: kuma:envoy:admin
+              resource:
+                '@type': type.googleapis.com/envoy.api.v2.Cluster
+                connectTimeout: 5s
+                loadAssignment:
+                  clusterName: kuma:envoy:admin
+                  endpoints:
+                  - lbEndpoints:
+                    - endpoint:
+                        address:
+                          socketAddress:
+                            address: 127.0.0.1
+                            portValue: 9902
+                name: kuma:envoy:admin
+                altStatName: kuma_envoy_admin
+                type: STATIC
+            - name: kuma:metrics:prometheus
+              resource:
+                '@type': type.googleapis.com/envoy.api.v2.Listener
+                trafficDirection: INBOUND
+                address:
+                  socketAddress:
+                    address: 0.0.0.0
+                    portValue: 1234
+                filterChains:
+                - filters:
+                  - name: envoy.http_connection_manager
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                      httpFilters:
+                      - name: envoy.router
+                      routeConfig:
+                        virtualHosts:
+                        - domains:
+                          - '*'
+                          name: envoy_admin
+                          routes:
+                          - match:
+                              prefix: /non-standard-path
+                            route:
+                              cluster: kuma:envoy:admin
+                              prefixRewrite: /stats/prometheus
+                      statPrefix: kuma_metrics_prometheus
+                  tlsContext:
+                    commonTlsContext:
+                      tlsCertificateSdsSecretConfigs:
+                      - name: identity_cert
+                        sdsConfig:
+                          apiConfigSource:
+                            apiType: GRPC
+                            grpcServices:
+                            - googleGrpc:
+                                channelCredentials:
+                                  sslCredentials:
+                                    rootCerts:
+                                      inlineBytes: MTIzNDU=
+                                statPrefix: sds_identity_cert
+                                targetUri: kuma-system:5677
+                      validationContextSdsSecretConfig:
+                        name: mesh_ca
+                        sdsConfig:
+                          apiConfigSource:
+                            apiType: GRPC
+                            grpcServices:
+                            - googleGrpc:
+                                channelCredentials:
+                                  sslCredentials:
+                                    rootCerts:
+                                      inlineBytes: MTIzNDU=
+                                statPrefix: sds_mesh_ca
+                                targetUri: kuma-system:5677
+                    requireClientCertificate: true
+                name: kuma:metrics:prometheus
+`,
+		}),
+		Entry("should forward scrapes to kuma-dp if it exposes metrics", testCase{
+			ctx: xds_context.Context{
+				Mesh: xds_context.MeshContext{
+					Resource: &mesh_core.MeshResource{
+						Meta: &test_model.ResourceMeta{
+							Name: "demo",
+						},
+						Spec: mesh_proto.Mesh{
+							Metrics: &mesh_proto.Metrics{
+								Prometheus: &mesh_proto.Metrics_Prometheus{
+									Port: 1234,
+									Path: "/non-standard-path",
+								},
+							},
+						},
+					},
+				},
+			},
+			proxy: &model.Proxy{
+				Id: model.ProxyId{Name: "demo.backend-01"},
+				Dataplane: &mesh_core.DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "backend-01",
+						Mesh: "demo",
+					},
+					Spec: mesh_proto.Dataplane{},
+				},
+				Metadata: &core_xds.DataplaneMetadata{
+					AdminPort:   9902,
+					MetricsPort: 9903,
+				},
+			},
+			expected: `
+            resources:
+            - name: kuma:dataplane:metrics
+              resource:
+                '@type': type.googleapis.com/envoy.api.v2.Cluster
+                connectTimeout: 5s
+                loadAssignment:
+                  clusterName: kuma:dataplane:metrics
+                  endpoints:
+                  - lbEndpoints:
+                    - endpoint:
+                        address:
+                          socketAddress:
+                            address: 127.0.0.1
+                            portValue: 9903
+                name: kuma:dataplane:metrics
+                altStatName: kuma_dataplane_metrics
+                type: STATIC
+            - name: kuma:metrics:prometheus
+              resource:
+                '@type': type.googleapis.com/envoy.api.v2.Listener
+                trafficDirection: INBOUND
+                address:
+                  socketAddress:
+                    address: 0.0.0.0
+                    portValue: 1234
+                filterChains:
+                - filters:
+                  - name: envoy.http_connection_manager
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                      httpFilters:
+                      - name: envoy.router
+                      routeConfig:
+                        virtualHosts:
+                        - domains:
+                          - '*'
+                          name: kuma_dp
+                          routes:
+                          - match:
+                              prefix: /non-standard-path
+                            route:
+                              cluster: kuma:dataplane:metrics
+                              prefixRewrite: /metrics/aggregate
+                      statPrefix: kuma_metrics_prometheus
+                name: kuma:metrics:prometheus
 `,
 		}),
 	)
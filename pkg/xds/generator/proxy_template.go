@@ -119,8 +119,9 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.Pr
 				// configuration for HTTP case
 				filterChainBuilder.
 					Configure(envoy_listeners.HttpConnectionManager(localClusterName)).
-					Configure(envoy_listeners.Tracing(proxy.TracingBackend)).
-					Configure(envoy_listeners.HttpInboundRoute(service, envoy_common.ClusterInfo{Name: localClusterName}))
+					Configure(envoy_listeners.HttpInboundRoute(service, envoy_common.ClusterInfo{Name: localClusterName})).
+					// tracing has to be configured after the route, so that paths excluded from tracing could be applied to it
+					Configure(envoy_listeners.Tracing(proxy.TracingBackend, trafficTraceConf(proxy)))
 			case mesh_core.ProtocolTCP:
 				fallthrough
 			default:
@@ -148,6 +149,14 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.Pr
 	return resources.List(), nil
 }
 
+// trafficTraceConf returns configuration of TrafficTrace that applies to a given proxy, if there is any.
+func trafficTraceConf(proxy *model.Proxy) *kuma_mesh.TrafficTrace_Conf {
+	if proxy.TrafficTrace == nil {
+		return nil
+	}
+	return proxy.TrafficTrace.Spec.GetConf()
+}
+
 type OutboundProxyGenerator struct {
 }
 
@@ -197,7 +206,7 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 				// configuration for HTTP case
 				filterChainBuilder.
 					Configure(envoy_listeners.HttpConnectionManager(outbound.Service)).
-					Configure(envoy_listeners.Tracing(proxy.TracingBackend)).
+					Configure(envoy_listeners.Tracing(proxy.TracingBackend, trafficTraceConf(proxy))).
 					Configure(envoy_listeners.HttpAccessLog(meshName, sourceService, destinationService, proxy.Logs[outbound.Service], proxy)).
 					Configure(envoy_listeners.HttpOutboundRoute(outboundRouteName))
 			case mesh_core.ProtocolTCP:
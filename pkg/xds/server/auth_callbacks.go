@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"sync"
+
+	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server/v2"
+	"github.com/pkg/errors"
+
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	sds_auth "github.com/Kong/kuma/pkg/sds/auth"
+)
+
+// NewAuthCallbacks returns xDS callbacks that reject streams of dataplanes
+// that cannot prove their identity.
+//
+// Credentials are the same as the ones accepted by SDS server, i.e.
+// a Dataplane Token in Universal and a Service Account Token in Kubernetes.
+func NewAuthCallbacks(authenticator sds_auth.Authenticator) envoy_xds.Callbacks {
+	return &authCallbacks{
+		authenticator: authenticator,
+		streams:       map[int64]*authStreamState{},
+	}
+}
+
+type authCallbacks struct {
+	authenticator sds_auth.Authenticator
+
+	mu      sync.RWMutex // protects access to the fields below
+	streams map[int64]*authStreamState
+}
+
+type authStreamState struct {
+	ctx        context.Context
+	credential sds_auth.Credential
+	proxyId    *core_xds.ProxyId // set once a stream has been authenticated
+}
+
+var _ envoy_xds.Callbacks = &authCallbacks{}
+
+func (a *authCallbacks) OnStreamOpen(ctx context.Context, streamID int64, _ string) error {
+	credential, err := sds_auth.ExtractCredential(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not extract credential from the xDS stream")
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.streams[streamID] = &authStreamState{
+		ctx:        ctx,
+		credential: credential,
+	}
+	return nil
+}
+
+func (a *authCallbacks) OnStreamClosed(streamID int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.streams, streamID)
+}
+
+func (a *authCallbacks) OnStreamRequest(streamID int64, req *envoy.DiscoveryRequest) error {
+	a.mu.RLock()
+	state, found := a.streams[streamID]
+	a.mu.RUnlock()
+	if !found {
+		return errors.Errorf("xDS stream %d has not been opened", streamID)
+	}
+
+	if state.proxyId != nil && req.Node == nil {
+		// only the first request on a stream is guaranteed to carry the node identifier
+		return nil
+	}
+
+	proxyId, err := core_xds.ParseProxyId(req.Node)
+	if err != nil {
+		return errors.Wrap(err, "xDS request must have a valid Proxy Id")
+	}
+
+	if state.proxyId != nil {
+		// node identifier must stay the same for the whole lifetime of a stream
+		if *state.proxyId != *proxyId {
+			return errors.Errorf("proxy id %q is different than the one the stream was authenticated with %q", proxyId, state.proxyId)
+		}
+		return nil
+	}
+
+	if _, err := a.authenticator.Authenticate(state.ctx, *proxyId, state.credential); err != nil {
+		return errors.Wrap(err, "authentication failed")
+	}
+
+	// requests on a single stream are processed sequentially, so there is no need to lock the stream state
+	state.proxyId = proxyId
+	return nil
+}
+
+func (a *authCallbacks) OnStreamResponse(int64, *envoy.DiscoveryRequest, *envoy.DiscoveryResponse) {
+}
+
+func (a *authCallbacks) OnFetchRequest(ctx context.Context, req *envoy.DiscoveryRequest) error {
+	credential, err := sds_auth.ExtractCredential(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not extract credential from the xDS request")
+	}
+	proxyId, err := core_xds.ParseProxyId(req.Node)
+	if err != nil {
+		return errors.Wrap(err, "xDS request must have a valid Proxy Id")
+	}
+	if _, err := a.authenticator.Authenticate(ctx, *proxyId, credential); err != nil {
+		return errors.Wrap(err, "authentication failed")
+	}
+	return nil
+}
+
+func (a *authCallbacks) OnFetchResponse(*envoy.DiscoveryRequest, *envoy.DiscoveryResponse) {
+}
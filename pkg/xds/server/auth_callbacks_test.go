@@ -0,0 +1,139 @@
+package server_test
+
+import (
+	"context"
+
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	sds_auth "github.com/Kong/kuma/pkg/sds/auth"
+	"github.com/Kong/kuma/pkg/xds/server"
+)
+
+type staticAuthenticator struct {
+	tokens map[sds_auth.Credential]core_xds.ProxyId
+}
+
+func (a *staticAuthenticator) Authenticate(_ context.Context, proxyId core_xds.ProxyId, credential sds_auth.Credential) (sds_auth.Identity, error) {
+	id, ok := a.tokens[credential]
+	if !ok {
+		return sds_auth.Identity{}, errors.New("unknown token")
+	}
+	if id != proxyId {
+		return sds_auth.Identity{}, errors.Errorf("proxy id %q is different than in token %q", proxyId.String(), id.String())
+	}
+	return sds_auth.Identity{Mesh: id.Mesh, Service: "backend"}, nil
+}
+
+var _ = Describe("Auth Callbacks", func() {
+
+	const streamId = 123
+
+	var callbacks = server.NewAuthCallbacks(&staticAuthenticator{
+		tokens: map[sds_auth.Credential]core_xds.ProxyId{
+			"token-of-backend-01": {Mesh: "default", Name: "backend-01"},
+		},
+	})
+
+	streamCtx := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", token))
+	}
+	requestFrom := func(nodeId string) *v2.DiscoveryRequest {
+		return &v2.DiscoveryRequest{
+			Node: &envoy_core.Node{
+				Id: nodeId,
+			},
+		}
+	}
+
+	AfterEach(func() {
+		callbacks.OnStreamClosed(streamId)
+	})
+
+	It("should accept a stream with a valid token", func() {
+		// when
+		err := callbacks.OnStreamOpen(streamCtx("token-of-backend-01"), streamId, "")
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = callbacks.OnStreamRequest(streamId, requestFrom("default.backend-01"))
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when consecutive request arrives
+		err = callbacks.OnStreamRequest(streamId, requestFrom("default.backend-01"))
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should accept consecutive requests without node on an authenticated stream", func() {
+		// given
+		err := callbacks.OnStreamOpen(streamCtx("token-of-backend-01"), streamId, "")
+		Expect(err).ToNot(HaveOccurred())
+		err = callbacks.OnStreamRequest(streamId, requestFrom("default.backend-01"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = callbacks.OnStreamRequest(streamId, &v2.DiscoveryRequest{})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should reject the first request of a stream without node", func() {
+		// given
+		err := callbacks.OnStreamOpen(streamCtx("token-of-backend-01"), streamId, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = callbacks.OnStreamRequest(streamId, &v2.DiscoveryRequest{})
+
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject a stream with an invalid token", func() {
+		// when
+		err := callbacks.OnStreamOpen(streamCtx("invalid-token"), streamId, "")
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = callbacks.OnStreamRequest(streamId, requestFrom("default.backend-01"))
+		// then
+		Expect(err).To(MatchError("authentication failed: unknown token"))
+	})
+
+	It("should reject a stream when node id does not match the token", func() {
+		// when
+		err := callbacks.OnStreamOpen(streamCtx("token-of-backend-01"), streamId, "")
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = callbacks.OnStreamRequest(streamId, requestFrom("default.web-01"))
+		// then
+		Expect(err).To(MatchError(`authentication failed: proxy id "default.web-01" is different than in token "default.backend-01"`))
+	})
+
+	It("should reject a stream when node id changes after authentication", func() {
+		// given
+		err := callbacks.OnStreamOpen(streamCtx("token-of-backend-01"), streamId, "")
+		Expect(err).ToNot(HaveOccurred())
+		err = callbacks.OnStreamRequest(streamId, requestFrom("default.backend-01"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		err = callbacks.OnStreamRequest(streamId, requestFrom("default.web-01"))
+
+		// then
+		Expect(err).To(MatchError(`proxy id "default.web-01" is different than the one the stream was authenticated with "default.backend-01"`))
+	})
+})
@@ -4,33 +4,45 @@ import (
 	"context"
 	"time"
 
-	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/pkg/errors"
+
+	config_core "github.com/Kong/kuma/pkg/config/core"
 	"github.com/Kong/kuma/pkg/core"
-	"github.com/Kong/kuma/pkg/core/logs"
-	"github.com/Kong/kuma/pkg/core/permissions"
 	core_model "github.com/Kong/kuma/pkg/core/resources/model"
 	core_store "github.com/Kong/kuma/pkg/core/resources/store"
 	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
 	"github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/dns"
+	universal_sds_auth "github.com/Kong/kuma/pkg/sds/auth/universal"
+	sds_server "github.com/Kong/kuma/pkg/sds/server"
+	tokens_builtin "github.com/Kong/kuma/pkg/tokens/builtin"
 	util_watchdog "github.com/Kong/kuma/pkg/util/watchdog"
 	util_xds "github.com/Kong/kuma/pkg/util/xds"
 	xds_bootstrap "github.com/Kong/kuma/pkg/xds/bootstrap"
 	xds_context "github.com/Kong/kuma/pkg/xds/context"
 	xds_sync "github.com/Kong/kuma/pkg/xds/sync"
 	xds_template "github.com/Kong/kuma/pkg/xds/template"
-	xds_topology "github.com/Kong/kuma/pkg/xds/topology"
 
 	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 
-	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server"
+	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server/v2"
 )
 
 var (
 	xdsServerLog = core.Log.WithName("xds-server")
 )
 
-func SetupServer(rt core_runtime.Runtime) error {
-	reconciler := DefaultReconciler(rt)
+// SetupServer sets up xDS and bootstrap servers. Stream closer is optional and is shared with SDS server.
+func SetupServer(rt core_runtime.Runtime, streamCloser *universal_sds_auth.RevokedStreamCloser) error {
+	reconciler, err := DefaultReconciler(rt)
+	if err != nil {
+		return err
+	}
+
+	authenticator, err := sds_server.DefaultAuthenticator(rt)
+	if err != nil {
+		return err
+	}
 
 	metadataTracker := NewDataplaneMetadataTracker()
 
@@ -38,42 +50,105 @@ func SetupServer(rt core_runtime.Runtime) error {
 	if err != nil {
 		return err
 	}
+	streamMetrics, err := NewStreamMetricsCallbacks(rt.Metrics())
+	if err != nil {
+		return err
+	}
 	callbacks := util_xds.CallbacksChain{
+		NewAuthCallbacks(authenticator),
 		tracker,
 		metadataTracker,
 		DefaultDataplaneStatusTracker(rt),
+		streamMetrics,
 	}
 
-	srv := NewServer(rt.XDS().Cache(), callbacks)
+	envoyCpCtx, err := xds_context.BuildControlPlaneContext(rt.Config())
+	if err != nil {
+		return err
+	}
+
+	registrar, err := DefaultDataplaneRegistrar(rt)
+	if err != nil {
+		return err
+	}
+
+	xdsServer := &grpcServer{
+		server:      NewServer(rt.XDS().Cache(), callbacks),
+		apiVersions: rt.XDS().ApiVersions(),
+		port:        rt.Config().XdsServer.GrpcPort,
+		tlsConfig:   *rt.Config().SdsServer,
+	}
+	if streamCloser != nil {
+		xdsServer.streamInterceptor = streamCloser.StreamInterceptor
+	}
 	return core_runtime.Add(
 		rt,
 		// xDS gRPC API
-		&grpcServer{srv, rt.Config().XdsServer.GrpcPort},
+		xdsServer,
 		// diagnostics server
-		&diagnosticsServer{rt.Config().XdsServer.DiagnosticsPort},
+		&diagnosticsServer{rt.Config().XdsServer.DiagnosticsPort, rt.Metrics(), rt.Health()},
 		// bootstrap server
 		&xds_bootstrap.BootstrapServer{
-			Port:      rt.Config().BootstrapServer.Port,
-			Generator: xds_bootstrap.NewDefaultBootstrapGenerator(rt.ResourceManager(), rt.Config().BootstrapServer.Params),
+			Port:          rt.Config().BootstrapServer.Port,
+			TlsCertFile:   rt.Config().BootstrapServer.TlsCertFile,
+			TlsKeyFile:    rt.Config().BootstrapServer.TlsKeyFile,
+			Generator:     xds_bootstrap.NewDefaultBootstrapGenerator(rt.ResourceManager(), rt.Config().BootstrapServer.Params, envoyCpCtx.SdsTlsCert),
+			Registrar:     registrar,
+			VIPs:          dns.VIPsLoader(rt),
+			Authenticator: authenticator,
+			DNSDomain:     rt.Config().DNSServer.Domain,
 		},
 	)
 }
 
-func DefaultReconciler(rt core_runtime.Runtime) SnapshotReconciler {
-	return &reconciler{
-		&templateSnapshotGenerator{
-			ProxyTemplateResolver: &simpleProxyTemplateResolver{
-				ReadOnlyResourceManager: rt.ReadOnlyResourceManager(),
-				DefaultProxyTemplate:    xds_template.DefaultProxyTemplate,
-			},
+// DefaultDataplaneRegistrar returns a DataplaneRegistrar for kuma-dp that registers itself or nil if self-registration
+// is not supported. Dataplanes on Kubernetes are managed by the Control Plane itself.
+func DefaultDataplaneRegistrar(rt core_runtime.Runtime) (xds_bootstrap.DataplaneRegistrar, error) {
+	switch env := rt.Config().Environment; env {
+	case config_core.KubernetesEnvironment:
+		return nil, nil
+	case config_core.UniversalEnvironment:
+		if !rt.Config().DataplaneTokenServer.Enabled {
+			return xds_bootstrap.NewDataplaneRegistrar(rt.ResourceManager(), nil, nil), nil
+		}
+		issuer, err := tokens_builtin.NewDataplaneTokenIssuer(rt)
+		if err != nil {
+			return nil, err
+		}
+		return xds_bootstrap.NewDataplaneRegistrar(rt.ResourceManager(), issuer, tokens_builtin.NewRevocationList(rt)), nil
+	default:
+		return nil, errors.Errorf("unknown environment type %q", env)
+	}
+}
+
+func DefaultReconciler(rt core_runtime.Runtime) (SnapshotReconciler, error) {
+	generator, err := newMeteredSnapshotGenerator(&templateSnapshotGenerator{
+		ProxyTemplateResolver: &simpleProxyTemplateResolver{
+			ReadOnlyResourceManager: rt.ReadOnlyResourceManager(),
+			DefaultProxyTemplate:    xds_template.DefaultProxyTemplate,
 		},
+	}, rt.Metrics())
+	if err != nil {
+		return nil, err
+	}
+	return NewMeteredReconciler(&reconciler{
+		generator,
 		&simpleSnapshotCacher{rt.XDS().Hasher(), rt.XDS().Cache()},
+	}, rt.Metrics())
+}
+
+// DefaultDataplaneProxyBuilder returns a DataplaneProxyBuilder that is shared by xDS and config dumps,
+// so a dumped config is the same as the one Envoy gets.
+func DefaultDataplaneProxyBuilder(rt core_runtime.Runtime) *DataplaneProxyBuilder {
+	return &DataplaneProxyBuilder{
+		ResourceManager: rt.ReadOnlyResourceManager(),
+		LoadVIPs:        dns.CachedVIPsLoader(rt),
+		VIPPort:         rt.Config().DNSServer.VIPPort,
 	}
 }
 
 func DefaultDataplaneSyncTracker(rt core_runtime.Runtime, reconciler SnapshotReconciler, metadataTracker *DataplaneMetadataTracker) (envoy_xds.Callbacks, error) {
-	permissionsMatcher := permissions.TrafficPermissionsMatcher{ResourceManager: rt.ReadOnlyResourceManager()}
-	logsMatcher := logs.TrafficLogsMatcher{ResourceManager: rt.ReadOnlyResourceManager()}
+	proxyBuilder := DefaultDataplaneProxyBuilder(rt)
 	envoyCpCtx, err := xds_context.BuildControlPlaneContext(rt.Config())
 	if err != nil {
 		return nil, err
@@ -107,59 +182,11 @@ func DefaultDataplaneSyncTracker(rt core_runtime.Runtime, reconciler SnapshotRec
 					},
 				}
 
-				// pick a single the most specific route for each outbound interface
-				routes, err := xds_topology.GetRoutes(ctx, dataplane, rt.ReadOnlyResourceManager())
-				if err != nil {
-					return err
-				}
-
-				// create creates a map of selectors to match other dataplanes reachable via given routes
-				destinations := xds_topology.BuildDestinationMap(dataplane, routes)
-
-				// resolve all endpoints that match given selectors
-				outbound, err := xds_topology.GetOutboundTargets(ctx, dataplane, destinations, rt.ReadOnlyResourceManager())
-				if err != nil {
-					return err
-				}
-
-				healthChecks, err := xds_topology.GetHealthChecks(ctx, dataplane, destinations, rt.ReadOnlyResourceManager())
-				if err != nil {
-					return err
-				}
-
-				trafficTrace, err := xds_topology.GetTrafficTrace(ctx, dataplane, rt.ReadOnlyResourceManager())
-				if err != nil {
-					return err
-				}
-				var tracingBackend *mesh_proto.TracingBackend
-				if trafficTrace != nil {
-					tracingBackend = mesh.GetTracingBackend(trafficTrace.Spec.GetConf().GetBackend())
-				}
-
-				matchedPermissions, err := permissionsMatcher.Match(ctx, dataplane)
+				proxy, err := proxyBuilder.Build(ctx, dataplane, mesh, metadataTracker.Metadata(streamId))
 				if err != nil {
 					return err
 				}
-
-				matchedLogs, err := logsMatcher.Match(ctx, dataplane)
-				if err != nil {
-					return err
-				}
-
-				proxy := xds.Proxy{
-					Id:                 proxyID,
-					Dataplane:          dataplane,
-					TrafficPermissions: matchedPermissions,
-					TrafficRoutes:      routes,
-					OutboundSelectors:  destinations,
-					OutboundTargets:    outbound,
-					HealthChecks:       healthChecks,
-					Logs:               matchedLogs,
-					TrafficTrace:       trafficTrace,
-					TracingBackend:     tracingBackend,
-					Metadata:           metadataTracker.Metadata(streamId),
-				}
-				return reconciler.Reconcile(envoyCtx, &proxy)
+				return reconciler.Reconcile(envoyCtx, proxy)
 			},
 			OnError: func(err error) {
 				log.Error(err, "OnTick() failed")
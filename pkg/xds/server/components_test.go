@@ -49,7 +49,9 @@ var _ = Describe("Components", func() {
 			cfg.XdsServer.DataplaneConfigurationRefreshInterval = 1 * time.Millisecond
 
 			// and
-			runtime, err := test_runtime.BuilderFor(cfg).Build()
+			builder, err := test_runtime.BuilderFor(cfg)
+			Expect(err).ToNot(HaveOccurred())
+			runtime, err := builder.Build()
 			Expect(err).ToNot(HaveOccurred())
 
 			// and example mesh
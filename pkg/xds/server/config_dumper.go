@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v2"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v2"
+
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+	"github.com/Kong/kuma/pkg/core/xds"
+	xds_context "github.com/Kong/kuma/pkg/xds/context"
+	xds_template "github.com/Kong/kuma/pkg/xds/template"
+)
+
+type ConfigDumpSource string
+
+const (
+	// ConfigDumpSourceCache means that resources have been taken from the snapshot cache
+	// of a Dataplane that is connected to the Control Plane.
+	ConfigDumpSourceCache ConfigDumpSource = "cache"
+	// ConfigDumpSourceGenerated means that resources have been generated on demand
+	// for a Dataplane that is not connected to the Control Plane.
+	ConfigDumpSourceGenerated ConfigDumpSource = "generated"
+)
+
+// ConfigDump represents Envoy resources the Control Plane generates for a Dataplane.
+type ConfigDump struct {
+	Source   ConfigDumpSource
+	Snapshot envoy_cache.Snapshot
+	// ApiVersions holds a version of Envoy xDS API resources of every xDS v2 type URL have been served in
+	ApiVersions map[string]xds.ApiVersion
+}
+
+// ConfigDumper returns Envoy resources the Control Plane generates for a Dataplane.
+type ConfigDumper interface {
+	Dump(ctx context.Context, key core_model.ResourceKey) (*ConfigDump, error)
+}
+
+func DefaultConfigDumper(rt core_runtime.Runtime) (ConfigDumper, error) {
+	envoyCpCtx, err := xds_context.BuildControlPlaneContext(rt.Config())
+	if err != nil {
+		return nil, err
+	}
+	return NewConfigDumper(rt.ReadOnlyResourceManager(), DefaultDataplaneProxyBuilder(rt), rt.XDS().Cache(), rt.XDS().ApiVersions(), envoyCpCtx), nil
+}
+
+func NewConfigDumper(resManager core_manager.ReadOnlyResourceManager, proxyBuilder *DataplaneProxyBuilder, cache envoy_cache.SnapshotCache, apiVersions xds.ApiVersionTracker, cpContext *xds_context.ControlPlaneContext) ConfigDumper {
+	return &configDumper{
+		resManager:   resManager,
+		proxyBuilder: proxyBuilder,
+		cache:        cache,
+		apiVersions:  apiVersions,
+		cpContext:    cpContext,
+		generator: &templateSnapshotGenerator{
+			ProxyTemplateResolver: &simpleProxyTemplateResolver{
+				ReadOnlyResourceManager: resManager,
+				DefaultProxyTemplate:    xds_template.DefaultProxyTemplate,
+			},
+		},
+	}
+}
+
+var _ ConfigDumper = &configDumper{}
+
+type configDumper struct {
+	resManager   core_manager.ReadOnlyResourceManager
+	proxyBuilder *DataplaneProxyBuilder
+	cache        envoy_cache.SnapshotCache
+	apiVersions  xds.ApiVersionTracker
+	cpContext    *xds_context.ControlPlaneContext
+	generator    snapshotGenerator
+}
+
+func (d *configDumper) Dump(ctx context.Context, key core_model.ResourceKey) (*ConfigDump, error) {
+	dataplane := &mesh_core.DataplaneResource{}
+	if err := d.resManager.Get(ctx, dataplane, core_store.GetBy(key)); err != nil {
+		return nil, err
+	}
+
+	proxyId := xds.FromResourceKey(key)
+	if snapshot, err := d.cache.GetSnapshot(proxyId.String()); err == nil && !isEmptySnapshot(snapshot) {
+		return &ConfigDump{
+			Source:      ConfigDumpSourceCache,
+			Snapshot:    snapshot,
+			ApiVersions: d.servedApiVersions(proxyId),
+		}, nil
+	}
+
+	// Dataplane is not connected to this instance of the Control Plane,
+	// so let's generate its configuration the same way it would be generated on connect
+	mesh := &mesh_core.MeshResource{}
+	if err := d.resManager.Get(ctx, mesh, core_store.GetByKey(key.Mesh, key.Mesh)); err != nil {
+		return nil, err
+	}
+	proxy, err := d.proxyBuilder.Build(ctx, dataplane, mesh, &xds.DataplaneMetadata{})
+	if err != nil {
+		return nil, err
+	}
+	envoyCtx := xds_context.Context{
+		ControlPlane: d.cpContext,
+		Mesh: xds_context.MeshContext{
+			Resource: mesh,
+		},
+	}
+	snapshot, err := d.generator.GenerateSnapshot(envoyCtx, proxy)
+	if err != nil {
+		return nil, err
+	}
+	return &ConfigDump{
+		Source:      ConfigDumpSourceGenerated,
+		Snapshot:    snapshot,
+		ApiVersions: generatedApiVersions(),
+	}, nil
+}
+
+var dumpedTypeURLs = []string{envoy_resource.ListenerType, envoy_resource.RouteType, envoy_resource.ClusterType, envoy_resource.EndpointType}
+
+// servedApiVersions returns versions of Envoy xDS API cached resources have been served in to a Dataplane.
+func (d *configDumper) servedApiVersions(proxyId xds.ProxyId) map[string]xds.ApiVersion {
+	versions := map[string]xds.ApiVersion{}
+	for _, typeURL := range dumpedTypeURLs {
+		versions[typeURL] = d.apiVersions.ApiVersion(proxyId, typeURL)
+	}
+	return versions
+}
+
+// generatedApiVersions returns versions of Envoy xDS API of resources generated on demand, which are always generated in xDS v2.
+func generatedApiVersions() map[string]xds.ApiVersion {
+	versions := map[string]xds.ApiVersion{}
+	for _, typeURL := range dumpedTypeURLs {
+		versions[typeURL] = xds.ApiVersionV2
+	}
+	return versions
+}
+
+// isEmptySnapshot returns true for a placeholder snapshot that is cached for a deleted Dataplane.
+func isEmptySnapshot(snapshot envoy_cache.Snapshot) bool {
+	return len(snapshot.Resources[envoy_types.Listener].Items) == 0 &&
+		len(snapshot.Resources[envoy_types.Cluster].Items) == 0 &&
+		len(snapshot.Resources[envoy_types.Route].Items) == 0 &&
+		len(snapshot.Resources[envoy_types.Endpoint].Items) == 0
+}
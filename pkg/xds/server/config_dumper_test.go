@@ -0,0 +1,95 @@
+package server_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	envoy_api_v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v2"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v2"
+
+	"github.com/Kong/kuma/pkg/api-server/types"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/manager"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	xds_context "github.com/Kong/kuma/pkg/xds/context"
+	"github.com/Kong/kuma/pkg/xds/server"
+)
+
+var _ = Describe("ConfigDumper", func() {
+
+	var resManager manager.ResourceManager
+	var xdsContext core_xds.XdsContext
+	var dumper server.ConfigDumper
+
+	proxyId := core_xds.ProxyId{Mesh: "default", Name: "backend-01"}
+
+	BeforeEach(func() {
+		resManager = manager.NewResourceManager(memory.NewStore())
+		xdsContext = core_xds.NewXdsContext()
+		dumper = server.NewConfigDumper(resManager, &server.DataplaneProxyBuilder{ResourceManager: resManager}, xdsContext.Cache(), xdsContext.ApiVersions(), &xds_context.ControlPlaneContext{})
+
+		err := resManager.Create(context.Background(), &mesh_core.MeshResource{}, store.CreateByKey("default", "default"))
+		Expect(err).ToNot(HaveOccurred())
+		dataplane := &mesh_core.DataplaneResource{}
+		err = util_proto.FromYAML([]byte(`
+        networking:
+          address: 192.168.0.1
+          inbound:
+          - port: 8080
+            servicePort: 80
+            tags:
+              service: backend`), &dataplane.Spec)
+		Expect(err).ToNot(HaveOccurred())
+		err = resManager.Create(context.Background(), dataplane, store.CreateBy(proxyId.ToResourceKey()))
+		Expect(err).ToNot(HaveOccurred())
+
+		snapshot := envoy_cache.NewSnapshot("1", nil, []envoy_types.Resource{&envoy_api_v2.Cluster{Name: "localhost:80"}}, nil, []envoy_types.Resource{
+			&envoy_api_v2.Listener{Name: "inbound:192.168.0.1:8080"},
+		}, nil)
+		Expect(xdsContext.Cache().SetSnapshot(proxyId.String(), snapshot)).To(Succeed())
+	})
+
+	It("should dump resources in versions they have been served in", func() {
+		// given
+		stream := &struct{ int }{}
+		xdsContext.ApiVersions().TrackV3(stream, proxyId, envoy_resource.ListenerType)
+
+		// when
+		dump, err := dumper.Dump(context.Background(), model.ResourceKey{Mesh: "default", Name: "backend-01"})
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dump.Source).To(Equal(server.ConfigDumpSourceCache))
+		Expect(dump.ApiVersions[envoy_resource.ListenerType]).To(Equal(core_xds.ApiVersionV3))
+		Expect(dump.ApiVersions[envoy_resource.ClusterType]).To(Equal(core_xds.ApiVersionV2))
+
+		// when
+		res, err := types.NewConfigDump(string(dump.Source), dump.Snapshot, dump.ApiVersions)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Listeners.ApiVersion).To(Equal("v3"))
+		Expect(res.Listeners.Items).To(HaveLen(1))
+		Expect(res.Listeners.Items[0]).To(MatchJSON(`{"name": "inbound:192.168.0.1:8080"}`))
+		Expect(res.Clusters.ApiVersion).To(Equal("v2"))
+		Expect(res.Clusters.Items).To(HaveLen(1))
+	})
+
+	It("should dump resources of a disconnected Dataplane in xDS v2", func() {
+		// given
+		xdsContext.Cache().ClearSnapshot(proxyId.String())
+
+		// when
+		dump, err := dumper.Dump(context.Background(), model.ResourceKey{Mesh: "default", Name: "backend-01"})
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dump.Source).To(Equal(server.ConfigDumpSourceGenerated))
+		Expect(dump.ApiVersions[envoy_resource.ListenerType]).To(Equal(core_xds.ApiVersionV2))
+	})
+})
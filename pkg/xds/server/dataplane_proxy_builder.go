@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/logs"
+	"github.com/Kong/kuma/pkg/core/permissions"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	"github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/dns"
+	xds_topology "github.com/Kong/kuma/pkg/xds/topology"
+)
+
+// DataplaneProxyBuilder resolves all policies and endpoints that apply to a given Dataplane.
+type DataplaneProxyBuilder struct {
+	ResourceManager core_manager.ReadOnlyResourceManager
+	// LoadVIPs returns VIPs allocated to services of a given mesh.
+	// If set, a Dataplane with transparent proxying gets an outbound interface for every service that has a VIP.
+	// It is called on every build of every Dataplane, so it is expected to cache VIPs per mesh, see dns.CachedVIPsLoader.
+	LoadVIPs dns.LoadVIPsFunc
+	// VIPPort is a port that services are available on at their VIPs.
+	VIPPort uint32
+}
+
+func (b *DataplaneProxyBuilder) Build(ctx context.Context, dataplane *mesh_core.DataplaneResource, mesh *mesh_core.MeshResource, metadata *xds.DataplaneMetadata) (*xds.Proxy, error) {
+	permissionsMatcher := permissions.TrafficPermissionsMatcher{ResourceManager: b.ResourceManager}
+	logsMatcher := logs.TrafficLogsMatcher{ResourceManager: b.ResourceManager}
+
+	dataplane, err := b.withVIPOutbounds(ctx, dataplane)
+	if err != nil {
+		return nil, err
+	}
+
+	// pick a single the most specific route for each outbound interface
+	routes, err := xds_topology.GetRoutes(ctx, dataplane, b.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+
+	// create creates a map of selectors to match other dataplanes reachable via given routes
+	destinations := xds_topology.BuildDestinationMap(dataplane, routes)
+
+	// resolve all endpoints that match given selectors
+	outbound, err := xds_topology.GetOutboundTargets(ctx, dataplane, destinations, b.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+
+	healthChecks, err := xds_topology.GetHealthChecks(ctx, dataplane, destinations, b.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+
+	trafficTrace, err := xds_topology.GetTrafficTrace(ctx, dataplane, b.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	var tracingBackend *mesh_proto.TracingBackend
+	if trafficTrace != nil {
+		tracingBackend = mesh.GetTracingBackend(trafficTrace.Spec.GetConf().GetBackend())
+	}
+
+	matchedPermissions, err := permissionsMatcher.Match(ctx, dataplane)
+	if err != nil {
+		return nil, err
+	}
+
+	matchedLogs, err := logsMatcher.Match(ctx, dataplane)
+	if err != nil {
+		return nil, err
+	}
+
+	return &xds.Proxy{
+		Id:                 xds.FromResourceKey(core_model.MetaToResourceKey(dataplane.GetMeta())),
+		Dataplane:          dataplane,
+		TrafficPermissions: matchedPermissions,
+		TrafficRoutes:      routes,
+		OutboundSelectors:  destinations,
+		OutboundTargets:    outbound,
+		HealthChecks:       healthChecks,
+		Logs:               matchedLogs,
+		TrafficTrace:       trafficTrace,
+		TracingBackend:     tracingBackend,
+		Metadata:           metadata,
+	}, nil
+}
+
+// withVIPOutbounds returns a copy of a given Dataplane with an outbound interface on <VIP>:<VIPPort> for every service that has a VIP.
+// Outbound interfaces of the Dataplane take precedence over the generated ones.
+func (b *DataplaneProxyBuilder) withVIPOutbounds(ctx context.Context, dataplane *mesh_core.DataplaneResource) (*mesh_core.DataplaneResource, error) {
+	if b.LoadVIPs == nil || dataplane.Spec.Networking.GetTransparentProxying().GetRedirectPort() == 0 {
+		return dataplane, nil
+	}
+	vips, err := b.LoadVIPs(ctx, dataplane.GetMeta().GetMesh())
+	if err != nil {
+		return nil, err
+	}
+	if len(vips) == 0 {
+		return dataplane, nil
+	}
+	ofaces, err := dataplane.Spec.Networking.GetOutboundInterfaces()
+	if err != nil {
+		return nil, err
+	}
+	taken := map[string]bool{}
+	for _, oface := range ofaces {
+		taken[oface.String()] = true
+	}
+	services := make([]string, 0, len(vips))
+	for service := range vips {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	spec := proto.Clone(&dataplane.Spec).(*mesh_proto.Dataplane)
+	for _, service := range services {
+		oface := mesh_proto.OutboundInterface{DataplaneIP: vips[service], DataplanePort: b.VIPPort}
+		if taken[oface.String()] {
+			continue
+		}
+		spec.Networking.Outbound = append(spec.Networking.Outbound, &mesh_proto.Dataplane_Networking_Outbound{
+			Address: oface.DataplaneIP,
+			Port:    oface.DataplanePort,
+			Service: service,
+		})
+	}
+	return &mesh_core.DataplaneResource{
+		Meta: dataplane.GetMeta(),
+		Spec: *spec,
+	}, nil
+}
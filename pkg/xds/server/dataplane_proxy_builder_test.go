@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/manager"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	model "github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/dns"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("DataplaneProxyBuilder", func() {
+
+	var rm manager.ResourceManager
+	var builder *DataplaneProxyBuilder
+
+	BeforeEach(func() {
+		rm = manager.NewResourceManager(memory.NewStore())
+		builder = &DataplaneProxyBuilder{
+			ResourceManager: rm,
+			LoadVIPs: func(_ context.Context, mesh string) (dns.VIPList, error) {
+				Expect(mesh).To(Equal("default"))
+				return dns.VIPList{
+					"backend": "240.0.0.1",
+					"redis":   "240.0.0.2",
+				}, nil
+			},
+			VIPPort: 80,
+		}
+
+		err := rm.Create(context.Background(), &mesh_core.MeshResource{}, store.CreateByKey("default", "default"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	build := func(transparentProxying *mesh_proto.Dataplane_Networking_TransparentProxying) *model.Proxy {
+		// setup
+		dataplane := &mesh_core.DataplaneResource{
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port:        8080,
+							ServicePort: 80,
+							Tags: map[string]string{
+								"service": "web",
+							},
+						},
+					},
+					Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+						{
+							Address: "240.0.0.2",
+							Port:    80,
+							Service: "redis-replica",
+						},
+					},
+					TransparentProxying: transparentProxying,
+				},
+			},
+		}
+		err := rm.Create(context.Background(), dataplane, store.CreateByKey("web-01", "default"))
+		Expect(err).ToNot(HaveOccurred())
+		mesh := &mesh_core.MeshResource{}
+		err = rm.Get(context.Background(), mesh, store.GetByKey("default", "default"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		proxy, err := builder.Build(context.Background(), dataplane, mesh, &model.DataplaneMetadata{})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		return proxy
+	}
+
+	It("should add outbound interfaces for VIPs of services to a Dataplane with transparent proxying", func() {
+		// when
+		proxy := build(&mesh_proto.Dataplane_Networking_TransparentProxying{
+			RedirectPort: 15001,
+		})
+
+		// then
+		Expect(proxy.Dataplane.Spec.Networking.Outbound).To(Equal([]*mesh_proto.Dataplane_Networking_Outbound{
+			{
+				Address: "240.0.0.2",
+				Port:    80,
+				Service: "redis-replica",
+			},
+			{
+				Address: "240.0.0.1",
+				Port:    80,
+				Service: "backend",
+			},
+		}))
+		// and
+		Expect(proxy.TrafficRoutes).To(HaveKey("backend"))
+		// and
+		Expect(proxy.Dataplane.Meta.GetName()).To(Equal("web-01"))
+	})
+
+	It("should add outbound interfaces on a configured port of VIPs", func() {
+		// given
+		builder.VIPPort = 8080
+
+		// when
+		proxy := build(&mesh_proto.Dataplane_Networking_TransparentProxying{
+			RedirectPort: 15001,
+		})
+
+		// then
+		Expect(proxy.Dataplane.Spec.Networking.Outbound).To(Equal([]*mesh_proto.Dataplane_Networking_Outbound{
+			{
+				Address: "240.0.0.2",
+				Port:    80,
+				Service: "redis-replica",
+			},
+			{
+				Address: "240.0.0.1",
+				Port:    8080,
+				Service: "backend",
+			},
+			{
+				Address: "240.0.0.2",
+				Port:    8080,
+				Service: "redis",
+			},
+		}))
+	})
+
+	It("should not add outbound interfaces for VIPs of services to a Dataplane without transparent proxying", func() {
+		// when
+		proxy := build(nil)
+
+		// then
+		Expect(proxy.Dataplane.Spec.Networking.Outbound).To(HaveLen(1))
+		Expect(proxy.TrafficRoutes).ToNot(HaveKey("backend"))
+	})
+})
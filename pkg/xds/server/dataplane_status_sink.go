@@ -10,7 +10,6 @@ import (
 	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	"github.com/Kong/kuma/pkg/core/resources/manager"
 	core_model "github.com/Kong/kuma/pkg/core/resources/model"
-	core_store "github.com/Kong/kuma/pkg/core/resources/store"
 )
 
 type DataplaneInsightSink interface {
@@ -21,6 +20,11 @@ type DataplaneInsightStore interface {
 	Upsert(dataplaneId core_model.ResourceKey, subscription *mesh_proto.DiscoverySubscription) error
 }
 
+// dataplaneInsightHeartbeat is the maximum period of time DataplaneInsight of a connected Dataplane
+// is left unchanged. Saving it periodically lets others (e.g. the garbage collector of offline Dataplanes)
+// tell subscriptions of Control Plane instances that died without closing them from the live ones.
+const dataplaneInsightHeartbeat = time.Minute
+
 func NewDataplaneInsightSink(
 	accessor SubscriptionStatusAccessor,
 	newTicker func() *time.Ticker,
@@ -41,10 +45,11 @@ func (s *dataplaneInsightSink) Start(stop <-chan struct{}) {
 	defer ticker.Stop()
 
 	var lastStoredState *mesh_proto.DiscoverySubscription
+	var lastStoredTime time.Time
 
-	flush := func() {
+	flush := func(now time.Time) {
 		dataplaneId, currentState := s.accessor.GetStatus()
-		if proto.Equal(currentState, lastStoredState) {
+		if proto.Equal(currentState, lastStoredState) && now.Sub(lastStoredTime) < dataplaneInsightHeartbeat {
 			return
 		}
 		copy := proto.Clone(currentState).(*mesh_proto.DiscoverySubscription)
@@ -53,15 +58,16 @@ func (s *dataplaneInsightSink) Start(stop <-chan struct{}) {
 		} else {
 			xdsServerLog.V(1).Info("saved Dataplane status", "dataplaneid", dataplaneId, "subscription", currentState)
 			lastStoredState = currentState
+			lastStoredTime = now
 		}
 	}
 
 	for {
 		select {
-		case <-ticker.C:
-			flush()
+		case now := <-ticker.C:
+			flush(now)
 		case <-stop:
-			flush()
+			flush(time.Now())
 			return
 		}
 	}
@@ -78,20 +84,8 @@ type dataplaneInsightStore struct {
 }
 
 func (s *dataplaneInsightStore) Upsert(dataplaneId core_model.ResourceKey, subscription *mesh_proto.DiscoverySubscription) error {
-	create := false
-	dataplaneInsight := &mesh_core.DataplaneInsightResource{}
-	err := s.resManager.Get(context.Background(), dataplaneInsight, core_store.GetBy(dataplaneId))
-	if err != nil {
-		if core_store.IsResourceNotFound(err) {
-			create = true
-		} else {
-			return err
-		}
-	}
-	dataplaneInsight.Spec.UpdateSubscription(subscription)
-	if create {
-		return s.resManager.Create(context.Background(), dataplaneInsight, core_store.CreateBy(dataplaneId))
-	} else {
-		return s.resManager.Update(context.Background(), dataplaneInsight)
-	}
+	return manager.Upsert(s.resManager, context.Background(), dataplaneId, &mesh_core.DataplaneInsightResource{}, func(resource core_model.Resource) {
+		dataplaneInsight := resource.(*mesh_core.DataplaneInsightResource)
+		dataplaneInsight.Spec.UpdateSubscription(subscription)
+	})
 }
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/Kong/kuma/pkg/core/resources/manager"
@@ -126,6 +127,34 @@ var _ = Describe("DataplaneInsightSink", func() {
 			case <-time.After(100 * time.Millisecond):
 				// no update is good
 			}
+
+			// when - time tick without changes after a heartbeat period
+			ticks <- t0.Add(2*time.Second + dataplaneInsightHeartbeat)
+			// then
+			Eventually(func() bool {
+				select {
+				case upsert, ok := <-recorder.Upserts:
+					latestUpsert = &upsert
+					return ok
+				default:
+					return false
+				}
+			}, "1s", "1ms").Should(BeTrue())
+			// and
+			Expect(util_proto.ToYAML(latestUpsert.DiscoverySubscription)).To(MatchYAML(`
+            connectTime: "2019-07-01T00:00:00Z"
+            controlPlaneInstanceId: control-plane-01
+            id: 3287995C-7E11-41FB-9479-7D39337F845D
+            status:
+              lastUpdateTime: "2019-07-01T00:00:02Z"
+              cds: {}
+              eds: {}
+              lds:
+                responsesSent: "1"
+              rds: {}
+              total:
+                responsesSent: "1"
+`))
 		})
 	})
 
@@ -221,9 +250,61 @@ var _ = Describe("DataplaneInsightSink", func() {
                   responsesSent: "1"
 `))
 		})
+
+		It("should retry when DataplaneInsight is modified concurrently", func() {
+			// setup
+			key := core_model.ResourceKey{Mesh: "default", Name: "example-001"}
+			subscription1 := &mesh_proto.DiscoverySubscription{
+				Id:                     "3287995C-7E11-41FB-9479-7D39337F845D",
+				ControlPlaneInstanceId: "control-plane-01",
+				ConnectTime:            util_proto.MustTimestampProto(t0),
+				Status:                 mesh_proto.NewSubscriptionStatus(),
+			}
+			subscription2 := &mesh_proto.DiscoverySubscription{
+				Id:                     "6E2B5E4E-2B4F-4E1A-8B0C-2B8F1B4B9E6A",
+				ControlPlaneInstanceId: "control-plane-02",
+				ConnectTime:            util_proto.MustTimestampProto(t0.Add(1 * time.Second)),
+				Status:                 mesh_proto.NewSubscriptionStatus(),
+			}
+			Expect(NewDataplaneInsightStore(manager.NewResourceManager(store)).Upsert(key, subscription1)).To(Succeed())
+
+			// given another instance of the Control Plane that updates DataplaneInsight in the meantime
+			racingStore := &racingResourceStore{
+				ResourceStore: store,
+				race: func() {
+					Expect(NewDataplaneInsightStore(manager.NewResourceManager(store)).Upsert(key, subscription2)).To(Succeed())
+				},
+			}
+			statusStore := NewDataplaneInsightStore(manager.NewResourceManager(racingStore))
+
+			// when
+			subscription1.Status.Lds.ResponsesSent += 1
+			err := statusStore.Upsert(key, proto.Clone(subscription1).(*mesh_proto.DiscoverySubscription))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and changes of both instances are preserved
+			dataplaneInsight := &mesh_core.DataplaneInsightResource{}
+			Expect(store.Get(context.Background(), dataplaneInsight, core_store.GetBy(key))).To(Succeed())
+			Expect(dataplaneInsight.Spec.Subscriptions).To(HaveLen(2))
+			Expect(dataplaneInsight.Spec.Subscriptions[0].Status.Lds.ResponsesSent).To(Equal(uint64(1)))
+			Expect(dataplaneInsight.Spec.Subscriptions[1].ControlPlaneInstanceId).To(Equal("control-plane-02"))
+		})
 	})
 })
 
+// racingResourceStore runs a racing operation right before the first update.
+type racingResourceStore struct {
+	core_store.ResourceStore
+	race func()
+	once sync.Once
+}
+
+func (s *racingResourceStore) Update(ctx context.Context, r core_model.Resource, fs ...core_store.UpdateOptionsFunc) error {
+	s.once.Do(s.race)
+	return s.ResourceStore.Update(ctx, r, fs...)
+}
+
 var _ SubscriptionStatusAccessor = &SubscriptionStatusHolder{}
 
 type SubscriptionStatusHolder struct {
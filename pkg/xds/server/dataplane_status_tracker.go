@@ -6,7 +6,7 @@ import (
 	"time"
 
 	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
-	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server"
+	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server/v2"
 	"github.com/golang/protobuf/proto"
 
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
@@ -89,7 +89,11 @@ func (c *dataplaneStatusTracker) OnStreamClosed(streamID int64) {
 	c.mu.Lock() // write access to the map of all ADS streams
 	defer c.mu.Unlock()
 
-	state := c.streams[streamID]
+	state, found := c.streams[streamID]
+	if !found {
+		// stream has been rejected before it reached this callback, e.g. by authentication
+		return
+	}
 
 	delete(c.streams, streamID)
 
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
 	"github.com/Kong/kuma/pkg/core"
 	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+	"github.com/Kong/kuma/pkg/metrics"
 )
 
 var (
@@ -14,7 +17,9 @@ var (
 )
 
 type diagnosticsServer struct {
-	port int
+	port    int
+	metrics metrics.Metrics
+	health  core_runtime.Health
 }
 
 // Make sure that grpcServer implements all relevant interfaces
@@ -25,11 +30,12 @@ var (
 func (s *diagnosticsServer) Start(stop <-chan struct{}) error {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/ready", func(resp http.ResponseWriter, _ *http.Request) {
-		resp.WriteHeader(http.StatusOK)
+		writeHealthReport(resp, s.health.CheckReadiness())
 	})
 	mux.HandleFunc("/healthy", func(resp http.ResponseWriter, _ *http.Request) {
-		resp.WriteHeader(http.StatusOK)
+		writeHealthReport(resp, s.health.CheckLiveness())
 	})
+	mux.Handle("/metrics", promhttp.InstrumentMetricHandler(s.metrics, promhttp.HandlerFor(s.metrics, promhttp.HandlerOpts{})))
 
 	httpServer := &http.Server{Addr: fmt.Sprintf(":%d", s.port), Handler: mux}
 
@@ -55,3 +61,15 @@ func (s *diagnosticsServer) Start(stop <-chan struct{}) error {
 		return err
 	}
 }
+
+func writeHealthReport(resp http.ResponseWriter, report core_runtime.HealthReport) {
+	resp.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if report.Healthy() {
+		resp.WriteHeader(http.StatusOK)
+	} else {
+		resp.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if _, err := resp.Write([]byte(report.String())); err != nil {
+		diagnosticsServerLog.Error(err, "could not write health report")
+	}
+}
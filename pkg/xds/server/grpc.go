@@ -4,12 +4,18 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/credentials"
+
 	envoy_discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v2"
-	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server"
+	envoy_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server/v2"
 	"google.golang.org/grpc"
 
+	sds_config "github.com/Kong/kuma/pkg/config/sds"
 	"github.com/Kong/kuma/pkg/core"
 	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
 )
 
 const grpcMaxConcurrentStreams = 1000000
@@ -19,8 +25,13 @@ var (
 )
 
 type grpcServer struct {
-	server envoy_xds.Server
-	port   int
+	server      envoy_xds.Server
+	apiVersions core_xds.ApiVersionTracker
+	port        int
+	// xDS server reuses TLS certificate of SDS server
+	tlsConfig sds_config.SdsServerConfig
+	// streamInterceptor is optional
+	streamInterceptor grpc.StreamServerInterceptor
 }
 
 // Make sure that grpcServer implements all relevant interfaces
@@ -31,6 +42,17 @@ var (
 func (s *grpcServer) Start(stop <-chan struct{}) error {
 	var grpcOptions []grpc.ServerOption
 	grpcOptions = append(grpcOptions, grpc.MaxConcurrentStreams(grpcMaxConcurrentStreams))
+	useTLS := s.tlsConfig.TlsCertFile != ""
+	if useTLS {
+		creds, err := credentials.NewServerTLSFromFile(s.tlsConfig.TlsCertFile, s.tlsConfig.TlsKeyFile)
+		if err != nil {
+			return errors.Wrap(err, "failed to load TLS certificate")
+		}
+		grpcOptions = append(grpcOptions, grpc.Creds(creds))
+	}
+	if s.streamInterceptor != nil {
+		grpcOptions = append(grpcOptions, grpc.StreamInterceptor(s.streamInterceptor))
+	}
 	grpcServer := grpc.NewServer(grpcOptions...)
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
@@ -40,6 +62,7 @@ func (s *grpcServer) Start(stop <-chan struct{}) error {
 
 	// register services
 	envoy_discovery.RegisterAggregatedDiscoveryServiceServer(grpcServer, s.server)
+	envoy_discovery_v3.RegisterAggregatedDiscoveryServiceServer(grpcServer, NewServerV3(s.server, s.apiVersions))
 
 	errChan := make(chan error)
 	go func() {
@@ -51,7 +74,7 @@ func (s *grpcServer) Start(stop <-chan struct{}) error {
 			grpcServerLog.Info("terminated normally")
 		}
 	}()
-	grpcServerLog.Info("starting", "interface", "0.0.0.0", "port", s.port)
+	grpcServerLog.Info("starting", "interface", "0.0.0.0", "port", s.port, "tls", useTLS)
 
 	select {
 	case <-stop:
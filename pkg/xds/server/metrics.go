@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v2"
+	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server/v2"
+	"github.com/prometheus/client_golang/prometheus"
+
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/metrics"
+	xds_context "github.com/Kong/kuma/pkg/xds/context"
+)
+
+// snapshotResourceTypes are labels of resource types in a snapshot.
+var snapshotResourceTypes = map[envoy_types.ResponseType]string{
+	envoy_types.Listener: "listener",
+	envoy_types.Route:    "route",
+	envoy_types.Cluster:  "cluster",
+	envoy_types.Endpoint: "endpoint",
+	envoy_types.Secret:   "secret",
+}
+
+// NewMeteredReconciler returns a SnapshotReconciler that measures duration and errors of reconciliation.
+func NewMeteredReconciler(delegate SnapshotReconciler, metrics metrics.Metrics) (SnapshotReconciler, error) {
+	duration := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name: "xds_reconcile_duration_seconds",
+		Help: "Duration of generation of Envoy configuration for a Dataplane",
+	})
+	if err := metrics.Register(duration); err != nil {
+		return nil, err
+	}
+	errs := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "xds_reconcile_errors_total",
+		Help: "Number of failed generations of Envoy configuration for a Dataplane",
+	})
+	if err := metrics.Register(errs); err != nil {
+		return nil, err
+	}
+	return &meteredReconciler{
+		delegate: delegate,
+		duration: duration,
+		errors:   errs,
+	}, nil
+}
+
+var _ SnapshotReconciler = &meteredReconciler{}
+
+type meteredReconciler struct {
+	delegate SnapshotReconciler
+	duration prometheus.Histogram
+	errors   prometheus.Counter
+}
+
+func (r *meteredReconciler) Reconcile(ctx xds_context.Context, proxy *core_xds.Proxy) error {
+	start := time.Now()
+	err := r.delegate.Reconcile(ctx, proxy)
+	r.duration.Observe(time.Since(start).Seconds())
+	if err != nil {
+		r.errors.Inc()
+	}
+	return err
+}
+
+func (r *meteredReconciler) Clear(proxyId *core_xds.ProxyId) error {
+	return r.delegate.Clear(proxyId)
+}
+
+// newMeteredSnapshotGenerator returns a snapshotGenerator that measures the number of resources per type in generated snapshots.
+func newMeteredSnapshotGenerator(delegate snapshotGenerator, metrics metrics.Metrics) (snapshotGenerator, error) {
+	resources := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "xds_snapshot_resources",
+		Help:    "Number of resources of a given type in Envoy configuration of a Dataplane",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 12),
+	}, []string{"type"})
+	if err := metrics.Register(resources); err != nil {
+		return nil, err
+	}
+	return &meteredSnapshotGenerator{
+		delegate:  delegate,
+		resources: resources,
+	}, nil
+}
+
+type meteredSnapshotGenerator struct {
+	delegate  snapshotGenerator
+	resources *prometheus.HistogramVec
+}
+
+func (g *meteredSnapshotGenerator) GenerateSnapshot(ctx xds_context.Context, proxy *core_xds.Proxy) (envoy_cache.Snapshot, error) {
+	snapshot, err := g.delegate.GenerateSnapshot(ctx, proxy)
+	if err != nil {
+		return snapshot, err
+	}
+	for typ, label := range snapshotResourceTypes {
+		g.resources.WithLabelValues(label).Observe(float64(len(snapshot.Resources[typ].Items)))
+	}
+	return snapshot, nil
+}
+
+// NewStreamMetricsCallbacks returns xDS callbacks that track the number of active xDS streams per Mesh.
+func NewStreamMetricsCallbacks(metrics metrics.Metrics) (envoy_xds.Callbacks, error) {
+	streams := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "xds_streams_active",
+		Help: "Number of active xDS streams",
+	}, []string{"mesh"})
+	if err := metrics.Register(streams); err != nil {
+		return nil, err
+	}
+	return &streamMetricsCallbacks{
+		streams:      streams,
+		meshByStream: map[int64]string{},
+	}, nil
+}
+
+type streamMetricsCallbacks struct {
+	streams *prometheus.GaugeVec
+
+	mu           sync.Mutex // protects access to the fields below
+	meshByStream map[int64]string
+}
+
+var _ envoy_xds.Callbacks = &streamMetricsCallbacks{}
+
+func (c *streamMetricsCallbacks) OnStreamOpen(context.Context, int64, string) error {
+	return nil
+}
+
+func (c *streamMetricsCallbacks) OnStreamClosed(streamID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if mesh, ok := c.meshByStream[streamID]; ok {
+		c.streams.WithLabelValues(mesh).Dec()
+		delete(c.meshByStream, streamID)
+	}
+}
+
+func (c *streamMetricsCallbacks) OnStreamRequest(streamID int64, req *envoy.DiscoveryRequest) error {
+	if req.Node == nil {
+		// only the first request on a stream is guaranteed to carry the node identifier
+		return nil
+	}
+	proxyId, err := core_xds.ParseProxyId(req.Node)
+	if err != nil {
+		// it's up to other callbacks to reject a stream
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.meshByStream[streamID]; ok {
+		return nil
+	}
+	c.meshByStream[streamID] = proxyId.Mesh
+	c.streams.WithLabelValues(proxyId.Mesh).Inc()
+	return nil
+}
+
+func (c *streamMetricsCallbacks) OnStreamResponse(int64, *envoy.DiscoveryRequest, *envoy.DiscoveryResponse) {
+}
+
+func (c *streamMetricsCallbacks) OnFetchRequest(context.Context, *envoy.DiscoveryRequest) error {
+	return nil
+}
+
+func (c *streamMetricsCallbacks) OnFetchResponse(*envoy.DiscoveryRequest, *envoy.DiscoveryResponse) {
+}
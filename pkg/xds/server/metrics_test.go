@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+
+	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/metrics"
+	xds_context "github.com/Kong/kuma/pkg/xds/context"
+)
+
+var _ = Describe("Metrics", func() {
+
+	var registry metrics.Metrics
+
+	BeforeEach(func() {
+		var err error
+		registry, err = metrics.NewMetrics()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("StreamMetricsCallbacks", func() {
+
+		It("should track active xDS streams per Mesh", func() {
+			// given
+			callbacks, err := NewStreamMetricsCallbacks(registry)
+			Expect(err).ToNot(HaveOccurred())
+			streams := callbacks.(*streamMetricsCallbacks).streams
+
+			// when
+			Expect(callbacks.OnStreamOpen(context.Background(), 1, "")).To(Succeed())
+			Expect(callbacks.OnStreamRequest(1, &envoy.DiscoveryRequest{Node: &envoy_core.Node{Id: "demo.backend-01"}})).To(Succeed())
+			Expect(callbacks.OnStreamRequest(1, &envoy.DiscoveryRequest{})).To(Succeed())
+			Expect(callbacks.OnStreamOpen(context.Background(), 2, "")).To(Succeed())
+			Expect(callbacks.OnStreamRequest(2, &envoy.DiscoveryRequest{Node: &envoy_core.Node{Id: "demo.web-01"}})).To(Succeed())
+
+			// then
+			Expect(testutil.ToFloat64(streams.WithLabelValues("demo"))).To(Equal(2.0))
+
+			// when
+			callbacks.OnStreamClosed(1)
+
+			// then
+			Expect(testutil.ToFloat64(streams.WithLabelValues("demo"))).To(Equal(1.0))
+		})
+	})
+
+	Describe("MeteredReconciler", func() {
+
+		It("should count failed reconciliations", func() {
+			// given
+			reconciler, err := NewMeteredReconciler(&failingReconciler{}, registry)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = reconciler.Reconcile(xds_context.Context{}, &core_xds.Proxy{})
+
+			// then
+			Expect(err).To(HaveOccurred())
+			Expect(testutil.ToFloat64(reconciler.(*meteredReconciler).errors)).To(Equal(1.0))
+			Expect(histogramCount(reconciler.(*meteredReconciler).duration)).To(Equal(uint64(1)))
+		})
+	})
+})
+
+func histogramCount(histogram prometheus.Histogram) uint64 {
+	ch := make(chan prometheus.Metric, 1)
+	histogram.Collect(ch)
+	metric := &io_prometheus_client.Metric{}
+	Expect((<-ch).Write(metric)).To(Succeed())
+	return metric.GetHistogram().GetSampleCount()
+}
+
+type failingReconciler struct{}
+
+func (r *failingReconciler) Reconcile(xds_context.Context, *core_xds.Proxy) error {
+	return errors.New("reconcile failed")
+}
+
+func (r *failingReconciler) Clear(*core_xds.ProxyId) error {
+	return nil
+}
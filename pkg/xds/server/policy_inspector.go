@@ -0,0 +1,249 @@
+package server
+
+import (
+	"context"
+	"sort"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/permissions"
+	"github.com/Kong/kuma/pkg/core/policy"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+)
+
+// MatchedPolicy is a policy selected for a Dataplane along with the rank (score) of its most specific matching selector.
+type MatchedPolicy struct {
+	Policy core_model.Resource
+	Rank   mesh_proto.TagSelectorRank
+}
+
+// InboundPolicies holds policies applied to an inbound interface of a Dataplane.
+type InboundPolicies struct {
+	Interface          mesh_proto.InboundInterface
+	Tags               map[string]string
+	TrafficPermissions []MatchedPolicy
+}
+
+// OutboundPolicies holds policies applied to connections from a Dataplane to a given service.
+type OutboundPolicies struct {
+	Service      core_xds.ServiceName
+	TrafficRoute *MatchedPolicy
+	TrafficLog   *MatchedPolicy
+	HealthCheck  *MatchedPolicy
+}
+
+// DataplanePolicies holds all policies the Control Plane applies to a Dataplane.
+type DataplanePolicies struct {
+	Inbound       []InboundPolicies
+	Outbound      []OutboundPolicies
+	TrafficTrace  *MatchedPolicy
+	ProxyTemplate *MatchedPolicy
+}
+
+// PolicyInspector explains which policies apply to a Dataplane.
+type PolicyInspector interface {
+	Inspect(ctx context.Context, key core_model.ResourceKey) (*DataplanePolicies, error)
+}
+
+func DefaultPolicyInspector(rt core_runtime.Runtime) PolicyInspector {
+	return NewPolicyInspector(rt.ReadOnlyResourceManager(), DefaultDataplaneProxyBuilder(rt))
+}
+
+func NewPolicyInspector(resManager core_manager.ReadOnlyResourceManager, proxyBuilder *DataplaneProxyBuilder) PolicyInspector {
+	return &policyInspector{
+		resManager:   resManager,
+		proxyBuilder: proxyBuilder,
+	}
+}
+
+var _ PolicyInspector = &policyInspector{}
+
+type policyInspector struct {
+	resManager   core_manager.ReadOnlyResourceManager
+	proxyBuilder *DataplaneProxyBuilder
+}
+
+func (i *policyInspector) Inspect(ctx context.Context, key core_model.ResourceKey) (*DataplanePolicies, error) {
+	dataplane := &mesh_core.DataplaneResource{}
+	if err := i.resManager.Get(ctx, dataplane, core_store.GetBy(key)); err != nil {
+		return nil, err
+	}
+	mesh := &mesh_core.MeshResource{}
+	if err := i.resManager.Get(ctx, mesh, core_store.GetByKey(key.Mesh, key.Mesh)); err != nil {
+		return nil, err
+	}
+	// policies are matched against the same Dataplane the Control Plane generates Envoy configuration for,
+	// e.g. including outbound interfaces of VIPs
+	proxy, err := i.proxyBuilder.Build(ctx, dataplane, mesh, &core_xds.DataplaneMetadata{})
+	if err != nil {
+		return nil, err
+	}
+	dataplane = proxy.Dataplane
+
+	inbound, err := i.inboundPolicies(ctx, dataplane)
+	if err != nil {
+		return nil, err
+	}
+	outbound, err := i.outboundPolicies(ctx, proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := &mesh_core.TrafficTraceResourceList{}
+	if err := i.resManager.List(ctx, traces, core_store.ListByMesh(key.Mesh)); err != nil {
+		return nil, err
+	}
+	tracePolicies := make([]policy.DataplanePolicy, len(traces.Items))
+	for idx, trace := range traces.Items {
+		tracePolicies[idx] = trace
+	}
+
+	templates := &mesh_core.ProxyTemplateResourceList{}
+	if err := i.resManager.List(ctx, templates, core_store.ListByMesh(key.Mesh)); err != nil {
+		return nil, err
+	}
+	templatePolicies := make([]policy.DataplanePolicy, len(templates.Items))
+	for idx, template := range templates.Items {
+		templatePolicies[idx] = template
+	}
+
+	return &DataplanePolicies{
+		Inbound:       inbound,
+		Outbound:      outbound,
+		TrafficTrace:  toMatchedPolicy(policy.RankDataplanePolicy(dataplane, tracePolicies)),
+		ProxyTemplate: toMatchedPolicy(policy.RankDataplanePolicy(dataplane, templatePolicies)),
+	}, nil
+}
+
+func (i *policyInspector) inboundPolicies(ctx context.Context, dataplane *mesh_core.DataplaneResource) ([]InboundPolicies, error) {
+	permissionsMatcher := permissions.TrafficPermissionsMatcher{ResourceManager: i.resManager}
+	matchedPermissions, err := permissionsMatcher.Match(ctx, dataplane)
+	if err != nil {
+		return nil, err
+	}
+	ifaces, err := dataplane.Spec.GetNetworking().GetInboundInterfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []InboundPolicies
+	for idx, inbound := range dataplane.Spec.GetNetworking().GetInbound() {
+		policies := InboundPolicies{
+			Interface:          ifaces[idx],
+			Tags:               inbound.Tags,
+			TrafficPermissions: []MatchedPolicy{},
+		}
+		for _, permission := range matchedPermissions.Get(ifaces[idx]).Items {
+			policies.TrafficPermissions = append(policies.TrafficPermissions, MatchedPolicy{
+				Policy: permission,
+				Rank:   destinationRank(inbound, permission),
+			})
+		}
+		result = append(result, policies)
+	}
+	return result, nil
+}
+
+// destinationRank returns the rank of the most specific `destination` selector of a TrafficPermission that matches a given inbound interface.
+func destinationRank(inbound *mesh_proto.Dataplane_Networking_Inbound, permission *mesh_core.TrafficPermissionResource) mesh_proto.TagSelectorRank {
+	var bestRank mesh_proto.TagSelectorRank
+	for _, destination := range permission.Spec.Destinations {
+		if inbound.MatchTags(destination.Match) {
+			rank := mesh_proto.TagSelector(destination.Match).Rank()
+			if rank.CompareTo(bestRank) > 0 {
+				bestRank = rank
+			}
+		}
+	}
+	return bestRank
+}
+
+func (i *policyInspector) outboundPolicies(ctx context.Context, proxy *core_xds.Proxy) ([]OutboundPolicies, error) {
+	dataplane := proxy.Dataplane
+	mesh := dataplane.GetMeta().GetMesh()
+
+	routes := &mesh_core.TrafficRouteResourceList{}
+	if err := i.resManager.List(ctx, routes, core_store.ListByMesh(mesh)); err != nil {
+		return nil, err
+	}
+	routePolicies := make([]policy.ConnectionPolicy, len(routes.Items))
+	for idx, route := range routes.Items {
+		routePolicies[idx] = route
+	}
+	rankedRoutes := policy.RankConnectionPolicies(dataplane, policy.ToServices(outboundServicesOf(dataplane)), routePolicies)
+
+	logs := &mesh_core.TrafficLogResourceList{}
+	if err := i.resManager.List(ctx, logs, core_store.ListByMesh(mesh)); err != nil {
+		return nil, err
+	}
+	logPolicies := make([]policy.ConnectionPolicy, len(logs.Items))
+	for idx, log := range logs.Items {
+		logPolicies[idx] = log
+	}
+	rankedLogs := policy.RankConnectionPolicies(dataplane, policy.ToServices(outboundServicesOf(dataplane)), logPolicies)
+
+	// health checks are selected by services that are reachable via routes rather than by outbound interfaces
+	destinations := proxy.OutboundSelectors
+	healthChecks := &mesh_core.HealthCheckResourceList{}
+	if err := i.resManager.List(ctx, healthChecks, core_store.ListByMesh(mesh)); err != nil {
+		return nil, err
+	}
+	healthCheckPolicies := make([]policy.ConnectionPolicy, len(healthChecks.Items))
+	for idx, healthCheck := range healthChecks.Items {
+		healthCheckPolicies[idx] = healthCheck
+	}
+	rankedHealthChecks := policy.RankConnectionPolicies(dataplane, policy.ToServicesOf(destinations), healthCheckPolicies)
+
+	services := outboundServicesOf(dataplane)
+	for service := range destinations {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	var result []OutboundPolicies
+	for idx, service := range services {
+		if idx > 0 && services[idx-1] == service {
+			continue
+		}
+		result = append(result, OutboundPolicies{
+			Service:      service,
+			TrafficRoute: toMatchedConnectionPolicy(rankedRoutes, service),
+			TrafficLog:   toMatchedConnectionPolicy(rankedLogs, service),
+			HealthCheck:  toMatchedConnectionPolicy(rankedHealthChecks, service),
+		})
+	}
+	return result, nil
+}
+
+func outboundServicesOf(dataplane *mesh_core.DataplaneResource) []core_xds.ServiceName {
+	var services []core_xds.ServiceName
+	for _, oface := range dataplane.Spec.GetNetworking().GetOutbound() {
+		services = append(services, oface.Service)
+	}
+	return services
+}
+
+func toMatchedConnectionPolicy(policies policy.RankedConnectionPolicyMap, service core_xds.ServiceName) *MatchedPolicy {
+	ranked, ok := policies[service]
+	if !ok {
+		return nil
+	}
+	return &MatchedPolicy{
+		Policy: ranked.Policy,
+		Rank:   ranked.Rank,
+	}
+}
+
+func toMatchedPolicy(dataplanePolicy policy.DataplanePolicy, rank mesh_proto.TagSelectorRank) *MatchedPolicy {
+	if dataplanePolicy == nil {
+		return nil
+	}
+	return &MatchedPolicy{
+		Policy: dataplanePolicy,
+		Rank:   rank,
+	}
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/manager"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	"github.com/Kong/kuma/pkg/dns"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("PolicyInspector", func() {
+
+	var rm manager.ResourceManager
+	var inspector PolicyInspector
+
+	BeforeEach(func() {
+		rm = manager.NewResourceManager(memory.NewStore())
+		inspector = NewPolicyInspector(rm, &DataplaneProxyBuilder{
+			ResourceManager: rm,
+			LoadVIPs: func(_ context.Context, mesh string) (dns.VIPList, error) {
+				return dns.VIPList{
+					"backend": "240.0.0.1",
+				}, nil
+			},
+			VIPPort: 80,
+		})
+
+		err := rm.Create(context.Background(), &mesh_core.MeshResource{}, store.CreateByKey("default", "default"))
+		Expect(err).ToNot(HaveOccurred())
+		err = rm.Create(context.Background(), &mesh_core.DataplaneResource{
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Address: "192.168.0.1",
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port:        8080,
+							ServicePort: 80,
+							Tags: map[string]string{
+								"service": "web",
+							},
+						},
+					},
+					TransparentProxying: &mesh_proto.Dataplane_Networking_TransparentProxying{
+						RedirectPort: 15001,
+					},
+				},
+			},
+		}, store.CreateByKey("web-01", "default"))
+		Expect(err).ToNot(HaveOccurred())
+		err = rm.Create(context.Background(), &mesh_core.TrafficLogResource{
+			Spec: mesh_proto.TrafficLog{
+				Sources:      []*mesh_proto.Selector{{Match: mesh_proto.MatchAnyService()}},
+				Destinations: []*mesh_proto.Selector{{Match: mesh_proto.MatchService("backend")}},
+			},
+		}, store.CreateByKey("backend-logs", "default"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should match policies of outbound interfaces of VIPs", func() {
+		// when
+		policies, err := inspector.Inspect(context.Background(), core_model.ResourceKey{Mesh: "default", Name: "web-01"})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(policies.Outbound).To(HaveLen(1))
+		Expect(policies.Outbound[0].Service).To(Equal("backend"))
+		Expect(policies.Outbound[0].TrafficLog).ToNot(BeNil())
+		Expect(policies.Outbound[0].TrafficLog.Policy.GetMeta().GetName()).To(Equal("backend-logs"))
+	})
+})
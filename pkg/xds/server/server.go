@@ -30,8 +30,9 @@ import (
 	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v2"
-	"github.com/envoyproxy/go-control-plane/pkg/cache"
-	gcp_server "github.com/envoyproxy/go-control-plane/pkg/server"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/v2"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v2"
+	gcp_server "github.com/envoyproxy/go-control-plane/pkg/server/v2"
 )
 
 // NewServer creates handlers from a config watcher and callbacks.
@@ -204,7 +205,7 @@ func (s *server) process(stream stream, reqCh <-chan *v2.DiscoveryRequest, defau
 			if !more {
 				return status.Errorf(codes.Unavailable, "endpoints watch failed")
 			}
-			nonce, err := send(resp, cache.EndpointType)
+			nonce, err := send(resp, resource.EndpointType)
 			if err != nil {
 				return err
 			}
@@ -215,7 +216,7 @@ func (s *server) process(stream stream, reqCh <-chan *v2.DiscoveryRequest, defau
 			if !more {
 				return status.Errorf(codes.Unavailable, "clusters watch failed")
 			}
-			nonce, err := send(resp, cache.ClusterType)
+			nonce, err := send(resp, resource.ClusterType)
 			if err != nil {
 				return err
 			}
@@ -225,7 +226,7 @@ func (s *server) process(stream stream, reqCh <-chan *v2.DiscoveryRequest, defau
 			if !more {
 				return status.Errorf(codes.Unavailable, "routes watch failed")
 			}
-			nonce, err := send(resp, cache.RouteType)
+			nonce, err := send(resp, resource.RouteType)
 			if err != nil {
 				return err
 			}
@@ -235,7 +236,7 @@ func (s *server) process(stream stream, reqCh <-chan *v2.DiscoveryRequest, defau
 			if !more {
 				return status.Errorf(codes.Unavailable, "listeners watch failed")
 			}
-			nonce, err := send(resp, cache.ListenerType)
+			nonce, err := send(resp, resource.ListenerType)
 			if err != nil {
 				return err
 			}
@@ -245,7 +246,7 @@ func (s *server) process(stream stream, reqCh <-chan *v2.DiscoveryRequest, defau
 			if !more {
 				return status.Errorf(codes.Unavailable, "secrets watch failed")
 			}
-			nonce, err := send(resp, cache.SecretType)
+			nonce, err := send(resp, resource.SecretType)
 			if err != nil {
 				return err
 			}
@@ -255,7 +256,7 @@ func (s *server) process(stream stream, reqCh <-chan *v2.DiscoveryRequest, defau
 			if !more {
 				return status.Errorf(codes.Unavailable, "runtimes watch failed")
 			}
-			nonce, err := send(resp, cache.RuntimeType)
+			nonce, err := send(resp, resource.RuntimeType)
 			if err != nil {
 				return err
 			}
@@ -281,7 +282,7 @@ func (s *server) process(stream stream, reqCh <-chan *v2.DiscoveryRequest, defau
 			nonce := req.GetResponseNonce()
 
 			// type URL is required for ADS but is implicit for xDS
-			if defaultTypeURL == cache.AnyType {
+			if defaultTypeURL == resource.AnyType {
 				if req.TypeUrl == "" {
 					return status.Errorf(codes.InvalidArgument, "type URL is required for ADS")
 				}
@@ -297,7 +298,7 @@ func (s *server) process(stream stream, reqCh <-chan *v2.DiscoveryRequest, defau
 
 			// cancel existing watches to (re-)request a newer version
 			switch {
-			case req.TypeUrl == cache.EndpointType && (values.endpointNonce == "" || values.endpointNonce == nonce):
+			case req.TypeUrl == resource.EndpointType && (values.endpointNonce == "" || values.endpointNonce == nonce):
 				// If Envoy uses the same Nonce for the second time, it probably means that
 				// a Cluster has been created or updated and goes through the warming stage.
 				// In that case we must respond even if EDS configuration hasn't changed.
@@ -314,27 +315,27 @@ func (s *server) process(stream stream, reqCh <-chan *v2.DiscoveryRequest, defau
 				} else {
 					values.endpoints, values.endpointCancel = s.cache.CreateWatch(*req)
 				}
-			case req.TypeUrl == cache.ClusterType && (values.clusterNonce == "" || values.clusterNonce == nonce):
+			case req.TypeUrl == resource.ClusterType && (values.clusterNonce == "" || values.clusterNonce == nonce):
 				if values.clusterCancel != nil {
 					values.clusterCancel()
 				}
 				values.clusters, values.clusterCancel = s.cache.CreateWatch(*req)
-			case req.TypeUrl == cache.RouteType && (values.routeNonce == "" || values.routeNonce == nonce):
+			case req.TypeUrl == resource.RouteType && (values.routeNonce == "" || values.routeNonce == nonce):
 				if values.routeCancel != nil {
 					values.routeCancel()
 				}
 				values.routes, values.routeCancel = s.cache.CreateWatch(*req)
-			case req.TypeUrl == cache.ListenerType && (values.listenerNonce == "" || values.listenerNonce == nonce):
+			case req.TypeUrl == resource.ListenerType && (values.listenerNonce == "" || values.listenerNonce == nonce):
 				if values.listenerCancel != nil {
 					values.listenerCancel()
 				}
 				values.listeners, values.listenerCancel = s.cache.CreateWatch(*req)
-			case req.TypeUrl == cache.SecretType && (values.secretNonce == "" || values.secretNonce == nonce):
+			case req.TypeUrl == resource.SecretType && (values.secretNonce == "" || values.secretNonce == nonce):
 				if values.secretCancel != nil {
 					values.secretCancel()
 				}
 				values.secrets, values.secretCancel = s.cache.CreateWatch(*req)
-			case req.TypeUrl == cache.RuntimeType && (values.runtimeNonce == "" || values.runtimeNonce == nonce):
+			case req.TypeUrl == resource.RuntimeType && (values.runtimeNonce == "" || values.runtimeNonce == nonce):
 				if values.runtimeCancel != nil {
 					values.runtimeCancel()
 				}
@@ -373,31 +374,31 @@ func (s *server) handler(stream stream, typeURL string) error {
 }
 
 func (s *server) StreamAggregatedResources(stream discovery.AggregatedDiscoveryService_StreamAggregatedResourcesServer) error {
-	return s.handler(stream, cache.AnyType)
+	return s.handler(stream, resource.AnyType)
 }
 
 func (s *server) StreamEndpoints(stream v2.EndpointDiscoveryService_StreamEndpointsServer) error {
-	return s.handler(stream, cache.EndpointType)
+	return s.handler(stream, resource.EndpointType)
 }
 
 func (s *server) StreamClusters(stream v2.ClusterDiscoveryService_StreamClustersServer) error {
-	return s.handler(stream, cache.ClusterType)
+	return s.handler(stream, resource.ClusterType)
 }
 
 func (s *server) StreamRoutes(stream v2.RouteDiscoveryService_StreamRoutesServer) error {
-	return s.handler(stream, cache.RouteType)
+	return s.handler(stream, resource.RouteType)
 }
 
 func (s *server) StreamListeners(stream v2.ListenerDiscoveryService_StreamListenersServer) error {
-	return s.handler(stream, cache.ListenerType)
+	return s.handler(stream, resource.ListenerType)
 }
 
 func (s *server) StreamSecrets(stream discovery.SecretDiscoveryService_StreamSecretsServer) error {
-	return s.handler(stream, cache.SecretType)
+	return s.handler(stream, resource.SecretType)
 }
 
 func (s *server) StreamRuntime(stream discovery.RuntimeDiscoveryService_StreamRuntimeServer) error {
-	return s.handler(stream, cache.RuntimeType)
+	return s.handler(stream, resource.RuntimeType)
 }
 
 // Fetch is the universal fetch method.
@@ -422,7 +423,7 @@ func (s *server) FetchEndpoints(ctx context.Context, req *v2.DiscoveryRequest) (
 	if req == nil {
 		return nil, status.Errorf(codes.Unavailable, "empty request")
 	}
-	req.TypeUrl = cache.EndpointType
+	req.TypeUrl = resource.EndpointType
 	return s.Fetch(ctx, req)
 }
 
@@ -430,7 +431,7 @@ func (s *server) FetchClusters(ctx context.Context, req *v2.DiscoveryRequest) (*
 	if req == nil {
 		return nil, status.Errorf(codes.Unavailable, "empty request")
 	}
-	req.TypeUrl = cache.ClusterType
+	req.TypeUrl = resource.ClusterType
 	return s.Fetch(ctx, req)
 }
 
@@ -438,7 +439,7 @@ func (s *server) FetchRoutes(ctx context.Context, req *v2.DiscoveryRequest) (*v2
 	if req == nil {
 		return nil, status.Errorf(codes.Unavailable, "empty request")
 	}
-	req.TypeUrl = cache.RouteType
+	req.TypeUrl = resource.RouteType
 	return s.Fetch(ctx, req)
 }
 
@@ -446,7 +447,7 @@ func (s *server) FetchListeners(ctx context.Context, req *v2.DiscoveryRequest) (
 	if req == nil {
 		return nil, status.Errorf(codes.Unavailable, "empty request")
 	}
-	req.TypeUrl = cache.ListenerType
+	req.TypeUrl = resource.ListenerType
 	return s.Fetch(ctx, req)
 }
 
@@ -454,7 +455,7 @@ func (s *server) FetchSecrets(ctx context.Context, req *v2.DiscoveryRequest) (*v
 	if req == nil {
 		return nil, status.Errorf(codes.Unavailable, "empty request")
 	}
-	req.TypeUrl = cache.SecretType
+	req.TypeUrl = resource.SecretType
 	return s.Fetch(ctx, req)
 }
 
@@ -462,7 +463,7 @@ func (s *server) FetchRuntime(ctx context.Context, req *v2.DiscoveryRequest) (*v
 	if req == nil {
 		return nil, status.Errorf(codes.Unavailable, "empty request")
 	}
-	req.TypeUrl = cache.RuntimeType
+	req.TypeUrl = resource.RuntimeType
 	return s.Fetch(ctx, req)
 }
 
@@ -26,9 +26,15 @@ import (
 
 	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
-	"github.com/envoyproxy/go-control-plane/pkg/cache"
-	"github.com/envoyproxy/go-control-plane/pkg/test/resource"
-
+	envoy_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/v2"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v2"
+	envoy_resource_v3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/test/resource/v2"
+
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
 	"github.com/Kong/kuma/pkg/xds/server"
 )
 
@@ -181,30 +187,30 @@ var (
 	route     = resource.MakeRoute(routeName, clusterName)
 	listener  = resource.MakeHTTPListener(resource.Ads, listenerName, 80, routeName)
 	testTypes = []string{
-		cache.EndpointType,
-		cache.ClusterType,
-		cache.RouteType,
-		cache.ListenerType,
+		envoy_resource.EndpointType,
+		envoy_resource.ClusterType,
+		envoy_resource.RouteType,
+		envoy_resource.ListenerType,
 	}
 )
 
 func makeResponses() map[string][]cache.Response {
 	return map[string][]cache.Response{
-		cache.EndpointType: []cache.Response{{
+		envoy_resource.EndpointType: []cache.Response{{
 			Version:   "1",
-			Resources: []cache.Resource{endpoint},
+			Resources: []envoy_types.Resource{endpoint},
 		}},
-		cache.ClusterType: []cache.Response{{
+		envoy_resource.ClusterType: []cache.Response{{
 			Version:   "2",
-			Resources: []cache.Resource{cluster},
+			Resources: []envoy_types.Resource{cluster},
 		}},
-		cache.RouteType: []cache.Response{{
+		envoy_resource.RouteType: []cache.Response{{
 			Version:   "3",
-			Resources: []cache.Resource{route},
+			Resources: []envoy_types.Resource{route},
 		}},
-		cache.ListenerType: []cache.Response{{
+		envoy_resource.ListenerType: []cache.Response{{
 			Version:   "4",
-			Resources: []cache.Resource{listener},
+			Resources: []envoy_types.Resource{listener},
 		}},
 	}
 }
@@ -222,13 +228,13 @@ func TestResponseHandlers(t *testing.T) {
 			go func() {
 				var err error
 				switch typ {
-				case cache.EndpointType:
+				case envoy_resource.EndpointType:
 					err = s.StreamEndpoints(resp)
-				case cache.ClusterType:
+				case envoy_resource.ClusterType:
 					err = s.StreamClusters(resp)
-				case cache.RouteType:
+				case envoy_resource.RouteType:
 					err = s.StreamRoutes(resp)
-				case cache.ListenerType:
+				case envoy_resource.ListenerType:
 					err = s.StreamListeners(resp)
 				}
 				if err != nil {
@@ -423,20 +429,20 @@ func TestAggregatedHandlers(t *testing.T) {
 
 	resp.recv <- &v2.DiscoveryRequest{
 		Node:    node,
-		TypeUrl: cache.ListenerType,
+		TypeUrl: envoy_resource.ListenerType,
 	}
 	resp.recv <- &v2.DiscoveryRequest{
 		Node:    node,
-		TypeUrl: cache.ClusterType,
+		TypeUrl: envoy_resource.ClusterType,
 	}
 	resp.recv <- &v2.DiscoveryRequest{
 		Node:          node,
-		TypeUrl:       cache.EndpointType,
+		TypeUrl:       envoy_resource.EndpointType,
 		ResourceNames: []string{clusterName},
 	}
 	resp.recv <- &v2.DiscoveryRequest{
 		Node:          node,
-		TypeUrl:       cache.RouteType,
+		TypeUrl:       envoy_resource.RouteType,
 		ResourceNames: []string{routeName},
 	}
 
@@ -455,10 +461,10 @@ func TestAggregatedHandlers(t *testing.T) {
 			if count >= 4 {
 				close(resp.recv)
 				if want := map[string]int{
-					cache.EndpointType: 1,
-					cache.ClusterType:  1,
-					cache.RouteType:    1,
-					cache.ListenerType: 1,
+					envoy_resource.EndpointType: 1,
+					envoy_resource.ClusterType:  1,
+					envoy_resource.RouteType:    1,
+					envoy_resource.ListenerType: 1,
 				}; !reflect.DeepEqual(want, config.counts) {
 					t.Errorf("watch counts => got %v, want %v", config.counts, want)
 				}
@@ -474,7 +480,7 @@ func TestAggregatedHandlers(t *testing.T) {
 
 func TestClusterWarming(t *testing.T) {
 	config := cache.NewSnapshotCache(true, hasher{}, nil)
-	err := config.SetSnapshot(node.Id, cache.NewSnapshot("1", []cache.Resource{endpoint}, nil, nil, nil, nil))
+	err := config.SetSnapshot(node.Id, cache.NewSnapshot("1", []envoy_types.Resource{endpoint}, nil, nil, nil, nil))
 	if err != nil {
 		t.Fatalf("got %v, want no error", err)
 	}
@@ -491,7 +497,7 @@ func TestClusterWarming(t *testing.T) {
 
 	resp.recv <- &v2.DiscoveryRequest{
 		Node:          node,
-		TypeUrl:       cache.EndpointType,
+		TypeUrl:       envoy_resource.EndpointType,
 		ResourceNames: []string{clusterName},
 	}
 
@@ -500,8 +506,8 @@ resp1:
 	for {
 		select {
 		case resp := <-resp.sent:
-			if resp.TypeUrl != cache.EndpointType {
-				t.Errorf("TypeUrl => got %v, want %v", resp.TypeUrl, cache.EndpointType)
+			if resp.TypeUrl != envoy_resource.EndpointType {
+				t.Errorf("TypeUrl => got %v, want %v", resp.TypeUrl, envoy_resource.EndpointType)
 			}
 			resp1 = resp
 			break resp1
@@ -516,7 +522,7 @@ resp1:
 		VersionInfo:   resp1.VersionInfo,
 		ResponseNonce: resp1.Nonce,
 		Node:          node,
-		TypeUrl:       cache.EndpointType,
+		TypeUrl:       envoy_resource.EndpointType,
 		ResourceNames: []string{clusterName},
 	}
 
@@ -527,8 +533,8 @@ resp2:
 	for {
 		select {
 		case resp := <-resp.sent:
-			if resp.TypeUrl != cache.EndpointType {
-				t.Errorf("TypeUrl => got %v, want %v", resp.TypeUrl, cache.EndpointType)
+			if resp.TypeUrl != envoy_resource.EndpointType {
+				t.Errorf("TypeUrl => got %v, want %v", resp.TypeUrl, envoy_resource.EndpointType)
 			}
 			break resp2
 		case <-time.After(1 * time.Second):
@@ -570,3 +576,77 @@ func TestCallbackError(t *testing.T) {
 		})
 	}
 }
+
+type mockStreamV3 struct {
+	ctx  context.Context
+	recv chan *envoy_discovery_v3.DiscoveryRequest
+	sent chan *envoy_discovery_v3.DiscoveryResponse
+	grpc.ServerStream
+}
+
+func (stream *mockStreamV3) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *mockStreamV3) Send(resp *envoy_discovery_v3.DiscoveryResponse) error {
+	stream.sent <- resp
+	return nil
+}
+
+func (stream *mockStreamV3) Recv() (*envoy_discovery_v3.DiscoveryRequest, error) {
+	req, more := <-stream.recv
+	if !more {
+		return nil, errors.New("empty")
+	}
+	return req, nil
+}
+
+func TestAggregatedHandlersV3(t *testing.T) {
+	config := makeMockConfigWatcher()
+	config.responses = makeResponses()
+	resp := &mockStreamV3{
+		ctx:  context.Background(),
+		sent: make(chan *envoy_discovery_v3.DiscoveryResponse, 10),
+		recv: make(chan *envoy_discovery_v3.DiscoveryRequest, 10),
+	}
+
+	// Envoy is free to request resources of either version over xDS v3 transport
+	resp.recv <- &envoy_discovery_v3.DiscoveryRequest{
+		Node:    &envoy_core_v3.Node{Id: node.Id},
+		TypeUrl: envoy_resource_v3.ListenerType,
+	}
+	resp.recv <- &envoy_discovery_v3.DiscoveryRequest{
+		Node:    &envoy_core_v3.Node{Id: node.Id},
+		TypeUrl: envoy_resource.ClusterType,
+	}
+
+	s := server.NewServerV3(server.NewServer(config, &callbacks{}), core_xds.NewApiVersionTracker())
+	go func() {
+		if err := s.StreamAggregatedResources(resp); err != nil {
+			t.Errorf("StreamAggregatedResources() => got %v, want no error", err)
+		}
+	}()
+
+	typeURLs := map[string]string{}
+	for len(typeURLs) < 2 {
+		select {
+		case out := <-resp.sent:
+			for _, res := range out.Resources {
+				if res.TypeUrl != out.TypeUrl {
+					t.Errorf("TypeUrl => got %q, want %q", res.TypeUrl, out.TypeUrl)
+				}
+			}
+			typeURLs[out.TypeUrl] = out.VersionInfo
+		case <-time.After(1 * time.Second):
+			t.Fatalf("got %d messages on the stream, not 2", len(typeURLs))
+		}
+	}
+	close(resp.recv)
+
+	if want := map[string]string{
+		envoy_resource_v3.ListenerType: "4",
+		envoy_resource.ClusterType:     "2",
+	}; !reflect.DeepEqual(want, typeURLs) {
+		t.Errorf("responses => got %v, want %v", typeURLs, want)
+	}
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"errors"
+
+	envoy_discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v2"
+	gcp_server "github.com/envoyproxy/go-control-plane/pkg/server/v2"
+
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	util_xds_v3 "github.com/Kong/kuma/pkg/util/xds/v3"
+)
+
+// NewServerV3 exposes a given xDS server over Envoy xDS v3 transport.
+//
+// xDS v3 streams are served by the same xDS server as xDS v2 streams,
+// which is why Envoy proxies of different versions can be connected to the Control Plane at the same time.
+// Resources that are served in xDS v3 are recorded in a given tracker.
+func NewServerV3(server gcp_server.Server, apiVersions core_xds.ApiVersionTracker) envoy_discovery_v3.AggregatedDiscoveryServiceServer {
+	return &serverV3{server: server, apiVersions: apiVersions}
+}
+
+type serverV3 struct {
+	server      gcp_server.Server
+	apiVersions core_xds.ApiVersionTracker
+}
+
+func (s *serverV3) StreamAggregatedResources(stream envoy_discovery_v3.AggregatedDiscoveryService_StreamAggregatedResourcesServer) error {
+	tracked := &apiVersionTrackingStream{
+		AggregatedDiscoveryService_StreamAggregatedResourcesServer: stream,
+		apiVersions: s.apiVersions,
+	}
+	defer s.apiVersions.Forget(tracked)
+	return s.server.StreamAggregatedResources(util_xds_v3.AdaptStream(tracked, envoy_resource.AnyType))
+}
+
+func (s *serverV3) DeltaAggregatedResources(_ envoy_discovery_v3.AggregatedDiscoveryService_DeltaAggregatedResourcesServer) error {
+	return errors.New("not implemented")
+}
+
+// apiVersionTrackingStream records resource types Envoy requests in xDS v3.
+type apiVersionTrackingStream struct {
+	envoy_discovery_v3.AggregatedDiscoveryService_StreamAggregatedResourcesServer
+	apiVersions core_xds.ApiVersionTracker
+
+	// requests on a single stream are received sequentially, so there is no need to lock
+	proxyId *core_xds.ProxyId
+}
+
+func (s *apiVersionTrackingStream) Recv() (*envoy_discovery_v3.DiscoveryRequest, error) {
+	req, err := s.AggregatedDiscoveryService_StreamAggregatedResourcesServer.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if s.proxyId == nil && req.Node != nil {
+		// it's up to xDS callbacks to reject a stream with invalid node identifier
+		s.proxyId, _ = core_xds.ParseProxyIdFromString(req.Node.Id)
+	}
+	if typeURL, isV3 := util_xds_v3.ToV2TypeURL(req.TypeUrl); isV3 && s.proxyId != nil {
+		s.apiVersions.TrackV3(s, *s.proxyId, typeURL)
+	}
+	return req, nil
+}
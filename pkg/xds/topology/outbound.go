@@ -30,6 +30,10 @@ func BuildEndpointMap(destinations core_xds.DestinationMap, dataplanes []*mesh_c
 	outbound := core_xds.EndpointMap{}
 	for _, dataplane := range dataplanes {
 		for i, inbound := range dataplane.Spec.Networking.GetInbound() {
+			if !inbound.IsReady() {
+				// e.g. a dataplane that is draining
+				continue
+			}
 			service := inbound.Tags[mesh_proto.ServiceTag]
 			selectors, ok := destinations[service]
 			if !ok {
@@ -315,6 +315,48 @@ var _ = Describe("TrafficRoute", func() {
 					},
 				},
 			}),
+			Entry("dataplane that is not ready", testCase{
+				destinations: core_xds.DestinationMap{
+					"redis": []mesh_proto.TagSelector{{"service": "redis"}},
+				},
+				dataplanes: []*mesh_core.DataplaneResource{
+					{
+						Spec: mesh_proto.Dataplane{
+							Networking: &mesh_proto.Dataplane_Networking{
+								Address: "192.168.0.1",
+								Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+									{
+										Tags:        map[string]string{"service": "redis", "version": "v1"},
+										Port:        6379,
+										ServicePort: 16379,
+										Health:      &mesh_proto.Dataplane_Networking_Inbound_Health{Ready: true},
+									},
+								},
+							},
+						},
+					},
+					{
+						Spec: mesh_proto.Dataplane{
+							Networking: &mesh_proto.Dataplane_Networking{
+								Address: "192.168.0.2",
+								Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+									{
+										Tags:        map[string]string{"service": "redis", "version": "v2"},
+										Port:        6379,
+										ServicePort: 26379,
+										Health:      &mesh_proto.Dataplane_Networking_Inbound_Health{Ready: false},
+									},
+								},
+							},
+						},
+					},
+				},
+				expected: core_xds.EndpointMap{
+					"redis": []core_xds.Endpoint{
+						{Target: "192.168.0.1", Port: 6379, Tags: map[string]string{"service": "redis", "version": "v1"}},
+					},
+				},
+			}),
 			Entry("destination with multiple selectors", testCase{
 				destinations: core_xds.DestinationMap{
 					"redis": []mesh_proto.TagSelector{